@@ -78,6 +78,15 @@ func runInstallCmd() (err error) {
 		if config.Machine().Install().LegacyBIOSSupport() {
 			options.LegacyBIOSSupport = true
 		}
+
+		ephemeral := config.Machine().Install().Ephemeral()
+
+		if options.EphemeralDisk, err = ephemeral.Disk(); err != nil {
+			return fmt.Errorf("error looking up the EPHEMERAL disk: %w", err)
+		}
+
+		options.EphemeralMinSize = ephemeral.MinSize()
+		options.EphemeralMaxSize = ephemeral.MaxSize()
 	}
 
 	return install.Install(p, seq, options)
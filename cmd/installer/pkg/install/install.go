@@ -36,6 +36,9 @@ type Options struct {
 	Force             bool
 	Zero              bool
 	LegacyBIOSSupport bool
+	EphemeralDisk     string
+	EphemeralMinSize  uint64
+	EphemeralMaxSize  uint64
 }
 
 // Install installs Talos.
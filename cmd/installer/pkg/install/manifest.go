@@ -41,6 +41,10 @@ type Device struct {
 	ResetPartitionTable bool
 	Zero                bool
 
+	// PreserveOtherPartitions keeps the partitions which are not manifest targets (e.g. user volumes),
+	// and the partitions are created after the existing ones.
+	PreserveOtherPartitions bool
+
 	SkipOverlayMountsCheck bool
 }
 
@@ -105,6 +109,9 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		ResetPartitionTable: opts.Force,
 		Zero:                opts.Zero,
 
+		// when upgrading with preserve, keep the user volumes on the install disk
+		PreserveOtherPartitions: !opts.Force,
+
 		SkipOverlayMountsCheck: skipOverlayMountsCheck,
 	}
 
@@ -147,12 +154,38 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		},
 	})
 
-	ephemeralTarget := EphemeralTarget(opts.Disk, NoFilesystem)
+	var ephemeralTarget *Target
+
+	// when upgrading with preserve, EPHEMERAL is left as is on whichever disk it is placed
+	if opts.Force {
+		ephemeralDisk := opts.Disk
+
+		if opts.EphemeralDisk != "" && opts.EphemeralDisk != opts.Disk {
+			ephemeralDisk = opts.EphemeralDisk
+
+			// EPHEMERAL is added to the partitions of the separate disk, the disk is never wiped
+			manifest.Devices[ephemeralDisk] = Device{
+				Device: ephemeralDisk,
+
+				PreserveOtherPartitions: true,
+
+				SkipOverlayMountsCheck: skipOverlayMountsCheck,
+			}
+		}
+
+		ephemeralTarget = EphemeralTarget(ephemeralDisk, NoFilesystem)
+		ephemeralTarget.MinSize = opts.EphemeralMinSize
+		ephemeralTarget.MaxSize = opts.EphemeralMaxSize
+	}
 
 	targets := []*Target{efiTarget, biosTarget, bootTarget, metaTarget, stateTarget, ephemeralTarget}
 
 	if !opts.Force {
 		for _, target := range targets {
+			if target == nil {
+				continue
+			}
+
 			target.Force = false
 			target.Skip = true
 		}
@@ -270,6 +303,9 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 
 	created := false
 
+	// positions of the partitions to be recreated among the preserved partitions
+	positions := map[string]int{}
+
 	pt, err = bd.PartitionTable()
 	if err != nil {
 		if !errors.Is(err, blockdevice.ErrMissingPartitionTable) {
@@ -322,10 +358,13 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 		} else {
 			// clean up partitions which are going to be recreated
 			keepPartitions := map[string]struct{}{}
+			recreatePartitions := map[string]struct{}{}
 
 			for _, target := range targets {
 				if target.Skip {
 					keepPartitions[target.Label] = struct{}{}
+				} else {
+					recreatePartitions[target.Label] = struct{}{}
 				}
 			}
 
@@ -344,14 +383,30 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 				return fmt.Errorf("some partitions to be skipped are missing: %v", missingPartitions)
 			}
 
-			// delete all partitions which are not skipped
+			// delete all partitions which are not skipped, or only the partitions to be recreated if other partitions are preserved
+			kept := 0
+
 			for _, part := range pt.Partitions().Items() {
-				if _, ok := keepPartitions[part.Name]; !ok {
-					log.Printf("deleting partition %s", part.Name)
+				if _, ok := keepPartitions[part.Name]; ok {
+					kept++
+
+					continue
+				}
 
-					if err = pt.Delete(part); err != nil {
-						return err
+				if device.PreserveOtherPartitions {
+					if _, ok := recreatePartitions[part.Name]; !ok {
+						kept++
+
+						continue
 					}
+
+					positions[part.Name] = kept
+				}
+
+				log.Printf("deleting partition %s", part.Name)
+
+				if err = pt.Delete(part); err != nil {
+					return err
 				}
 			}
 
@@ -367,7 +422,18 @@ func (m *Manifest) executeOnDevice(device Device, targets []*Target) (err error)
 	}
 
 	for i, target := range targets {
-		if err = target.Partition(pt, i, bd); err != nil {
+		pos := i
+
+		if device.PreserveOtherPartitions {
+			// recreate the partition in the same place, or append it to the existing partitions
+			var ok bool
+
+			if pos, ok = positions[target.Label]; !ok {
+				pos = len(pt.Partitions().Items())
+			}
+		}
+
+		if err = target.Partition(pt, pos, bd); err != nil {
 			return fmt.Errorf("failed to partition device: %w", err)
 		}
 	}
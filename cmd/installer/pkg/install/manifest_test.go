@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/loopback"
+	"github.com/talos-systems/go-blockdevice/blockdevice/partition/gpt"
 
 	"github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
@@ -34,12 +35,18 @@ type manifestSuite struct {
 
 	disk           *os.File
 	loopbackDevice *os.File
+
+	secondDisk           *os.File
+	secondLoopbackDevice *os.File
 }
 
 const (
 	diskSize    = 4 * 1024 * 1024 * 1024 // 4 GiB
 	lbaSize     = 512
 	gptReserved = 67
+
+	userPartitionSize = 256 * 1024 * 1024  // 256 MiB
+	ephemeralSize     = 1024 * 1024 * 1024 // 1 GiB
 )
 
 func TestManifestSuite(t *testing.T) {
@@ -75,6 +82,107 @@ func (suite *manifestSuite) TearDownTest() {
 		suite.Assert().NoError(os.Remove(suite.disk.Name()))
 		suite.Assert().NoError(suite.disk.Close())
 	}
+
+	if suite.secondLoopbackDevice != nil {
+		suite.Assert().NoError(loopback.Unloop(suite.secondLoopbackDevice))
+
+		suite.secondLoopbackDevice = nil
+	}
+
+	if suite.secondDisk != nil {
+		suite.Assert().NoError(os.Remove(suite.secondDisk.Name()))
+		suite.Assert().NoError(suite.secondDisk.Close())
+
+		suite.secondDisk = nil
+	}
+}
+
+// setupSecondDisk attaches the second loopback device, e.g. to hold the EPHEMERAL partition.
+func (suite *manifestSuite) setupSecondDisk() {
+	var err error
+
+	suite.secondDisk, err = ioutil.TempFile("", "talos")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.secondDisk.Truncate(diskSize))
+
+	suite.secondLoopbackDevice, err = loopback.NextLoopDevice()
+	suite.Require().NoError(err)
+
+	suite.T().Logf("Using %s as the second disk", suite.secondLoopbackDevice.Name())
+
+	suite.Require().NoError(loopback.Loop(suite.secondLoopbackDevice, suite.secondDisk))
+
+	suite.Require().NoError(loopback.LoopSetReadWrite(suite.secondLoopbackDevice))
+}
+
+// skipOverlayMountsCheck disables the overlay mounts check for all manifest devices, as overlay mounts should be ignored in the tests.
+func (suite *manifestSuite) skipOverlayMountsCheck(manifest *install.Manifest) {
+	for name, dev := range manifest.Devices {
+		dev.SkipOverlayMountsCheck = true
+		manifest.Devices[name] = dev
+	}
+}
+
+// appendUserPartition appends the partition to the disk (creating the partition table if it is missing),
+// and writes the label to the beginning of the partition to verify that the partition contents survive.
+func (suite *manifestSuite) appendUserPartition(devname, label string) {
+	bd, err := blockdevice.Open(devname, blockdevice.WithExclusiveLock(true))
+	suite.Require().NoError(err)
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		suite.Require().ErrorIs(err, blockdevice.ErrMissingPartitionTable)
+
+		pt, err = gpt.New(bd.Device())
+		suite.Require().NoError(err)
+	}
+
+	part, err := pt.InsertAt(len(pt.Partitions().Items()), userPartitionSize, gpt.WithPartitionName(label))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pt.Write())
+
+	_, err = bd.Device().WriteAt([]byte(label), int64(part.FirstLBA)*lbaSize)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(bd.Device().Sync())
+	suite.Require().NoError(bd.Close())
+}
+
+// partitions returns the partitions of the disk.
+func (suite *manifestSuite) partitions(devname string) []*gpt.Partition {
+	bd, err := blockdevice.Open(devname)
+	suite.Require().NoError(err)
+
+	defer bd.Close() //nolint:errcheck
+
+	table, err := bd.PartitionTable()
+	suite.Require().NoError(err)
+
+	return table.Partitions().Items()
+}
+
+// assertUserPartition verifies that the user partition is kept in place along with its contents.
+func (suite *manifestSuite) assertUserPartition(devname string, expected, actual *gpt.Partition) {
+	suite.Assert().Equal(expected.Name, actual.Name)
+	suite.Assert().Equal(expected.ID, actual.ID)
+	suite.Assert().Equal(expected.FirstLBA, actual.FirstLBA)
+	suite.Assert().Equal(expected.LastLBA, actual.LastLBA)
+
+	f, err := os.Open(devname)
+	suite.Require().NoError(err)
+
+	defer f.Close() //nolint:errcheck
+
+	buf := make([]byte, len(expected.Name))
+
+	_, err = f.ReadAt(buf, int64(actual.FirstLBA)*lbaSize)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(expected.Name, string(buf))
 }
 
 func (suite *manifestSuite) skipUnderBuildkit() {
@@ -324,6 +432,234 @@ func (suite *manifestSuite) TestExecuteManifestPreserve() {
 	suite.verifyBlockdevice(manifest, "A", "B", true, true)
 }
 
+func (suite *manifestSuite) TestExecuteManifestEphemeralMaxSize() {
+	suite.skipUnderBuildkit()
+
+	const ephemeralMaxSize = 1024 * 1024 * 1024 // 1 GiB
+
+	manifest, err := install.NewManifest("A", runtime.SequenceInstall, false, &install.Options{
+		Disk:             suite.loopbackDevice.Name(),
+		Bootloader:       true,
+		Force:            true,
+		Board:            constants.BoardNone,
+		EphemeralMaxSize: ephemeralMaxSize,
+	})
+	suite.Require().NoError(err)
+
+	// in the tests overlay mounts should be ignored
+	dev := manifest.Devices[suite.loopbackDevice.Name()]
+	dev.SkipOverlayMountsCheck = true
+	manifest.Devices[suite.loopbackDevice.Name()] = dev
+
+	suite.Assert().NoError(manifest.Execute())
+
+	bd, err := blockdevice.Open(suite.loopbackDevice.Name())
+	suite.Require().NoError(err)
+
+	defer bd.Close() //nolint:errcheck
+
+	table, err := bd.PartitionTable()
+	suite.Require().NoError(err)
+
+	suite.Require().Len(table.Partitions().Items(), 6)
+
+	part := table.Partitions().Items()[5]
+	suite.Assert().Equal(constants.EphemeralPartitionLabel, part.Name)
+	suite.Assert().EqualValues(ephemeralMaxSize/lbaSize, part.Length())
+}
+
+func (suite *manifestSuite) TestExecuteManifestEphemeralMinSize() {
+	suite.skipUnderBuildkit()
+
+	manifest, err := install.NewManifest("A", runtime.SequenceInstall, false, &install.Options{
+		Disk:             suite.loopbackDevice.Name(),
+		Bootloader:       true,
+		Force:            true,
+		Board:            constants.BoardNone,
+		EphemeralMinSize: diskSize,
+	})
+	suite.Require().NoError(err)
+
+	// in the tests overlay mounts should be ignored
+	dev := manifest.Devices[suite.loopbackDevice.Name()]
+	dev.SkipOverlayMountsCheck = true
+	manifest.Devices[suite.loopbackDevice.Name()] = dev
+
+	err = manifest.Execute()
+	suite.Require().Error(err)
+	suite.Assert().Contains(err.Error(), "not enough free space for EPHEMERAL partition")
+}
+
+func (suite *manifestSuite) TestExecuteManifestEphemeralSecondDisk() {
+	suite.skipUnderBuildkit()
+
+	suite.setupSecondDisk()
+
+	// the second disk already has a partition which should be kept
+	suite.appendUserPartition(suite.secondLoopbackDevice.Name(), "u-data")
+
+	userData := suite.partitions(suite.secondLoopbackDevice.Name())[0]
+
+	manifest, err := install.NewManifest("A", runtime.SequenceInstall, false, &install.Options{
+		Disk:             suite.loopbackDevice.Name(),
+		Bootloader:       true,
+		Force:            true,
+		Board:            constants.BoardNone,
+		EphemeralDisk:    suite.secondLoopbackDevice.Name(),
+		EphemeralMaxSize: ephemeralSize,
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(manifest.Devices, 2)
+	suite.Assert().True(manifest.Devices[suite.loopbackDevice.Name()].ResetPartitionTable)
+	suite.Assert().False(manifest.Devices[suite.secondLoopbackDevice.Name()].ResetPartitionTable)
+	suite.Assert().True(manifest.Devices[suite.secondLoopbackDevice.Name()].PreserveOtherPartitions)
+
+	suite.skipOverlayMountsCheck(manifest)
+
+	suite.Require().NoError(manifest.Execute())
+
+	// the install disk has all system partitions but EPHEMERAL
+	parts := suite.partitions(suite.loopbackDevice.Name())
+	suite.Require().Len(parts, 5)
+	suite.Assert().Equal(constants.StatePartitionLabel, parts[4].Name)
+
+	// EPHEMERAL is appended to the existing partitions of the second disk
+	parts = suite.partitions(suite.secondLoopbackDevice.Name())
+	suite.Require().Len(parts, 2)
+
+	suite.assertUserPartition(suite.secondLoopbackDevice.Name(), userData, parts[0])
+
+	ephemeral := parts[1]
+	suite.Assert().Equal(constants.EphemeralPartitionLabel, ephemeral.Name)
+	suite.Assert().EqualValues(ephemeralSize/lbaSize, ephemeral.Length())
+
+	// another partition is added after EPHEMERAL
+	suite.appendUserPartition(suite.secondLoopbackDevice.Name(), "u-logs")
+
+	userLogs := suite.partitions(suite.secondLoopbackDevice.Name())[2]
+
+	// reinstall
+
+	manifest, err = install.NewManifest("B", runtime.SequenceUpgrade, true, &install.Options{
+		Disk:             suite.loopbackDevice.Name(),
+		Bootloader:       true,
+		Force:            true,
+		Board:            constants.BoardNone,
+		EphemeralDisk:    suite.secondLoopbackDevice.Name(),
+		EphemeralMaxSize: ephemeralSize,
+	})
+	suite.Require().NoError(err)
+
+	suite.skipOverlayMountsCheck(manifest)
+
+	suite.Require().NoError(manifest.Execute())
+
+	// EPHEMERAL is recreated in place between the preserved partitions
+	parts = suite.partitions(suite.secondLoopbackDevice.Name())
+	suite.Require().Len(parts, 3)
+
+	suite.assertUserPartition(suite.secondLoopbackDevice.Name(), userData, parts[0])
+
+	suite.Assert().Equal(constants.EphemeralPartitionLabel, parts[1].Name)
+	suite.Assert().Equal(ephemeral.FirstLBA, parts[1].FirstLBA)
+	suite.Assert().EqualValues(ephemeralSize/lbaSize, parts[1].Length())
+	suite.Assert().NotEqual(ephemeral.ID, parts[1].ID)
+
+	suite.assertUserPartition(suite.secondLoopbackDevice.Name(), userLogs, parts[2])
+}
+
+func (suite *manifestSuite) TestExecuteManifestEphemeralCreate() {
+	suite.skipUnderBuildkit()
+
+	suite.setupSecondDisk()
+
+	suite.appendUserPartition(suite.secondLoopbackDevice.Name(), "u-data")
+	suite.appendUserPartition(suite.secondLoopbackDevice.Name(), "u-logs")
+
+	existing := suite.partitions(suite.secondLoopbackDevice.Name())
+
+	// the manifest used to create the missing EPHEMERAL partition on boot
+	target := install.EphemeralTarget(suite.secondLoopbackDevice.Name(), install.NoFilesystem)
+
+	manifest := &install.Manifest{
+		Devices: map[string]install.Device{
+			suite.secondLoopbackDevice.Name(): {
+				Device:                  suite.secondLoopbackDevice.Name(),
+				PreserveOtherPartitions: true,
+			},
+		},
+		Targets: map[string][]*install.Target{
+			suite.secondLoopbackDevice.Name(): {target},
+		},
+	}
+
+	suite.skipOverlayMountsCheck(manifest)
+
+	suite.Require().NoError(manifest.Execute())
+
+	parts := suite.partitions(suite.secondLoopbackDevice.Name())
+	suite.Require().Len(parts, 3)
+
+	suite.assertUserPartition(suite.secondLoopbackDevice.Name(), existing[0], parts[0])
+	suite.assertUserPartition(suite.secondLoopbackDevice.Name(), existing[1], parts[1])
+
+	// EPHEMERAL occupies the rest of the disk
+	suite.Assert().Equal(constants.EphemeralPartitionLabel, parts[2].Name)
+	suite.Assert().EqualValues((diskSize-2*userPartitionSize)/lbaSize-gptReserved, parts[2].Length())
+}
+
+func (suite *manifestSuite) TestExecuteManifestPreserveOtherPartitions() {
+	suite.skipUnderBuildkit()
+
+	manifest, err := install.NewManifest("A", runtime.SequenceInstall, false, &install.Options{
+		Disk:             suite.loopbackDevice.Name(),
+		Bootloader:       true,
+		Force:            true,
+		Board:            constants.BoardNone,
+		EphemeralMaxSize: ephemeralSize,
+	})
+	suite.Require().NoError(err)
+
+	suite.skipOverlayMountsCheck(manifest)
+
+	suite.Require().NoError(manifest.Execute())
+
+	// the user volume is added after EPHEMERAL on the install disk
+	suite.appendUserPartition(suite.loopbackDevice.Name(), "u-data")
+
+	existing := suite.partitions(suite.loopbackDevice.Name())
+	suite.Require().Len(existing, 7)
+
+	// upgrade with preserve
+
+	manifest, err = install.NewManifest("B", runtime.SequenceUpgrade, true, &install.Options{
+		Disk:       suite.loopbackDevice.Name(),
+		Bootloader: true,
+		Force:      false,
+		Board:      constants.BoardNone,
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().True(manifest.Devices[suite.loopbackDevice.Name()].PreserveOtherPartitions)
+
+	suite.skipOverlayMountsCheck(manifest)
+
+	suite.Require().NoError(manifest.Execute())
+
+	parts := suite.partitions(suite.loopbackDevice.Name())
+	suite.Require().Len(parts, 7)
+
+	for i := range existing {
+		suite.Assert().Equal(existing[i].Name, parts[i].Name)
+		suite.Assert().Equal(existing[i].ID, parts[i].ID)
+		suite.Assert().Equal(existing[i].FirstLBA, parts[i].FirstLBA)
+		suite.Assert().Equal(existing[i].LastLBA, parts[i].LastLBA)
+	}
+
+	suite.assertUserPartition(suite.loopbackDevice.Name(), existing[6], parts[6])
+}
+
 func (suite *manifestSuite) TestTargetInstall() {
 	// Create Temp dirname for mountpoint
 	dir, err := ioutil.TempDir("", "talostest")
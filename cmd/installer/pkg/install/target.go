@@ -50,6 +50,12 @@ type Target struct {
 	// Skipped partitions should exist on the disk by the time manifest execution starts.
 	Skip bool
 
+	// Size limits for the partition which occupies the rest of the disk (Size is zero).
+	//
+	// MaxSize is ignored if it's zero.
+	MinSize uint64
+	MaxSize uint64
+
 	// set during execution
 	PartitionName string
 	Contents      *bytes.Buffer
@@ -198,15 +204,18 @@ func (t *Target) Partition(pt *gpt.GPT, pos int, bd *blockdevice.BlockDevice) (e
 		gpt.WithPartitionName(t.Label),
 	}
 
-	if t.Size == 0 {
-		opts = append(opts, gpt.WithMaximumSize(true))
-	}
-
 	if t.LegacyBIOSBootable {
 		opts = append(opts, gpt.WithLegacyBIOSBootableAttribute(true))
 	}
 
-	part, err := pt.InsertAt(pos, t.Size, opts...)
+	var part *gpt.Partition
+
+	if t.Size == 0 {
+		part, err = t.partitionRestOfDisk(pt, pos, opts)
+	} else {
+		part, err = pt.InsertAt(pos, t.Size, opts...)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -221,6 +230,31 @@ func (t *Target) Partition(pt *gpt.GPT, pos int, bd *blockdevice.BlockDevice) (e
 	return nil
 }
 
+// partitionRestOfDisk creates the partition occupying the rest of the disk,
+// verifies that it satisfies MinSize, and shrinks it down to MaxSize.
+func (t *Target) partitionRestOfDisk(pt *gpt.GPT, pos int, opts []gpt.PartitionOption) (*gpt.Partition, error) {
+	part, err := pt.InsertAt(pos, 0, append(opts, gpt.WithMaximumSize(true))...)
+	if err != nil {
+		return nil, err
+	}
+
+	size := uint64(part.Length()) * uint64(pt.Header().LBA.LogicalBlockSize)
+
+	if size < t.MinSize {
+		return nil, fmt.Errorf("not enough free space for %s partition on %s: %s available, %s required", t.Label, t.Device, humanize.Bytes(size), humanize.Bytes(t.MinSize))
+	}
+
+	if t.MaxSize == 0 || size <= t.MaxSize {
+		return part, nil
+	}
+
+	if err = pt.Delete(part); err != nil {
+		return nil, err
+	}
+
+	return pt.InsertAt(pos, t.MaxSize, opts...)
+}
+
 // Format creates a filesystem on the device/partition.
 func (t *Target) Format() error {
 	if t.Skip {
@@ -24,7 +24,13 @@ func VerifyEphemeralPartition(opts *Options) (err error) {
 		return nil
 	}
 
-	if err = VerifyDiskAvailability(opts.Disk, constants.EphemeralPartitionLabel); err != nil {
+	disk := opts.Disk
+
+	if opts.EphemeralDisk != "" {
+		disk = opts.EphemeralDisk
+	}
+
+	if err = VerifyDiskAvailability(disk, constants.EphemeralPartitionLabel); err != nil {
 		return fmt.Errorf("failed to verify disk availability: %w", err)
 	}
 
@@ -12,7 +12,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/spf13/cobra"
 	talosnet "github.com/talos-systems/net"
 	yaml "gopkg.in/yaml.v3"
@@ -23,6 +22,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/network"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/bundle"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
@@ -48,6 +48,8 @@ var genConfigCmdFlags struct {
 	withDocs                bool
 	withClusterDiscovery    bool
 	withKubeSpan            bool
+	withHostFirewall        bool
+	hostFirewallSubnets     []string
 }
 
 // genConfigCmd represents the `gen config` command.
@@ -124,6 +126,7 @@ func GenV1Alpha1Config(genOptions []generate.GenOption,
 	configPatch []string,
 	configPatchControlPlane []string,
 	configPatchWorker []string,
+	extraOpts ...bundle.Option,
 ) (*v1alpha1.ConfigBundle, error) {
 	configBundleOpts := []bundle.Option{
 		bundle.WithInputOptions(
@@ -136,26 +139,28 @@ func GenV1Alpha1Config(genOptions []generate.GenOption,
 		),
 	}
 
-	addConfigPatch := func(configPatches []string, configOpt func(jsonpatch.Patch) bundle.Option) error {
-		jsonPatch, err := configpatcher.LoadPatches(configPatches)
+	addConfigPatch := func(configPatches []string, configOpt func([]configpatcher.Patch) bundle.Option) error {
+		patches, err := configpatcher.LoadPatches(configPatches)
 		if err != nil {
-			return fmt.Errorf("error parsing config JSON patch: %w", err)
+			return fmt.Errorf("error parsing config patch: %w", err)
 		}
 
-		configBundleOpts = append(configBundleOpts, configOpt(jsonPatch))
+		configBundleOpts = append(configBundleOpts, configOpt(patches))
 
 		return nil
 	}
 
-	if err := addConfigPatch(configPatch, bundle.WithJSONPatch); err != nil {
+	configBundleOpts = append(configBundleOpts, extraOpts...)
+
+	if err := addConfigPatch(configPatch, bundle.WithPatch); err != nil {
 		return nil, err
 	}
 
-	if err := addConfigPatch(configPatchControlPlane, bundle.WithJSONPatchControlPlane); err != nil {
+	if err := addConfigPatch(configPatchControlPlane, bundle.WithPatchControlPlane); err != nil {
 		return nil, err
 	}
 
-	if err := addConfigPatch(configPatchWorker, bundle.WithJSONPatchWorker); err != nil {
+	if err := addConfigPatch(configPatchWorker, bundle.WithPatchWorker); err != nil {
 		return nil, err
 	}
 
@@ -234,6 +239,12 @@ func writeV1Alpha1Config(args []string) error {
 		commentsFlags |= encoder.CommentsExamples
 	}
 
+	var bundleOptions []bundle.Option
+
+	if genConfigCmdFlags.withHostFirewall {
+		bundleOptions = append(bundleOptions, bundle.WithHostFirewall(genConfigCmdFlags.hostFirewallSubnets))
+	}
+
 	configBundle, err := GenV1Alpha1Config(
 		genOptions,
 		args[0],
@@ -241,7 +252,8 @@ func writeV1Alpha1Config(args []string) error {
 		genConfigCmdFlags.kubernetesVersion,
 		genConfigCmdFlags.configPatch,
 		genConfigCmdFlags.configPatchControlPlane,
-		genConfigCmdFlags.configPatchWorker)
+		genConfigCmdFlags.configPatchWorker,
+		bundleOptions...)
 	if err != nil {
 		return err
 	}
@@ -284,6 +296,8 @@ func init() {
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withDocs, "with-docs", "", true, "renders all machine configs adding the documentation for each field")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withClusterDiscovery, "with-cluster-discovery", "", true, "enable cluster discovery feature")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withKubeSpan, "with-kubespan", "", false, "enable KubeSpan feature")
+	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withHostFirewall, "with-host-firewall", "", false, "enable host firewall with the default ingress rules")
+	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.hostFirewallSubnets, "host-firewall-subnets", network.DefaultClusterSubnets, "subnets allowed to access the Talos API, Kubernetes API and cluster services when the host firewall is enabled")
 
 	gen.Cmd.AddCommand(genConfigCmd)
 }
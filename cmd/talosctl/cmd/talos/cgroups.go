@@ -0,0 +1,305 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var cgroupsCmdFlags struct {
+	preset    string
+	processes bool
+}
+
+// cgroupsPreset is a set of columns displayed for each cgroup.
+type cgroupsPreset struct {
+	header []string
+	values func(info *machineapi.CgroupInfo) []string
+}
+
+var cgroupsPresets = map[string]cgroupsPreset{
+	"cpu": {
+		header: []string{"CPU WEIGHT", "CPU MAX", "USER", "SYSTEM", "THROTTLED", "THROTTLED TIME"},
+		values: func(info *machineapi.CgroupInfo) []string {
+			cpu := info.Cpu
+			if cpu == nil {
+				return nil
+			}
+
+			weight := "-"
+			if cpu.Weight != 0 {
+				weight = strconv.FormatUint(cpu.Weight, 10)
+			}
+
+			limit := "max"
+			if cpu.MaxQuota != math.MaxUint64 && cpu.MaxPeriod != 0 {
+				limit = fmt.Sprintf("%.2f", float64(cpu.MaxQuota)/float64(cpu.MaxPeriod))
+			}
+
+			return []string{
+				weight,
+				limit,
+				cgroupsDuration(cpu.UserUsec),
+				cgroupsDuration(cpu.SystemUsec),
+				strconv.FormatUint(cpu.NrThrottled, 10),
+				cgroupsDuration(cpu.ThrottledUsec),
+			}
+		},
+	},
+	"memory": {
+		header: []string{"CURRENT", "MIN", "LOW", "HIGH", "MAX", "ANON", "FILE", "OOM KILLS"},
+		values: func(info *machineapi.CgroupInfo) []string {
+			memory := info.Memory
+			if memory == nil {
+				return nil
+			}
+
+			return []string{
+				cgroupsBytes(memory.Current),
+				cgroupsBytes(memory.Min),
+				cgroupsBytes(memory.Low),
+				cgroupsBytes(memory.High),
+				cgroupsBytes(memory.Max),
+				cgroupsBytes(memory.Anon),
+				cgroupsBytes(memory.File),
+				strconv.FormatUint(memory.OomKill, 10),
+			}
+		},
+	},
+	"io": {
+		header: []string{"DEVICES", "READ", "WRITE", "READ IOS", "WRITE IOS"},
+		values: func(info *machineapi.CgroupInfo) []string {
+			if len(info.Io) == 0 {
+				return nil
+			}
+
+			var (
+				devices                    []string
+				rbytes, wbytes, rios, wios uint64
+			)
+
+			for _, stat := range info.Io {
+				device := stat.DeviceName
+				if device == "" {
+					device = stat.Device
+				}
+
+				devices = append(devices, device)
+
+				rbytes += stat.Rbytes
+				wbytes += stat.Wbytes
+				rios += stat.Rios
+				wios += stat.Wios
+			}
+
+			return []string{
+				strings.Join(devices, ","),
+				humanize.IBytes(rbytes),
+				humanize.IBytes(wbytes),
+				strconv.FormatUint(rios, 10),
+				strconv.FormatUint(wios, 10),
+			}
+		},
+	},
+	"psi": {
+		header: []string{"CPU SOME", "CPU FULL", "MEMORY SOME", "MEMORY FULL", "IO SOME", "IO FULL"},
+		values: func(info *machineapi.CgroupInfo) []string {
+			var values []string
+
+			for _, pressure := range []*machineapi.CgroupPressure{info.CpuPressure, info.MemoryPressure, info.IoPressure} {
+				values = append(values, cgroupsPressure(pressure.GetSome()), cgroupsPressure(pressure.GetFull()))
+			}
+
+			return values
+		},
+	},
+}
+
+// cgroupsCmd represents the cgroups command.
+var cgroupsCmd = &cobra.Command{
+	Use:   "cgroups [<path>]",
+	Short: "Show the cgroup hierarchy with the resource usage",
+	Long: `Show the cgroup hierarchy with the resource usage.
+
+The columns are selected with the preset:
+
+  cpu      CPU weight, limit (in CPUs), usage and throttling
+  memory   memory usage, protection and limits
+  io       block device I/O usage summed over the devices
+  psi      pressure stall information (avg10 avg60 avg300, in percent)`,
+	Example: `  talosctl cgroups --preset cpu
+  talosctl cgroups /system --processes`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		preset, ok := cgroupsPresets[cgroupsCmdFlags.preset]
+		if !ok {
+			return fmt.Errorf("unknown preset %q, supported presets: cpu, io, memory, psi", cgroupsCmdFlags.preset)
+		}
+
+		req := &machineapi.CgroupsRequest{}
+
+		if len(args) > 0 {
+			req.Path = args[0]
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Cgroups(ctx, req, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting cgroups: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return cgroupsRender(&remotePeer, resp, preset)
+		})
+	},
+}
+
+func cgroupsRender(remotePeer *peer.Peer, resp *machineapi.CgroupsResponse, preset cgroupsPreset) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, strings.Join(append([]string{"NODE", "NAME", "PROCS"}, preset.header...), "\t"))
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		children := map[string][]*machineapi.CgroupInfo{}
+		paths := map[string]struct{}{}
+
+		for _, info := range msg.Cgroups {
+			paths[info.Path] = struct{}{}
+		}
+
+		var roots []*machineapi.CgroupInfo
+
+		for _, info := range msg.Cgroups {
+			parent := path.Dir(info.Path)
+
+			if _, ok := paths[parent]; ok && info.Path != "/" {
+				children[parent] = append(children[parent], info)
+			} else {
+				roots = append(roots, info)
+			}
+		}
+
+		var walk func(info *machineapi.CgroupInfo, prefix, name, childPrefix string)
+
+		walk = func(info *machineapi.CgroupInfo, prefix, name, childPrefix string) {
+			values := preset.values(info)
+			if values == nil {
+				values = make([]string, len(preset.header))
+
+				for i := range values {
+					values[i] = "-"
+				}
+			}
+
+			row := append([]string{node, prefix + name, strconv.Itoa(len(info.Processes))}, values...)
+
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+
+			type entry struct {
+				name    string
+				cgroup  *machineapi.CgroupInfo
+				process string
+			}
+
+			var entries []entry
+
+			if cgroupsCmdFlags.processes {
+				for _, process := range info.Processes {
+					entries = append(entries, entry{process: fmt.Sprintf("%d %s", process.Pid, process.Command)})
+				}
+			}
+
+			sort.Slice(children[info.Path], func(i, j int) bool { return children[info.Path][i].Path < children[info.Path][j].Path })
+
+			for _, child := range children[info.Path] {
+				entries = append(entries, entry{name: path.Base(child.Path), cgroup: child})
+			}
+
+			for i, e := range entries {
+				branch, nextPrefix := "├── ", "│   "
+
+				if i == len(entries)-1 {
+					branch, nextPrefix = "└── ", "    "
+				}
+
+				if e.cgroup == nil {
+					// pad the row to keep the columns aligned
+					row := make([]string, 3+len(preset.header))
+					row[0], row[1] = node, childPrefix+branch+e.process
+
+					fmt.Fprintln(w, strings.Join(row, "\t"))
+
+					continue
+				}
+
+				walk(e.cgroup, childPrefix+branch, e.name, childPrefix+nextPrefix)
+			}
+		}
+
+		for _, root := range roots {
+			walk(root, "", root.Path, "")
+		}
+	}
+
+	return w.Flush()
+}
+
+func cgroupsBytes(v uint64) string {
+	if v == math.MaxUint64 {
+		return "max"
+	}
+
+	return humanize.IBytes(v)
+}
+
+func cgroupsDuration(usec uint64) string {
+	return (time.Duration(usec) * time.Microsecond).Round(time.Millisecond).String()
+}
+
+func cgroupsPressure(stat *machineapi.PressureStat) string {
+	if stat == nil {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.2f %.2f %.2f", stat.Avg10, stat.Avg60, stat.Avg300)
+}
+
+func init() {
+	cgroupsCmd.Flags().StringVar(&cgroupsCmdFlags.preset, "preset", "memory", "preset of the columns to display: cpu, io, memory, psi")
+	cgroupsCmd.Flags().BoolVar(&cgroupsCmdFlags.processes, "processes", false, "display the processes in each cgroup")
+
+	addCommand(cgroupsCmd)
+}
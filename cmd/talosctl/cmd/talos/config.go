@@ -30,6 +30,7 @@ import (
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
 	"github.com/talos-systems/talos/pkg/machinery/generic/maps"
 	"github.com/talos-systems/talos/pkg/machinery/role"
+	"github.com/talos-systems/talos/pkg/oidc"
 )
 
 // configCmd represents the config command.
@@ -291,10 +292,8 @@ var configNewCmd = &cobra.Command{
 				return err
 			}
 
-			roles, unknownRoles := role.Parse(configNewCmdFlags.roles)
-			if len(unknownRoles) != 0 {
-				return fmt.Errorf("unknown roles: %s", strings.Join(unknownRoles, ", "))
-			}
+			// roles unknown to talosctl might be custom roles defined in the machine config, they are validated by the node
+			roles, _ := role.Parse(configNewCmdFlags.roles)
 
 			if _, err := os.Stat(path); err == nil {
 				return fmt.Errorf("talosconfig file already exists: %q", path)
@@ -407,6 +406,103 @@ var configInfoCmd = &cobra.Command{
 	},
 }
 
+// configLoginCmdFlags represents the `config login` command flags.
+var configLoginCmdFlags struct {
+	issuerURL    string
+	clientID     string
+	scopes       []string
+	redirectPort int
+}
+
+// configLoginCmd represents the `config login` command.
+var configLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in to the current context with the OIDC provider",
+	Long: `Log in to the current context with the OIDC provider.
+
+The ID token is exchanged for the short-lived client certificate with the roles granted to the user groups.
+The certificate is refreshed automatically when it expires.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			c, err := openConfigAndContext(Cmdcontext)
+			if err != nil {
+				return err
+			}
+
+			contextName := c.Context
+			if Cmdcontext != "" {
+				contextName = Cmdcontext
+			}
+
+			configContext := c.Contexts[contextName]
+
+			oidcContext := &clientconfig.OIDCContext{
+				IssuerURL: configLoginCmdFlags.issuerURL,
+				ClientID:  configLoginCmdFlags.clientID,
+				Scopes:    configLoginCmdFlags.scopes,
+			}
+
+			// log in again with the settings of the previous login
+			if configContext.OIDC != nil {
+				if oidcContext.IssuerURL == "" {
+					oidcContext.IssuerURL = configContext.OIDC.IssuerURL
+				}
+
+				if oidcContext.ClientID == "" {
+					oidcContext.ClientID = configContext.OIDC.ClientID
+				}
+
+				if len(oidcContext.Scopes) == 0 {
+					oidcContext.Scopes = configContext.OIDC.Scopes
+				}
+			}
+
+			if oidcContext.IssuerURL == "" || oidcContext.ClientID == "" {
+				return fmt.Errorf("both --issuer-url and --client-id should be set")
+			}
+
+			login := &oidc.Login{
+				IssuerURL:    oidcContext.IssuerURL,
+				ClientID:     oidcContext.ClientID,
+				Scopes:       oidcContext.Scopes,
+				RedirectPort: configLoginCmdFlags.redirectPort,
+				OpenURL: func(url string) error {
+					fmt.Fprintf(os.Stderr, "Open the following URL in the browser to log in:\n\n  %s\n\n", url)
+
+					return nil
+				},
+			}
+
+			token, err := login.Run(ctx)
+			if err != nil {
+				return err
+			}
+
+			endpoints := configContext.Endpoints
+			if len(Endpoints) > 0 {
+				endpoints = Endpoints
+			}
+
+			resp, err := oidcExchange(ctx, configContext, endpoints, token.IDToken)
+			if err != nil {
+				return err
+			}
+
+			oidcContext.RefreshToken = token.RefreshToken
+			configContext.OIDC = oidcContext
+
+			if err = c.Save(Talosconfig); err != nil {
+				return fmt.Errorf("error writing config: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "Logged in with roles: %s\n", strings.Join(resp.Roles, ", "))
+
+			return nil
+		})
+	},
+}
+
 // CompleteConfigContext represents tab completion for `--context` argument and `config context` command.
 func CompleteConfigContext(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	c, err := clientconfig.Open(Talosconfig)
@@ -430,6 +526,7 @@ func init() {
 		configMergeCmd,
 		configNewCmd,
 		configInfoCmd,
+		configLoginCmd,
 	)
 
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.ca, "ca", "", "the path to the CA certificate")
@@ -439,8 +536,13 @@ func init() {
 	cli.Should(configAddCmd.MarkFlagRequired("crt"))
 	cli.Should(configAddCmd.MarkFlagRequired("key"))
 
-	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.roles, "roles", role.MakeSet(role.Admin).Strings(), "roles")
+	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.roles, "roles", role.MakeSet(role.Admin).Strings(), "roles (built-in or custom roles defined in the machine config)")
 	configNewCmd.Flags().DurationVar(&configNewCmdFlags.crtTTL, "crt-ttl", 87600*time.Hour, "certificate TTL")
 
+	configLoginCmd.Flags().StringVar(&configLoginCmdFlags.issuerURL, "issuer-url", "", "OIDC issuer URL (defaults to the one of the previous login)")
+	configLoginCmd.Flags().StringVar(&configLoginCmdFlags.clientID, "client-id", "", "OIDC client ID (defaults to the one of the previous login)")
+	configLoginCmd.Flags().StringSliceVar(&configLoginCmdFlags.scopes, "scopes", nil, "OIDC scopes to request (defaults to "+strings.Join(oidc.DefaultScopes, ",")+")")
+	configLoginCmd.Flags().IntVar(&configLoginCmdFlags.redirectPort, "redirect-port", 8000, "local port to receive the OIDC provider redirect on (http://localhost:<port>/callback)")
+
 	addCommand(configCmd)
 }
@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+var encryptionCmdFlags struct {
+	partition        string
+	slot             int
+	staticPassphrase string
+	nodeID           bool
+	kmsEndpoint      string
+}
+
+// encryptionCmd represents the encryption command.
+var encryptionCmd = &cobra.Command{
+	Use:   "encryption",
+	Short: "Manage system disk encryption keys",
+	Long: `Manage the encryption keys of the mounted STATE and EPHEMERAL partitions without a reboot.
+
+The key slot is changed on the partition first, and then the machine configuration is updated to match it.
+Use 'talosctl get encryption' to see the key slots of the partitions.`,
+}
+
+var encryptionAddKeyCmd = &cobra.Command{
+	Use:   "add-key",
+	Short: "Add the encryption key to the free key slot",
+	Long:  ``,
+	Example: `  talosctl encryption add-key --partition EPHEMERAL --slot 1 --static-passphrase secret
+  talosctl encryption add-key --partition STATE --slot 2 --kms-endpoint https://kms.example.com:4443`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateEncryptionKeys(machine.UpdateEncryptionKeysRequest_ADD)
+	},
+}
+
+var encryptionRemoveKeyCmd = &cobra.Command{
+	Use:     "remove-key",
+	Short:   "Remove the encryption key from the key slot",
+	Long:    `The last key slot of the partition can't be removed.`,
+	Example: `  talosctl encryption remove-key --partition EPHEMERAL --slot 0`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateEncryptionKeys(machine.UpdateEncryptionKeysRequest_REMOVE)
+	},
+}
+
+var encryptionRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Replace the encryption key in the key slot",
+	Long: `The new key is staged in a free key slot before the old key is removed,
+so the partition with a single key can be rotated as well.`,
+	Example: `  talosctl encryption rotate-key --partition EPHEMERAL --slot 0 --static-passphrase newsecret`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateEncryptionKeys(machine.UpdateEncryptionKeysRequest_ROTATE)
+	},
+}
+
+func updateEncryptionKeys(action machine.UpdateEncryptionKeysRequest_Action) error {
+	if encryptionCmdFlags.partition != constants.StatePartitionLabel && encryptionCmdFlags.partition != constants.EphemeralPartitionLabel {
+		return fmt.Errorf("--partition should be either %s or %s", constants.StatePartitionLabel, constants.EphemeralPartitionLabel)
+	}
+
+	return WithClient(func(ctx context.Context, c *client.Client) error {
+		_, err := c.UpdateEncryptionKeys(ctx, &machine.UpdateEncryptionKeysRequest{
+			Partition: encryptionCmdFlags.partition,
+			Action:    action,
+			Key: &machine.EncryptionKeySpec{
+				Slot:             int32(encryptionCmdFlags.slot),
+				StaticPassphrase: encryptionCmdFlags.staticPassphrase,
+				NodeId:           encryptionCmdFlags.nodeID,
+				KmsEndpoint:      encryptionCmdFlags.kmsEndpoint,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error updating encryption keys: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{encryptionAddKeyCmd, encryptionRemoveKeyCmd, encryptionRotateKeyCmd} {
+		cmd.Flags().StringVar(&encryptionCmdFlags.partition, "partition", "", "partition label: STATE or EPHEMERAL")
+		cmd.Flags().IntVar(&encryptionCmdFlags.slot, "slot", 0, "LUKS2 key slot")
+		cli.Should(cmd.MarkFlagRequired("partition"))
+		cli.Should(cmd.MarkFlagRequired("slot"))
+	}
+
+	for _, cmd := range []*cobra.Command{encryptionAddKeyCmd, encryptionRotateKeyCmd} {
+		cmd.Flags().StringVar(&encryptionCmdFlags.staticPassphrase, "static-passphrase", "", "use the static passphrase as the key")
+		cmd.Flags().BoolVar(&encryptionCmdFlags.nodeID, "node-id", false, "use the key derived from the node UUID and the partition label")
+		cmd.Flags().StringVar(&encryptionCmdFlags.kmsEndpoint, "kms-endpoint", "", "use the key sealed by the KMS server at the endpoint")
+	}
+
+	encryptionCmd.AddCommand(encryptionAddKeyCmd, encryptionRemoveKeyCmd, encryptionRotateKeyCmd)
+	addCommand(encryptionCmd)
+}
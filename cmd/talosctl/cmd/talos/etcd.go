@@ -15,14 +15,17 @@ import (
 	"sync"
 	"text/tabwriter"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
 )
 
 // etcdCmd represents the etcd command.
@@ -32,6 +35,178 @@ var etcdCmd = &cobra.Command{
 	Long:  ``,
 }
 
+var etcdAlarmCmd = &cobra.Command{
+	Use:   "alarm",
+	Short: "Manage etcd alarms",
+	Long:  ``,
+}
+
+type alarmMessage interface {
+	GetMetadata() *common.Metadata
+	GetMemberAlarms() []*machine.EtcdMemberAlarm
+}
+
+func displayAlarms(messages []alarmMessage) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	node := ""
+	pattern := "%s\t%s\n"
+	header := "MEMBER\tALARM"
+
+	for i, message := range messages {
+		if message.GetMetadata() != nil && message.GetMetadata().GetHostname() != "" {
+			node = message.GetMetadata().GetHostname()
+		}
+
+		for j, alarm := range message.GetMemberAlarms() {
+			if i == 0 && j == 0 {
+				if node != "" {
+					header = "NODE\t" + header
+					pattern = "%s\t" + pattern
+				}
+
+				fmt.Fprintln(w, header)
+			}
+
+			args := []interface{}{
+				strconv.FormatUint(alarm.GetMemberId(), 16),
+				alarm.GetAlarm().String(),
+			}
+			if node != "" {
+				args = append([]interface{}{node}, args...)
+			}
+
+			fmt.Fprintf(w, pattern, args...)
+		}
+	}
+
+	return w.Flush()
+}
+
+var etcdAlarmListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the etcd alarms for the node.",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdAlarmList(ctx)
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error getting alarms: %w", err)
+				}
+				cli.Warning("%s", err)
+			}
+
+			return displayAlarms(slices.Map(response.Messages, func(v *machine.EtcdAlarm) alarmMessage {
+				return v
+			}))
+		})
+	},
+}
+
+var etcdAlarmDisarmCmd = &cobra.Command{
+	Use:   "disarm",
+	Short: "Disarm the etcd alarms for the node.",
+	Long:  ``,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdAlarmDisarm(ctx)
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error disarming alarms: %w", err)
+				}
+				cli.Warning("%s", err)
+			}
+
+			return displayAlarms(slices.Map(response.Messages, func(v *machine.EtcdAlarmDisarm) alarmMessage {
+				return v
+			}))
+		})
+	},
+}
+
+var etcdDefragCmd = &cobra.Command{
+	Use:   "defrag",
+	Short: "Defragment etcd database on the node",
+	Long: `Defragmentation is a maintenance operation that releases unused space from the etcd database file.
+Defragmentation is a resource heavy operation and should be run only on a single etcd node at a time.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd defrag"); err != nil {
+				return err
+			}
+
+			_, err := c.EtcdDefragment(ctx)
+
+			return err
+		})
+	},
+}
+
+var etcdStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get the status of etcd cluster member",
+	Long:  `Returns the status of etcd member on the node, use multiple nodes to get status of all members.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdStatus(ctx)
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error getting status: %w", err)
+				}
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			node := ""
+			pattern := "%s\t%s\t%s (%.2f%%)\t%s\t%s\t%d\t%d\t%d\t%v\t%s\n"
+
+			for i, message := range response.Messages {
+				if message.Metadata != nil && message.Metadata.Hostname != "" {
+					node = message.Metadata.Hostname
+				}
+
+				if i == 0 {
+					if node != "" {
+						fmt.Fprintln(w, "NODE\tMEMBER\tDB SIZE\tIN USE\tQUOTA\tLEADER\tRAFT INDEX\tRAFT TERM\tRAFT APPLIED INDEX\tLEARNER\tERRORS")
+						pattern = "%s\t" + pattern
+					} else {
+						fmt.Fprintln(w, "MEMBER\tDB SIZE\tIN USE\tQUOTA\tLEADER\tRAFT INDEX\tRAFT TERM\tRAFT APPLIED INDEX\tLEARNER\tERRORS")
+					}
+				}
+
+				status := message.GetMemberStatus()
+
+				var ratio float64
+
+				if status.GetDbSize() > 0 {
+					ratio = float64(status.GetDbSizeInUse()) / float64(status.GetDbSize()) * 100.0
+				}
+
+				args := []interface{}{
+					strconv.FormatUint(status.GetMemberId(), 16),
+					humanize.Bytes(uint64(status.GetDbSize())),
+					humanize.Bytes(uint64(status.GetDbSizeInUse())),
+					ratio,
+					humanize.Bytes(uint64(status.GetDbSizeQuota())),
+					strconv.FormatUint(status.GetLeader(), 16),
+					status.GetRaftIndex(),
+					status.GetRaftTerm(),
+					status.GetRaftAppliedIndex(),
+					status.GetIsLearner(),
+					strings.Join(status.GetErrors(), ", "),
+				}
+				if node != "" {
+					args = append([]interface{}{node}, args...)
+				}
+
+				fmt.Fprintf(w, pattern, args...)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
 var etcdLeaveCmd = &cobra.Command{
 	Use:   "leave",
 	Short: "Tell nodes to leave etcd cluster",
@@ -209,6 +384,7 @@ var etcdSnapshotCmd = &cobra.Command{
 }
 
 func init() {
-	etcdCmd.AddCommand(etcdLeaveCmd, etcdForfeitLeadershipCmd, etcdMemberListCmd, etcdMemberRemoveCmd, etcdSnapshotCmd)
+	etcdAlarmCmd.AddCommand(etcdAlarmListCmd, etcdAlarmDisarmCmd)
+	etcdCmd.AddCommand(etcdAlarmCmd, etcdDefragCmd, etcdForfeitLeadershipCmd, etcdLeaveCmd, etcdMemberListCmd, etcdMemberRemoveCmd, etcdSnapshotCmd, etcdStatusCmd)
 	addCommand(etcdCmd)
 }
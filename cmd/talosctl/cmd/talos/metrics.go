@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
+)
+
+var metricsCmdFlags struct {
+	services []string
+}
+
+// metricsCmd represents the metrics command.
+var metricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Fetch Prometheus metrics of Talos services",
+	Long: `Prints the metrics of machined, apid and trustd in the Prometheus text format.
+
+Metrics should be enabled in the machine configuration (.machine.metrics.enabled).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Metrics(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting metrics: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				for _, svc := range msg.Services {
+					if len(metricsCmdFlags.services) > 0 && !slices.Contains(metricsCmdFlags.services, func(s string) bool { return s == svc.Service }) {
+						continue
+					}
+
+					if svc.Error != "" {
+						cli.Warning("%s: error getting %s metrics: %s", node, svc.Service, svc.Error)
+
+						continue
+					}
+
+					fmt.Printf("# NODE: %s SERVICE: %s\n", node, svc.Service)
+					fmt.Print(svc.Text)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	metricsCmd.Flags().StringSliceVar(&metricsCmdFlags.services, "services", nil, "services to fetch the metrics of (machined, apid, trustd), all by default")
+	addCommand(metricsCmd)
+}
@@ -0,0 +1,211 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var netstatCmdFlags struct {
+	all       bool
+	listening bool
+	programs  bool
+	pods      bool
+	tcp       bool
+	udp       bool
+	raw       bool
+	unix      bool
+	ipv4      bool
+	ipv6      bool
+}
+
+// netstatCmd represents the netstat command.
+var netstatCmd = &cobra.Command{
+	Use:     "netstat",
+	Aliases: []string{"ss"},
+	Short:   "List network connections and sockets",
+	Long: `List network connections and sockets.
+
+By default, connected sockets of all protocols are listed in the host network namespace.`,
+	Example: `  talosctl netstat --listening --tcp --programs
+  talosctl netstat --all --pods`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			req := &machineapi.NetstatRequest{
+				Filter:    machineapi.NetstatRequest_CONNECTED,
+				Protocols: netstatProtocols(),
+				Pid:       netstatCmdFlags.programs,
+				Pods:      netstatCmdFlags.pods,
+			}
+
+			switch {
+			case netstatCmdFlags.all:
+				req.Filter = machineapi.NetstatRequest_ALL
+			case netstatCmdFlags.listening:
+				req.Filter = machineapi.NetstatRequest_LISTENING
+			}
+
+			var remotePeer peer.Peer
+
+			resp, err := c.Netstat(ctx, req, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting netstat: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return netstatRender(&remotePeer, resp)
+		})
+	},
+}
+
+func netstatProtocols() []machineapi.SocketInfo_Protocol {
+	anyProtocol := netstatCmdFlags.tcp || netstatCmdFlags.udp || netstatCmdFlags.raw || netstatCmdFlags.unix
+	anyFamily := netstatCmdFlags.ipv4 || netstatCmdFlags.ipv6
+
+	if !anyProtocol && !anyFamily {
+		// all protocols
+		return nil
+	}
+
+	var protocols []machineapi.SocketInfo_Protocol
+
+	for _, inet := range []struct {
+		enabled bool
+		v4, v6  machineapi.SocketInfo_Protocol
+	}{
+		{netstatCmdFlags.tcp, machineapi.SocketInfo_TCP, machineapi.SocketInfo_TCP6},
+		{netstatCmdFlags.udp, machineapi.SocketInfo_UDP, machineapi.SocketInfo_UDP6},
+		{netstatCmdFlags.raw, machineapi.SocketInfo_RAW, machineapi.SocketInfo_RAW6},
+	} {
+		if anyProtocol && !inet.enabled {
+			continue
+		}
+
+		if !anyFamily || netstatCmdFlags.ipv4 {
+			protocols = append(protocols, inet.v4)
+		}
+
+		if !anyFamily || netstatCmdFlags.ipv6 {
+			protocols = append(protocols, inet.v6)
+		}
+	}
+
+	if netstatCmdFlags.unix {
+		protocols = append(protocols, machineapi.SocketInfo_UNIX)
+	}
+
+	return protocols
+}
+
+func netstatRender(remotePeer *peer.Peer, resp *machineapi.NetstatResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	header := []string{"NODE"}
+
+	if netstatCmdFlags.pods {
+		header = append(header, "NETNS")
+	}
+
+	header = append(header, "PROTO", "RECV-Q", "SEND-Q", "LOCAL ADDRESS", "FOREIGN ADDRESS", "STATE")
+
+	if netstatCmdFlags.programs {
+		header = append(header, "PID/PROGRAM")
+	}
+
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, socket := range msg.Sockets {
+			row := []string{node}
+
+			if netstatCmdFlags.pods {
+				netNS := socket.Netns
+				if netNS == "" {
+					netNS = "host"
+				}
+
+				row = append(row, netNS)
+			}
+
+			localAddress, foreignAddress := socket.Path, ""
+
+			if socket.Protocol != machineapi.SocketInfo_UNIX {
+				localAddress = netstatAddress(socket.LocalAddress, socket.LocalPort)
+				foreignAddress = netstatAddress(socket.RemoteAddress, socket.RemotePort)
+			}
+
+			row = append(row,
+				strings.ToLower(socket.Protocol.String()),
+				strconv.FormatUint(socket.RxQueue, 10),
+				strconv.FormatUint(socket.TxQueue, 10),
+				localAddress,
+				foreignAddress,
+				socket.State,
+			)
+
+			if netstatCmdFlags.programs {
+				program := "-"
+				if socket.Pid != 0 {
+					program = fmt.Sprintf("%d/%s", socket.Pid, socket.Command)
+				}
+
+				row = append(row, program)
+			}
+
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+	}
+
+	return w.Flush()
+}
+
+func netstatAddress(address string, port uint32) string {
+	if port == 0 {
+		return net.JoinHostPort(address, "*")
+	}
+
+	return net.JoinHostPort(address, strconv.FormatUint(uint64(port), 10))
+}
+
+func init() {
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.all, "all", "a", false, "display all sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.listening, "listening", "l", false, "display listening sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.programs, "programs", "p", false, "display the PID and the name of the process owning the socket")
+	netstatCmd.Flags().BoolVar(&netstatCmdFlags.pods, "pods", false, "display sockets in the network namespaces of the pods as well")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.tcp, "tcp", "t", false, "display TCP sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.udp, "udp", "u", false, "display UDP sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.raw, "raw", "w", false, "display raw sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.unix, "unix", "x", false, "display unix sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv4, "ipv4", "4", false, "display IPv4 sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv6, "ipv6", "6", false, "display IPv6 sockets")
+
+	addCommand(netstatCmd)
+}
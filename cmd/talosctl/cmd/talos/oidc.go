@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	securityapi "github.com/talos-systems/talos/pkg/machinery/api/security"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/oidc"
+)
+
+// oidcRefreshWindow is the time before the certificate expiration when it gets refreshed.
+const oidcRefreshWindow = time.Minute
+
+// oidcExchange exchanges the ID token for the client certificate, and stores it in the context.
+func oidcExchange(ctx context.Context, configContext *clientconfig.Context, endpoints []string, idToken string) (*securityapi.OIDCLoginResponse, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("endpoints are not set: please use `--endpoints` flag or `talosctl config endpoint` command")
+	}
+
+	ca, err := base64.StdEncoding.DecodeString(configContext.CA)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no CA certificates found in the context")
+	}
+
+	// the login service listens on the same address as the API, but on a different port
+	host := endpoints[0]
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	conn, err := grpc.DialContext(ctx, net.JoinHostPort(host, strconv.Itoa(constants.ApidLoginPort)),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing the login service: %w", err)
+	}
+
+	//nolint:errcheck
+	defer conn.Close()
+
+	resp, err := securityapi.NewLoginServiceClient(conn).OIDCLogin(ctx, &securityapi.OIDCLoginRequest{
+		IdToken: idToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging ID token: %w", err)
+	}
+
+	configContext.Crt = base64.StdEncoding.EncodeToString(resp.Crt)
+	configContext.Key = base64.StdEncoding.EncodeToString(resp.Key)
+
+	return resp, nil
+}
+
+// oidcRefresh renews the short-lived client certificate of the context logged in with OIDC.
+//
+// oidcRefresh returns true if the context was updated and the config should be saved.
+func oidcRefresh(ctx context.Context, configContext *clientconfig.Context, endpoints []string, now time.Time) (bool, error) {
+	if configContext.OIDC == nil {
+		return false, nil
+	}
+
+	if notAfter, err := certificateNotAfter(configContext); err == nil && notAfter.Sub(now) > oidcRefreshWindow {
+		return false, nil
+	}
+
+	if configContext.OIDC.RefreshToken == "" {
+		return false, fmt.Errorf("client certificate expired: please use `talosctl config login` to log in again")
+	}
+
+	login := &oidc.Login{
+		IssuerURL: configContext.OIDC.IssuerURL,
+		ClientID:  configContext.OIDC.ClientID,
+		Scopes:    configContext.OIDC.Scopes,
+	}
+
+	token, err := login.Refresh(ctx, configContext.OIDC.RefreshToken)
+	if err != nil {
+		return false, fmt.Errorf("%w: please use `talosctl config login` to log in again", err)
+	}
+
+	if _, err = oidcExchange(ctx, configContext, endpoints, token.IDToken); err != nil {
+		return false, err
+	}
+
+	configContext.OIDC.RefreshToken = token.RefreshToken
+
+	return true, nil
+}
+
+// oidcRefreshConfig refreshes the selected context of the config, and saves the config if it was updated.
+func oidcRefreshConfig(ctx context.Context, cfg *clientconfig.Config) error {
+	contextName := cfg.Context
+	if Cmdcontext != "" {
+		contextName = Cmdcontext
+	}
+
+	configContext, ok := cfg.Contexts[contextName]
+	if !ok {
+		return nil
+	}
+
+	endpoints := configContext.Endpoints
+	if len(Endpoints) > 0 {
+		endpoints = Endpoints
+	}
+
+	refreshed, err := oidcRefresh(ctx, configContext, endpoints, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !refreshed {
+		return nil
+	}
+
+	if err = cfg.Save(Talosconfig); err != nil {
+		return fmt.Errorf("error writing config: %w", err)
+	}
+
+	return nil
+}
+
+func certificateNotAfter(configContext *clientconfig.Context) (time.Time, error) {
+	b, err := base64.StdEncoding.DecodeString(configContext.Crt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return time.Time{}, fmt.Errorf("error decoding PEM")
+	}
+
+	crt, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return crt.NotAfter, nil
+}
@@ -0,0 +1,280 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/internal/pkg/pcap"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+var pcapCmdFlags struct {
+	iface       string
+	promiscuous bool
+	snapLen     int
+	bpfFilter   string
+	output      string
+	duration    time.Duration
+}
+
+// pcapCmd represents the pcap command.
+var pcapCmd = &cobra.Command{
+	Use:   "pcap",
+	Short: "Capture the network packets on the node",
+	Long: `Capture the network packets on the node.
+
+The packets are either printed to the standard output, or written to the file in the pcap format (--output),
+which can be analyzed with tcpdump or Wireshark.
+
+The capture filter (--bpf-filter) supports a subset of the pcap-filter syntax:
+protocols (ip, ip6, arp, tcp, udp, icmp, icmp6), [src|dst] host, [src|dst] net and [tcp|udp] [src|dst] port
+primitives combined with and, or, not and parentheses.`,
+	Example: `  talosctl pcap --interface eth0 --bpf-filter 'udp port 51820'
+  talosctl pcap --interface kubespan --output kubespan.pcap --duration 1m`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "pcap"); err != nil {
+				return err
+			}
+
+			if pcapCmdFlags.duration > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, pcapCmdFlags.duration)
+				defer cancel()
+			}
+
+			req := &machine.PacketCaptureRequest{
+				Interface:   pcapCmdFlags.iface,
+				Promiscuous: pcapCmdFlags.promiscuous,
+				SnapLen:     uint32(pcapCmdFlags.snapLen),
+			}
+
+			if strings.TrimSpace(pcapCmdFlags.bpfFilter) != "" {
+				linkType, err := pcapLinkType(ctx, c, pcapCmdFlags.iface)
+				if err != nil {
+					return err
+				}
+
+				filter, err := pcap.Compile(pcapCmdFlags.bpfFilter, linkType)
+				if err != nil {
+					return fmt.Errorf("error compiling BPF filter: %w", err)
+				}
+
+				for _, ins := range filter {
+					req.BpfFilter = append(req.BpfFilter, &machine.BPFInstruction{
+						Op: uint32(ins.Op),
+						Jt: uint32(ins.Jt),
+						Jf: uint32(ins.Jf),
+						K:  ins.K,
+					})
+				}
+			}
+
+			r, errCh, err := c.PacketCapture(ctx, req)
+			if err != nil {
+				return fmt.Errorf("error capturing packets: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for err := range errCh {
+					fmt.Fprintln(os.Stderr, err.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			switch pcapCmdFlags.output {
+			case "":
+				return dumpPackets(r)
+			case "-":
+				_, err = io.Copy(os.Stdout, r)
+
+				return err
+			default:
+				out, err := os.Create(pcapCmdFlags.output)
+				if err != nil {
+					return err
+				}
+
+				defer out.Close() //nolint:errcheck
+
+				if _, err = io.Copy(out, r); err != nil {
+					return err
+				}
+
+				return out.Close()
+			}
+		})
+	},
+}
+
+// pcapLinkType returns the link-layer header type of the interface the BPF filter is compiled for.
+func pcapLinkType(ctx context.Context, c *client.Client, iface string) (pcap.LinkType, error) {
+	list, err := c.Resources.Get(ctx, network.NamespaceName, network.LinkStatusType, iface)
+	if err != nil {
+		return 0, fmt.Errorf("error getting interface %q: %w", iface, err)
+	}
+
+	if len(list) == 0 || list[0].Resource == nil {
+		return 0, fmt.Errorf("interface %q not found", iface)
+	}
+
+	b, err := yaml.Marshal(list[0].Resource.Spec())
+	if err != nil {
+		return 0, err
+	}
+
+	var spec network.LinkStatusSpec
+
+	if err = yaml.Unmarshal(b, &spec); err != nil {
+		return 0, err
+	}
+
+	return pcap.LinkTypeOf(uint16(spec.Type))
+}
+
+// dumpPackets prints a line per packet read from the pcap stream.
+func dumpPackets(r io.Reader) error {
+	reader, err := pcapgo.NewReader(r)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return fmt.Errorf("error reading pcap header: %w", err)
+	}
+
+	for {
+		data, ci, err := reader.ReadPacketData()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+
+			return fmt.Errorf("error reading packet: %w", err)
+		}
+
+		packet := gopacket.NewPacket(data, reader.LinkType(), gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+
+		fmt.Printf("%s %s\n", ci.Timestamp.Format("15:04:05.000000"), formatPacket(packet, ci.Length))
+	}
+}
+
+//nolint:gocyclo,cyclop
+func formatPacket(packet gopacket.Packet, length int) string {
+	var (
+		src, dst, proto string
+		next            layers.IPProtocol
+	)
+
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		src, dst, proto = ip.SrcIP.String(), ip.DstIP.String(), "IP"
+		next = ip.Protocol
+	case *layers.IPv6:
+		src, dst, proto = ip.SrcIP.String(), ip.DstIP.String(), "IP6"
+		next = ip.NextHeader
+	default:
+		if arp, ok := packet.Layer(layers.LayerTypeARP).(*layers.ARP); ok {
+			switch arp.Operation {
+			case layers.ARPRequest:
+				return fmt.Sprintf("ARP, Request who-has %s tell %s, length %d", ipString(arp.DstProtAddress), ipString(arp.SourceProtAddress), length)
+			case layers.ARPReply:
+				return fmt.Sprintf("ARP, Reply %s is-at %s, length %d", ipString(arp.SourceProtAddress), macString(arp.SourceHwAddress), length)
+			}
+		}
+
+		var names []string
+
+		for _, layer := range packet.Layers() {
+			names = append(names, layer.LayerType().String())
+		}
+
+		return fmt.Sprintf("%s, length %d", strings.Join(names, "/"), length)
+	}
+
+	switch transport := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		flags := ""
+
+		for _, flag := range []struct {
+			set  bool
+			name string
+		}{
+			{transport.SYN, "S"},
+			{transport.FIN, "F"},
+			{transport.RST, "R"},
+			{transport.PSH, "P"},
+			{transport.URG, "U"},
+			{transport.ACK, "."},
+		} {
+			if flag.set {
+				flags += flag.name
+			}
+		}
+
+		return fmt.Sprintf("%s %s.%d > %s.%d: Flags [%s], seq %d, ack %d, win %d, length %d",
+			proto, src, transport.SrcPort, dst, transport.DstPort, flags, transport.Seq, transport.Ack, transport.Window, len(transport.Payload))
+	case *layers.UDP:
+		return fmt.Sprintf("%s %s.%d > %s.%d: UDP, length %d", proto, src, transport.SrcPort, dst, transport.DstPort, len(transport.Payload))
+	}
+
+	if icmp, ok := packet.Layer(layers.LayerTypeICMPv4).(*layers.ICMPv4); ok {
+		return fmt.Sprintf("%s %s > %s: ICMP %s, length %d", proto, src, dst, icmp.TypeCode, len(icmp.Payload))
+	}
+
+	if icmp, ok := packet.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6); ok {
+		return fmt.Sprintf("%s %s > %s: ICMP6 %s, length %d", proto, src, dst, icmp.TypeCode, len(icmp.Payload))
+	}
+
+	return fmt.Sprintf("%s %s > %s: %s, length %d", proto, src, dst, next, length)
+}
+
+func ipString(b []byte) string {
+	return net.IP(b).String()
+}
+
+func macString(b []byte) string {
+	return net.HardwareAddr(b).String()
+}
+
+func init() {
+	addCommand(pcapCmd)
+
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.iface, "interface", "i", "eth0", "interface name to capture packets on")
+	pcapCmd.Flags().BoolVar(&pcapCmdFlags.promiscuous, "promiscuous", false, "put interface into promiscuous mode")
+	pcapCmd.Flags().IntVarP(&pcapCmdFlags.snapLen, "snaplen", "s", pcap.DefaultSnapLen, "maximum packet size to capture")
+	pcapCmd.Flags().StringVar(&pcapCmdFlags.bpfFilter, "bpf-filter", "", "filter expression of the packets to capture")
+	pcapCmd.Flags().StringVarP(&pcapCmdFlags.output, "output", "o", "", "file to write the captured packets in the pcap format to, '-' for the standard output")
+	pcapCmd.Flags().DurationVar(&pcapCmdFlags.duration, "duration", 0, "duration of the capture, captures until interrupted if not set")
+}
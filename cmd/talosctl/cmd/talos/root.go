@@ -52,6 +52,10 @@ func WithClientNoNodes(action func(context.Context, *client.Client) error) error
 				return fmt.Errorf("failed to open config file %q: %w", Talosconfig, err)
 			}
 
+			if err = oidcRefreshConfig(ctx, cfg); err != nil {
+				return err
+			}
+
 			opts := []client.OptionFunc{
 				client.WithConfig(cfg),
 			}
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/storage"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
+)
+
+var wipeCmdFlags struct {
+	wipeMethod string
+}
+
+// wipeCmd represents the wipe command.
+var wipeCmd = &cobra.Command{
+	Use:   "wipe",
+	Short: "Wipe block devices",
+	Args:  cobra.NoArgs,
+}
+
+// wipeDiskCmd represents the wipe disk command.
+var wipeDiskCmd = &cobra.Command{
+	Use:   "disk <device names>...",
+	Short: "Wipe a block device (disk) which is not used by Talos",
+	Long: `Wipe a block device (disk) which is not used by Talos.
+
+The system disk, the disks with mounted partitions and the disks used by the user volumes can't be wiped.
+Disks can't be wiped in the maintenance mode.
+Use 'talosctl get disks' and 'talosctl get discoveredvolumes' to find the disk to wipe.`,
+	Example: `  talosctl wipe disk sdb
+  talosctl wipe disk sdb sdc --method ZEROES`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		method, ok := storage.BlockDeviceWipeDescriptor_Method_value[wipeCmdFlags.wipeMethod]
+		if !ok {
+			return fmt.Errorf("invalid wipe method %q, supported methods: %s", wipeCmdFlags.wipeMethod, strings.Join(wipeMethodValues(), ", "))
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			_, err := c.BlockDeviceWipe(ctx, &storage.BlockDeviceWipeRequest{
+				Devices: slices.Map(args, func(device string) *storage.BlockDeviceWipeDescriptor {
+					return &storage.BlockDeviceWipeDescriptor{
+						Device: device,
+						Method: storage.BlockDeviceWipeDescriptor_Method(method),
+					}
+				}),
+			})
+			if err != nil {
+				return fmt.Errorf("error wiping disks: %w", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+func wipeMethodValues() []string {
+	values := make([]string, len(storage.BlockDeviceWipeDescriptor_Method_name))
+
+	for value, name := range storage.BlockDeviceWipeDescriptor_Method_name {
+		values[value] = name
+	}
+
+	return values
+}
+
+func init() {
+	wipeDiskCmd.Flags().StringVar(&wipeCmdFlags.wipeMethod, "method", storage.BlockDeviceWipeDescriptor_FAST.String(),
+		fmt.Sprintf("wipe method to use %v", wipeMethodValues()))
+
+	wipeCmd.AddCommand(wipeDiskCmd)
+	addCommand(wipeCmd)
+}
@@ -228,6 +228,39 @@ func collectStructs(node ast.Node) []*structType {
 	return structs
 }
 
+// collectSliceTypes collects named slice types of the documented structs, e.g. `type DeviceList []*Device`.
+//
+// Fields of such types are documented as the underlying slice type.
+func collectSliceTypes(node ast.Node, structs []*structType) map[string]*ast.ArrayType {
+	structNames := map[string]struct{}{}
+
+	for _, s := range structs {
+		structNames[s.name] = struct{}{}
+	}
+
+	sliceTypes := map[string]*ast.ArrayType{}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		t, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+
+		x, ok := t.Type.(*ast.ArrayType)
+		if !ok || x.Len != nil {
+			return true
+		}
+
+		if _, ok = structNames[getFieldType(x.Elt)]; ok {
+			sliceTypes[t.Name.Name] = x
+		}
+
+		return true
+	})
+
+	return sliceTypes
+}
+
 func parseComment(comment []byte) *Text {
 	text := &Text{}
 	if err := yaml.Unmarshal(comment, text); err != nil {
@@ -307,7 +340,7 @@ func escape(value string) string {
 	return strings.TrimSpace(value)
 }
 
-func collectFields(s *structType) (fields []*Field) {
+func collectFields(s *structType, sliceTypes map[string]*ast.ArrayType) (fields []*Field) {
 	fields = []*Field{}
 
 	for _, f := range s.node.Fields.List {
@@ -332,8 +365,16 @@ func collectFields(s *structType) (fields []*Field) {
 			log.Fatalf("field %q is missing a tag", name)
 		}
 
-		fieldType := formatFieldType(f.Type)
-		fieldTypeRef := getFieldType(f.Type)
+		var typ ast.Expr = f.Type
+
+		if ident, ok := typ.(*ast.Ident); ok {
+			if sliceType, ok := sliceTypes[ident.Name]; ok {
+				typ = sliceType
+			}
+		}
+
+		fieldType := formatFieldType(typ)
+		fieldTypeRef := getFieldType(typ)
 
 		tag := reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
 		yamlTag := tag.Get("yaml")
@@ -427,13 +468,15 @@ func processFile(inputFile, outputFile, typeName string) {
 		Structs: []*Struct{},
 	}
 
+	sliceTypes := collectSliceTypes(node, structs)
+
 	extraExamples := map[string][]*Example{}
 	backReferences := map[string][]Appearance{}
 
 	for _, s := range structs {
 		fmt.Printf("generating docs for type: %q\n", s.name)
 
-		fields := collectFields(s)
+		fields := collectFields(s, sliceTypes)
 
 		s := &Struct{
 			Name:   s.name,
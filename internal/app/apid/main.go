@@ -9,6 +9,7 @@ import (
 	"flag"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/cosi-project/runtime/api/v1alpha1"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -22,15 +23,28 @@ import (
 
 	apidbackend "github.com/talos-systems/talos/internal/app/apid/pkg/backend"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
+	"github.com/talos-systems/talos/internal/app/apid/pkg/login"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
+	"github.com/talos-systems/talos/internal/pkg/metrics"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
 	"github.com/talos-systems/talos/pkg/grpc/proxy/backend"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/oidc"
 	"github.com/talos-systems/talos/pkg/startup"
 )
 
-var rbacEnabled *bool
+var (
+	rbacEnabled      *bool
+	metricsAddress   *string
+	oidcIssuerURL    *string
+	oidcClientID     *string
+	oidcGroupsClaim  *string
+	oidcCertLifetime *time.Duration
+	oidcGroupRoles   = login.GroupRoles{}
+)
 
 func runDebugServer(ctx context.Context) {
 	const debugAddr = ":9981"
@@ -44,16 +58,32 @@ func runDebugServer(ctx context.Context) {
 	}
 }
 
+func runMetricsServer(ctx context.Context, address string) {
+	if err := metrics.ListenAndServe(ctx, address, log.Printf); err != nil {
+		log.Fatalf("failed to start metrics server: %s", err)
+	}
+}
+
 // Main is the entrypoint of apid.
 func Main() {
 	log.SetFlags(log.Lshortfile | log.Ldate | log.Lmicroseconds | log.Ltime)
 
 	rbacEnabled = flag.Bool("enable-rbac", false, "enable RBAC for Talos API")
+	metricsAddress = flag.String("metrics-address", "", "address to serve Prometheus metrics on, disabled if empty")
+	oidcIssuerURL = flag.String("oidc-issuer-url", "", "OIDC issuer URL, OIDC login is disabled if empty")
+	oidcClientID = flag.String("oidc-client-id", "", "OIDC client ID (expected audience of the ID tokens)")
+	oidcGroupsClaim = flag.String("oidc-groups-claim", constants.DefaultOIDCGroupsClaim, "ID token claim holding the user groups")
+	oidcCertLifetime = flag.Duration("oidc-cert-lifetime", constants.DefaultOIDCCertLifetime, "lifetime of the client certificates issued on OIDC login")
+	flag.Var(oidcGroupRoles, "oidc-group-roles", "roles granted to the group, in the <group>=<role>[,<role>...] format (can be repeated)")
 
 	flag.Parse()
 
 	go runDebugServer(context.TODO())
 
+	if *metricsAddress != "" {
+		go runMetricsServer(context.TODO(), *metricsAddress)
+	}
+
 	if err := startup.RandSeed(); err != nil {
 		log.Fatalf("failed to seed RNG: %v", err)
 	}
@@ -96,6 +126,7 @@ func Main() {
 		"/machine.MachineService/Kubeconfig",
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
+		"/machine.MachineService/PacketCapture",
 		"/machine.MachineService/Read",
 		"/resource.ResourceService/List",
 		"/resource.ResourceService/Watch",
@@ -110,6 +141,22 @@ func Main() {
 
 	var errGroup errgroup.Group
 
+	requestDuration := metrics.NewRequestDuration("apid")
+
+	auditWriter := &audit.SocketWriter{
+		Path: constants.MachineAuditSocketPath,
+	}
+
+	//nolint:errcheck
+	defer auditWriter.Close()
+
+	// request bodies are not decoded by the proxy, they are recorded by machined
+	auditor := &audit.Auditor{
+		Component: "apid",
+		Writer:    auditWriter,
+		Logger:    log.New(log.Writer(), "apid/audit ", log.Flags()).Printf,
+	}
+
 	errGroup.Go(func() error {
 		mode := authz.Disabled
 		if *rbacEnabled {
@@ -136,8 +183,12 @@ func Main() {
 						proxy.WithStreamedDetector(router.StreamedDetector),
 					)),
 			),
+			factory.WithUnaryInterceptor(requestDuration.UnaryInterceptor()),
+			factory.WithStreamInterceptor(requestDuration.StreamInterceptor()),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
+			factory.WithUnaryInterceptor(auditor.UnaryInterceptor()),
+			factory.WithStreamInterceptor(auditor.StreamInterceptor()),
 		)
 	})
 
@@ -160,11 +211,56 @@ func Main() {
 						proxy.WithStreamedDetector(router.StreamedDetector),
 					)),
 			),
+			factory.WithUnaryInterceptor(requestDuration.UnaryInterceptor()),
+			factory.WithStreamInterceptor(requestDuration.StreamInterceptor()),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
+			factory.WithUnaryInterceptor(auditor.UnaryInterceptor()),
+			factory.WithStreamInterceptor(auditor.StreamInterceptor()),
 		)
 	})
 
+	if *oidcIssuerURL != "" {
+		loginTLSConfig, err := tlsConfig.LoginServerConfig()
+		if err != nil {
+			log.Fatalf("failed to create login TLS configuration: %v", err)
+		}
+
+		machineConn, err := grpc.Dial("unix://"+constants.MachineSocketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("failed to dial machined connection: %v", err)
+		}
+
+		loginServer := &login.Server{
+			Verifier: &oidc.Verifier{
+				IssuerURL:   *oidcIssuerURL,
+				ClientID:    *oidcClientID,
+				GroupsClaim: *oidcGroupsClaim,
+			},
+			GroupRoles:   oidcGroupRoles,
+			CertLifetime: *oidcCertLifetime,
+			Machine:      machine.NewMachineServiceClient(machineConn),
+			Logger:       log.New(log.Writer(), "apid/login ", log.Flags()).Printf,
+		}
+
+		errGroup.Go(func() error {
+			return factory.ListenAndServe(
+				loginServer,
+				factory.Port(constants.ApidLoginPort),
+				factory.WithDefaultLog(),
+				factory.ServerOptions(
+					grpc.Creds(
+						credentials.NewTLS(loginTLSConfig),
+					),
+				),
+				factory.WithUnaryInterceptor(requestDuration.UnaryInterceptor()),
+				factory.WithStreamInterceptor(requestDuration.StreamInterceptor()),
+				factory.WithUnaryInterceptor(auditor.UnaryInterceptor()),
+				factory.WithStreamInterceptor(auditor.StreamInterceptor()),
+			)
+		})
+	}
+
 	if err := errGroup.Wait(); err != nil {
 		log.Fatalf("listen: %v", err)
 	}
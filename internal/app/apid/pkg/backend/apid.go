@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protowire"
 
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -57,6 +58,7 @@ func (a *APID) GetConnection(ctx context.Context) (context.Context, *grpc.Client
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	audit.SetMetadata(md, audit.GetSubject(ctx))
 
 	if authority := md[":authority"]; len(authority) > 0 {
 		md.Set("proxyfrom", authority...)
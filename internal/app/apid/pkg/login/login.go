@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package login implements the apid login service, which exchanges OIDC ID tokens for client certificates.
+package login
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	securityapi "github.com/talos-systems/talos/pkg/machinery/api/security"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+	"github.com/talos-systems/talos/pkg/oidc"
+)
+
+// Server implements securityapi.LoginServiceServer.
+type Server struct {
+	securityapi.UnimplementedLoginServiceServer
+
+	// Verifier checks the ID tokens.
+	Verifier *oidc.Verifier
+
+	// GroupRoles maps the user groups to the roles of the issued certificates.
+	GroupRoles GroupRoles
+
+	// CertLifetime is the lifetime of the issued certificates.
+	CertLifetime time.Duration
+
+	// Machine is the client of the local machined, which issues the certificates.
+	Machine machine.MachineServiceClient
+
+	// Logger.
+	Logger func(format string, v ...interface{})
+}
+
+func (s *Server) logf(format string, v ...interface{}) {
+	if s.Logger != nil {
+		s.Logger(format, v...)
+	}
+}
+
+// Register implements the factory.Registrator interface.
+func (s *Server) Register(obj *grpc.Server) {
+	securityapi.RegisterLoginServiceServer(obj, s)
+}
+
+// OIDCLogin implements the securityapi.LoginServiceServer interface.
+func (s *Server) OIDCLogin(ctx context.Context, in *securityapi.OIDCLoginRequest) (*securityapi.OIDCLoginResponse, error) {
+	claims, err := s.Verifier.Verify(ctx, in.IdToken)
+	if err != nil {
+		s.logf("OIDC login failed: %s", err)
+
+		if errors.Is(err, oidc.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid ID token")
+		}
+
+		return nil, status.Error(codes.Unavailable, "failed to verify ID token")
+	}
+
+	roles := s.GroupRoles.Roles(claims.Groups)
+	if len(roles) == 0 {
+		s.logf("OIDC login of %q denied: no roles for groups %v", claims.Subject, claims.Groups)
+
+		return nil, status.Error(codes.PermissionDenied, "no roles are granted to the user groups")
+	}
+
+	// apid is trusted by machined to pass the roles, and the certificates are issued with the admin role
+	md := metadata.New(nil)
+	authz.SetMetadata(md, role.MakeSet(role.Admin))
+	audit.SetMetadata(md, "oidc:"+claims.Subject)
+
+	// the user identity is recorded in the certificate, so that the API calls made with it are attributed to the user in the audit log
+	commonName := claims.Subject
+	if claims.Email != "" {
+		commonName = claims.Email
+	}
+
+	resp, err := s.Machine.GenerateClientConfiguration(metadata.NewOutgoingContext(ctx, md), &machine.GenerateClientConfigurationRequest{
+		Roles:      roles,
+		CrtTtl:     durationpb.New(s.CertLifetime),
+		CommonName: commonName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Messages) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected 1 message, got %d", len(resp.Messages))
+	}
+
+	s.logf("OIDC login of %q (%s): issued certificate with roles %v", claims.Subject, claims.Email, roles)
+
+	return &securityapi.OIDCLoginResponse{
+		Ca:    resp.Messages[0].Ca,
+		Crt:   resp.Messages[0].Crt,
+		Key:   resp.Messages[0].Key,
+		Roles: roles,
+	}, nil
+}
+
+// GroupRoles maps the user groups to the roles.
+//
+// GroupRoles implements flag.Value, the flag value format is `<group>=<role>[,<role>...]`.
+type GroupRoles map[string][]string
+
+// Roles returns the sorted roles of the groups.
+func (g GroupRoles) Roles(groups []string) []string {
+	set := map[string]struct{}{}
+
+	for _, group := range groups {
+		for _, r := range g[group] {
+			set[r] = struct{}{}
+		}
+	}
+
+	roles := make([]string, 0, len(set))
+
+	for r := range set {
+		roles = append(roles, r)
+	}
+
+	sort.Strings(roles)
+
+	return roles
+}
+
+// String implements flag.Value.
+func (g GroupRoles) String() string {
+	groups := make([]string, 0, len(g))
+
+	for group, roles := range g {
+		groups = append(groups, group+"="+strings.Join(roles, ","))
+	}
+
+	sort.Strings(groups)
+
+	return strings.Join(groups, " ")
+}
+
+// Set implements flag.Value.
+func (g GroupRoles) Set(value string) error {
+	group, roles, ok := strings.Cut(value, "=")
+	if !ok || group == "" || roles == "" {
+		return fmt.Errorf("expected <group>=<role>[,<role>...], got %q", value)
+	}
+
+	g[group] = append(g[group], strings.Split(roles, ",")...)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package login_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/login"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	securityapi "github.com/talos-systems/talos/pkg/machinery/api/security"
+	"github.com/talos-systems/talos/pkg/oidc"
+	"github.com/talos-systems/talos/pkg/oidc/oidctest"
+)
+
+type mockMachineClient struct {
+	machine.MachineServiceClient
+
+	md  metadata.MD
+	req *machine.GenerateClientConfigurationRequest
+}
+
+func (m *mockMachineClient) GenerateClientConfiguration(ctx context.Context, in *machine.GenerateClientConfigurationRequest, opts ...grpc.CallOption) (*machine.GenerateClientConfigurationResponse, error) {
+	m.md, _ = metadata.FromOutgoingContext(ctx)
+	m.req = in
+
+	return &machine.GenerateClientConfigurationResponse{
+		Messages: []*machine.GenerateClientConfiguration{
+			{
+				Ca:  []byte("ca"),
+				Crt: []byte("crt"),
+				Key: []byte("key"),
+			},
+		},
+	}, nil
+}
+
+func TestOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	issuer, err := oidctest.NewIssuer("talos")
+	require.NoError(t, err)
+
+	defer issuer.Close()
+
+	machineClient := &mockMachineClient{}
+
+	server := &login.Server{
+		Verifier: &oidc.Verifier{
+			IssuerURL:   issuer.URL,
+			ClientID:    "talos",
+			GroupsClaim: "groups",
+		},
+		GroupRoles:   login.GroupRoles{},
+		CertLifetime: time.Hour,
+		Machine:      machineClient,
+	}
+
+	require.NoError(t, server.GroupRoles.Set("sre=sre,os:reader"))
+	require.NoError(t, server.GroupRoles.Set("dev=os:reader"))
+	assert.Error(t, server.GroupRoles.Set("admins"))
+
+	issuer.Groups = []string{"sre", "dev", "other"}
+
+	token, err := issuer.IssueToken("talos", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	resp, err := server.OIDCLogin(ctx, &securityapi.OIDCLoginRequest{IdToken: token})
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("crt"), resp.Crt)
+	assert.Equal(t, []string{"os:reader", "sre"}, resp.Roles)
+	assert.Equal(t, []string{"os:reader", "sre"}, machineClient.req.Roles)
+	assert.Equal(t, time.Hour, machineClient.req.CrtTtl.AsDuration())
+	assert.Equal(t, "user@example.com", machineClient.req.CommonName)
+	assert.Equal(t, []string{"os:admin"}, machineClient.md.Get("talos-role"))
+	assert.Equal(t, []string{"oidc:user"}, machineClient.md.Get("talos-audit-subject"))
+
+	// the subject is used if there is no email claim
+	issuer.Email = ""
+
+	token, err = issuer.IssueToken("talos", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = server.OIDCLogin(ctx, &securityapi.OIDCLoginRequest{IdToken: token})
+	require.NoError(t, err)
+
+	assert.Equal(t, "user", machineClient.req.CommonName)
+
+	// no roles for the user groups
+	issuer.Groups = []string{"other"}
+
+	token, err = issuer.IssueToken("talos", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = server.OIDCLogin(ctx, &securityapi.OIDCLoginRequest{IdToken: token})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// invalid token
+	_, err = server.OIDCLogin(ctx, &securityapi.OIDCLoginRequest{IdToken: "invalid"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
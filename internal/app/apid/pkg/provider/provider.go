@@ -84,6 +84,14 @@ func (tlsConfig *TLSConfig) ServerConfig() (*stdlibtls.Config, error) {
 	)
 }
 
+// LoginServerConfig generates server-side tls.Config for the login service, which doesn't require client certificates.
+func (tlsConfig *TLSConfig) LoginServerConfig() (*stdlibtls.Config, error) {
+	return tls.New(
+		tls.WithClientAuthType(tls.ServerOnly),
+		tls.WithServerCertificateProvider(tlsConfig.certificateProvider),
+	)
+}
+
 // ClientConfig generates client-side tls.Config.
 func (tlsConfig *TLSConfig) ClientConfig() (*stdlibtls.Config, error) {
 	ca, err := tlsConfig.certificateProvider.GetCA()
@@ -14,11 +14,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"io/fs"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -38,6 +42,7 @@ import (
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
+	"golang.org/x/net/bpf"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -54,6 +59,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/app/resources"
 	storaged "github.com/talos-systems/talos/internal/app/storaged"
+	"github.com/talos-systems/talos/internal/pkg/cgroups"
 	"github.com/talos-systems/talos/internal/pkg/configuration"
 	"github.com/talos-systems/talos/internal/pkg/containers"
 	taloscontainerd "github.com/talos-systems/talos/internal/pkg/containers/containerd"
@@ -61,8 +67,10 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/containers/image"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
+	"github.com/talos-systems/talos/internal/pkg/metrics"
 	"github.com/talos-systems/talos/internal/pkg/miniprocfs"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/pcap"
 	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/chunker"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
@@ -75,6 +83,7 @@ import (
 	timeapi "github.com/talos-systems/talos/pkg/machinery/api/time"
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	configcontainer "github.com/talos-systems/talos/pkg/machinery/config/container"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -103,6 +112,9 @@ type Server struct {
 	Controller runtime.Controller
 
 	server *grpc.Server
+
+	// encryptionKeysMu serializes the encryption key updates, as each update modifies both the key slots and the machine configuration.
+	encryptionKeysMu sync.Mutex
 }
 
 func (s *Server) checkSupported(feature runtime.ModeCapability) error {
@@ -224,7 +236,7 @@ Config diff:
 	}
 
 	if in.Mode != machine.ApplyConfigurationRequest_TRY {
-		if err := ioutil.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
+		if err := saveConfig(cfg); err != nil {
 			return nil, err
 		}
 	}
@@ -625,8 +637,14 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		ResetRequest: in,
 	}
 
-	if len(in.GetSystemPartitionsToWipe()) > 0 {
-		bd := s.Controller.Runtime().State().Machine().Disk().BlockDevice
+	for _, spec := range in.GetSystemPartitionsToWipe() {
+		// EPHEMERAL might be placed on a separate disk, so look up the disk for each partition
+		dev := s.Controller.Runtime().State().Machine().Disk(disk.WithPartitionLabel(spec.Label))
+		if dev == nil {
+			return nil, fmt.Errorf("failed to find the disk with the partition labeled %q", spec.Label)
+		}
+
+		bd := dev.BlockDevice
 
 		var pt *gpt.GPT
 
@@ -635,34 +653,32 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 			return nil, fmt.Errorf("error reading partition table: %w", err)
 		}
 
-		for _, spec := range in.GetSystemPartitionsToWipe() {
-			var target *installer.Target
-
-			switch spec.Label {
-			case constants.EFIPartitionLabel:
-				target = installer.EFITarget(bd.Device().Name(), nil)
-			case constants.BIOSGrubPartitionLabel:
-				target = installer.BIOSTarget(bd.Device().Name(), nil)
-			case constants.BootPartitionLabel:
-				target = installer.BootTarget(bd.Device().Name(), nil)
-			case constants.MetaPartitionLabel:
-				target = installer.MetaTarget(bd.Device().Name(), nil)
-			case constants.StatePartitionLabel:
-				target = installer.StateTarget(bd.Device().Name(), installer.NoFilesystem)
-			case constants.EphemeralPartitionLabel:
-				target = installer.EphemeralTarget(bd.Device().Name(), installer.NoFilesystem)
-			default:
-				return nil, fmt.Errorf("label %q is not supported", spec.Label)
-			}
+		var target *installer.Target
 
-			_, err = target.Locate(pt)
-			if err != nil {
-				return nil, fmt.Errorf("failed location partition with label %q: %w", spec.Label, err)
-			}
+		switch spec.Label {
+		case constants.EFIPartitionLabel:
+			target = installer.EFITarget(bd.Device().Name(), nil)
+		case constants.BIOSGrubPartitionLabel:
+			target = installer.BIOSTarget(bd.Device().Name(), nil)
+		case constants.BootPartitionLabel:
+			target = installer.BootTarget(bd.Device().Name(), nil)
+		case constants.MetaPartitionLabel:
+			target = installer.MetaTarget(bd.Device().Name(), nil)
+		case constants.StatePartitionLabel:
+			target = installer.StateTarget(bd.Device().Name(), installer.NoFilesystem)
+		case constants.EphemeralPartitionLabel:
+			target = installer.EphemeralTarget(bd.Device().Name(), installer.NoFilesystem)
+		default:
+			return nil, fmt.Errorf("label %q is not supported", spec.Label)
+		}
 
-			if spec.Wipe {
-				opts.systemDiskTargets = append(opts.systemDiskTargets, target)
-			}
+		_, err = target.Locate(pt)
+		if err != nil {
+			return nil, fmt.Errorf("failed location partition with label %q: %w", spec.Label, err)
+		}
+
+		if spec.Wipe {
+			opts.systemDiskTargets = append(opts.systemDiskTargets, target)
 		}
 	}
 
@@ -1689,6 +1705,405 @@ func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machi
 	return reply, nil
 }
 
+// Netstat implements the machine.MachineServer interface.
+//
+//nolint:gocyclo
+func (s *Server) Netstat(ctx context.Context, in *machine.NetstatRequest) (*machine.NetstatResponse, error) {
+	protocols := in.Protocols
+	if len(protocols) == 0 {
+		for protocol := range machine.SocketInfo_Protocol_name {
+			protocols = append(protocols, machine.SocketInfo_Protocol(protocol))
+		}
+
+		sort.Slice(protocols, func(i, j int) bool { return protocols[i] < protocols[j] })
+	}
+
+	type netNS struct {
+		name    string
+		netPath string
+	}
+
+	// the host network namespace goes first
+	namespaces := []netNS{
+		{
+			netPath: "/proc/net",
+		},
+	}
+
+	if in.Pods {
+		podNamespaces, err := miniprocfs.NetNamespaces("/proc")
+		if err != nil {
+			return nil, err
+		}
+
+		for name, pid := range podNamespaces {
+			namespaces = append(namespaces, netNS{
+				name:    name,
+				netPath: filepath.Join("/proc", strconv.Itoa(int(pid)), "net"),
+			})
+		}
+
+		podNetNS := namespaces[1:]
+
+		sort.Slice(podNetNS, func(i, j int) bool { return podNetNS[i].name < podNetNS[j].name })
+	}
+
+	var owners map[uint64]miniprocfs.SocketOwner
+
+	if in.Pid {
+		var err error
+
+		owners, err = miniprocfs.SocketOwners("/proc")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sockets []*machine.SocketInfo
+
+	for _, ns := range namespaces {
+		for _, protocol := range protocols {
+			list, err := miniprocfs.Sockets(ns.netPath, protocol)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, info := range list {
+				switch in.Filter {
+				case machine.NetstatRequest_ALL:
+				case machine.NetstatRequest_LISTENING:
+					if !miniprocfs.IsListening(info) {
+						continue
+					}
+				case machine.NetstatRequest_CONNECTED:
+					if miniprocfs.IsListening(info) {
+						continue
+					}
+				}
+
+				info.Netns = ns.name
+
+				if owner, ok := owners[info.Inode]; ok {
+					info.Pid = owner.Pid
+					info.Command = owner.Command
+				}
+
+				sockets = append(sockets, info)
+			}
+		}
+	}
+
+	return &machine.NetstatResponse{
+		Messages: []*machine.Netstat{
+			{
+				Sockets: sockets,
+			},
+		},
+	}, nil
+}
+
+// Cgroups implements the machine.MachineServer interface.
+func (s *Server) Cgroups(ctx context.Context, in *machine.CgroupsRequest) (*machine.CgroupsResponse, error) {
+	tree := cgroups.Tree{
+		Root:         constants.CgroupMountPath,
+		ProcPath:     "/proc",
+		DevBlockPath: "/sys/dev/block",
+	}
+
+	infos, err := tree.Walk(in.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, status.Errorf(codes.NotFound, "cgroup %q not found: %s", in.Path, err)
+		}
+
+		return nil, err
+	}
+
+	return &machine.CgroupsResponse{
+		Messages: []*machine.Cgroups{
+			{
+				Cgroups: infos,
+			},
+		},
+	}, nil
+}
+
+// UpdateEncryptionKeys implements the machine.MachineServer interface.
+//
+// The key slot is changed on the mounted partition first, and then the machine configuration is updated
+// to match the key slots (the same way as ApplyConfiguration in no-reboot mode), so that the keys are not changed back on the next boot.
+// If the machine configuration can't be persisted, the key slot change is rolled back.
+// If the node goes down before the configuration is persisted, the keys are synced back to the old configuration on the next boot.
+//
+//nolint:gocyclo,cyclop
+func (s *Server) UpdateEncryptionKeys(ctx context.Context, in *machine.UpdateEncryptionKeysRequest) (*machine.UpdateEncryptionKeysResponse, error) {
+	if in.Partition != constants.StatePartitionLabel && in.Partition != constants.EphemeralPartitionLabel {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported partition %q, expected %s or %s", in.Partition, constants.StatePartitionLabel, constants.EphemeralPartitionLabel)
+	}
+
+	if in.Key == nil {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	handler := mount.SystemPartitionEncryption(in.Partition)
+	if handler == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "partition %s is not mounted or not encrypted", in.Partition)
+	}
+
+	// the machine configuration is read and persisted under the lock, so that concurrent updates don't overwrite each other's key slots
+	s.encryptionKeysMu.Lock()
+	defer s.encryptionKeysMu.Unlock()
+
+	cfg := s.Controller.Runtime().Config()
+
+	v1alpha1Config, ok := cfg.Raw().(*v1alpha1.Config)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "unsupported machine configuration")
+	}
+
+	v1alpha1Config = v1alpha1Config.DeepCopy()
+
+	var encryptionConfig *v1alpha1.EncryptionConfig
+
+	if encryption := v1alpha1Config.MachineConfig.MachineSystemDiskEncryption; encryption != nil {
+		switch in.Partition {
+		case constants.StatePartitionLabel:
+			encryptionConfig = encryption.StatePartition
+		case constants.EphemeralPartitionLabel:
+			encryptionConfig = encryption.EphemeralPartition
+		}
+	}
+
+	if encryptionConfig == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "partition %s encryption is not configured", in.Partition)
+	}
+
+	slot := int(in.Key.Slot)
+
+	var key *v1alpha1.EncryptionKey
+
+	if in.Action != machine.UpdateEncryptionKeysRequest_REMOVE {
+		var err error
+
+		if key, err = encryptionKeyFromSpec(in.Key); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var previousKey *v1alpha1.EncryptionKey
+
+	for _, k := range encryptionConfig.EncryptionKeys {
+		if k.KeySlot == slot {
+			previousKey = k
+		}
+	}
+
+	configured := previousKey != nil
+
+	encryptionConfig.EncryptionKeys = slices.Filter(encryptionConfig.EncryptionKeys, func(k *v1alpha1.EncryptionKey) bool { return k.KeySlot != slot })
+
+	switch in.Action {
+	case machine.UpdateEncryptionKeysRequest_ADD:
+		if configured {
+			return nil, status.Errorf(codes.InvalidArgument, "key slot %d is already defined in the machine configuration", slot)
+		}
+
+		encryptionConfig.EncryptionKeys = append(encryptionConfig.EncryptionKeys, key)
+	case machine.UpdateEncryptionKeysRequest_ROTATE:
+		encryptionConfig.EncryptionKeys = append(encryptionConfig.EncryptionKeys, key)
+	case machine.UpdateEncryptionKeysRequest_REMOVE:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported action %s", in.Action)
+	}
+
+	sort.Slice(encryptionConfig.EncryptionKeys, func(i, j int) bool {
+		return encryptionConfig.EncryptionKeys[i].KeySlot < encryptionConfig.EncryptionKeys[j].KeySlot
+	})
+
+	cfgContainer, err := configcontainer.New(v1alpha1Config, cfg.Documents()...)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgBytes, err := cfgContainer.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	cfgProvider, err := s.Controller.Runtime().LoadAndValidateConfig(cfgBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	oldCfgBytes, err := cfg.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	// as with ApplyConfiguration, the pending 'try' mode config rollback would revert the key slots in the configuration
+	s.Controller.Runtime().CancelConfigRollbackTimeout()
+
+	//nolint:exhaustive
+	switch in.Action {
+	case machine.UpdateEncryptionKeysRequest_ADD:
+		err = handler.AddKey(ctx, key)
+	case machine.UpdateEncryptionKeysRequest_ROTATE:
+		err = handler.RotateKey(ctx, key)
+	case machine.UpdateEncryptionKeysRequest_REMOVE:
+		err = handler.RemoveKey(ctx, slot)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to update encryption keys of partition %s: %s", in.Partition, err)
+	}
+
+	log.Printf("encryption key at slot %d of partition %s updated: %s", slot, in.Partition, strings.ToLower(in.Action.String()))
+
+	// rollback reverts the key slot change to match the previous machine configuration
+	rollback := func(cause error) error {
+		var rollbackErr error
+
+		//nolint:exhaustive
+		switch in.Action {
+		case machine.UpdateEncryptionKeysRequest_ADD:
+			rollbackErr = handler.RemoveKey(ctx, slot)
+		case machine.UpdateEncryptionKeysRequest_ROTATE:
+			if previousKey != nil {
+				rollbackErr = handler.RotateKey(ctx, previousKey)
+			}
+		case machine.UpdateEncryptionKeysRequest_REMOVE:
+			if previousKey != nil {
+				rollbackErr = handler.AddKey(ctx, previousKey)
+			}
+		}
+
+		if rollbackErr != nil {
+			return fmt.Errorf("failed to update machine configuration: %w (rolling back encryption keys failed: %s)", cause, rollbackErr)
+		}
+
+		log.Printf("rolled back encryption key update at slot %d of partition %s", slot, in.Partition)
+
+		return fmt.Errorf("failed to update machine configuration: %w", cause)
+	}
+
+	if err = saveConfig(cfgBytes); err != nil {
+		return nil, rollback(err)
+	}
+
+	if err = s.Controller.Runtime().SetConfig(cfgProvider); err != nil {
+		if restoreErr := saveConfig(oldCfgBytes); restoreErr != nil {
+			log.Printf("failed to restore machine configuration: %s", restoreErr)
+		}
+
+		return nil, rollback(err)
+	}
+
+	if in.Partition == constants.StatePartitionLabel {
+		if err = saveStateEncryptionConfig(cfgProvider.Machine().SystemDiskEncryption().Get(constants.StatePartitionLabel)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = mount.UpdateEncryptionStatus(ctx, s.Controller.Runtime(), in.Partition); err != nil {
+		return nil, err
+	}
+
+	return &machine.UpdateEncryptionKeysResponse{
+		Messages: []*machine.UpdateEncryptionKeys{
+			{},
+		},
+	}, nil
+}
+
+// saveConfig atomically replaces the machine configuration file.
+func saveConfig(cfg []byte) error {
+	tmp := constants.ConfigPath + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp) //nolint:errcheck
+
+	defer f.Close() //nolint:errcheck
+
+	if _, err = f.Write(cfg); err != nil {
+		return err
+	}
+
+	if err = f.Sync(); err != nil {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, constants.ConfigPath)
+}
+
+func encryptionKeyFromSpec(spec *machine.EncryptionKeySpec) (*v1alpha1.EncryptionKey, error) {
+	key := &v1alpha1.EncryptionKey{
+		KeySlot: int(spec.Slot),
+	}
+
+	kinds := 0
+
+	if spec.StaticPassphrase != "" {
+		key.KeyStatic = &v1alpha1.EncryptionKeyStatic{
+			KeyData: spec.StaticPassphrase,
+		}
+
+		kinds++
+	}
+
+	if spec.NodeId {
+		key.KeyNodeID = &v1alpha1.EncryptionKeyNodeID{}
+
+		kinds++
+	}
+
+	if spec.KmsEndpoint != "" {
+		key.KeyKMS = &v1alpha1.EncryptionKeyKMS{
+			KMSEndpoint: spec.KmsEndpoint,
+		}
+
+		kinds++
+	}
+
+	if kinds != 1 {
+		return nil, fmt.Errorf("exactly one key kind should be specified: static passphrase, node ID or KMS endpoint")
+	}
+
+	return key, nil
+}
+
+// saveStateEncryptionConfig keeps the STATE encryption config in the META partition in sync with the machine configuration.
+func saveStateEncryptionConfig(encryption config.Encryption) error {
+	if encryption == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(encryption)
+	if err != nil {
+		return err
+	}
+
+	meta, err := bootloader.NewMeta()
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer meta.Close()
+
+	if !meta.ADV.SetTagBytes(adv.StateEncryptionConfig, data) {
+		return fmt.Errorf("failed to save state encryption config in the META partition")
+	}
+
+	return meta.Write()
+}
+
 // Memory implements the machine.MachineServer interface.
 func (s *Server) Memory(ctx context.Context, in *emptypb.Empty) (reply *machine.MemoryResponse, err error) {
 	proc, err := procfs.NewDefaultFS()
@@ -1763,6 +2178,64 @@ func (s *Server) Memory(ctx context.Context, in *emptypb.Empty) (reply *machine.
 	return reply, err
 }
 
+// Metrics implements the machine.MachineServer interface.
+func (s *Server) Metrics(ctx context.Context, in *emptypb.Empty) (*machine.MetricsResponse, error) {
+	metricsConfig := s.Controller.Runtime().Config().Machine().Metrics()
+
+	if !metricsConfig.Enabled() {
+		return nil, status.Error(codes.FailedPrecondition, "metrics are not enabled in the machine configuration")
+	}
+
+	reply := &machine.Metrics{}
+
+	text, err := metrics.Gather()
+	reply.Services = append(reply.Services, serviceMetrics("machined", text, err))
+
+	type endpoint struct {
+		service string
+		port    int
+	}
+
+	endpoints := []endpoint{
+		{"apid", constants.ApidMetricsPort},
+	}
+
+	// trustd runs only on control plane nodes
+	if s.checkControlplane("trustd") == nil {
+		endpoints = append(endpoints, endpoint{"trustd", constants.TrustdMetricsPort})
+	}
+
+	for _, endpoint := range endpoints {
+		fetchCtx, fetchCancel := context.WithTimeout(ctx, 10*time.Second)
+
+		text, err = metrics.Fetch(fetchCtx, metrics.LocalAddress(metricsConfig.BindAddress(), endpoint.port))
+
+		fetchCancel()
+
+		reply.Services = append(reply.Services, serviceMetrics(endpoint.service, text, err))
+	}
+
+	return &machine.MetricsResponse{
+		Messages: []*machine.Metrics{
+			reply,
+		},
+	}, nil
+}
+
+func serviceMetrics(service, text string, err error) *machine.ServiceMetrics {
+	if err != nil {
+		return &machine.ServiceMetrics{
+			Service: service,
+			Error:   err.Error(),
+		}
+	}
+
+	return &machine.ServiceMetrics{
+		Service: service,
+		Text:    text,
+	}
+}
+
 // EtcdMemberList implements the machine.MachineServer interface.
 func (s *Server) EtcdMemberList(ctx context.Context, in *machine.EtcdMemberListRequest) (reply *machine.EtcdMemberListResponse, err error) {
 	if err = s.checkControlplane("member list"); err != nil {
@@ -1915,27 +2388,25 @@ func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.Machi
 	//nolint:errcheck
 	defer client.Close()
 
-	rd, err := client.Snapshot(srv.Context())
-	if err != nil {
-		return fmt.Errorf("failed reading etcd snapshot: %w", err)
-	}
-
-	ctx, cancel := context.WithCancel(srv.Context())
-	defer cancel()
+	_, err = client.WriteSnapshot(srv.Context(), dataStreamWriter{srv})
 
-	chunker := stream.NewChunker(ctx, rd)
-	chunkCh := chunker.Read()
+	return err
+}
 
-	for data := range chunkCh {
-		err := srv.SendMsg(&common.Data{Bytes: data})
-		if err != nil {
-			cancel()
+// dataStreamWriter sends the data written to it as common.Data messages.
+type dataStreamWriter struct {
+	srv grpc.ServerStream
+}
 
-			return err
-		}
+// Write implements io.Writer.
+//
+// The message is marshaled before SendMsg returns, so the buffer is not retained.
+func (w dataStreamWriter) Write(p []byte) (int, error) {
+	if err := w.srv.SendMsg(&common.Data{Bytes: p}); err != nil {
+		return 0, err
 	}
 
-	return nil
+	return len(p), nil
 }
 
 // EtcdRecover implements the machine.MachineServer interface.
@@ -2003,6 +2474,141 @@ func (s *Server) EtcdRecover(srv machine.MachineService_EtcdRecoverServer) error
 	})
 }
 
+// EtcdAlarmList implements the machine.MachineServer interface.
+func (s *Server) EtcdAlarmList(ctx context.Context, in *emptypb.Empty) (*machine.EtcdAlarmListResponse, error) {
+	if err := s.checkControlplane("etcd alarm list"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	resp, err := client.AlarmList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etcd alarms: %w", err)
+	}
+
+	return &machine.EtcdAlarmListResponse{
+		Messages: []*machine.EtcdAlarm{
+			{
+				MemberAlarms: slices.Map(resp.Alarms, mapEtcdMemberAlarm),
+			},
+		},
+	}, nil
+}
+
+// EtcdAlarmDisarm implements the machine.MachineServer interface.
+func (s *Server) EtcdAlarmDisarm(ctx context.Context, in *emptypb.Empty) (*machine.EtcdAlarmDisarmResponse, error) {
+	if err := s.checkControlplane("etcd alarm disarm"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	// zero member alarm disarms all alarms
+	resp, err := client.AlarmDisarm(ctx, &clientv3.AlarmMember{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to disarm etcd alarm: %w", err)
+	}
+
+	return &machine.EtcdAlarmDisarmResponse{
+		Messages: []*machine.EtcdAlarmDisarm{
+			{
+				MemberAlarms: slices.Map(resp.Alarms, mapEtcdMemberAlarm),
+			},
+		},
+	}, nil
+}
+
+func mapEtcdMemberAlarm(alarm *etcdserverpb.AlarmMember) *machine.EtcdMemberAlarm {
+	return &machine.EtcdMemberAlarm{
+		MemberId: alarm.MemberID,
+		Alarm:    machine.EtcdMemberAlarm_AlarmType(alarm.Alarm),
+	}
+}
+
+// EtcdDefragment implements the machine.MachineServer interface.
+func (s *Server) EtcdDefragment(ctx context.Context, in *emptypb.Empty) (*machine.EtcdDefragmentResponse, error) {
+	if err := s.checkControlplane("etcd defragment"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if err = client.DefragmentLocal(ctx); err != nil {
+		return nil, fmt.Errorf("failed to defragment etcd: %w", err)
+	}
+
+	return &machine.EtcdDefragmentResponse{
+		Messages: []*machine.EtcdDefragment{
+			{},
+		},
+	}, nil
+}
+
+// EtcdStatus implements the machine.MachineServer interface.
+func (s *Server) EtcdStatus(ctx context.Context, in *emptypb.Empty) (*machine.EtcdStatusResponse, error) {
+	if err := s.checkControlplane("etcd status"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	resp, err := client.LocalStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd status: %w", err)
+	}
+
+	// etcd doesn't report the quota in the status response, so it is taken from the etcd configuration
+	quota, err := etcd.QuotaBackendBytes(s.Controller.Runtime().Config().Cluster().Etcd().ExtraArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.EtcdStatusResponse{
+		Messages: []*machine.EtcdStatus{
+			{
+				MemberStatus: &machine.EtcdMemberStatus{
+					MemberId:         resp.Header.MemberId,
+					ProtocolVersion:  resp.Version,
+					DbSize:           resp.DbSize,
+					DbSizeInUse:      resp.DbSizeInUse,
+					Leader:           resp.Leader,
+					RaftIndex:        resp.RaftIndex,
+					RaftTerm:         resp.RaftTerm,
+					RaftAppliedIndex: resp.RaftAppliedIndex,
+					Errors:           resp.Errors,
+					IsLearner:        resp.IsLearner,
+					DbSizeQuota:      quota,
+				},
+			},
+		},
+	}, nil
+}
+
 // GenerateClientConfiguration implements the machine.MachineServer interface.
 func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.GenerateClientConfigurationRequest) (*machine.GenerateClientConfigurationResponse, error) {
 	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeWorker {
@@ -2016,9 +2622,27 @@ func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.Ge
 
 	ca := s.Controller.Runtime().Config().Machine().Security().CA()
 
-	roles, _ := role.Parse(in.Roles)
+	roles, unknownRoles := role.Parse(in.Roles)
+
+	customRoles := map[string]struct{}{}
+
+	for _, customRole := range s.Controller.Runtime().Config().Machine().Features().RBACRoles() {
+		customRoles[customRole.Name()] = struct{}{}
+	}
+
+	var undefinedRoles []string
+
+	for _, r := range unknownRoles {
+		if _, ok := customRoles[r]; !ok {
+			undefinedRoles = append(undefinedRoles, r)
+		}
+	}
+
+	if len(undefinedRoles) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown roles: %s", strings.Join(undefinedRoles, ", "))
+	}
 
-	cert, err := generate.NewAdminCertificateAndKey(time.Now(), ca, roles, crtTTL)
+	cert, err := generate.NewClientCertificateAndKey(time.Now(), ca, roles, crtTTL, in.CommonName)
 	if err != nil {
 		return nil, err
 	}
@@ -2050,6 +2674,67 @@ func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.Ge
 	return reply, nil
 }
 
+// PacketCapture implements the machine.MachineServer interface.
+func (s *Server) PacketCapture(in *machine.PacketCaptureRequest, srv machine.MachineService_PacketCaptureServer) error {
+	if _, err := net.InterfaceByName(in.Interface); err != nil {
+		return status.Errorf(codes.NotFound, "interface %q not found: %s", in.Interface, err)
+	}
+
+	filter := make([]bpf.RawInstruction, len(in.BpfFilter))
+
+	for i, ins := range in.BpfFilter {
+		filter[i] = bpf.RawInstruction{
+			Op: uint16(ins.Op),
+			Jt: uint8(ins.Jt),
+			Jf: uint8(ins.Jf),
+			K:  ins.K,
+		}
+	}
+
+	pr, pw := io.Pipe()
+
+	errCh := make(chan error, 1)
+
+	ctx, ctxCancel := context.WithCancel(srv.Context())
+	defer ctxCancel()
+
+	go func() {
+		//nolint:errcheck
+		defer pw.Close()
+
+		errCh <- pcap.Capture(ctx, pw, pcap.Options{
+			Interface:   in.Interface,
+			Promiscuous: in.Promiscuous,
+			SnapLen:     int(in.SnapLen),
+			Filter:      filter,
+		})
+	}()
+
+	chunker := stream.NewChunker(ctx, pr)
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		err := srv.SendMsg(&common.Data{Bytes: data})
+		if err != nil {
+			ctxCancel()
+		}
+	}
+
+	// unblock the capture if the chunker stopped reading on cancel
+	pr.Close() //nolint:errcheck
+
+	captureErr := <-errCh
+	if captureErr != nil && ctx.Err() == nil {
+		return srv.SendMsg(&common.Data{
+			Metadata: &common.Metadata{
+				Error: captureErr.Error(),
+			},
+		})
+	}
+
+	return nil
+}
+
 func upgradeMutex(c *etcd.Client) (*concurrency.Mutex, error) {
 	sess, err := concurrency.NewSession(c.Client,
 		concurrency.WithTTL(MinimumEtcdUpgradeLeaseLockSeconds),
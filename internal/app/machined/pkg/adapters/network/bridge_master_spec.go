@@ -25,6 +25,15 @@ type bridgeMaster struct {
 	*network.BridgeMasterSpec
 }
 
+// FillDefaults fills zero values with proper default values.
+func (a bridgeMaster) FillDefaults() {
+	bridge := a.BridgeMasterSpec
+
+	if bridge.VLAN.DefaultPVID == 0 {
+		bridge.VLAN.DefaultPVID = 1
+	}
+}
+
 // Encode the BridgeMasterSpec into netlink attributes.
 func (a bridgeMaster) Encode() ([]byte, error) {
 	bridge := a.BridgeMasterSpec
@@ -38,6 +47,17 @@ func (a bridgeMaster) Encode() ([]byte, error) {
 
 	encoder.Uint32(unix.IFLA_BR_STP_STATE, uint32(stpEnabled))
 
+	vlanFiltering := 0
+	if bridge.VLAN.FilteringEnabled {
+		vlanFiltering = 1
+	}
+
+	encoder.Uint8(unix.IFLA_BR_VLAN_FILTERING, uint8(vlanFiltering))
+
+	if bridge.VLAN.DefaultPVID != 0 {
+		encoder.Uint16(unix.IFLA_BR_VLAN_DEFAULT_PVID, bridge.VLAN.DefaultPVID)
+	}
+
 	return encoder.Encode()
 }
 
@@ -51,8 +71,13 @@ func (a bridgeMaster) Decode(data []byte) error {
 	}
 
 	for decoder.Next() {
-		if decoder.Type() == unix.IFLA_BR_STP_STATE {
+		switch decoder.Type() {
+		case unix.IFLA_BR_STP_STATE:
 			bridge.STP.Enabled = decoder.Uint32() == 1
+		case unix.IFLA_BR_VLAN_FILTERING:
+			bridge.VLAN.FilteringEnabled = decoder.Uint8() == 1
+		case unix.IFLA_BR_VLAN_DEFAULT_PVID:
+			bridge.VLAN.DefaultPVID = decoder.Uint16()
 		}
 	}
 
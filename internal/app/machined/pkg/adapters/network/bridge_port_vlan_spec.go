@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/vishvananda/netlink/nl"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// bridgePortVLANFlags is the set of VLAN flags managed by the adapter.
+const bridgePortVLANFlags = nl.BRIDGE_VLAN_INFO_PVID | nl.BRIDGE_VLAN_INFO_UNTAGGED
+
+// BridgePortVLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive
+func BridgePortVLANSpec(r *network.BridgePortVLANSpec) bridgePortVLAN {
+	return bridgePortVLAN{
+		BridgePortVLANSpec: r,
+	}
+}
+
+// bridgePortVLAN contains the bridge port VLAN spec and provides methods for encoding/decoding it to netlink structures.
+type bridgePortVLAN struct {
+	*network.BridgePortVLANSpec
+}
+
+// Encode the BridgePortVLANSpec into the map of VLAN ID to bridge VLAN flags.
+//
+// The PVID is egressed untagged, all other VLANs are egressed tagged.
+func (a bridgePortVLAN) Encode() map[uint16]uint16 {
+	spec := a.BridgePortVLANSpec
+
+	vlans := make(map[uint16]uint16, len(spec.TaggedVLANs)+1)
+
+	for _, vid := range spec.TaggedVLANs {
+		vlans[vid] = 0
+	}
+
+	if spec.PVID != 0 {
+		vlans[spec.PVID] = bridgePortVLANFlags
+	}
+
+	return vlans
+}
+
+// Decode the BridgePortVLANSpec from the list of bridge VLAN infos of the port.
+//
+// VLANs which are egressed untagged (except for the PVID) are not reported.
+func (a bridgePortVLAN) Decode(infos []*nl.BridgeVlanInfo) {
+	spec := a.BridgePortVLANSpec
+
+	spec.PVID = 0
+	spec.TaggedVLANs = nil
+
+	for _, info := range infos {
+		switch {
+		case info.PortVID():
+			spec.PVID = info.Vid
+		case !info.EngressUntag():
+			spec.TaggedVLANs = append(spec.TaggedVLANs, info.Vid)
+		}
+	}
+
+	spec.Sort()
+}
+
+// Equal checks whether the list of bridge VLAN infos of the port matches the spec.
+func (a bridgePortVLAN) Equal(infos []*nl.BridgeVlanInfo) bool {
+	desired := a.Encode()
+
+	if len(desired) != len(infos) {
+		return false
+	}
+
+	for _, info := range infos {
+		flags, ok := desired[info.Vid]
+		if !ok || flags != info.Flags&bridgePortVLANFlags {
+			return false
+		}
+	}
+
+	return true
+}
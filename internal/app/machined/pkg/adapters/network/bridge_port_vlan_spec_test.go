@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink/nl"
+
+	networkadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func TestBridgePortVLANSpec(t *testing.T) {
+	spec := network.BridgePortVLANSpec{
+		PVID:        10,
+		TaggedVLANs: []uint16{30, 20},
+	}
+
+	require.Equal(t, map[uint16]uint16{
+		10: nl.BRIDGE_VLAN_INFO_PVID | nl.BRIDGE_VLAN_INFO_UNTAGGED,
+		20: 0,
+		30: 0,
+	}, networkadapter.BridgePortVLANSpec(&spec).Encode())
+
+	infos := []*nl.BridgeVlanInfo{
+		{Vid: 10, Flags: nl.BRIDGE_VLAN_INFO_PVID | nl.BRIDGE_VLAN_INFO_UNTAGGED},
+		{Vid: 20},
+		{Vid: 30},
+	}
+
+	require.True(t, networkadapter.BridgePortVLANSpec(&spec).Equal(infos))
+
+	var decodedSpec network.BridgePortVLANSpec
+
+	networkadapter.BridgePortVLANSpec(&decodedSpec).Decode(infos)
+
+	require.Equal(t, network.BridgePortVLANSpec{
+		PVID:        10,
+		TaggedVLANs: []uint16{20, 30},
+	}, decodedSpec)
+
+	// default PVID assigned by the kernel
+	require.False(t, networkadapter.BridgePortVLANSpec(&spec).Equal([]*nl.BridgeVlanInfo{
+		{Vid: 1, Flags: nl.BRIDGE_VLAN_INFO_PVID | nl.BRIDGE_VLAN_INFO_UNTAGGED},
+	}))
+
+	// VLAN 20 is egressed untagged
+	require.False(t, networkadapter.BridgePortVLANSpec(&spec).Equal([]*nl.BridgeVlanInfo{
+		{Vid: 10, Flags: nl.BRIDGE_VLAN_INFO_PVID | nl.BRIDGE_VLAN_INFO_UNTAGGED},
+		{Vid: 20, Flags: nl.BRIDGE_VLAN_INFO_UNTAGGED},
+		{Vid: 30},
+	}))
+}
@@ -0,0 +1,38 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package block provides controllers which manage block device resources.
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// cleanupResources destroys the resources of the controller which were not touched during the reconcile loop.
+func cleanupResources(ctx context.Context, r controller.Runtime, owner string, resourceType resource.Type, touched map[resource.ID]struct{}) error {
+	list, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, resourceType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if res.Metadata().Owner() != owner {
+			continue
+		}
+
+		if _, ok := touched[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up %s %q: %w", resourceType, res.Metadata().ID(), err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/talos-systems/go-retry/retry"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// createBlockDevices creates block devices matching the testdata sysfs tree.
+func createBlockDevices(suite *ctest.DefaultSuite) {
+	sda := block.NewBlockDevice(block.NamespaceName, "sda")
+	*sda.TypedSpec() = block.BlockDeviceSpec{
+		Type:       block.BlockDeviceTypeDisk,
+		Major:      8,
+		Minor:      0,
+		DevicePath: "/devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda",
+	}
+
+	sda1 := block.NewBlockDevice(block.NamespaceName, "sda1")
+	*sda1.TypedSpec() = block.BlockDeviceSpec{
+		Type:       block.BlockDeviceTypePartition,
+		Major:      8,
+		Minor:      1,
+		Parent:     "sda",
+		DevicePath: "/devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda/sda1",
+	}
+
+	loop0 := block.NewBlockDevice(block.NamespaceName, "loop0")
+	*loop0.TypedSpec() = block.BlockDeviceSpec{
+		Type:       block.BlockDeviceTypeDisk,
+		Major:      7,
+		Minor:      0,
+		DevicePath: "/devices/virtual/block/loop0",
+	}
+
+	for _, res := range []resource.Resource{sda, sda1, loop0} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res))
+	}
+}
+
+// assertResource waits for the resource to be created and checks it with the assert function.
+func assertResource[T resource.Resource](suite *ctest.DefaultSuite, md resource.Metadata, assert func(T)) {
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				res, err := ctest.Get[T](suite, md)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				assert(res)
+
+				return nil
+			},
+		),
+	)
+}
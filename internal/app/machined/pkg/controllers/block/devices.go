@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block/internal/udev"
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DevicesController maintains the list of block devices based on sysfs and udev events.
+type DevicesController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+	SysfsPath    string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DevicesController) Name() string {
+	return "block.DevicesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DevicesController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DevicesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.BlockDeviceType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DevicesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		// no block devices in container mode
+		return nil
+	}
+
+	var (
+		mu      sync.Mutex
+		changed = map[string]struct{}{}
+	)
+
+	// udevd re-broadcasts kernel events after the udev database is updated,
+	// so watching udev events guarantees that the properties are already in the database
+	watcher, err := udev.Watch(udev.GroupUdev, func(event *udev.Event) {
+		if event.Subsystem != "block" {
+			return
+		}
+
+		mu.Lock()
+		changed[filepath.Base(event.DevPath)] = struct{}{}
+		mu.Unlock()
+
+		r.QueueReconcile()
+	})
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		mu.Lock()
+		changedDevices := changed
+		changed = map[string]struct{}{}
+		mu.Unlock()
+
+		if err = ctrl.reconcile(ctx, r, changedDevices); err != nil {
+			return err
+		}
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *DevicesController) reconcile(ctx context.Context, r controller.Runtime, changed map[string]struct{}) error {
+	disks, err := os.ReadDir(filepath.Join(ctrl.SysfsPath, "block"))
+	if err != nil {
+		return fmt.Errorf("error listing block devices: %w", err)
+	}
+
+	touched := map[resource.ID]struct{}{}
+
+	updateDevice := func(id, parent, typ string) error {
+		path := blockDevicePath(ctrl.SysfsPath, id, parent)
+
+		var major, minor int
+
+		if _, err := fmt.Sscanf(readSysfsString(path, "dev"), "%d:%d", &major, &minor); err != nil {
+			// device disappeared while scanning
+			return nil //nolint:nilerr
+		}
+
+		devicePath, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return nil //nolint:nilerr
+		}
+
+		touched[id] = struct{}{}
+
+		return r.Modify(ctx, block.NewBlockDevice(block.NamespaceName, id), func(res resource.Resource) error {
+			spec := res.(*block.BlockDevice).TypedSpec()
+
+			spec.Type = typ
+			spec.Major = major
+			spec.Minor = minor
+			spec.Parent = parent
+			spec.DevicePath = strings.TrimPrefix(devicePath, ctrl.SysfsPath)
+
+			if _, ok := changed[id]; ok {
+				spec.Generation++
+			}
+
+			return nil
+		})
+	}
+
+	for _, disk := range disks {
+		if err = updateDevice(disk.Name(), "", block.BlockDeviceTypeDisk); err != nil {
+			return fmt.Errorf("error updating block device %q: %w", disk.Name(), err)
+		}
+
+		entries, err := os.ReadDir(blockDevicePath(ctrl.SysfsPath, disk.Name(), ""))
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if _, err = os.Stat(filepath.Join(blockDevicePath(ctrl.SysfsPath, entry.Name(), disk.Name()), "partition")); err != nil {
+				continue
+			}
+
+			if err = updateDevice(entry.Name(), disk.Name(), block.BlockDeviceTypePartition); err != nil {
+				return fmt.Errorf("error updating block device %q: %w", entry.Name(), err)
+			}
+		}
+	}
+
+	return cleanupResources(ctx, r, ctrl.Name(), block.BlockDeviceType, touched)
+}
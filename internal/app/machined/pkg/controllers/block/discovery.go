@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block/internal/udev"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DiscoveryController reports the contents of the block devices (filesystems, partition tables and partitions).
+//
+// Block devices are probed by udevd (blkid builtin), so the controller reads the results from the udev database.
+type DiscoveryController struct {
+	SysfsPath    string
+	UdevDataPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DiscoveryController) Name() string {
+	return "block.DiscoveryController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DiscoveryController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.BlockDeviceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DiscoveryController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.DiscoveredVolumeType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: block.PartitionType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *DiscoveryController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		devices, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, block.BlockDeviceType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing block devices: %w", err)
+		}
+
+		touchedVolumes := map[resource.ID]struct{}{}
+		touchedPartitions := map[resource.ID]struct{}{}
+
+		for _, item := range devices.Items {
+			id := item.Metadata().ID()
+			device := item.(*block.BlockDevice).TypedSpec()
+
+			properties, err := udev.ReadBlockDeviceProperties(ctrl.UdevDataPath, device.Major, device.Minor)
+			if err != nil {
+				return fmt.Errorf("error reading udev properties for %q: %w", id, err)
+			}
+
+			path := blockDevicePath(ctrl.SysfsPath, id, device.Parent)
+			size := readSysfsUint(path, "size") * sectorSize
+
+			touchedVolumes[id] = struct{}{}
+
+			if err = r.Modify(ctx, block.NewDiscoveredVolume(block.NamespaceName, id), func(res resource.Resource) error {
+				*res.(*block.DiscoveredVolume).TypedSpec() = discoveredVolumeSpec(id, device, size, properties)
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating discovered volume %q: %w", id, err)
+			}
+
+			if device.Type != block.BlockDeviceTypePartition {
+				continue
+			}
+
+			touchedPartitions[id] = struct{}{}
+
+			if err = r.Modify(ctx, block.NewPartition(block.NamespaceName, id), func(res resource.Resource) error {
+				*res.(*block.Partition).TypedSpec() = block.PartitionSpec{
+					DevPath:  filepath.Join("/dev", id),
+					Parent:   device.Parent,
+					Number:   uint(readSysfsUint(path, "partition")),
+					Offset:   readSysfsUint(path, "start") * sectorSize,
+					Size:     size,
+					Label:    properties["ID_PART_ENTRY_NAME"],
+					UUID:     properties["ID_PART_ENTRY_UUID"],
+					TypeUUID: properties["ID_PART_ENTRY_TYPE"],
+				}
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating partition %q: %w", id, err)
+			}
+		}
+
+		if err = cleanupResources(ctx, r, ctrl.Name(), block.DiscoveredVolumeType, touchedVolumes); err != nil {
+			return err
+		}
+
+		if err = cleanupResources(ctx, r, ctrl.Name(), block.PartitionType, touchedPartitions); err != nil {
+			return err
+		}
+	}
+}
+
+func discoveredVolumeSpec(id string, device *block.BlockDeviceSpec, size uint64, properties map[string]string) block.DiscoveredVolumeSpec {
+	spec := block.DiscoveredVolumeSpec{
+		DevPath:        filepath.Join("/dev", id),
+		Type:           device.Type,
+		Parent:         device.Parent,
+		Size:           size,
+		PrettySize:     humanize.Bytes(size),
+		Name:           properties["ID_FS_TYPE"],
+		Label:          properties["ID_FS_LABEL"],
+		UUID:           properties["ID_FS_UUID"],
+		PartitionLabel: properties["ID_PART_ENTRY_NAME"],
+		PartitionUUID:  properties["ID_PART_ENTRY_UUID"],
+		PartitionType:  properties["ID_PART_ENTRY_TYPE"],
+	}
+
+	// whole disks might have a partition table instead of a filesystem,
+	// partitions inherit partition table properties of the parent disk, so they are ignored
+	if device.Type == block.BlockDeviceTypeDisk && spec.Name == "" && properties["ID_PART_TABLE_TYPE"] != "" {
+		spec.Name = properties["ID_PART_TABLE_TYPE"]
+		spec.UUID = properties["ID_PART_TABLE_UUID"]
+	}
+
+	if index, err := strconv.ParseUint(properties["ID_PART_ENTRY_NUMBER"], 10, 32); err == nil {
+		spec.PartitionIndex = uint(index)
+	}
+
+	return spec
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/suite"
+
+	blockctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+func TestDiscoverySuite(t *testing.T) {
+	suite.Run(t, &DiscoverySuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrl.DiscoveryController{
+					SysfsPath:    "testdata/sys",
+					UdevDataPath: "testdata/udev",
+				}))
+			},
+		},
+	})
+}
+
+type DiscoverySuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DiscoverySuite) TestReconcile() {
+	createBlockDevices(&suite.DefaultSuite)
+
+	assertResource(&suite.DefaultSuite, resource.NewMetadata(block.NamespaceName, block.DiscoveredVolumeType, "sda", resource.VersionUndefined),
+		func(volume *block.DiscoveredVolume) {
+			suite.Assert().Equal(block.DiscoveredVolumeSpec{
+				DevPath:    "/dev/sda",
+				Type:       block.BlockDeviceTypeDisk,
+				Size:       10737418240,
+				PrettySize: "11 GB",
+				Name:       "gpt",
+				UUID:       "4bb1fa4e-7b8f-4d1f-9a45-13dbd2a6c0b3",
+			}, *volume.TypedSpec())
+		},
+	)
+
+	assertResource(&suite.DefaultSuite, resource.NewMetadata(block.NamespaceName, block.DiscoveredVolumeType, "sda1", resource.VersionUndefined),
+		func(volume *block.DiscoveredVolume) {
+			suite.Assert().Equal(block.DiscoveredVolumeSpec{
+				DevPath:        "/dev/sda1",
+				Type:           block.BlockDeviceTypePartition,
+				Parent:         "sda",
+				Size:           104857600,
+				PrettySize:     "105 MB",
+				Name:           "vfat",
+				Label:          "EFI",
+				UUID:           "9E0B-2E15",
+				PartitionIndex: 1,
+				PartitionLabel: "EFI",
+				PartitionUUID:  "3c9d1c6e-3cb0-4c2b-8d4d-6b0a3f0d2a11",
+				PartitionType:  "c12a7328-f81f-11d2-ba4b-00a0c93ec93b",
+			}, *volume.TypedSpec())
+		},
+	)
+
+	assertResource(&suite.DefaultSuite, resource.NewMetadata(block.NamespaceName, block.PartitionType, "sda1", resource.VersionUndefined),
+		func(partition *block.Partition) {
+			suite.Assert().Equal(block.PartitionSpec{
+				DevPath:  "/dev/sda1",
+				Parent:   "sda",
+				Number:   1,
+				Offset:   1048576,
+				Size:     104857600,
+				Label:    "EFI",
+				UUID:     "3c9d1c6e-3cb0-4c2b-8d4d-6b0a3f0d2a11",
+				TypeUUID: "c12a7328-f81f-11d2-ba4b-00a0c93ec93b",
+			}, *partition.TypedSpec())
+		},
+	)
+
+	// loop device without udev properties
+	assertResource(&suite.DefaultSuite, resource.NewMetadata(block.NamespaceName, block.DiscoveredVolumeType, "loop0", resource.VersionUndefined),
+		func(volume *block.DiscoveredVolume) {
+			suite.Assert().Equal("/dev/loop0", volume.TypedSpec().DevPath)
+			suite.Assert().Empty(volume.TypedSpec().Name)
+		},
+	)
+}
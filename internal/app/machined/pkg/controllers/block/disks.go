@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block/internal/udev"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+// DisksController provides the hardware information for the disk block devices.
+type DisksController struct {
+	SysfsPath    string
+	UdevDataPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DisksController) Name() string {
+	return "block.DisksController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DisksController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.BlockDeviceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DisksController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.DiskType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DisksController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		devices, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, block.BlockDeviceType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing block devices: %w", err)
+		}
+
+		touched := map[resource.ID]struct{}{}
+
+		for _, item := range devices.Items {
+			id := item.Metadata().ID()
+			device := item.(*block.BlockDevice).TypedSpec()
+
+			// virtual devices (loop, device-mapper, etc.) are not disks
+			if device.Type != block.BlockDeviceTypeDisk || strings.HasPrefix(device.DevicePath, "/devices/virtual/") {
+				continue
+			}
+
+			properties, err := udev.ReadBlockDeviceProperties(ctrl.UdevDataPath, device.Major, device.Minor)
+			if err != nil {
+				return fmt.Errorf("error reading udev properties for %q: %w", id, err)
+			}
+
+			touched[id] = struct{}{}
+
+			if err = r.Modify(ctx, block.NewDisk(block.NamespaceName, id), func(res resource.Resource) error {
+				*res.(*block.Disk).TypedSpec() = ctrl.diskSpec(id, device, properties)
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating disk %q: %w", id, err)
+			}
+		}
+
+		if err = cleanupResources(ctx, r, ctrl.Name(), block.DiskType, touched); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *DisksController) diskSpec(id string, device *block.BlockDeviceSpec, properties map[string]string) block.DiskSpec {
+	path := blockDevicePath(ctrl.SysfsPath, id, "")
+	size := readSysfsUint(path, "size") * sectorSize
+
+	spec := block.DiskSpec{
+		DevPath:    filepath.Join("/dev", id),
+		Size:       size,
+		PrettySize: humanize.Bytes(size),
+		IOSize:     uint(readSysfsUint(path, "queue", "minimum_io_size")),
+		SectorSize: uint(readSysfsUint(path, "queue", "logical_block_size")),
+		Readonly:   readSysfsUint(path, "ro") == 1,
+		CDROM:      properties["ID_CDROM"] == "1",
+		Rotational: readSysfsUint(path, "queue", "rotational") == 1,
+		Model:      readSysfsString(path, "device", "model"),
+		Serial:     readSysfsString(path, "device", "serial"),
+		Modalias:   readSysfsString(path, "device", "modalias"),
+		WWID:       readSysfsString(path, "device", "wwid"),
+	}
+
+	if spec.Model == "" {
+		spec.Model = properties["ID_MODEL"]
+	}
+
+	if spec.Serial == "" {
+		spec.Serial = properties["ID_SERIAL_SHORT"]
+	}
+
+	if spec.WWID == "" {
+		spec.WWID = properties["ID_WWN"]
+	}
+
+	// bus path is the path of the parent device of the disk, e.g. /pci0000:00/0000:00:05.0/virtio2
+	if idx := strings.Index(device.DevicePath, "/block/"); idx != -1 {
+		spec.BusPath = strings.TrimPrefix(device.DevicePath[:idx], "/devices")
+	}
+
+	if subsystem, err := os.Readlink(filepath.Join(path, "device", "subsystem")); err == nil {
+		spec.SubSystem = filepath.Base(subsystem)
+	}
+
+	spec.Transport = diskTransport(device.DevicePath, properties["ID_BUS"])
+
+	return spec
+}
+
+// diskTransport detects the transport of the disk based on the sysfs device path and the udev bus.
+func diskTransport(devicePath, bus string) string {
+	switch {
+	case strings.Contains(devicePath, "/usb"):
+		return block.TransportUSB
+	case strings.Contains(devicePath, "/nvme/"):
+		return block.TransportNVMe
+	case strings.Contains(devicePath, "/virtio"):
+		return block.TransportVirtIO
+	case strings.Contains(devicePath, "/mmc_host/"):
+		return block.TransportMMC
+	case strings.Contains(devicePath, "/ata") || bus == "ata":
+		return block.TransportSATA
+	case bus == "scsi":
+		return block.TransportSCSI
+	default:
+		return bus
+	}
+}
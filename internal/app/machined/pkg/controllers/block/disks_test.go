@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	blockctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+)
+
+func TestDisksSuite(t *testing.T) {
+	suite.Run(t, &DisksSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrl.DisksController{
+					SysfsPath:    "testdata/sys",
+					UdevDataPath: "testdata/udev",
+				}))
+			},
+		},
+	})
+}
+
+type DisksSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DisksSuite) TestReconcile() {
+	createBlockDevices(&suite.DefaultSuite)
+
+	assertResource(&suite.DefaultSuite, resource.NewMetadata(block.NamespaceName, block.DiskType, "sda", resource.VersionUndefined),
+		func(disk *block.Disk) {
+			suite.Assert().Equal(block.DiskSpec{
+				DevPath:    "/dev/sda",
+				Size:       10737418240,
+				PrettySize: "11 GB",
+				IOSize:     512,
+				SectorSize: 512,
+				Rotational: true,
+				Model:      "QEMU HARDDISK",
+				Serial:     "QM00001",
+				BusPath:    "/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0",
+				Transport:  block.TransportSATA,
+			}, *disk.TypedSpec())
+		},
+	)
+
+	// partitions and virtual devices are not disks
+	for _, id := range []string{"sda1", "loop0"} {
+		_, err := suite.State().Get(suite.Ctx(), resource.NewMetadata(block.NamespaceName, block.DiskType, id, resource.VersionUndefined))
+		suite.Assert().True(state.IsNotFoundError(err))
+	}
+
+	// disk is removed
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), block.NewBlockDevice(block.NamespaceName, "sda").Metadata()))
+
+	suite.AssertWithin(10*time.Second, 100*time.Millisecond, func() error {
+		_, err := suite.State().Get(suite.Ctx(), resource.NewMetadata(block.NamespaceName, block.DiskType, "sda", resource.VersionUndefined))
+		if !state.IsNotFoundError(err) {
+			return retry.ExpectedErrorf("disk is not removed yet")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package udev
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReadBlockDeviceProperties reads udev properties of the block device from the udev database (usually /run/udev/data).
+//
+// If udev hasn't processed the device yet, empty properties are returned.
+func ReadBlockDeviceProperties(dbPath string, major, minor int) (map[string]string, error) {
+	f, err := os.Open(filepath.Join(dbPath, fmt.Sprintf("b%d:%d", major, minor)))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return map[string]string{}, nil
+		}
+
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	properties := map[string]string{}
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		// property lines look like "E:ID_FS_TYPE=xfs", other lines (symlinks, tags, etc.) are ignored
+		line := scanner.Text()
+
+		if !strings.HasPrefix(line, "E:") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line[2:], "=")
+		if !ok {
+			continue
+		}
+
+		properties[key] = value
+	}
+
+	return properties, scanner.Err()
+}
@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package udev_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block/internal/udev"
+)
+
+func TestReadBlockDeviceProperties(t *testing.T) {
+	properties, err := udev.ReadBlockDeviceProperties("testdata", 8, 6)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"ID_FS_TYPE":           "xfs",
+		"ID_FS_LABEL":          "EPHEMERAL",
+		"ID_FS_UUID":           "8c6b8e4e-4b5c-4d47-a1a2-8f4c9b2d1e0a",
+		"ID_PART_ENTRY_NAME":   "EPHEMERAL",
+		"ID_PART_ENTRY_NUMBER": "6",
+	}, properties)
+
+	// not processed by udev yet
+	properties, err = udev.ReadBlockDeviceProperties("testdata", 8, 7)
+	require.NoError(t, err)
+
+	assert.Empty(t, properties)
+}
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package udev implements watching udev events and reading udev database.
+package udev
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// Netlink multicast groups of NETLINK_KOBJECT_UEVENT socket.
+const (
+	GroupKernel = 1
+	GroupUdev   = 2
+)
+
+// libudev message header prefix and magic, see udev_monitor_netlink_header in libudev.
+const (
+	libudevPrefix = "libudev\x00"
+	libudevMagic  = 0xfeedcafe
+
+	libudevHeaderSize = 40
+)
+
+// Event is a single uevent (either sent by the kernel, or re-broadcast by udevd).
+type Event struct {
+	Action    string
+	DevPath   string
+	Subsystem string
+
+	// Values contains all event properties including the ones above.
+	Values map[string]string
+}
+
+// Watcher receives uevents from the netlink socket.
+type Watcher struct {
+	wg   sync.WaitGroup
+	file *os.File
+}
+
+// Watch starts watching uevents of the specified multicast group.
+//
+// Callback is called for every successfully parsed event.
+func Watch(group uint32, callback func(*Event)) (*Watcher, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, fmt.Errorf("error opening uevent socket: %w", err)
+	}
+
+	if err = unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: group}); err != nil {
+		unix.Close(fd) //nolint:errcheck
+
+		return nil, fmt.Errorf("error binding uevent socket: %w", err)
+	}
+
+	// socket is non-blocking, so os.File uses runtime poller, and Close() unblocks pending Read()
+	watcher := &Watcher{
+		file: os.NewFile(uintptr(fd), "uevent"),
+	}
+
+	watcher.wg.Add(1)
+
+	go func() {
+		defer watcher.wg.Done()
+
+		buf := make([]byte, 128*1024)
+
+		for {
+			n, readErr := watcher.file.Read(buf)
+			if readErr != nil {
+				return
+			}
+
+			event, parseErr := ParseEvent(buf[:n])
+			if parseErr != nil {
+				continue
+			}
+
+			callback(event)
+		}
+	}()
+
+	return watcher, nil
+}
+
+// Done stops the watcher.
+func (watcher *Watcher) Done() {
+	watcher.file.Close() //nolint:errcheck
+
+	watcher.wg.Wait()
+}
+
+// ParseEvent parses uevent message either in the kernel or libudev format.
+func ParseEvent(msg []byte) (*Event, error) {
+	var properties []byte
+
+	switch {
+	case bytes.HasPrefix(msg, []byte(libudevPrefix)):
+		if len(msg) < libudevHeaderSize {
+			return nil, fmt.Errorf("libudev message is too short: %d", len(msg))
+		}
+
+		if magic := binary.BigEndian.Uint32(msg[8:12]); magic != libudevMagic {
+			return nil, fmt.Errorf("unexpected libudev magic %x", magic)
+		}
+
+		// offsets are in host byte order, and Talos supports only little-endian architectures
+		offset := binary.LittleEndian.Uint32(msg[16:20])
+		length := binary.LittleEndian.Uint32(msg[20:24])
+
+		if uint64(offset)+uint64(length) > uint64(len(msg)) {
+			return nil, fmt.Errorf("libudev properties are out of bounds")
+		}
+
+		properties = msg[offset : offset+length]
+	default:
+		// kernel message: "action@devpath\0KEY=VALUE\0..."
+		idx := bytes.IndexByte(msg, 0)
+		if idx == -1 || !bytes.Contains(msg[:idx], []byte("@")) {
+			return nil, fmt.Errorf("malformed kernel uevent")
+		}
+
+		properties = msg[idx+1:]
+	}
+
+	event := &Event{
+		Values: map[string]string{},
+	}
+
+	for _, prop := range bytes.Split(properties, []byte{0}) {
+		key, value, ok := strings.Cut(string(prop), "=")
+		if !ok {
+			continue
+		}
+
+		event.Values[key] = value
+	}
+
+	event.Action = event.Values["ACTION"]
+	event.DevPath = event.Values["DEVPATH"]
+	event.Subsystem = event.Values["SUBSYSTEM"]
+
+	if event.Action == "" || event.DevPath == "" {
+		return nil, fmt.Errorf("uevent is missing ACTION or DEVPATH")
+	}
+
+	return event, nil
+}
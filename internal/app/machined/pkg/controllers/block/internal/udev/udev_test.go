@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package udev_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block/internal/udev"
+)
+
+func TestParseEventKernel(t *testing.T) {
+	msg := []byte("change@/devices/virtual/block/loop0\x00ACTION=change\x00DEVPATH=/devices/virtual/block/loop0\x00SUBSYSTEM=block\x00DEVNAME=loop0\x00DEVTYPE=disk\x00")
+
+	event, err := udev.ParseEvent(msg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "change", event.Action)
+	assert.Equal(t, "/devices/virtual/block/loop0", event.DevPath)
+	assert.Equal(t, "block", event.Subsystem)
+	assert.Equal(t, "disk", event.Values["DEVTYPE"])
+}
+
+func TestParseEventLibudev(t *testing.T) {
+	properties := []byte("ACTION=add\x00DEVPATH=/devices/pci0000:00/0000:00:05.0/virtio2/block/vda/vda1\x00SUBSYSTEM=block\x00ID_FS_TYPE=vfat\x00")
+
+	header := make([]byte, 40)
+	copy(header, "libudev\x00")
+	binary.BigEndian.PutUint32(header[8:12], 0xfeedcafe)
+	binary.LittleEndian.PutUint32(header[12:16], 40)
+	binary.LittleEndian.PutUint32(header[16:20], 40)
+	binary.LittleEndian.PutUint32(header[20:24], uint32(len(properties)))
+
+	event, err := udev.ParseEvent(append(header, properties...))
+	require.NoError(t, err)
+
+	assert.Equal(t, "add", event.Action)
+	assert.Equal(t, "/devices/pci0000:00/0000:00:05.0/virtio2/block/vda/vda1", event.DevPath)
+	assert.Equal(t, "block", event.Subsystem)
+	assert.Equal(t, "vfat", event.Values["ID_FS_TYPE"])
+
+	// properties out of bounds
+	binary.LittleEndian.PutUint32(header[20:24], uint32(len(properties))+1)
+
+	_, err = udev.ParseEvent(append(header, properties...))
+	assert.Error(t, err)
+}
+
+func TestParseEventMalformed(t *testing.T) {
+	for _, msg := range []string{
+		"",
+		"garbage",
+		"add@/devices/virtual/block/loop0\x00SUBSYSTEM=block\x00",
+	} {
+		_, err := udev.ParseEvent([]byte(msg))
+		assert.Error(t, err, "%q", msg)
+	}
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sectorSize is the unit of the `size` and `start` sysfs attributes.
+const sectorSize = 512
+
+// readSysfsString reads the sysfs attribute, missing attributes are returned as empty strings.
+func readSysfsString(path ...string) string {
+	contents, err := os.ReadFile(filepath.Join(path...))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(contents))
+}
+
+// readSysfsUint reads the numeric sysfs attribute, missing or invalid attributes are returned as zero.
+func readSysfsUint(path ...string) uint64 {
+	v, err := strconv.ParseUint(readSysfsString(path...), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+// blockDevicePath returns the sysfs directory of the block device.
+//
+// Partitions are nested under the parent disk directory.
+func blockDevicePath(sysfsPath, id, parent string) string {
+	if parent != "" {
+		return filepath.Join(sysfsPath, "block", parent, id)
+	}
+
+	return filepath.Join(sysfsPath, "block", id)
+}
@@ -0,0 +1,300 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	etcdcli "github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// BackupController takes scheduled etcd snapshots and publishes the list of completed backups.
+//
+// For the shared (S3) destinations only the etcd leader takes the snapshots, so that a single backup is taken per interval across the cluster.
+// Local destinations are per-node: each control plane node takes and keeps its own snapshots.
+type BackupController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *BackupController) Name() string {
+	return "etcd.BackupController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BackupController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To("etcd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BackupController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.BackupType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *BackupController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var timer *time.Timer
+
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	timerCh := func() <-chan time.Time {
+		if timer == nil {
+			return nil
+		}
+
+		return timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-timerCh():
+		}
+
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		var backupConfig talosconfig.EtcdBackup
+
+		if cfg != nil {
+			cfgProvider := cfg.(*config.MachineConfig).Config()
+
+			if cfgProvider.Machine().Type() != machine.TypeWorker && cfgProvider.Cluster().Etcd().Backup().Enabled() {
+				backupConfig = cfgProvider.Cluster().Etcd().Backup()
+			}
+		}
+
+		if backupConfig == nil {
+			if err = ctrl.cleanup(ctx, r, nil); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		etcdResource, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		if !etcdResource.(*v1alpha1.Service).TypedSpec().Healthy {
+			continue
+		}
+
+		delay, err := ctrl.reconcile(ctx, r, logger, backupConfig)
+		if err != nil {
+			logger.Warn("etcd backup failed, will retry", zap.Error(err))
+
+			delay = constants.EtcdBackupRetryInterval
+		}
+
+		timer = time.NewTimer(delay)
+	}
+}
+
+// reconcile takes the snapshot if it's due, and returns the delay until the next snapshot.
+func (ctrl *BackupController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, backupConfig talosconfig.EtcdBackup) (time.Duration, error) {
+	dest, err := backup.NewDestination(backupConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	snapshots, err := dest.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var delay time.Duration
+
+	if len(snapshots) > 0 {
+		delay = time.Until(snapshots[len(snapshots)-1].Timestamp.Add(backupConfig.Interval()))
+	}
+
+	if delay <= 0 {
+		var taken bool
+
+		taken, err = ctrl.takeSnapshot(ctx, logger, dest)
+		if err != nil {
+			return 0, err
+		}
+
+		if taken {
+			delay = backupConfig.Interval()
+
+			if snapshots, err = dest.List(ctx); err != nil {
+				return 0, err
+			}
+
+			expired := backup.Expired(snapshots, backupConfig.Retention())
+
+			for _, snapshot := range expired {
+				if err = dest.Delete(ctx, snapshot.Name); err != nil {
+					return 0, fmt.Errorf("error removing expired backup %q: %w", snapshot.Name, err)
+				}
+
+				logger.Info("removed expired etcd backup", zap.String("location", dest.Location(snapshot.Name)))
+			}
+
+			snapshots = snapshots[len(expired):]
+		} else {
+			// not a leader, check again later in case the leader changes
+			delay = constants.EtcdBackupRetryInterval
+		}
+	}
+
+	return delay, ctrl.updateBackups(ctx, r, logger, dest, snapshots)
+}
+
+func (ctrl *BackupController) takeSnapshot(ctx context.Context, logger *zap.Logger, dest backup.Destination) (bool, error) {
+	client, err := etcdcli.NewLocalClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if dest.Shared() {
+		leader, err := client.IsLocalLeader(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to get etcd status: %w", err)
+		}
+
+		if !leader {
+			return false, nil
+		}
+	}
+
+	timestamp := time.Now()
+
+	defer os.Remove(constants.EtcdBackupStagingPath) //nolint:errcheck
+
+	info, err := client.SaveSnapshot(ctx, constants.EtcdBackupStagingPath)
+	if err != nil {
+		return false, err
+	}
+
+	name := backup.Name(timestamp, info.Revision)
+
+	if err = dest.Save(ctx, name, constants.EtcdBackupStagingPath, info.SHA256); err != nil {
+		return false, err
+	}
+
+	logger.Info("etcd backup completed",
+		zap.String("location", dest.Location(name)),
+		zap.Int64("size", info.Size),
+		zap.Int64("revision", info.Revision),
+	)
+
+	return true, nil
+}
+
+func (ctrl *BackupController) updateBackups(ctx context.Context, r controller.Runtime, logger *zap.Logger, dest backup.Destination, snapshots []backup.Snapshot) error {
+	touchedIDs := make(map[resource.ID]struct{}, len(snapshots))
+
+	for _, snapshot := range snapshots {
+		snapshot := snapshot
+
+		if err := r.Modify(ctx, etcd.NewBackup(etcd.NamespaceName, snapshot.Name), func(res resource.Resource) error {
+			spec := res.(*etcd.Backup).TypedSpec()
+
+			if spec.SHA256 == "" {
+				checksum, err := dest.Checksum(ctx, snapshot.Name)
+				if err != nil {
+					logger.Warn("failed to get etcd backup checksum", zap.String("location", dest.Location(snapshot.Name)), zap.Error(err))
+				}
+
+				spec.SHA256 = checksum
+			}
+
+			spec.Location = dest.Location(snapshot.Name)
+			spec.Timestamp = snapshot.Timestamp
+			spec.Size = snapshot.Size
+			spec.Revision = snapshot.Revision
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating backup: %w", err)
+		}
+
+		touchedIDs[snapshot.Name] = struct{}{}
+	}
+
+	return ctrl.cleanup(ctx, r, touchedIDs)
+}
+
+func (ctrl *BackupController) cleanup(ctx context.Context, r controller.Runtime, touchedIDs map[resource.ID]struct{}) error {
+	list, err := r.List(ctx, resource.NewMetadata(etcd.NamespaceName, etcd.BackupType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if res.Metadata().Owner() != ctrl.Name() {
+			continue
+		}
+
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up backups: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
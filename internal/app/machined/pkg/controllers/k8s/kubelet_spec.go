@@ -7,6 +7,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,8 +18,10 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 	"inet.af/netaddr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	kubeletconfig "k8s.io/kubelet/config/v1beta1"
 
 	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
@@ -27,6 +30,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
 	"github.com/talos-systems/talos/pkg/machinery/kubelet"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // KubeletSpecController renders manifests based on templates and config/secrets.
@@ -60,6 +64,17 @@ func (ctrl *KubeletSpecController) Inputs() []controller.Input {
 			ID:        pointer.To(k8s.KubeletID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtimeres.NamespaceName,
+			Type:      runtimeres.PlatformMetadataType,
+			ID:        pointer.To(runtimeres.PlatformMetadataID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeTaintSpecType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -143,6 +158,22 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 			args["node-ip"] = strings.Join(nodeIPsString, ",")
 		}
 
+		platformMetadata, err := r.Get(ctx, resource.NewMetadata(runtimeres.NamespaceName, runtimeres.PlatformMetadataType, runtimeres.PlatformMetadataID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting platform metadata: %w", err)
+			}
+		} else {
+			applyPlatformMetadata(args, platformMetadata.(*runtimeres.PlatformMetadata).TypedSpec())
+		}
+
+		taints, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeTaintSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing node taint specs: %w", err)
+		}
+
+		applyNodeTaints(args, taints.Items)
+
 		if err = args.Merge(extraArgs, argsbuilder.WithMergePolicies(
 			argsbuilder.MergePolicies{
 				"bootstrap-kubeconfig":       argsbuilder.MergeDenied,
@@ -151,6 +182,8 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 				"container-runtime-endpoint": argsbuilder.MergeDenied,
 				"config":                     argsbuilder.MergeDenied,
 				"cert-dir":                   argsbuilder.MergeDenied,
+				"node-labels":                argsbuilder.MergeAdditive,
+				"register-with-taints":       argsbuilder.MergeAdditive,
 			},
 		)); err != nil {
 			return fmt.Errorf("error merging arguments: %w", err)
@@ -192,6 +225,61 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 	}
 }
 
+// applyPlatformMetadata sets the provider ID and the well-known topology labels as reported by the platform.
+//
+// Labels set explicitly via extra args take precedence, as they are appended to the list.
+func applyPlatformMetadata(args argsbuilder.Args, metadata *runtimeres.PlatformMetadataSpec) {
+	if metadata.ProviderID != "" {
+		args["provider-id"] = metadata.ProviderID
+	}
+
+	var labels []string
+
+	for _, label := range []struct {
+		key   string
+		value string
+	}{
+		{corev1.LabelTopologyRegion, metadata.Region},
+		{corev1.LabelTopologyZone, metadata.Zone},
+		{corev1.LabelInstanceTypeStable, metadata.InstanceType},
+	} {
+		// skip values which are not valid label values (e.g. availability domains with colons)
+		if label.value != "" && len(validation.IsValidLabelValue(label.value)) == 0 {
+			labels = append(labels, label.key+"="+label.value)
+		}
+	}
+
+	if len(labels) > 0 {
+		args["node-labels"] = strings.Join(labels, ",")
+	}
+}
+
+// applyNodeTaints registers the node with the taints from the machine config.
+//
+// NodeRestriction admission plugin doesn't allow the kubelet to modify taints of the registered node,
+// so the taints are passed to the kubelet to be set when the node is registered.
+func applyNodeTaints(args argsbuilder.Args, taints []resource.Resource) {
+	if len(taints) == 0 {
+		return
+	}
+
+	specs := make([]string, 0, len(taints))
+
+	for _, res := range taints {
+		taint := res.(*k8s.NodeTaintSpec).TypedSpec()
+
+		if taint.Value == "" {
+			specs = append(specs, taint.Key+":"+taint.Effect)
+		} else {
+			specs = append(specs, taint.Key+"="+taint.Value+":"+taint.Effect)
+		}
+	}
+
+	sort.Strings(specs)
+
+	args["register-with-taints"] = strings.Join(specs, ",")
+}
+
 func prepareExtraConfig(extraConfig map[string]interface{}) (*kubeletconfig.KubeletConfiguration, error) {
 	// check for fields that can't be overridden via extraConfig
 	var multiErr *multierror.Error
@@ -270,6 +358,10 @@ func NewKubeletConfiguration(clusterDNS []string, dnsDomain string, extraConfig
 		config.ClusterDNS = clusterDNS
 	}
 
+	if config.ResolverConfig == nil {
+		config.ResolverConfig = pointer.To(constants.PodResolvConfPath)
+	}
+
 	if config.SerializeImagePulls == nil {
 		config.SerializeImagePulls = pointer.To(false)
 	}
@@ -8,6 +8,7 @@ package k8s_test
 import (
 	"context"
 	"log"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -32,7 +33,9 @@ import (
 	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
 	"github.com/talos-systems/talos/pkg/logging"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 type KubeletSpecSuite struct {
@@ -265,6 +268,143 @@ func (suite *KubeletSpecSuite) TestReconcileWithExtraConfig() {
 	)
 }
 
+func (suite *KubeletSpecSuite) TestReconcileWithPlatformMetadata() {
+	cfg := k8s.NewKubeletConfig(k8s.NamespaceName, k8s.KubeletID)
+	cfg.TypedSpec().Image = "kubelet:v1.0.0"
+	cfg.TypedSpec().ClusterDNS = []string{"10.96.0.10"}
+	cfg.TypedSpec().ClusterDomain = "cluster.local"
+	cfg.TypedSpec().ExtraArgs = map[string]string{"node-labels": "foo=bar"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	nodeIP := k8s.NewNodeIP(k8s.NamespaceName, k8s.KubeletID)
+	nodeIP.TypedSpec().Addresses = []netaddr.IP{netaddr.MustParseIP("172.20.0.2")}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodeIP))
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "example.com"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodename))
+
+	platformMetadata := runtimeres.NewPlatformMetadata(runtimeres.NamespaceName, runtimeres.PlatformMetadataID)
+	*platformMetadata.TypedSpec() = runtimeres.PlatformMetadataSpec{
+		Platform:     "aws",
+		Region:       "us-east-1",
+		Zone:         "us-east-1a",
+		InstanceType: "t3.small",
+		InstanceID:   "i-0123456789abcdef0",
+		ProviderID:   "aws:///us-east-1a/i-0123456789abcdef0",
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, platformMetadata))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletSpec, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletSpecType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := kubeletSpec.(*k8s.KubeletSpec).TypedSpec()
+
+				suite.Assert().Equal(
+					[]string{
+						"--bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubeconfig",
+						"--cert-dir=/var/lib/kubelet/pki",
+						"--config=/etc/kubernetes/kubelet.yaml",
+						"--container-runtime=remote",
+						"--container-runtime-endpoint=unix:///run/containerd/containerd.sock",
+						"--hostname-override=example.com",
+						"--kubeconfig=/etc/kubernetes/kubeconfig-kubelet",
+						"--node-ip=172.20.0.2",
+						"--node-labels=topology.kubernetes.io/region=us-east-1,topology.kubernetes.io/zone=us-east-1a,node.kubernetes.io/instance-type=t3.small,foo=bar",
+						"--provider-id=aws:///us-east-1a/i-0123456789abcdef0",
+					}, spec.Args,
+				)
+
+				return nil
+			},
+		),
+	)
+}
+
+func (suite *KubeletSpecSuite) TestReconcileWithNodeTaints() {
+	cfg := k8s.NewKubeletConfig(k8s.NamespaceName, k8s.KubeletID)
+	cfg.TypedSpec().Image = "kubelet:v1.0.0"
+	cfg.TypedSpec().ClusterDNS = []string{"10.96.0.10"}
+	cfg.TypedSpec().ClusterDomain = "cluster.local"
+	cfg.TypedSpec().ExtraArgs = map[string]string{"register-with-taints": "foo=bar:NoExecute"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	nodeIP := k8s.NewNodeIP(k8s.NamespaceName, k8s.KubeletID)
+	nodeIP.TypedSpec().Addresses = []netaddr.IP{netaddr.MustParseIP("172.20.0.2")}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodeIP))
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "example.com"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodename))
+
+	for _, taint := range []k8s.NodeTaintSpecSpec{
+		{Key: "gpu", Effect: "NoSchedule"},
+		{Key: "dedicated", Value: "db", Effect: "NoSchedule"},
+	} {
+		res := k8s.NewNodeTaintSpec(k8s.NamespaceName, taint.Key)
+		*res.TypedSpec() = taint
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, res))
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletSpec, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletSpecType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := kubeletSpec.(*k8s.KubeletSpec).TypedSpec()
+
+				if !slices.Contains(spec.Args, func(arg string) bool { return strings.HasPrefix(arg, "--register-with-taints=") }) {
+					return retry.ExpectedErrorf("taints are not set yet")
+				}
+
+				suite.Assert().Contains(spec.Args, "--register-with-taints=dedicated=db:NoSchedule,gpu:NoSchedule,foo=bar:NoExecute")
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *KubeletSpecSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -353,6 +493,7 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 		OOMScoreAdj:           pointer.To[int32](constants.KubeletOOMScoreAdj),
 		ClusterDomain:         "cluster.local",
 		ClusterDNS:            []string{"10.0.0.5"},
+		ResolverConfig:        pointer.To(constants.PodResolvConfPath),
 		SerializeImagePulls:   pointer.To(false),
 		FailSwapOn:            pointer.To(false),
 		SystemReserved: map[string]string{
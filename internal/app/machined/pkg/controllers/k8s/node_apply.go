@@ -0,0 +1,351 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/generic/maps"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// NodeApplyController applies node labels and taints to the Kubernetes Node object.
+//
+// Talos keeps track of the labels and taints it applied in the Node annotations,
+// so that only the keys owned by Talos are removed when they disappear from the configuration.
+//
+// Updates are done with the kubelet credentials, so with the NodeRestriction admission plugin
+// taints can't be updated once the node is registered (see KubeletSpecController which registers the node with the taints).
+type NodeApplyController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeApplyController) Name() string {
+	return "k8s.NodeApplyController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeApplyController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeLabelSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeTaintSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        pointer.To(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeApplyController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NodeApplyController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var client *kubernetes.Client
+
+	defer func() {
+		if client != nil {
+			client.Close() //nolint:errcheck
+		}
+	}()
+
+	ticker := time.NewTicker(constants.NodeApplyInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		nodenameRes, err := r.Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodenameType, k8s.NodenameID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting nodename: %w", err)
+		}
+
+		nodename := nodenameRes.(*k8s.Nodename).TypedSpec().Nodename
+
+		labelsList, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeLabelSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing node label specs: %w", err)
+		}
+
+		labels := make(map[string]string, len(labelsList.Items))
+
+		for _, res := range labelsList.Items {
+			spec := res.(*k8s.NodeLabelSpec).TypedSpec()
+
+			labels[spec.Key] = spec.Value
+		}
+
+		taintsList, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeTaintSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing node taint specs: %w", err)
+		}
+
+		taints := make([]k8s.NodeTaintSpecSpec, 0, len(taintsList.Items))
+
+		for _, res := range taintsList.Items {
+			taints = append(taints, *res.(*k8s.NodeTaintSpec).TypedSpec())
+		}
+
+		sort.Slice(taints, func(i, j int) bool { return taints[i].Key < taints[j].Key })
+
+		if client == nil {
+			logger.Debug("waiting for kubelet client config", zap.String("file", constants.KubeletKubeconfig))
+
+			if err = conditions.WaitForKubeconfigReady(constants.KubeletKubeconfig).Wait(ctx); err != nil {
+				return err
+			}
+
+			client, err = kubernetes.NewClientFromKubeletKubeconfig()
+			if err != nil {
+				return fmt.Errorf("error building Kubernetes client: %w", err)
+			}
+		}
+
+		if err = ctrl.apply(ctx, logger, client, nodename, labels, taints); err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsConflict(err) || kubernetes.IsRetryableError(err) {
+				// node is not registered yet or the update raced with the kubelet, retry on the next tick
+				logger.Debug("failed to apply node labels and taints, will retry", zap.Error(err))
+
+				continue
+			}
+
+			if apierrors.IsForbidden(err) {
+				// the update was rejected by the admission (e.g. NodeRestriction), don't fail the controller
+				logger.Warn("node labels and taints update is not allowed", zap.String("node", nodename), zap.Error(err))
+
+				continue
+			}
+
+			return fmt.Errorf("error applying node labels and taints: %w", err)
+		}
+	}
+}
+
+func (ctrl *NodeApplyController) apply(ctx context.Context, logger *zap.Logger, client *kubernetes.Client, nodename string,
+	labels map[string]string, taints []k8s.NodeTaintSpecSpec,
+) error {
+	node, err := client.CoreV1().Nodes().Get(ctx, nodename, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	updated := node.DeepCopy()
+
+	if err = ctrl.ApplyLabels(updated, labels); err != nil {
+		return err
+	}
+
+	if err = ctrl.ApplyTaints(updated, taints); err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(node.Labels, updated.Labels) &&
+		reflect.DeepEqual(node.Annotations, updated.Annotations) &&
+		reflect.DeepEqual(node.Spec.Taints, updated.Spec.Taints) {
+		return nil
+	}
+
+	_, err = client.CoreV1().Nodes().Update(ctx, updated, metav1.UpdateOptions{})
+	if err == nil {
+		logger.Info("updated node labels and taints", zap.String("node", nodename))
+
+		return nil
+	}
+
+	if !apierrors.IsForbidden(err) || reflect.DeepEqual(node.Spec.Taints, updated.Spec.Taints) {
+		return err
+	}
+
+	// NodeRestriction admission plugin doesn't allow the kubelet to modify the taints,
+	// taints are set by the kubelet only when the node is registered, so update the labels alone
+	logger.Warn("node taints can't be updated with kubelet credentials, taints are only applied on node registration",
+		zap.String("node", nodename), zap.Error(err))
+
+	updated = node.DeepCopy()
+
+	if err = ctrl.ApplyLabels(updated, labels); err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(node.Labels, updated.Labels) &&
+		reflect.DeepEqual(node.Annotations, updated.Annotations) {
+		return nil
+	}
+
+	if _, err = client.CoreV1().Nodes().Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	logger.Info("updated node labels", zap.String("node", nodename))
+
+	return nil
+}
+
+// ApplyLabels updates the node labels to match the desired set.
+//
+// Labels previously applied by Talos which are not in the desired set are removed.
+func (ctrl *NodeApplyController) ApplyLabels(node *corev1.Node, labels map[string]string) error {
+	owned, err := ownedKeys(node, constants.AnnotationOwnedLabels)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range owned {
+		if _, ok := labels[key]; !ok {
+			delete(node.Labels, key)
+		}
+	}
+
+	for key, value := range labels {
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+
+		node.Labels[key] = value
+	}
+
+	return setOwnedKeys(node, constants.AnnotationOwnedLabels, maps.Keys(labels))
+}
+
+// ApplyTaints updates the node taints to match the desired set.
+//
+// Taints previously applied by Talos which are not in the desired set are removed.
+func (ctrl *NodeApplyController) ApplyTaints(node *corev1.Node, taints []k8s.NodeTaintSpecSpec) error {
+	owned, err := ownedKeys(node, constants.AnnotationOwnedTaints)
+	if err != nil {
+		return err
+	}
+
+	managed := make(map[string]struct{}, len(owned)+len(taints))
+
+	for _, key := range owned {
+		managed[key] = struct{}{}
+	}
+
+	desiredKeys := make([]string, 0, len(taints))
+
+	for _, taint := range taints {
+		managed[taint.Key] = struct{}{}
+
+		desiredKeys = append(desiredKeys, taint.Key)
+	}
+
+	result := make([]corev1.Taint, 0, len(node.Spec.Taints)+len(taints))
+
+	// keep the taints not managed by Talos as is
+	for _, taint := range node.Spec.Taints {
+		if _, ok := managed[taint.Key]; !ok {
+			result = append(result, taint)
+		}
+	}
+
+	// keep the existing managed taints which match the desired state to preserve their timestamps
+	for _, desired := range taints {
+		var found bool
+
+		for _, taint := range node.Spec.Taints {
+			if taint.Key == desired.Key && taint.Value == desired.Value && string(taint.Effect) == desired.Effect {
+				result = append(result, taint)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, corev1.Taint{
+				Key:    desired.Key,
+				Value:  desired.Value,
+				Effect: corev1.TaintEffect(desired.Effect),
+			})
+		}
+	}
+
+	if len(result) == 0 {
+		// avoid spurious updates of nil vs. empty list
+		result = node.Spec.Taints[:0:0]
+	}
+
+	node.Spec.Taints = result
+
+	return setOwnedKeys(node, constants.AnnotationOwnedTaints, desiredKeys)
+}
+
+func ownedKeys(node *corev1.Node, annotation string) ([]string, error) {
+	value, ok := node.Annotations[annotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var keys []string
+
+	if err := json.Unmarshal([]byte(value), &keys); err != nil {
+		return nil, fmt.Errorf("error decoding annotation %q: %w", annotation, err)
+	}
+
+	return keys, nil
+}
+
+func setOwnedKeys(node *corev1.Node, annotation string, keys []string) error {
+	if len(keys) == 0 {
+		delete(node.Annotations, annotation)
+
+		return nil
+	}
+
+	sort.Strings(keys)
+
+	value, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	node.Annotations[annotation] = string(value)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+func TestNodeApplyLabels(t *testing.T) {
+	ctrl := &k8sctrl.NodeApplyController{}
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"kubernetes.io/hostname": "node1",
+				"foo":                    "bar",
+				"owned":                  "value",
+			},
+			Annotations: map[string]string{
+				constants.AnnotationOwnedLabels: `["owned"]`,
+			},
+		},
+	}
+
+	require.NoError(t, ctrl.ApplyLabels(node, map[string]string{
+		"foo": "baz",
+		"new": "",
+	}))
+
+	// label "owned" is removed, "foo" is taken over by Talos
+	assert.Equal(t, map[string]string{
+		"kubernetes.io/hostname": "node1",
+		"foo":                    "baz",
+		"new":                    "",
+	}, node.Labels)
+	assert.Equal(t, `["foo","new"]`, node.Annotations[constants.AnnotationOwnedLabels])
+
+	require.NoError(t, ctrl.ApplyLabels(node, nil))
+
+	assert.Equal(t, map[string]string{
+		"kubernetes.io/hostname": "node1",
+	}, node.Labels)
+	assert.NotContains(t, node.Annotations, constants.AnnotationOwnedLabels)
+}
+
+func TestNodeApplyTaints(t *testing.T) {
+	ctrl := &k8sctrl.NodeApplyController{}
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.AnnotationOwnedTaints: `["owned"]`,
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{
+					Key:    "node-role.kubernetes.io/master",
+					Effect: corev1.TaintEffectNoSchedule,
+				},
+				{
+					Key:    "owned",
+					Effect: corev1.TaintEffectNoExecute,
+				},
+			},
+		},
+	}
+
+	require.NoError(t, ctrl.ApplyTaints(node, []k8s.NodeTaintSpecSpec{
+		{
+			Key:    "dedicated",
+			Value:  "gpu",
+			Effect: string(corev1.TaintEffectNoSchedule),
+		},
+	}))
+
+	assert.Equal(t, []corev1.Taint{
+		{
+			Key:    "node-role.kubernetes.io/master",
+			Effect: corev1.TaintEffectNoSchedule,
+		},
+		{
+			Key:    "dedicated",
+			Value:  "gpu",
+			Effect: corev1.TaintEffectNoSchedule,
+		},
+	}, node.Spec.Taints)
+	assert.Equal(t, `["dedicated"]`, node.Annotations[constants.AnnotationOwnedTaints])
+
+	require.NoError(t, ctrl.ApplyTaints(node, nil))
+
+	assert.Equal(t, []corev1.Taint{
+		{
+			Key:    "node-role.kubernetes.io/master",
+			Effect: corev1.TaintEffectNoSchedule,
+		},
+	}, node.Spec.Taints)
+	assert.NotContains(t, node.Annotations, constants.AnnotationOwnedTaints)
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// NodeLabelSpecController manages k8s.NodeLabelSpec based on configuration.
+type NodeLabelSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeLabelSpecController) Name() string {
+	return "k8s.NodeLabelSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeLabelSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeLabelSpecController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.NodeLabelSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NodeLabelSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil {
+			for key, value := range cfg.(*config.MachineConfig).Config().Machine().NodeLabels() {
+				key, value := key, value
+
+				if err = r.Modify(ctx, k8s.NewNodeLabelSpec(k8s.NamespaceName, key), func(r resource.Resource) error {
+					*r.(*k8s.NodeLabelSpec).TypedSpec() = k8s.NodeLabelSpecSpec{
+						Key:   key,
+						Value: value,
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating node label spec: %w", err)
+				}
+
+				touchedIDs[key] = struct{}{}
+			}
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeLabelSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up node label specs: %w", err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// NodeTaintSpecController manages k8s.NodeTaintSpec based on configuration.
+type NodeTaintSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeTaintSpecController) Name() string {
+	return "k8s.NodeTaintSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeTaintSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeTaintSpecController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.NodeTaintSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NodeTaintSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil {
+			for key, val := range cfg.(*config.MachineConfig).Config().Machine().NodeTaints() {
+				key := key
+
+				// taint is specified as `value:Effect`, value is optional
+				value, effect, found := strings.Cut(val, ":")
+				if !found {
+					value, effect = "", val
+				}
+
+				if err = r.Modify(ctx, k8s.NewNodeTaintSpec(k8s.NamespaceName, key), func(r resource.Resource) error {
+					*r.(*k8s.NodeTaintSpec).TypedSpec() = k8s.NodeTaintSpecSpec{
+						Key:    key,
+						Value:  value,
+						Effect: effect,
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating node taint spec: %w", err)
+				}
+
+				touchedIDs[key] = struct{}{}
+			}
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeTaintSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up node taint specs: %w", err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"context"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+type NodeTaintSpecSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *NodeTaintSpecSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&k8sctrl.NodeTaintSpecController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *NodeTaintSpecSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *NodeTaintSpecSuite) listTaints() (map[string]k8s.NodeTaintSpecSpec, error) {
+	list, err := suite.state.List(suite.ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeTaintSpecType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]k8s.NodeTaintSpecSpec{}
+
+	for _, res := range list.Items {
+		result[res.Metadata().ID()] = *res.(*k8s.NodeTaintSpec).TypedSpec()
+	}
+
+	return result, nil
+}
+
+func (suite *NodeTaintSpecSuite) TestReconcile() {
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNodeTaints: map[string]string{
+					"example.com/dedicated": "gpu:NoSchedule",
+					"example.com/evict":     "NoExecute",
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				taints, err := suite.listTaints()
+				if err != nil {
+					return err
+				}
+
+				if len(taints) != 2 {
+					return retry.ExpectedErrorf("expected 2 taints, got %d", len(taints))
+				}
+
+				suite.Assert().Equal(k8s.NodeTaintSpecSpec{
+					Key:    "example.com/dedicated",
+					Value:  "gpu",
+					Effect: "NoSchedule",
+				}, taints["example.com/dedicated"])
+				suite.Assert().Equal(k8s.NodeTaintSpecSpec{
+					Key:    "example.com/evict",
+					Effect: "NoExecute",
+				}, taints["example.com/evict"])
+
+				return nil
+			},
+		),
+	)
+
+	// remove a taint from the config, the spec should be cleaned up
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
+		r.(*config.MachineConfig).Config().(*v1alpha1.Config).MachineConfig.MachineNodeTaints = map[string]string{
+			"example.com/dedicated": "gpu:NoSchedule",
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				taints, err := suite.listTaints()
+				if err != nil {
+					return err
+				}
+
+				if len(taints) != 1 {
+					return retry.ExpectedErrorf("expected 1 taint, got %d", len(taints))
+				}
+
+				return nil
+			},
+		),
+	)
+}
+
+func (suite *NodeTaintSpecSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestNodeTaintSpecSuite(t *testing.T) {
+	suite.Run(t, new(NodeTaintSpecSuite))
+}
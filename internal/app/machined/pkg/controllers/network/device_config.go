@@ -17,6 +17,7 @@ import (
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
@@ -117,6 +118,20 @@ func (ctrl *DeviceConfigController) Run(ctx context.Context, r controller.Runtim
 					selectedInterfaces[device.Interface()] = struct{}{}
 				}
 
+				if device.Bond() != nil && len(device.Bond().DeviceSelectors()) > 0 {
+					device = device.(*v1alpha1.Device).DeepCopy()
+
+					dev := device.(*v1alpha1.Device) //nolint:errcheck,forcetypeassert
+					dev.DeviceBond.BondInterfaces = ctrl.selectMembers(device.Bond().DeviceSelectors(), links.Items)
+				}
+
+				if device.Bridge() != nil && len(device.Bridge().DeviceSelectors()) > 0 {
+					device = device.(*v1alpha1.Device).DeepCopy()
+
+					dev := device.(*v1alpha1.Device) //nolint:errcheck,forcetypeassert
+					dev.DeviceBridge.BridgedInterfaces = ctrl.selectMembers(device.Bridge().DeviceSelectors(), links.Items)
+				}
+
 				id := fmt.Sprintf("%s/%d", device.Interface(), index)
 
 				touchedIDs[id] = struct{}{}
@@ -157,36 +172,59 @@ func (ctrl *DeviceConfigController) getDeviceBySelector(ctx context.Context, dev
 	selector := device.Selector()
 
 	for _, link := range links {
-		linkStatus := link.(*network.LinkStatus).TypedSpec() //nolint:forcetypeassert,errcheck
+		if linkMatchesSelector(link.(*network.LinkStatus).TypedSpec(), selector) { //nolint:forcetypeassert,errcheck
+			dev := device.(*v1alpha1.Device) //nolint:errcheck,forcetypeassert
+			dev.DeviceInterface = link.Metadata().ID()
 
-		matches := false
+			return nil
+		}
+	}
 
-		for _, pair := range [][]string{
-			{selector.HardwareAddress(), linkStatus.HardwareAddr.String()},
-			{selector.PCIID(), linkStatus.PCIID},
-			{selector.KernelDriver(), linkStatus.Driver},
-			{selector.Bus(), linkStatus.BusPath},
-		} {
-			if pair[0] == "" {
-				continue
-			}
+	return fmt.Errorf("no matching network device for defined selector: %v", selector)
+}
 
-			if !glob.Glob(pair[0], pair[1]) {
-				matches = false
+// selectMembers returns the physical links matching any of the bond or bridge member selectors.
+func (ctrl *DeviceConfigController) selectMembers(selectors []talosconfig.NetworkDeviceSelector, links []resource.Resource) []string {
+	var members []string
 
-				break
-			}
+	for _, link := range links {
+		linkStatus := link.(*network.LinkStatus).TypedSpec() //nolint:forcetypeassert,errcheck
 
-			matches = true
+		if !linkStatus.Physical() {
+			continue
 		}
 
-		if matches {
-			dev := device.(*v1alpha1.Device) //nolint:errcheck,forcetypeassert
-			dev.DeviceInterface = link.Metadata().ID()
+		matches := func(selector talosconfig.NetworkDeviceSelector) bool {
+			return linkMatchesSelector(linkStatus, selector)
+		}
 
-			return nil
+		if slices.Contains(selectors, matches) {
+			members = append(members, link.Metadata().ID())
 		}
 	}
 
-	return fmt.Errorf("no matching network device for defined selector: %v", selector)
+	return members
+}
+
+func linkMatchesSelector(linkStatus *network.LinkStatusSpec, selector talosconfig.NetworkDeviceSelector) bool {
+	matches := false
+
+	for _, pair := range [][]string{
+		{selector.HardwareAddress(), linkStatus.HardwareAddr.String()},
+		{selector.PCIID(), linkStatus.PCIID},
+		{selector.KernelDriver(), linkStatus.Driver},
+		{selector.Bus(), linkStatus.BusPath},
+	} {
+		if pair[0] == "" {
+			continue
+		}
+
+		if !glob.Glob(pair[0], pair[1]) {
+			return false
+		}
+
+		matches = true
+	}
+
+	return matches
 }
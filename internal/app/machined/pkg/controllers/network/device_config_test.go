@@ -9,6 +9,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -24,6 +26,7 @@ import (
 	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/talos-systems/talos/pkg/logging"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
@@ -175,6 +178,109 @@ func (suite *DeviceConfigSpecSuite) TestSelectors() {
 	suite.Assert().EqualValues(1500, deviceConfig.TypedSpec().Device.MTU())
 }
 
+func (suite *DeviceConfigSpecSuite) TestMemberSelectors() {
+	cfgProvider := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkInterfaces: []*v1alpha1.Device{
+					{
+						DeviceInterface: "bond0",
+						DeviceBond: &v1alpha1.Bond{
+							BondMode: "balance-rr",
+							BondDeviceSelectors: []v1alpha1.NetworkDeviceSelector{
+								{
+									NetworkDeviceHardwareAddress: "00:01:*",
+								},
+							},
+						},
+					},
+					{
+						DeviceInterface: "br0",
+						DeviceBridge: &v1alpha1.Bridge{
+							BridgeDeviceSelectors: []v1alpha1.NetworkDeviceSelector{
+								{
+									NetworkDeviceKernelDriver: "e1000",
+								},
+								{
+									NetworkDeviceKernelDriver: "igb",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cfg := config.NewMachineConfig(cfgProvider)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	for _, link := range []struct {
+		name     string
+		hwAddr   net.HardwareAddr
+		driver   string
+		physical bool
+	}{
+		{"eth0", net.HardwareAddr{0, 1, 0, 0, 0, 1}, "virtio_net", true},
+		{"eth1", net.HardwareAddr{0, 1, 0, 0, 0, 2}, "virtio_net", true},
+		{"eth2", net.HardwareAddr{0, 2, 0, 0, 0, 1}, "e1000", true},
+		{"eth3", net.HardwareAddr{0, 2, 0, 0, 0, 2}, "igb", true},
+		{"eth4", net.HardwareAddr{0, 2, 0, 0, 0, 3}, "r8169", true},
+		{"dummy0", net.HardwareAddr{0, 1, 0, 0, 0, 3}, "", false},
+	} {
+		status := network.NewLinkStatus(network.NamespaceName, link.name)
+		status.TypedSpec().Type = nethelpers.LinkEther
+		status.TypedSpec().HardwareAddr = nethelpers.HardwareAddr(link.hwAddr)
+		status.TypedSpec().Driver = link.driver
+
+		if !link.physical {
+			status.TypedSpec().Kind = "dummy"
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, status))
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				for id, expected := range map[string][]string{
+					"bond0/0": {"eth0", "eth1"},
+					"br0/1":   {"eth2", "eth3"},
+				} {
+					res, err := suite.state.Get(
+						suite.ctx,
+						resource.NewMetadata(network.NamespaceName, network.DeviceConfigSpecType, id, resource.VersionUndefined),
+					)
+					if err != nil {
+						return retry.ExpectedError(err)
+					}
+
+					device := res.(*network.DeviceConfigSpec).TypedSpec().Device //nolint:errcheck,forcetypeassert
+
+					var members []string
+
+					if device.Bond() != nil {
+						members = device.Bond().Interfaces()
+					} else {
+						members = device.Bridge().Interfaces()
+					}
+
+					if !reflect.DeepEqual(members, expected) {
+						return retry.ExpectedErrorf("%s: members %v, expected %v", id, members, expected)
+					}
+				}
+
+				return nil
+			},
+		))
+
+	// the machine configuration itself is not modified
+	suite.Assert().Empty(cfgProvider.MachineConfig.MachineNetwork.NetworkInterfaces[0].DeviceBond.BondInterfaces)
+	suite.Assert().Empty(cfgProvider.MachineConfig.MachineNetwork.NetworkInterfaces[1].DeviceBridge.BridgedInterfaces)
+}
+
 func (suite *DeviceConfigSpecSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
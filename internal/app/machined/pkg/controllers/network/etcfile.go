@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
+	"inet.af/netaddr"
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
@@ -24,7 +27,13 @@ import (
 )
 
 // EtcFileController creates /etc/hostname and /etc/resolv.conf files based on finalized network configuration.
-type EtcFileController struct{}
+//
+// If the host DNS forwarder is enabled, /etc/resolv.conf points to the forwarder.
+// The resolv.conf for the pods always lists upstream nameservers, as the forwarder is not reachable from the pods.
+type EtcFileController struct {
+	// Path to the resolv.conf file used by kubelet for the pods, if empty, the file is not written.
+	PodResolvConfPath string
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *EtcFileController) Name() string {
@@ -58,6 +67,12 @@ func (ctrl *EtcFileController) Inputs() []controller.Input {
 			ID:        pointer.To(network.NodeAddressDefaultID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -126,10 +141,39 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 			nodeAddressStatus = naStatus.(*network.NodeAddress).TypedSpec()
 		}
 
+		var hostDNSConfig *network.HostDNSConfigSpec
+
+		hdConfig, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else {
+			hostDNSConfig = hdConfig.(*network.HostDNSConfig).TypedSpec()
+		}
+
 		if resolverStatus != nil {
+			nameservers := resolverStatus.DNSServers
+
+			// when the host DNS forwarder is enabled, all host queries go through it
+			if hostDNSConfig != nil && hostDNSConfig.Enabled && len(hostDNSConfig.ListenAddresses) > 0 {
+				nameservers = make([]netaddr.IP, 0, len(hostDNSConfig.ListenAddresses))
+
+				for _, addr := range hostDNSConfig.ListenAddresses {
+					nameservers = append(nameservers, addr.IP())
+				}
+			}
+
+			// pod resolv.conf is written first, so that it's ready once the /etc files are ready
+			if ctrl.PodResolvConfPath != "" {
+				if err = writeFileAtomic(ctrl.PodResolvConfPath, ctrl.renderResolvConf(resolverStatus.DNSServers, hostnameStatus, cfgProvider), 0o644); err != nil {
+					return fmt.Errorf("error writing pod resolv.conf: %w", err)
+				}
+			}
+
 			if err = r.Modify(ctx, files.NewEtcFileSpec(files.NamespaceName, "resolv.conf"),
 				func(r resource.Resource) error {
-					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(resolverStatus, hostnameStatus, cfgProvider)
+					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(nameservers, hostnameStatus, cfgProvider)
 					r.(*files.EtcFileSpec).TypedSpec().Mode = 0o644
 
 					return nil
@@ -152,10 +196,14 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 	}
 }
 
-func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.ResolverStatusSpec, hostnameStatus *network.HostnameStatusSpec, cfgProvider talosconfig.Provider) []byte {
+func (ctrl *EtcFileController) renderResolvConf(
+	nameservers []netaddr.IP,
+	hostnameStatus *network.HostnameStatusSpec,
+	cfgProvider talosconfig.Provider,
+) []byte {
 	var buf bytes.Buffer
 
-	for i, resolver := range resolverStatus.DNSServers {
+	for i, resolver := range nameservers {
 		if i >= 3 {
 			// only use firt 3 nameservers, see MAXNS in https://linux.die.net/man/5/resolv.conf
 			break
@@ -176,6 +224,21 @@ func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.Resolver
 	return buf.Bytes()
 }
 
+// writeFileAtomic writes the file via a temporary file, so that readers never observe partially written contents.
+func writeFileAtomic(path string, contents []byte, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, contents, mode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
 func (ctrl *EtcFileController) renderHosts(hostnameStatus *network.HostnameStatusSpec, nodeAddressStatus *network.NodeAddressSpec, cfgProvider talosconfig.Provider) ([]byte, error) {
 	var buf bytes.Buffer
 
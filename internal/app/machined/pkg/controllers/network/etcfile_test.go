@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -42,6 +44,8 @@ type EtcFileConfigSuite struct {
 	ctx       context.Context //nolint:containedctx
 	ctxCancel context.CancelFunc
 
+	podResolvConfPath string
+
 	cfg            *config.MachineConfig
 	defaultAddress *network.NodeAddress
 	hostnameStatus *network.HostnameStatus
@@ -60,7 +64,11 @@ func (suite *EtcFileConfigSuite) SetupTest() {
 
 	suite.startRuntime()
 
-	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.EtcFileController{}))
+	suite.podResolvConfPath = filepath.Join(suite.T().TempDir(), "resolved", "resolv.conf")
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.EtcFileController{
+		PodResolvConfPath: suite.podResolvConfPath,
+	}))
 
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -275,6 +283,34 @@ func (suite *EtcFileConfigSuite) TestOnlyResolvers() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestHostDNS() {
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSID)
+	hostDNSConfig.TypedSpec().Enabled = true
+	hostDNSConfig.TypedSpec().ListenAddresses = []netaddr.IPPort{netaddr.MustParseIPPort("169.254.116.108:53")}
+
+	suite.testFiles(
+		[]resource.Resource{suite.hostnameStatus, suite.resolverStatus, hostDNSConfig},
+		"nameserver 169.254.116.108\n\nsearch example.com\n",
+		"",
+	)
+
+	// pods can't reach the forwarder, so pod resolv.conf lists upstream nameservers
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				contents, err := os.ReadFile(suite.podResolvConfPath)
+				if err != nil {
+					return retry.ExpectedError(err)
+				}
+
+				suite.Assert().Equal("nameserver 1.1.1.1\nnameserver 2.2.2.2\nnameserver 3.3.3.3\n\nsearch example.com\n", string(contents))
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *EtcFileConfigSuite) TestOnlyHostname() {
 	suite.testFiles(
 		[]resource.Resource{suite.defaultAddress, suite.hostnameStatus},
@@ -323,6 +359,12 @@ func (suite *EtcFileConfigSuite) TearDownTest() {
 			network.NewNodeAddress(network.NamespaceName, "bar"),
 		),
 	)
+	suite.Assert().NoError(
+		suite.state.Create(
+			context.Background(),
+			network.NewHostDNSConfig(network.NamespaceName, "bar"),
+		),
+	)
 }
 
 func TestEtcFileConfigSuite(t *testing.T) {
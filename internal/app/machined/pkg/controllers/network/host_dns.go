@@ -0,0 +1,240 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/internal/pkg/dns"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// HostDNSController runs the host DNS caching forwarder based on network.HostDNSConfig.
+//
+// Default upstreams come from the network.ResolverStatus, stats and upstream health are published as network.HostDNSStatus.
+type HostDNSController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *HostDNSController) Name() string {
+	return "network.HostDNSController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *HostDNSController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ResolverStatusType,
+			ID:        pointer.To(network.ResolverID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *HostDNSController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.HostDNSStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *HostDNSController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	handler := dns.NewHandler(logger)
+
+	var (
+		servers         []*dns.Server
+		listenAddresses []netaddr.IPPort
+	)
+
+	stopServers := func() {
+		for _, srv := range servers {
+			srv.Stop()
+		}
+
+		servers = nil
+		listenAddresses = nil
+	}
+
+	defer stopServers()
+
+	// refresh the stats periodically
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		var cfg *network.HostDNSConfigSpec
+
+		res, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else {
+			cfg = res.(*network.HostDNSConfig).TypedSpec()
+		}
+
+		if cfg == nil || !cfg.Enabled {
+			if servers != nil {
+				stopServers()
+
+				logger.Info("host DNS forwarder stopped")
+			}
+
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSStatusType, network.HostDNSID, resource.VersionUndefined)); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying host DNS status: %w", err)
+			}
+
+			continue
+		}
+
+		var resolvers []netaddr.IP
+
+		res, err = r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.ResolverStatusType, network.ResolverID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting resolver status: %w", err)
+			}
+		} else {
+			resolvers = res.(*network.ResolverStatus).TypedSpec().DNSServers
+		}
+
+		handler.SetCacheSize(cfg.CacheSize)
+		handler.SetUpstreams(ctrl.defaultUpstreams(cfg, resolvers), ctrl.domainUpstreams(cfg))
+		handler.SetStaticHosts(ctrl.staticHosts(cfg))
+
+		if !ipPortsEqual(listenAddresses, cfg.ListenAddresses) {
+			stopServers()
+
+			for _, addr := range cfg.ListenAddresses {
+				var srv *dns.Server
+
+				srv, err = dns.NewServer(addr, handler, logger)
+				if err != nil {
+					return fmt.Errorf("error starting host DNS forwarder: %w", err)
+				}
+
+				srv.Start()
+
+				servers = append(servers, srv)
+			}
+
+			listenAddresses = append([]netaddr.IPPort(nil), cfg.ListenAddresses...)
+
+			logger.Info("host DNS forwarder started", zap.Any("addresses", listenAddresses))
+		}
+
+		cacheStats, upstreamStats := handler.Stats()
+
+		if err = r.Modify(ctx, network.NewHostDNSStatus(network.NamespaceName, network.HostDNSID), func(r resource.Resource) error {
+			status := r.(*network.HostDNSStatus).TypedSpec()
+
+			status.ListenAddresses = append([]netaddr.IPPort(nil), listenAddresses...)
+			status.CacheSize = cacheStats.Size
+			status.CacheEntries = cacheStats.Entries
+			status.CacheHits = cacheStats.Hits
+			status.CacheMisses = cacheStats.Misses
+			status.Upstreams = make([]network.HostDNSUpstreamStatus, 0, len(upstreamStats))
+
+			for _, stats := range upstreamStats {
+				status.Upstreams = append(status.Upstreams, network.HostDNSUpstreamStatus{
+					Address:   stats.Addr,
+					Domain:    stats.Domain,
+					Healthy:   stats.Healthy,
+					Queries:   stats.Queries,
+					Failures:  stats.Failures,
+					LastError: stats.LastError,
+				})
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying host DNS status: %w", err)
+		}
+	}
+}
+
+// defaultUpstreams builds the list of default upstreams skipping the forwarder itself.
+func (ctrl *HostDNSController) defaultUpstreams(cfg *network.HostDNSConfigSpec, resolvers []netaddr.IP) []netaddr.IPPort {
+	upstreams := make([]netaddr.IPPort, 0, len(resolvers))
+
+outer:
+	for _, resolver := range resolvers {
+		for _, addr := range cfg.ListenAddresses {
+			if addr.IP() == resolver {
+				continue outer
+			}
+		}
+
+		upstreams = append(upstreams, netaddr.IPPortFrom(resolver, 53))
+	}
+
+	return upstreams
+}
+
+func (ctrl *HostDNSController) domainUpstreams(cfg *network.HostDNSConfigSpec) map[string][]netaddr.IPPort {
+	domains := make(map[string][]netaddr.IPPort, len(cfg.DomainUpstreams))
+
+	for _, domainUpstream := range cfg.DomainUpstreams {
+		for _, ip := range domainUpstream.Upstreams {
+			domains[domainUpstream.Domain] = append(domains[domainUpstream.Domain], netaddr.IPPortFrom(ip, 53))
+		}
+	}
+
+	return domains
+}
+
+func (ctrl *HostDNSController) staticHosts(cfg *network.HostDNSConfigSpec) map[string][]netaddr.IP {
+	hosts := map[string][]netaddr.IP{}
+
+	for _, host := range cfg.StaticHosts {
+		for _, alias := range host.Aliases {
+			hosts[alias] = append(hosts[alias], host.IP)
+		}
+	}
+
+	return hosts
+}
+
+func ipPortsEqual(a, b []netaddr.IPPort) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// HostDNSConfigController manages network.HostDNSConfig based on machine configuration.
+//
+// When the host DNS forwarder is enabled, the controller also assigns the forwarder listen address to the loopback interface.
+type HostDNSConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Name() string {
+	return "network.HostDNSConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.HostDNSConfigType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.AddressSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		var cfgProvider talosconfig.Provider
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			cfgProvider = cfg.(*config.MachineConfig).Config()
+		}
+
+		spec := ctrl.buildConfig(logger, cfgProvider)
+
+		if err = r.Modify(ctx, network.NewHostDNSConfig(network.NamespaceName, network.HostDNSID), func(r resource.Resource) error {
+			*r.(*network.HostDNSConfig).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying host DNS config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if spec.Enabled {
+			for _, addr := range spec.ListenAddresses {
+				address := network.AddressSpecSpec{
+					Address:     netaddr.IPPrefixFrom(addr.IP(), addr.IP().BitLen()),
+					Family:      nethelpers.FamilyInet4,
+					Scope:       nethelpers.ScopeHost,
+					Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+					LinkName:    constants.HostDNSLinkName,
+					ConfigLayer: network.ConfigMachineConfiguration,
+				}
+
+				if addr.IP().Is6() {
+					address.Family = nethelpers.FamilyInet6
+				}
+
+				id := network.LayeredID(address.ConfigLayer, network.AddressID(address.LinkName, address.Address))
+
+				if err = r.Modify(ctx, network.NewAddressSpec(network.ConfigNamespaceName, id), func(r resource.Resource) error {
+					*r.(*network.AddressSpec).TypedSpec() = address
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error modifying address: %w", err)
+				}
+
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		// list addresses for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up addresses: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func (ctrl *HostDNSConfigController) buildConfig(logger *zap.Logger, cfgProvider talosconfig.Provider) network.HostDNSConfigSpec {
+	if cfgProvider == nil || !cfgProvider.Machine().Network().HostDNS().Enabled() {
+		return network.HostDNSConfigSpec{}
+	}
+
+	hostDNS := cfgProvider.Machine().Network().HostDNS()
+
+	spec := network.HostDNSConfigSpec{
+		Enabled:         true,
+		ListenAddresses: []netaddr.IPPort{netaddr.IPPortFrom(netaddr.MustParseIP(constants.HostDNSAddress), 53)},
+		CacheSize:       hostDNS.CacheSize(),
+	}
+
+	for _, domainUpstream := range hostDNS.DomainUpstreams() {
+		upstream := network.HostDNSDomainUpstream{
+			Domain: domainUpstream.Domain(),
+		}
+
+		for _, nameserver := range domainUpstream.Nameservers() {
+			ip, err := netaddr.ParseIP(nameserver)
+			if err != nil {
+				logger.Warn("failed to parse domain nameserver", zap.String("domain", upstream.Domain), zap.String("nameserver", nameserver), zap.Error(err))
+
+				continue
+			}
+
+			upstream.Upstreams = append(upstream.Upstreams, ip)
+		}
+
+		spec.DomainUpstreams = append(spec.DomainUpstreams, upstream)
+	}
+
+	for _, extraHost := range cfgProvider.Machine().Network().ExtraHosts() {
+		ip, err := netaddr.ParseIP(extraHost.IP())
+		if err != nil {
+			logger.Warn("failed to parse extra host address", zap.String("address", extraHost.IP()), zap.Error(err))
+
+			continue
+		}
+
+		spec.StaticHosts = append(spec.StaticHosts, network.HostDNSStaticHost{
+			IP:      ip,
+			Aliases: extraHost.Aliases(),
+		})
+	}
+
+	return spec
+}
@@ -0,0 +1,199 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package network_test
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type HostDNSConfigSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *HostDNSConfigSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.HostDNSConfigController{}))
+
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *HostDNSConfigSuite) getConfig() (*network.HostDNSConfigSpec, error) {
+	res, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSID, resource.VersionUndefined))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*network.HostDNSConfig).TypedSpec(), nil
+}
+
+func (suite *HostDNSConfigSuite) hostDNSAddressID() string {
+	return network.LayeredID(
+		network.ConfigMachineConfiguration,
+		network.AddressID(constants.HostDNSLinkName, netaddr.MustParseIPPrefix(constants.HostDNSAddress+"/32")),
+	)
+}
+
+func (suite *HostDNSConfigSuite) TestConfig() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				ExtraHostEntries: []*v1alpha1.ExtraHost{
+					{
+						HostIP:      "10.0.0.1",
+						HostAliases: []string{"a", "b"},
+					},
+				},
+				NetworkHostDNS: &v1alpha1.HostDNS{
+					HostDNSEnabled: true,
+					HostDNSDomainUpstreams: []*v1alpha1.HostDNSDomainUpstream{
+						{
+							UpstreamDomain:      "corp.example.com",
+							UpstreamNameservers: []string{"10.0.0.53", "10.0.0.54"},
+						},
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: u,
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		spec, err := suite.getConfig()
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return retry.ExpectedError(err)
+			}
+
+			return err
+		}
+
+		if !spec.Enabled {
+			return retry.ExpectedErrorf("host DNS is not enabled yet")
+		}
+
+		return nil
+	}))
+
+	spec, err := suite.getConfig()
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(network.HostDNSConfigSpec{
+		Enabled:         true,
+		ListenAddresses: []netaddr.IPPort{netaddr.MustParseIPPort("169.254.116.108:53")},
+		CacheSize:       constants.DefaultHostDNSCacheSize,
+		DomainUpstreams: []network.HostDNSDomainUpstream{
+			{
+				Domain:    "corp.example.com",
+				Upstreams: []netaddr.IP{netaddr.MustParseIP("10.0.0.53"), netaddr.MustParseIP("10.0.0.54")},
+			},
+		},
+		StaticHosts: []network.HostDNSStaticHost{
+			{
+				IP:      netaddr.MustParseIP("10.0.0.1"),
+				Aliases: []string{"a", "b"},
+			},
+		},
+	}, *spec)
+
+	address, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, suite.hostDNSAddressID(), resource.VersionUndefined))
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("lo", address.(*network.AddressSpec).TypedSpec().LinkName)
+	suite.Assert().Equal(network.ConfigMachineConfiguration, address.(*network.AddressSpec).TypedSpec().ConfigLayer)
+
+	// disable host DNS
+	_, err = suite.state.UpdateWithConflicts(suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
+		r.(*config.MachineConfig).Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkHostDNS.HostDNSEnabled = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		_, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, suite.hostDNSAddressID(), resource.VersionUndefined))
+		if err == nil {
+			return retry.ExpectedErrorf("address is still there")
+		}
+
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}))
+
+	spec, err = suite.getConfig()
+	suite.Require().NoError(err)
+
+	suite.Assert().False(spec.Enabled)
+}
+
+func (suite *HostDNSConfigSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestHostDNSConfigSuite(t *testing.T) {
+	suite.Run(t, new(HostDNSConfigSuite))
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type HostDNSSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *HostDNSSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.HostDNSController{}))
+
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *HostDNSSuite) listenAddress() netaddr.IPPort {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	defer pc.Close() //nolint:errcheck
+
+	return netaddr.MustParseIPPort(pc.LocalAddr().String())
+}
+
+func (suite *HostDNSSuite) assertStatus(check func(*network.HostDNSStatusSpec) error) error {
+	res, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSStatusType, network.HostDNSID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return retry.ExpectedError(err)
+		}
+
+		return err
+	}
+
+	return check(res.(*network.HostDNSStatus).TypedSpec())
+}
+
+func (suite *HostDNSSuite) TestStaticHosts() {
+	addr := suite.listenAddress()
+
+	cfg := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSID)
+	*cfg.TypedSpec() = network.HostDNSConfigSpec{
+		Enabled:         true,
+		ListenAddresses: []netaddr.IPPort{addr},
+		CacheSize:       10,
+		StaticHosts: []network.HostDNSStaticHost{
+			{
+				IP:      netaddr.MustParseIP("10.0.0.1"),
+				Aliases: []string{"gateway"},
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	resolvers := network.NewResolverStatus(network.NamespaceName, network.ResolverID)
+	resolvers.TypedSpec().DNSServers = []netaddr.IP{netaddr.MustParseIP("1.1.1.1"), addr.IP()}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, resolvers))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		return suite.assertStatus(func(status *network.HostDNSStatusSpec) error {
+			if len(status.Upstreams) != 1 {
+				return retry.ExpectedErrorf("upstreams are not updated yet: %v", status.Upstreams)
+			}
+
+			suite.Assert().Equal([]netaddr.IPPort{addr}, status.ListenAddresses)
+			suite.Assert().Equal(10, status.CacheSize)
+
+			// forwarder address is not used as an upstream
+			suite.Assert().Equal(netaddr.MustParseIPPort("1.1.1.1:53"), status.Upstreams[0].Address)
+
+			return nil
+		})
+	}))
+
+	for _, proto := range []string{"udp", "tcp"} {
+		req := new(dns.Msg)
+		req.SetQuestion("gateway.", dns.TypeA)
+
+		client := &dns.Client{Net: proto}
+
+		resp, _, err := client.Exchange(req, addr.String())
+		suite.Require().NoError(err)
+
+		suite.Require().Len(resp.Answer, 1)
+		suite.Assert().Equal("10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+	}
+
+	// disable the forwarder
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
+		r.(*network.HostDNSConfig).TypedSpec().Enabled = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		_, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSStatusType, network.HostDNSID, resource.VersionUndefined))
+		if err == nil {
+			return retry.ExpectedErrorf("host DNS status is still there")
+		}
+
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}))
+}
+
+func (suite *HostDNSSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestHostDNSSuite(t *testing.T) {
+	suite.Run(t, new(HostDNSSuite))
+}
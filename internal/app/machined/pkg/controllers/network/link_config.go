@@ -273,8 +273,9 @@ func (ctrl *LinkConfigController) parseCmdline(logger *zap.Logger) ([]network.Li
 //nolint:gocyclo,cyclop
 func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger, devices []talosconfig.Device) []network.LinkSpecSpec {
 	// scan for the bonds or bridges
-	bondedLinks := map[string]ordered.Pair[string, int]{} // mapping physical interface -> bond interface
-	bridgedLinks := map[string]string{}                   // mapping physical interface -> bridge interface
+	bondedLinks := map[string]ordered.Pair[string, int]{}      // mapping physical interface -> bond interface
+	bridgedLinks := map[string]string{}                        // mapping physical interface -> bridge interface
+	bridgePortVLANs := map[string]network.BridgePortVLANSpec{} // mapping physical interface -> bridge port VLAN settings
 
 	for _, device := range devices {
 		if device.Ignore() {
@@ -311,6 +312,10 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 
 				bridgedLinks[linkName] = device.Interface()
 			}
+
+			for linkName, vlan := range BridgePortVLANs(device.Bridge()) {
+				bridgePortVLANs[linkName] = vlan
+			}
 		}
 	}
 
@@ -343,6 +348,8 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 			if err := SetBridgeMaster(linkMap[device.Interface()], device.Bridge()); err != nil {
 				logger.Error("error parsing bridge config", zap.Error(err))
 			}
+
+			linkMap[device.Interface()].BridgeSelfVLAN = BridgeSelfVLAN(device.Bridge(), device.Vlans())
 		}
 
 		if device.WireguardConfig() != nil {
@@ -383,7 +390,7 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 			}
 		}
 
-		SetBridgeSlave(linkMap[slaveName], bridgeIface)
+		SetBridgeSlave(linkMap[slaveName], bridgeIface, bridgePortVLANs[slaveName])
 	}
 
 	return maps.ValuesFunc(linkMap, func(link *network.LinkSpecSpec) network.LinkSpecSpec { return *link })
@@ -250,7 +250,7 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 						{
 							DeviceInterface: "br0",
 							DeviceBridge: &v1alpha1.Bridge{
-								BridgedInterfaces: []string{"eth4", "eth5"},
+								BridgedInterfaces: []string{"eth4", "eth5", "bond0"},
 								BridgeSTP: &v1alpha1.STP{
 									STPEnabled: pointer.To(false),
 								},
@@ -262,6 +262,28 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 								BridgeSTP: &v1alpha1.STP{
 									STPEnabled: pointer.To(true),
 								},
+								BridgeVLAN: &v1alpha1.BridgeVLAN{
+									BridgeVLANFiltering: pointer.To(true),
+									BridgeVLANPorts: []*v1alpha1.BridgePortVLAN{
+										{
+											PortInterface:   "eth5",
+											PortPVID:        10,
+											PortTaggedVLANs: []uint16{30, 20},
+										},
+										{
+											PortInterface:   "bond0",
+											PortTaggedVLANs: []uint16{40},
+										},
+									},
+								},
+							},
+							DeviceVlans: []*v1alpha1.Vlan{
+								{
+									VlanID: 40,
+									VlanAddresses: []string{
+										"10.0.40.1/24",
+									},
+								},
 							},
 						},
 						{
@@ -312,6 +334,7 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 						"configuration/eth3",
 						"configuration/bond0",
 						"configuration/br0",
+						"configuration/br0.40",
 						"configuration/dummy0",
 						"configuration/wireguard0",
 					}, func(r *network.LinkSpec) error {
@@ -353,16 +376,31 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 							suite.Assert().Equal(network.LinkKindBond, r.TypedSpec().Kind)
 							suite.Assert().Equal(nethelpers.BondModeXOR, r.TypedSpec().BondMaster.Mode)
 							suite.Assert().True(r.TypedSpec().BondMaster.UseCarrier)
+							suite.Assert().Equal("br0", r.TypedSpec().BridgeSlave.MasterName)
+							suite.Assert().Equal(network.BridgePortVLANSpec{TaggedVLANs: []uint16{40}}, r.TypedSpec().BridgeSlave.VLAN)
 						case "eth4", "eth5":
 							suite.Assert().True(r.TypedSpec().Up)
 							suite.Assert().False(r.TypedSpec().Logical)
 							suite.Assert().Equal("br0", r.TypedSpec().BridgeSlave.MasterName)
+
+							if r.TypedSpec().Name == "eth5" {
+								suite.Assert().Equal(network.BridgePortVLANSpec{PVID: 10, TaggedVLANs: []uint16{20, 30}}, r.TypedSpec().BridgeSlave.VLAN)
+							} else {
+								suite.Assert().True(r.TypedSpec().BridgeSlave.VLAN.IsZero())
+							}
 						case "br0":
 							suite.Assert().True(r.TypedSpec().Up)
 							suite.Assert().True(r.TypedSpec().Logical)
 							suite.Assert().Equal(nethelpers.LinkEther, r.TypedSpec().Type)
 							suite.Assert().Equal(network.LinkKindBridge, r.TypedSpec().Kind)
 							suite.Assert().Equal(true, r.TypedSpec().BridgeMaster.STP.Enabled)
+							suite.Assert().Equal(network.BridgeVLANSpec{FilteringEnabled: true, DefaultPVID: 1}, r.TypedSpec().BridgeMaster.VLAN)
+							suite.Assert().Equal(network.BridgePortVLANSpec{PVID: 1, TaggedVLANs: []uint16{40}}, r.TypedSpec().BridgeSelfVLAN)
+						case "br0.40":
+							suite.Assert().True(r.TypedSpec().Logical)
+							suite.Assert().Equal(network.LinkKindVLAN, r.TypedSpec().Kind)
+							suite.Assert().Equal("br0", r.TypedSpec().ParentName)
+							suite.Assert().EqualValues(40, r.TypedSpec().VLAN.VID)
 						case "wireguard0":
 							suite.Assert().True(r.TypedSpec().Up)
 							suite.Assert().True(r.TypedSpec().Logical)
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/jsimonetti/rtnetlink"
 	"github.com/siderolabs/go-pointer"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
 	"golang.zx2c4.com/wireguard/wgctrl"
@@ -73,6 +75,13 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 
 	defer conn.Close() //nolint:errcheck
 
+	nlHandle, err := netlink.NewHandle()
+	if err != nil {
+		return fmt.Errorf("error getting netlink handle: %w", err)
+	}
+
+	defer nlHandle.Close()
+
 	wgClient, err := wgctrl.New()
 	if err != nil {
 		logger.Warn("error creating wireguard client", zap.Error(err))
@@ -80,6 +89,9 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		defer wgClient.Close() //nolint:errcheck
 	}
 
+	// bridge ports which have VLAN settings applied by the controller
+	vlanPorts := map[string]struct{}{}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -118,7 +130,7 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		for _, res := range list.Items {
 			link := res.(*network.LinkSpec) //nolint:forcetypeassert,errcheck
 
-			if err = ctrl.syncLink(ctx, r, logger, conn, wgClient, &links, link); err != nil {
+			if err = ctrl.syncLink(ctx, r, logger, conn, nlHandle, wgClient, &links, link, vlanPorts); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 		}
@@ -183,9 +195,12 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 //
 // For wireguard links, only settings are synced with the diff generated by the WireguardSpec.
 //
+// For bridge ports with VLAN settings, VLAN membership of the port is synced after the port is enslaved to the bridge.
+// If VLAN settings are removed from the spec, all VLANs are removed from the port.
+//
 //nolint:gocyclo,cyclop,dupl
-func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, wgClient *wgctrl.Client,
-	links *[]rtnetlink.LinkMessage, link *network.LinkSpec,
+func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, nlHandle *netlink.Handle,
+	wgClient *wgctrl.Client, links *[]rtnetlink.LinkMessage, link *network.LinkSpec, vlanPorts map[string]struct{},
 ) error {
 	logger = logger.With(zap.String("link", link.TypedSpec().Name))
 
@@ -400,6 +415,11 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				return fmt.Errorf("error parsing bridge attributes for %q: %w", link.TypedSpec().Name, err)
 			}
 
+			// default PVID is left as is if it's not set in the spec
+			if link.TypedSpec().BridgeMaster.VLAN.DefaultPVID == 0 {
+				existingBridge.VLAN.DefaultPVID = 0
+			}
+
 			if existingBridge != link.TypedSpec().BridgeMaster {
 				logger.Debug("updating bridge settings",
 					zap.String("old", fmt.Sprintf("%+v", existingBridge)),
@@ -457,6 +477,13 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 				logger.Info("updated bridge settings")
 			}
+
+			// sync VLANs of the bridge itself, they are only set for VLAN filtering bridges
+			if !link.TypedSpec().BridgeSelfVLAN.IsZero() {
+				if err := ctrl.syncBridgePortVLAN(logger, nlHandle, existing.Index, &link.TypedSpec().BridgeSelfVLAN, true); err != nil {
+					return fmt.Errorf("error syncing bridge VLANs for %q: %w", link.TypedSpec().Name, err)
+				}
+			}
 		}
 
 		// sync wireguard settings
@@ -579,7 +606,75 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 			logger.Info("enslaved/unslaved link", zap.String("parent", masterName))
 		}
+
+		// sync bridge port VLANs (for links which are bridge ports)
+		//
+		// if the VLAN settings were removed from the spec, VLANs previously configured by the controller are removed
+		if masterIndex != 0 && masterName == bridgeMasterName {
+			_, managed := vlanPorts[link.TypedSpec().Name]
+
+			if managed || !link.TypedSpec().BridgeSlave.VLAN.IsZero() {
+				if err := ctrl.syncBridgePortVLAN(logger, nlHandle, existing.Index, &link.TypedSpec().BridgeSlave.VLAN, false); err != nil {
+					return fmt.Errorf("error syncing bridge port VLANs for %q: %w", link.TypedSpec().Name, err)
+				}
+			}
+
+			if link.TypedSpec().BridgeSlave.VLAN.IsZero() {
+				delete(vlanPorts, link.TypedSpec().Name)
+			} else {
+				vlanPorts[link.TypedSpec().Name] = struct{}{}
+			}
+		}
+	}
+
+	return nil
+}
+
+// syncBridgePortVLAN syncs VLAN membership of the bridge port with the spec.
+//
+// VLANs which are not in the spec (including the default PVID assigned by the kernel) are removed from the port.
+// If self is set, the port is the bridge itself.
+func (ctrl *LinkSpecController) syncBridgePortVLAN(logger *zap.Logger, nlHandle *netlink.Handle, index uint32, spec *network.BridgePortVLANSpec, self bool) error {
+	vlans, err := nlHandle.BridgeVlanList()
+	if err != nil {
+		return fmt.Errorf("error listing bridge VLANs: %w", err)
+	}
+
+	existing := vlans[int32(index)]
+
+	if networkadapter.BridgePortVLANSpec(spec).Equal(existing) {
+		return nil
+	}
+
+	port := &netlink.Device{
+		LinkAttrs: netlink.LinkAttrs{
+			Index: int(index),
+		},
+	}
+
+	desired := networkadapter.BridgePortVLANSpec(spec).Encode()
+
+	for _, info := range existing {
+		if _, ok := desired[info.Vid]; ok {
+			continue
+		}
+
+		if err = nlHandle.BridgeVlanDel(port, info.Vid, false, false, self, false); err != nil {
+			return fmt.Errorf("error removing VLAN %d: %w", info.Vid, err)
+		}
 	}
 
+	// adding an existing VLAN overwrites its flags
+	for vid, flags := range desired {
+		pvid := flags&nl.BRIDGE_VLAN_INFO_PVID != 0
+		untagged := flags&nl.BRIDGE_VLAN_INFO_UNTAGGED != 0
+
+		if err = nlHandle.BridgeVlanAdd(port, vid, pvid, untagged, self, false); err != nil {
+			return fmt.Errorf("error adding VLAN %d: %w", vid, err)
+		}
+	}
+
+	logger.Info("updated bridge port VLANs", zap.Uint16("pvid", spec.PVID), zap.Any("tagged", spec.TaggedVLANs))
+
 	return nil
 }
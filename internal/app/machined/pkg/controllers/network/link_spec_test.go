@@ -725,6 +725,89 @@ func (suite *LinkSpecSuite) TestBridge() {
 		),
 	)
 
+	// enable VLAN filtering and configure VLANs of one of the ports
+	_, err = suite.state.UpdateWithConflicts(
+		suite.ctx, bridge.Metadata(), func(r resource.Resource) error {
+			r.(*network.LinkSpec).TypedSpec().BridgeMaster.VLAN.FilteringEnabled = true
+			r.(*network.LinkSpec).TypedSpec().BridgeSelfVLAN = network.BridgePortVLANSpec{
+				PVID:        1,
+				TaggedVLANs: []uint16{20},
+			}
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.state.UpdateWithConflicts(
+		suite.ctx, dummy1.Metadata(), func(r resource.Resource) error {
+			r.(*network.LinkSpec).TypedSpec().BridgeSlave.VLAN = network.BridgePortVLANSpec{
+				PVID:        10,
+				TaggedVLANs: []uint16{20, 30},
+			}
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{bridgeName, dummy1Name}, func(r *network.LinkStatus) error {
+						switch r.Metadata().ID() {
+						case bridgeName:
+							if !r.TypedSpec().BridgeMaster.VLAN.FilteringEnabled {
+								return retry.ExpectedErrorf("vlan filtering is not enabled on bridge %s", r.Metadata().ID())
+							}
+
+							if len(r.TypedSpec().BridgePortVLAN.TaggedVLANs) == 0 {
+								return retry.ExpectedErrorf("bridge vlans are not set yet")
+							}
+
+							suite.Assert().Equal(network.BridgePortVLANSpec{PVID: 1, TaggedVLANs: []uint16{20}}, r.TypedSpec().BridgePortVLAN)
+						case dummy1Name:
+							if r.TypedSpec().BridgePortVLAN.PVID != 10 {
+								return retry.ExpectedErrorf("pvid is not set yet: %d", r.TypedSpec().BridgePortVLAN.PVID)
+							}
+
+							suite.Assert().Equal([]uint16{20, 30}, r.TypedSpec().BridgePortVLAN.TaggedVLANs)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// remove VLAN settings of the port, VLANs should be removed
+	_, err = suite.state.UpdateWithConflicts(
+		suite.ctx, dummy1.Metadata(), func(r resource.Resource) error {
+			r.(*network.LinkSpec).TypedSpec().BridgeSlave.VLAN = network.BridgePortVLANSpec{}
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{dummy1Name}, func(r *network.LinkStatus) error {
+						if !r.TypedSpec().BridgePortVLAN.IsZero() {
+							return retry.ExpectedErrorf("vlans are not removed yet: %v", r.TypedSpec().BridgePortVLAN)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
 	// unslave one of the interfaces
 	_, err = suite.state.UpdateWithConflicts(
 		suite.ctx, dummy0.Metadata(), func(r resource.Resource) error {
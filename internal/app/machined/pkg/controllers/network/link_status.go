@@ -15,6 +15,7 @@ import (
 	"github.com/jsimonetti/rtnetlink"
 	"github.com/mdlayher/ethtool"
 	ethtoolioctl "github.com/safchain/ethtool"
+	"github.com/vishvananda/netlink"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
 	"golang.zx2c4.com/wireguard/wgctrl"
@@ -84,6 +85,13 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 
 	defer conn.Close() //nolint:errcheck
 
+	nlHandle, err := netlink.NewHandle()
+	if err != nil {
+		return fmt.Errorf("error getting netlink handle: %w", err)
+	}
+
+	defer nlHandle.Close()
+
 	ethClient, err := ethtool.New()
 	if err != nil {
 		logger.Warn("error dialing ethtool socket", zap.Error(err))
@@ -112,7 +120,7 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 		case <-r.EventCh():
 		}
 
-		if err = ctrl.reconcile(ctx, r, logger, conn, ethClient, ethIoctlClient, wgClient); err != nil {
+		if err = ctrl.reconcile(ctx, r, logger, conn, nlHandle, ethClient, ethIoctlClient, wgClient); err != nil {
 			return err
 		}
 	}
@@ -126,6 +134,7 @@ func (ctrl *LinkStatusController) reconcile(
 	r controller.Runtime,
 	logger *zap.Logger,
 	conn *rtnetlink.Conn,
+	nlHandle *netlink.Handle,
 	ethClient *ethtool.Client,
 	ethtoolIoctlClient *ethtoolioctl.Ethtool,
 	wgClient *wgctrl.Client,
@@ -147,6 +156,12 @@ func (ctrl *LinkStatusController) reconcile(
 		return fmt.Errorf("error listing links: %w", err)
 	}
 
+	// VLANs of the bridge ports are only reported via AF_BRIDGE link dump
+	bridgeVLANs, err := nlHandle.BridgeVlanList()
+	if err != nil {
+		logger.Warn("error listing bridge VLANs", zap.Error(err))
+	}
+
 	// for every rtnetlink discovered link
 	for _, link := range links {
 		link := link
@@ -278,6 +293,13 @@ func (ctrl *LinkStatusController) reconcile(
 			status.DriverVersion = driverInfo.Version
 			status.FirmwareVersion = driverInfo.FwVersion
 
+			// VLANs are reported both for the bridge ports and for the bridge itself
+			if status.SlaveKind == network.LinkKindBridge || status.Kind == network.LinkKindBridge {
+				networkadapter.BridgePortVLANSpec(&status.BridgePortVLAN).Decode(bridgeVLANs[int32(link.Index)])
+			} else {
+				status.BridgePortVLAN = network.BridgePortVLANSpec{}
+			}
+
 			switch status.Kind {
 			case network.LinkKindVLAN:
 				if err = networkadapter.VLANSpec(&status.VLAN).Decode(link.Attributes.Info.Data); err != nil {
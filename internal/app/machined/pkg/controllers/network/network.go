@@ -118,9 +118,10 @@ func SetBondMaster(link *network.LinkSpecSpec, bond talosconfig.Bond) error {
 }
 
 // SetBridgeSlave sets the bridge slave spec.
-func SetBridgeSlave(link *network.LinkSpecSpec, bridge string) {
+func SetBridgeSlave(link *network.LinkSpecSpec, bridge string, vlan network.BridgePortVLANSpec) {
 	link.BridgeSlave = network.BridgeSlave{
 		MasterName: bridge,
+		VLAN:       vlan,
 	}
 }
 
@@ -136,5 +137,65 @@ func SetBridgeMaster(link *network.LinkSpecSpec, bridge talosconfig.Bridge) erro
 		},
 	}
 
+	if bridge.VLAN() != nil {
+		link.BridgeMaster.VLAN = network.BridgeVLANSpec{
+			FilteringEnabled: bridge.VLAN().FilteringEnabled(),
+			DefaultPVID:      bridge.VLAN().DefaultPVID(),
+		}
+	}
+
+	networkadapter.BridgeMasterSpec(&link.BridgeMaster).FillDefaults()
+
 	return nil
 }
+
+// BridgePortVLANs returns the VLAN settings of the bridge ports indexed by the port name.
+func BridgePortVLANs(bridge talosconfig.Bridge) map[string]network.BridgePortVLANSpec {
+	if bridge.VLAN() == nil {
+		return nil
+	}
+
+	ports := map[string]network.BridgePortVLANSpec{}
+
+	for _, port := range bridge.VLAN().Ports() {
+		spec := network.BridgePortVLANSpec{
+			PVID:        port.PVID(),
+			TaggedVLANs: append([]uint16(nil), port.TaggedVLANs()...),
+		}
+
+		spec.Sort()
+
+		ports[port.Interface()] = spec
+	}
+
+	return ports
+}
+
+// BridgeSelfVLAN returns the VLAN settings of the bridge itself.
+//
+// With VLAN filtering enabled, the bridge should be a member of the VLANs of the VLAN links created on top of it,
+// otherwise the traffic of these VLANs is not delivered to the host.
+func BridgeSelfVLAN(bridge talosconfig.Bridge, vlans []talosconfig.Vlan) network.BridgePortVLANSpec {
+	if bridge.VLAN() == nil || !bridge.VLAN().FilteringEnabled() {
+		return network.BridgePortVLANSpec{}
+	}
+
+	spec := network.BridgePortVLANSpec{
+		PVID: bridge.VLAN().DefaultPVID(),
+	}
+
+	// keep the kernel default PVID, so that the untagged traffic still reaches the bridge
+	if spec.PVID == 0 {
+		spec.PVID = 1
+	}
+
+	for _, vlan := range vlans {
+		if vlan.ID() != spec.PVID {
+			spec.TaggedVLANs = append(spec.TaggedVLANs, vlan.ID())
+		}
+	}
+
+	spec.Sort()
+
+	return spec
+}
@@ -0,0 +1,416 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// NfTablesChainController applies network.NfTablesChain to the Linux nftables interface.
+type NfTablesChainController struct {
+	TableName string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Name() string {
+	return "network.NfTablesChainController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NfTablesChainType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.TableName == "" {
+		ctrl.TableName = "talos"
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NfTablesChainType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing nftables chains: %w", err)
+		}
+
+		chains := make([]*network.NfTablesChain, 0, len(list.Items))
+
+		for _, res := range list.Items {
+			chains = append(chains, res.(*network.NfTablesChain)) //nolint:forcetypeassert,errcheck
+		}
+
+		if err = ctrl.apply(chains); err != nil {
+			return fmt.Errorf("error applying nftables chains: %w", err)
+		}
+
+		logger.Debug("applied nftables chains", zap.Int("count", len(chains)))
+	}
+}
+
+// apply replaces the contents of the Talos nftables table with the chains.
+//
+// All the changes are sent in a single netlink batch, so they are applied atomically.
+//
+//nolint:gocyclo
+func (ctrl *NfTablesChainController) apply(chains []*network.NfTablesChain) error {
+	c := &nftables.Conn{}
+
+	table := &nftables.Table{
+		Family: nftables.TableFamilyINet,
+		Name:   ctrl.TableName,
+	}
+
+	tables, err := c.ListTables()
+	if err != nil {
+		return fmt.Errorf("error listing tables: %w", err)
+	}
+
+	for _, t := range tables {
+		if t.Name == table.Name && t.Family == table.Family {
+			c.DelTable(table)
+
+			break
+		}
+	}
+
+	if len(chains) > 0 {
+		c.AddTable(table)
+
+		sort.Slice(chains, func(i, j int) bool {
+			return chains[i].Metadata().ID() < chains[j].Metadata().ID()
+		})
+
+		for _, chain := range chains {
+			spec := chain.TypedSpec()
+			policy := nftables.ChainPolicy(spec.Policy)
+
+			nfChain := c.AddChain(&nftables.Chain{
+				Name:     chain.Metadata().ID(),
+				Table:    table,
+				Type:     nftables.ChainTypeFilter,
+				Hooknum:  nftables.ChainHook(spec.Hook),
+				Priority: nftables.ChainPriority(spec.Priority),
+				Policy:   &policy,
+			})
+
+			for _, rule := range spec.Rules {
+				compiled := compileNfTablesRule(rule)
+
+				for _, r := range compiled {
+					for _, s := range r.sets {
+						s.set.Table = table
+
+						if err = c.AddSet(s.set, s.elements); err != nil {
+							return fmt.Errorf("error adding set for chain %q: %w", chain.Metadata().ID(), err)
+						}
+
+						s.lookup.SetName = s.set.Name
+						s.lookup.SetID = s.set.ID
+					}
+
+					c.AddRule(&nftables.Rule{
+						Table: table,
+						Chain: nfChain,
+						Exprs: r.exprs,
+					})
+				}
+			}
+		}
+	}
+
+	if err = c.Flush(); err != nil {
+		return fmt.Errorf("error flushing nftables: %w", err)
+	}
+
+	return nil
+}
+
+type nfTablesSet struct {
+	set      *nftables.Set
+	elements []nftables.SetElement
+
+	// lookup is updated with the set name and ID once the set is added
+	lookup *expr.Lookup
+}
+
+type nfTablesRule struct {
+	sets  []nfTablesSet
+	exprs []expr.Any
+}
+
+func (r *nfTablesRule) matchSet(set *nftables.Set, elements []nftables.SetElement) {
+	lookup := &expr.Lookup{
+		SourceRegister: 1,
+	}
+
+	r.sets = append(r.sets, nfTablesSet{
+		set:      set,
+		elements: elements,
+		lookup:   lookup,
+	})
+
+	r.exprs = append(r.exprs, lookup)
+}
+
+// compileNfTablesRule compiles the rule into the list of nftables rules.
+//
+// As the table is of `inet` family, the rule which matches on source address
+// is compiled into separate rules for IPv4 and IPv6.
+func compileNfTablesRule(rule network.NfTablesRule) []nfTablesRule {
+	if rule.MatchSourceAddress == nil {
+		var r nfTablesRule
+
+		r.compileMatches(rule)
+
+		return []nfTablesRule{r}
+	}
+
+	elements4, elements6 := addressSetElements(rule.MatchSourceAddress)
+
+	var result []nfTablesRule
+
+	for _, family := range []struct {
+		family   nftables.TableFamily
+		keyType  nftables.SetDatatype
+		offset   uint32
+		length   uint32
+		elements []nftables.SetElement
+	}{
+		{nftables.TableFamilyIPv4, nftables.TypeIPAddr, 12, 4, elements4},
+		{nftables.TableFamilyIPv6, nftables.TypeIP6Addr, 8, 16, elements6},
+	} {
+		if len(family.elements) == 0 {
+			continue
+		}
+
+		var r nfTablesRule
+
+		r.exprs = append(r.exprs,
+			// match the protocol family
+			&expr.Meta{
+				Key:      expr.MetaKeyNFPROTO,
+				Register: 1,
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{byte(family.family)},
+			},
+			// store the source address to register 1
+			&expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseNetworkHeader,
+				Offset:       family.offset,
+				Len:          family.length,
+			},
+		)
+
+		r.matchSet(&nftables.Set{
+			Anonymous: true,
+			Constant:  true,
+			Interval:  true,
+			KeyType:   family.keyType,
+		}, family.elements)
+
+		r.compileMatches(rule)
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// compileMatches appends all the matches except for the source address match, and the verdict.
+func (r *nfTablesRule) compileMatches(rule network.NfTablesRule) {
+	r.exprs = append(r.exprs, matchIIfName(rule.MatchIIfName)...)
+
+	if rule.MatchLayer4 != nil {
+		r.exprs = append(r.exprs,
+			&expr.Meta{
+				Key:      expr.MetaKeyL4PROTO,
+				Register: 1,
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{byte(rule.MatchLayer4.Protocol)},
+			},
+		)
+
+		if rule.MatchLayer4.MatchDestinationPort != nil {
+			r.exprs = append(r.exprs,
+				// store the destination port to register 1
+				&expr.Payload{
+					DestRegister: 1,
+					Base:         expr.PayloadBaseTransportHeader,
+					Offset:       2,
+					Len:          2,
+				},
+			)
+
+			r.matchSet(&nftables.Set{
+				Anonymous: true,
+				Constant:  true,
+				Interval:  true,
+				KeyType:   nftables.TypeInetService,
+			}, portSetElements(rule.MatchLayer4.MatchDestinationPort.Ranges))
+		}
+	}
+
+	if rule.MatchConntrackState != nil {
+		var mask uint32
+
+		for _, state := range rule.MatchConntrackState.States {
+			mask |= uint32(state)
+		}
+
+		r.exprs = append(r.exprs,
+			&expr.Ct{
+				Register: 1,
+				Key:      expr.CtKeySTATE,
+			},
+			&expr.Bitwise{
+				SourceRegister: 1,
+				DestRegister:   1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(mask),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpNeq,
+				Register: 1,
+				Data:     binaryutil.NativeEndian.PutUint32(0),
+			},
+		)
+	}
+
+	if rule.Verdict != nil {
+		kind := expr.VerdictDrop
+
+		if *rule.Verdict == nethelpers.VerdictAccept {
+			kind = expr.VerdictAccept
+		}
+
+		r.exprs = append(r.exprs, &expr.Verdict{
+			Kind: kind,
+		})
+	}
+}
+
+func matchIIfName(match *network.NfTablesIfNameMatch) []expr.Any {
+	if match == nil {
+		return nil
+	}
+
+	ifname := make([]byte, 16)
+	copy(ifname, match.InterfaceName)
+
+	return []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyIIFNAME,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     ifname,
+		},
+	}
+}
+
+func portSetElements(ranges []network.PortRange) []nftables.SetElement {
+	elements := make([]nftables.SetElement, 0, len(ranges)*2)
+
+	for _, r := range ranges {
+		elements = append(elements, nftables.SetElement{
+			Key: binaryutil.BigEndian.PutUint16(r.Lo),
+		})
+
+		// open interval at the end of the port range
+		if r.Hi < 65535 {
+			elements = append(elements, nftables.SetElement{
+				Key:         binaryutil.BigEndian.PutUint16(r.Hi + 1),
+				IntervalEnd: true,
+			})
+		}
+	}
+
+	return elements
+}
+
+func addressSetElements(match *network.NfTablesAddressMatch) (elements4, elements6 []nftables.SetElement) {
+	var builder netaddr.IPSetBuilder
+
+	for _, prefix := range match.IncludeSubnets {
+		builder.AddPrefix(prefix)
+	}
+
+	for _, prefix := range match.ExcludeSubnets {
+		builder.RemovePrefix(prefix)
+	}
+
+	set, err := builder.IPSet()
+	if err != nil {
+		return nil, nil
+	}
+
+	for _, r := range set.Ranges() {
+		fromBin, _ := r.From().MarshalBinary() //nolint:errcheck // doesn't fail
+
+		se := []nftables.SetElement{
+			{
+				Key: fromBin,
+			},
+		}
+
+		// open interval at the end of the address space
+		if next := r.To().Next(); !next.IsZero() {
+			toBin, _ := next.MarshalBinary() //nolint:errcheck // doesn't fail
+
+			se = append(se, nftables.SetElement{
+				Key:         toBin,
+				IntervalEnd: true,
+			})
+		}
+
+		if r.From().Is6() {
+			elements6 = append(elements6, se...)
+		} else {
+			elements4 = append(elements4, se...)
+		}
+	}
+
+	return elements4, elements6
+}
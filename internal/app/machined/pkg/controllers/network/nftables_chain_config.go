@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// IngressChainName is the name of the ingress chain.
+const IngressChainName = "ingress"
+
+// NfTablesChainConfigController generates nftables rules based on machine configuration.
+type NfTablesChainConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Name() string {
+	return "network.NfTablesChainConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NfTablesChainType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		var cfgProvider talosconfig.Provider
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			cfgProvider = cfg.(*config.MachineConfig).Config()
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfgProvider != nil {
+			if spec, ok := ctrl.buildIngressChain(cfgProvider); ok {
+				if err = r.Modify(ctx, network.NewNfTablesChain(network.NamespaceName, IngressChainName), func(r resource.Resource) error {
+					*r.(*network.NfTablesChain).TypedSpec() = spec
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error modifying nftables chain: %w", err)
+				}
+
+				touchedIDs[IngressChainName] = struct{}{}
+			}
+		}
+
+		// list chains for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NfTablesChainType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up nftables chain: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// buildIngressChain builds the ingress chain out of the machine configuration.
+//
+// If the default action is not configured, the host firewall is disabled and no chain is built.
+//
+// The chain always accepts loopback traffic, established connections and ICMP, so that
+// blocking by default doesn't break the node networking.
+func (ctrl *NfTablesChainConfigController) buildIngressChain(cfg talosconfig.Provider) (network.NfTablesChainSpec, bool) {
+	var (
+		defaultAction talosconfig.NetworkDefaultActionConfig
+		rules         []talosconfig.NetworkRule
+	)
+
+	for _, doc := range cfg.Documents() {
+		switch c := doc.(type) {
+		case talosconfig.NetworkDefaultActionConfig:
+			defaultAction = c
+		case talosconfig.NetworkRuleConfig:
+			rules = append(rules, c.NetworkRules()...)
+		}
+	}
+
+	if defaultAction == nil {
+		return network.NfTablesChainSpec{}, false
+	}
+
+	spec := network.NfTablesChainSpec{
+		Hook:     nethelpers.ChainHookInput,
+		Priority: nethelpers.ChainPriorityFilter,
+		Policy:   nethelpers.VerdictAccept,
+	}
+
+	if defaultAction.DefaultActionIngress() == nethelpers.DefaultActionBlock {
+		spec.Policy = nethelpers.VerdictDrop
+	}
+
+	spec.Rules = []network.NfTablesRule{
+		{
+			MatchIIfName: &network.NfTablesIfNameMatch{
+				InterfaceName: "lo",
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{
+					nethelpers.ConntrackStateEstablished,
+					nethelpers.ConntrackStateRelated,
+				},
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolICMP,
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolICMPv6,
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		},
+	}
+
+	for _, rule := range rules {
+		portRanges := rule.PortRanges()
+
+		ranges := make([]network.PortRange, 0, len(portRanges))
+
+		for _, pr := range portRanges {
+			ranges = append(ranges, network.PortRange{Lo: pr[0], Hi: pr[1]})
+		}
+
+		spec.Rules = append(spec.Rules, network.NfTablesRule{
+			MatchSourceAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: rule.Subnets(),
+				ExcludeSubnets: rule.ExceptSubnets(),
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: rule.Protocol(),
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: ranges,
+				},
+			},
+			Verdict: pointer.To(nethelpers.VerdictAccept),
+		})
+	}
+
+	return spec, true
+}
@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
+	networkcfg "github.com/talos-systems/talos/pkg/machinery/config/types/network"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type NfTablesChainConfigSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *NfTablesChainConfigSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.NfTablesChainConfigController{}))
+
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *NfTablesChainConfigSuite) getChain() (*network.NfTablesChain, error) {
+	res, err := suite.state.Get(suite.ctx, resource.NewMetadata(network.NamespaceName, network.NfTablesChainType, netctrl.IngressChainName, resource.VersionUndefined))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*network.NfTablesChain), nil
+}
+
+func (suite *NfTablesChainConfigSuite) TestDisabled() {
+	cfg, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(cfg)))
+
+	// give the controller some time to run
+	time.Sleep(time.Second)
+
+	_, err = suite.getChain()
+	suite.Assert().True(state.IsNotFoundError(err))
+}
+
+func (suite *NfTablesChainConfigSuite) TestDefaultBlock() {
+	defaultAction := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultAction.Ingress = nethelpers.DefaultActionBlock
+
+	rule := networkcfg.NewRuleConfigV1Alpha1()
+	rule.MetaName = "apid"
+	rule.PortSelector.Ports = networkcfg.PortRanges{{Lo: 50000, Hi: 50000}}
+	rule.PortSelector.Protocol = nethelpers.ProtocolTCP
+	rule.Ingress = networkcfg.IngressConfig{
+		{
+			Subnet: netaddr.MustParseIPPrefix("10.0.0.0/8"),
+			Except: netaddr.MustParseIPPrefix("10.0.0.1/32"),
+		},
+	}
+
+	cfg, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	}, defaultAction, rule)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineConfig))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		_, err := suite.getChain()
+		if state.IsNotFoundError(err) {
+			return retry.ExpectedError(err)
+		}
+
+		return err
+	}))
+
+	chain, err := suite.getChain()
+	suite.Require().NoError(err)
+
+	spec := chain.TypedSpec()
+
+	suite.Assert().Equal(nethelpers.ChainHookInput, spec.Hook)
+	suite.Assert().Equal(nethelpers.VerdictDrop, spec.Policy)
+	suite.Require().Len(spec.Rules, 5)
+
+	suite.Assert().Equal("lo", spec.Rules[0].MatchIIfName.InterfaceName)
+	suite.Assert().Equal(network.NfTablesRule{
+		MatchSourceAddress: &network.NfTablesAddressMatch{
+			IncludeSubnets: []netaddr.IPPrefix{netaddr.MustParseIPPrefix("10.0.0.0/8")},
+			ExcludeSubnets: []netaddr.IPPrefix{netaddr.MustParseIPPrefix("10.0.0.1/32")},
+		},
+		MatchLayer4: &network.NfTablesLayer4Match{
+			Protocol: nethelpers.ProtocolTCP,
+			MatchDestinationPort: &network.NfTablesPortMatch{
+				Ranges: []network.PortRange{{Lo: 50000, Hi: 50000}},
+			},
+		},
+		Verdict: pointer.To(nethelpers.VerdictAccept),
+	}, spec.Rules[4])
+
+	// remove the machine configuration, chain should be removed
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, machineConfig.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		_, err := suite.getChain()
+		if err == nil {
+			return retry.ExpectedErrorf("chain still exists")
+		}
+
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}))
+}
+
+func (suite *NfTablesChainConfigSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestNfTablesChainConfigSuite(t *testing.T) {
+	suite.Run(t, new(NfTablesChainConfigSuite))
+}
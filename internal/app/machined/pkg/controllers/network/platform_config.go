@@ -91,6 +91,10 @@ func (ctrl *PlatformConfigController) Outputs() []controller.Output {
 			Type: network.OperatorSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: runtimeres.PlatformMetadataType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -453,6 +457,16 @@ func (ctrl *PlatformConfigController) apply(ctx context.Context, r controller.Ru
 		}
 	}
 
+	if networkConfig.Metadata != nil {
+		if err := r.Modify(ctx, runtimeres.NewPlatformMetadata(runtimeres.NamespaceName, runtimeres.PlatformMetadataID), func(r resource.Resource) error {
+			*r.(*runtimeres.PlatformMetadata).TypedSpec() = *networkConfig.Metadata
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying platform metadata: %w", err)
+		}
+	}
+
 	return nil
 }
 
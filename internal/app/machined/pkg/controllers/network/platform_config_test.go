@@ -469,6 +469,54 @@ func (suite *PlatformConfigSuite) TestPlatformMockExternalIPs() {
 	)
 }
 
+func (suite *PlatformConfigSuite) TestPlatformMockMetadata() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&netctrl.PlatformConfigController{
+				V1alpha1Platform: &platformMock{
+					metadata: &runtimeres.PlatformMetadataSpec{
+						Platform:     "mock",
+						Hostname:     "talos-node",
+						Region:       "us-east-1",
+						Zone:         "us-east-1a",
+						InstanceType: "t3.small",
+						InstanceID:   "i-0123456789abcdef0",
+						ProviderID:   "aws:///us-east-1a/i-0123456789abcdef0",
+						Spot:         true,
+					},
+				},
+				StatePath: suite.statePath,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertResources(
+					runtimeres.NamespaceName, runtimeres.PlatformMetadataType, []string{
+						runtimeres.PlatformMetadataID,
+					}, func(r resource.Resource) error {
+						spec := r.(*runtimeres.PlatformMetadata).TypedSpec()
+
+						suite.Assert().Equal("mock", spec.Platform)
+						suite.Assert().Equal("us-east-1", spec.Region)
+						suite.Assert().Equal("us-east-1a", spec.Zone)
+						suite.Assert().Equal("t3.small", spec.InstanceType)
+						suite.Assert().Equal("i-0123456789abcdef0", spec.InstanceID)
+						suite.Assert().Equal("aws:///us-east-1a/i-0123456789abcdef0", spec.ProviderID)
+						suite.Assert().True(spec.Spot)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
 const sampleStoredConfig = "addresses: []\nlinks: []\nroutes: []\nhostnames:\n    - hostname: talos-e2e-897b4e49-gcp-controlplane-jvcnl\n      domainname: \"\"\n      layer: default\nresolvers: []\ntimeServers: []\noperators: []\nexternalIPs:\n    - 10.3.4.5\n    - 2001:470:6d:30e:96f4:4219:5733:b860\n" //nolint:lll
 
 func (suite *PlatformConfigSuite) TestStoreConfig() {
@@ -610,6 +658,7 @@ type platformMock struct {
 	resolvers     []netaddr.IP
 	timeServers   []string
 	dhcp4Links    []string
+	metadata      *runtimeres.PlatformMetadataSpec
 }
 
 func (mock *platformMock) Name() string {
@@ -639,6 +688,7 @@ func (mock *platformMock) NetworkConfiguration(
 
 	networkConfig := &v1alpha1runtime.PlatformNetworkConfig{
 		ExternalIPs: mock.externalIPs,
+		Metadata:    mock.metadata,
 	}
 
 	if mock.hostname != nil {
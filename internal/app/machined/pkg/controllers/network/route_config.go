@@ -249,7 +249,11 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 			route.Family = nethelpers.FamilyInet4
 		}
 
-		route.Table = nethelpers.TableMain
+		route.Table, err = nethelpers.RoutingTableByName(in.Table())
+		if err != nil {
+			return route, fmt.Errorf("error parsing route table: %w", err)
+		}
+
 		route.Protocol = nethelpers.ProtocolStatic
 		route.OutLinkName = linkName
 		route.ConfigLayer = network.ConfigMachineConfiguration
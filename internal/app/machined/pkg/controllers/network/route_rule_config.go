@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RouteRuleConfigController manages network.RouteRuleSpec based on machine configuration.
+type RouteRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Name() string {
+	return "network.RouteRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RouteRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		var cfgProvider talosconfig.Provider
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			cfgProvider = cfg.(*config.MachineConfig).Config()
+		}
+
+		// parse machine configuration for routing rules
+		if cfgProvider != nil {
+			rules := ctrl.processRoutingRules(logger, cfgProvider.Machine().Network().RoutingRules())
+
+			var ids []string
+
+			ids, err = ctrl.apply(ctx, r, rules)
+			if err != nil {
+				return fmt.Errorf("error applying machine configuration routing rules: %w", err)
+			}
+
+			for _, id := range ids {
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		// list routing rules for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.RouteRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up routing rules: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func (ctrl *RouteRuleConfigController) apply(ctx context.Context, r controller.Runtime, rules []network.RouteRuleSpecSpec) ([]resource.ID, error) {
+	ids := make([]string, 0, len(rules))
+
+	for _, rule := range rules {
+		rule := rule
+		id := network.LayeredID(rule.ConfigLayer, network.RouteRuleID(rule.Family, rule.Priority))
+
+		if err := r.Modify(
+			ctx,
+			network.NewRouteRuleSpec(network.ConfigNamespaceName, id),
+			func(r resource.Resource) error {
+				*r.(*network.RouteRuleSpec).TypedSpec() = rule
+
+				return nil
+			},
+		); err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+//nolint:gocyclo
+func (ctrl *RouteRuleConfigController) processRoutingRules(logger *zap.Logger, rules []talosconfig.RoutingRule) (specs []network.RouteRuleSpecSpec) {
+	convert := func(in talosconfig.RoutingRule) (families []nethelpers.Family, rule network.RouteRuleSpecSpec, err error) {
+		if in.From() != "" {
+			rule.Source, err = netaddr.ParseIPPrefix(in.From())
+			if err != nil {
+				return nil, rule, fmt.Errorf("error parsing rule source: %w", err)
+			}
+
+			rule.Source = rule.Source.Masked()
+		}
+
+		if in.To() != "" {
+			rule.Destination, err = netaddr.ParseIPPrefix(in.To())
+			if err != nil {
+				return nil, rule, fmt.Errorf("error parsing rule destination: %w", err)
+			}
+
+			rule.Destination = rule.Destination.Masked()
+		}
+
+		rule.Table, err = nethelpers.RoutingTableByName(in.Table())
+		if err != nil {
+			return nil, rule, fmt.Errorf("error parsing rule table: %w", err)
+		}
+
+		rule.Priority = in.Priority()
+		rule.IIFName = in.IIFName()
+		rule.OIFName = in.OIFName()
+		rule.FwMark = in.FwMark()
+		rule.FwMask = in.FwMask()
+		rule.ConfigLayer = network.ConfigMachineConfiguration
+
+		switch {
+		case !rule.Source.IsZero() && !rule.Destination.IsZero() && rule.Source.IP().Is6() != rule.Destination.IP().Is6():
+			return nil, rule, fmt.Errorf("source and destination should be of the same address family")
+		case !rule.Source.IsZero():
+			families = append(families, familyOf(rule.Source))
+		case !rule.Destination.IsZero():
+			families = append(families, familyOf(rule.Destination))
+		default:
+			// rule doesn't match on addresses, so it applies to both families
+			families = append(families, nethelpers.FamilyInet4, nethelpers.FamilyInet6)
+		}
+
+		return families, rule, nil
+	}
+
+	for _, in := range rules {
+		families, rule, err := convert(in)
+		if err != nil {
+			logger.Sugar().Infof("skipping routing rule with priority %d: %s", in.Priority(), err)
+
+			continue
+		}
+
+		for _, family := range families {
+			rule.Family = family
+
+			specs = append(specs, rule)
+		}
+	}
+
+	return specs
+}
+
+func familyOf(prefix netaddr.IPPrefix) nethelpers.Family {
+	if prefix.IP().Is6() {
+		return nethelpers.FamilyInet6
+	}
+
+	return nethelpers.FamilyInet4
+}
@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleMergeSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *RouteRuleMergeSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RouteRuleMergeController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *RouteRuleMergeSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *RouteRuleMergeSuite) assertRules(requiredIDs []string, check func(*network.RouteRuleSpec) error) error {
+	missingIDs := make(map[string]struct{}, len(requiredIDs))
+
+	for _, id := range requiredIDs {
+		missingIDs[id] = struct{}{}
+	}
+
+	resources, err := suite.state.List(
+		suite.ctx,
+		resource.NewMetadata(network.NamespaceName, network.RouteRuleSpecType, "", resource.VersionUndefined),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources.Items {
+		_, required := missingIDs[res.Metadata().ID()]
+		if !required {
+			continue
+		}
+
+		delete(missingIDs, res.Metadata().ID())
+
+		if err = check(res.(*network.RouteRuleSpec)); err != nil {
+			return retry.ExpectedError(err)
+		}
+	}
+
+	if len(missingIDs) > 0 {
+		return retry.ExpectedError(fmt.Errorf("some resources are missing: %q", missingIDs))
+	}
+
+	return nil
+}
+
+func (suite *RouteRuleMergeSuite) assertNoRule(id string) error {
+	resources, err := suite.state.List(
+		suite.ctx,
+		resource.NewMetadata(network.NamespaceName, network.RouteRuleSpecType, "", resource.VersionUndefined),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources.Items {
+		if res.Metadata().ID() == id {
+			return retry.ExpectedError(fmt.Errorf("routing rule %q is still there", id))
+		}
+	}
+
+	return nil
+}
+
+func (suite *RouteRuleMergeSuite) TestMerge() {
+	operator := network.NewRouteRuleSpec(network.ConfigNamespaceName, "operator/inet4/01000")
+	*operator.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netaddr.MustParseIPPrefix("10.3.0.0/24"),
+		Table:       nethelpers.TableMain,
+		Priority:    1000,
+		ConfigLayer: network.ConfigOperator,
+	}
+
+	static4 := network.NewRouteRuleSpec(network.ConfigNamespaceName, "configuration/inet4/01000")
+	*static4.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netaddr.MustParseIPPrefix("10.3.0.0/24"),
+		Table:       nethelpers.RoutingTable(100),
+		Priority:    1000,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	static6 := network.NewRouteRuleSpec(network.ConfigNamespaceName, "configuration/inet6/01000")
+	*static6.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		Source:      netaddr.MustParseIPPrefix("fd00::/64"),
+		Table:       nethelpers.RoutingTable(100),
+		Priority:    1000,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{operator, static4, static6} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRules(
+					[]string{
+						"inet4/01000",
+						"inet6/01000",
+					}, func(r *network.RouteRuleSpec) error {
+						suite.Assert().Equal(resource.PhaseRunning, r.Metadata().Phase())
+
+						switch r.Metadata().ID() {
+						case "inet4/01000":
+							suite.Assert().Equal(*static4.TypedSpec(), *r.TypedSpec())
+						case "inet6/01000":
+							suite.Assert().Equal(*static6.TypedSpec(), *r.TypedSpec())
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, static4.Metadata()))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRules(
+					[]string{
+						"inet4/01000",
+					}, func(r *network.RouteRuleSpec) error {
+						if *operator.TypedSpec() != *r.TypedSpec() {
+							// using retry here, as it might not be reconciled immediately
+							return retry.ExpectedError(fmt.Errorf("not equal yet"))
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, static6.Metadata()))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoRule("inet6/01000")
+			},
+		),
+	)
+}
+
+func (suite *RouteRuleMergeSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	suite.Assert().NoError(
+		suite.state.Create(
+			context.Background(),
+			network.NewRouteRuleSpec(network.ConfigNamespaceName, "bar"),
+		),
+	)
+}
+
+func TestRouteRuleMergeSuite(t *testing.T) {
+	suite.Run(t, new(RouteRuleMergeSuite))
+}
@@ -0,0 +1,293 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// rtnetlink multicast groups for routing rule changes.
+const ruleGroups = 1<<(unix.RTNLGRP_IPV4_RULE-1) | 1<<(unix.RTNLGRP_IPV6_RULE-1)
+
+// RouteRuleSpecController applies network.RouteRuleSpec to the kernel routing policy database.
+type RouteRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Name() string {
+	return "network.RouteRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RouteRuleSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RouteRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// watch rule changes to restore rules removed outside of Talos
+	watcher, err := watch.NewRtNetlink(r, ruleGroups)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	nc, err := netlink.NewHandle()
+	if err != nil {
+		return fmt.Errorf("error getting netlink handle: %w", err)
+	}
+
+	defer nc.Close()
+
+	// rules created by this controller, keyed by resource ID
+	//
+	// rules at the same priority which were not created by the controller (e.g. KubeSpan or CNI rules)
+	// are never touched
+	created := map[resource.ID]network.RouteRuleSpecSpec{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RouteRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source routing rules: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for _, res := range list.Items {
+			if res.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		// list kernel routing rules
+		existingRules := map[int][]netlink.Rule{}
+
+		for _, family := range []int{unix.AF_INET, unix.AF_INET6} {
+			existingRules[family], err = nc.RuleList(family)
+			if err != nil {
+				return fmt.Errorf("error listing routing rules: %w", err)
+			}
+		}
+
+		var multiErr *multierror.Error
+
+		// loop over rules and make reconcile decision
+		for _, res := range list.Items {
+			rule := res.(*network.RouteRuleSpec) //nolint:forcetypeassert,errcheck
+
+			if err = ctrl.syncRule(ctx, r, logger, nc, existingRules[int(rule.TypedSpec().Family)], rule, created); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+}
+
+func findRules(rules []netlink.Rule, priority uint32) []*netlink.Rule {
+	var result []*netlink.Rule //nolint:prealloc
+
+	for i, rule := range rules {
+		if rule.Priority != int(priority) {
+			continue
+		}
+
+		result = append(result, &rules[i])
+	}
+
+	return result
+}
+
+func ruleMatchesPrefix(ipNet *netaddr.IPPrefix, prefix netaddr.IPPrefix) bool {
+	if prefix.IsZero() {
+		return ipNet == nil
+	}
+
+	return ipNet != nil && *ipNet == prefix
+}
+
+func ruleMatchesSpec(existing *netlink.Rule, spec *network.RouteRuleSpecSpec) bool {
+	var src, dst *netaddr.IPPrefix
+
+	if existing.Src != nil {
+		if prefix, ok := netaddr.FromStdIPNet(existing.Src); ok {
+			src = &prefix
+		}
+	}
+
+	if existing.Dst != nil {
+		if prefix, ok := netaddr.FromStdIPNet(existing.Dst); ok {
+			dst = &prefix
+		}
+	}
+
+	if !ruleMatchesPrefix(src, spec.Source) || !ruleMatchesPrefix(dst, spec.Destination) {
+		return false
+	}
+
+	if existing.Table != int(spec.Table) || existing.IifName != spec.IIFName || existing.OifName != spec.OIFName {
+		return false
+	}
+
+	if spec.FwMark == 0 {
+		return existing.Mark <= 0
+	}
+
+	// kernel defaults the mask to all ones
+	mask := spec.FwMask
+	if mask == 0 {
+		mask = 0xffffffff
+	}
+
+	return existing.Mark == int(spec.FwMark) && existing.Mask == int(mask)
+}
+
+func ruleFromSpec(spec *network.RouteRuleSpecSpec) *netlink.Rule {
+	rule := netlink.NewRule()
+
+	rule.Family = int(spec.Family)
+	rule.Priority = int(spec.Priority)
+	rule.Table = int(spec.Table)
+	rule.IifName = spec.IIFName
+	rule.OifName = spec.OIFName
+
+	if !spec.Source.IsZero() {
+		rule.Src = spec.Source.IPNet()
+	}
+
+	if !spec.Destination.IsZero() {
+		rule.Dst = spec.Destination.IPNet()
+	}
+
+	if spec.FwMark != 0 {
+		rule.Mark = int(spec.FwMark)
+
+		if spec.FwMask != 0 {
+			rule.Mask = int(spec.FwMask)
+		}
+	}
+
+	return rule
+}
+
+//nolint:gocyclo
+func (ctrl *RouteRuleSpecController) syncRule(ctx context.Context, r controller.Runtime, logger *zap.Logger, nc *netlink.Handle,
+	existingRules []netlink.Rule, rule *network.RouteRuleSpec, created map[resource.ID]network.RouteRuleSpecSpec,
+) error {
+	logger = logger.With(
+		zap.Stringer("family", rule.TypedSpec().Family),
+		zap.Uint32("priority", rule.TypedSpec().Priority),
+		zap.Stringer("table", rule.TypedSpec().Table),
+	)
+
+	switch rule.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		for _, existing := range findRules(existingRules, rule.TypedSpec().Priority) {
+			if !ruleMatchesSpec(existing, rule.TypedSpec()) {
+				continue
+			}
+
+			existing.Family = int(rule.TypedSpec().Family)
+
+			// delete rule
+			if err := nc.RuleDel(existing); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing routing rule: %w", err)
+			}
+
+			logger.Info("deleted routing rule")
+		}
+
+		delete(created, rule.Metadata().ID())
+
+		// now remove finalizer as rule was deleted
+		if err := r.RemoveFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		matchFound := false
+
+		previous, wasCreated := created[rule.Metadata().ID()]
+
+		for _, existing := range findRules(existingRules, rule.TypedSpec().Priority) {
+			// check if existing rule matches the spec: if it does, skip update
+			if !matchFound && ruleMatchesSpec(existing, rule.TypedSpec()) {
+				matchFound = true
+
+				continue
+			}
+
+			// only rules previously created for this spec are removed, other rules at the same priority are left intact
+			if !wasCreated || !ruleMatchesSpec(existing, &previous) {
+				continue
+			}
+
+			existing.Family = int(rule.TypedSpec().Family)
+
+			// delete the rule, it doesn't match the spec
+			if err := nc.RuleDel(existing); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing routing rule: %w", err)
+			}
+
+			logger.Debug("removed routing rule due to mismatch", zap.Stringer("old_rule", existing))
+		}
+
+		created[rule.Metadata().ID()] = *rule.TypedSpec()
+
+		if matchFound {
+			return nil
+		}
+
+		// add rule
+		if err := nc.RuleAdd(ruleFromSpec(rule.TypedSpec())); err != nil {
+			return fmt.Errorf("error adding routing rule: %w", err)
+		}
+
+		logger.Info("created routing rule",
+			zap.Stringer("src", rule.TypedSpec().Source),
+			zap.Stringer("dst", rule.TypedSpec().Destination),
+		)
+	}
+
+	return nil
+}
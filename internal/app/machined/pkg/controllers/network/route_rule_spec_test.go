@@ -0,0 +1,263 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"inet.af/netaddr"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RouteRuleSpecSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *RouteRuleSpecSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RouteRuleSpecController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *RouteRuleSpecSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *RouteRuleSpecSuite) assertRule(priority int, check func(netlink.Rule) error) error {
+	rules, err := netlink.RuleList(unix.AF_INET)
+	suite.Require().NoError(err)
+
+	matching := 0
+
+	for _, rule := range rules {
+		if rule.Priority != priority {
+			continue
+		}
+
+		matching++
+
+		if err = check(rule); err != nil {
+			return retry.ExpectedError(err)
+		}
+	}
+
+	switch matching {
+	case 1:
+		return nil
+	case 0:
+		return retry.ExpectedError(fmt.Errorf("routing rule with priority %d not found", priority))
+	default:
+		return retry.ExpectedError(fmt.Errorf("routing rule with priority %d found %d matches", priority, matching))
+	}
+}
+
+func (suite *RouteRuleSpecSuite) assertNoRule(priority int) error {
+	rules, err := netlink.RuleList(unix.AF_INET)
+	suite.Require().NoError(err)
+
+	for _, rule := range rules {
+		if rule.Priority == priority {
+			return retry.ExpectedError(fmt.Errorf("routing rule with priority %d is present", priority))
+		}
+	}
+
+	return nil
+}
+
+func (suite *RouteRuleSpecSuite) TestRule() {
+	rule := network.NewRouteRuleSpec(network.NamespaceName, "inet4/31000")
+	*rule.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netaddr.MustParseIPPrefix("127.0.11.0/24"),
+		Table:       nethelpers.RoutingTable(200),
+		Priority:    31000,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRule(31000, func(r netlink.Rule) error {
+					suite.Assert().Equal(200, r.Table)
+					suite.Assert().Equal("127.0.11.0/24", r.Src.String())
+					suite.Assert().Nil(r.Dst)
+
+					return nil
+				})
+			},
+		),
+	)
+
+	// update the rule, it should be replaced
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, rule.Metadata(), func(r resource.Resource) error {
+		r.(*network.RouteRuleSpec).TypedSpec().FwMark = 0x100
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRule(31000, func(r netlink.Rule) error {
+					if r.Mark != 0x100 {
+						return fmt.Errorf("mark is not updated yet: %d", r.Mark)
+					}
+
+					return nil
+				})
+			},
+		),
+	)
+
+	// teardown the rule
+	for {
+		ready, err := suite.state.Teardown(suite.ctx, rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// torn down rule should be removed immediately
+	suite.Assert().NoError(suite.assertNoRule(31000))
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, rule.Metadata()))
+}
+
+func (suite *RouteRuleSpecSuite) countRules(priority int) int {
+	rules, err := netlink.RuleList(unix.AF_INET)
+	suite.Require().NoError(err)
+
+	count := 0
+
+	for _, rule := range rules {
+		if rule.Priority == priority {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (suite *RouteRuleSpecSuite) TestForeignRule() {
+	// rule created outside of Talos (e.g. by KubeSpan or CNI) at the same priority
+	foreign := netlink.NewRule()
+	foreign.Family = unix.AF_INET
+	foreign.Priority = 31001
+	foreign.Table = 201
+	foreign.Src = netaddr.MustParseIPPrefix("127.0.12.0/24").IPNet()
+
+	suite.Require().NoError(netlink.RuleAdd(foreign))
+
+	defer netlink.RuleDel(foreign) //nolint:errcheck
+
+	rule := network.NewRouteRuleSpec(network.NamespaceName, "inet4/31001")
+	*rule.TypedSpec() = network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netaddr.MustParseIPPrefix("127.0.13.0/24"),
+		Table:       nethelpers.RoutingTable(202),
+		Priority:    31001,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				if count := suite.countRules(31001); count != 2 {
+					return retry.ExpectedError(fmt.Errorf("expected 2 rules, got %d", count))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	// teardown the rule
+	for {
+		ready, err := suite.state.Teardown(suite.ctx, rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// foreign rule should be kept
+	suite.Assert().NoError(suite.assertRule(31001, func(r netlink.Rule) error {
+		suite.Assert().Equal(201, r.Table)
+		suite.Assert().Equal("127.0.12.0/24", r.Src.String())
+
+		return nil
+	}))
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, rule.Metadata()))
+}
+
+func (suite *RouteRuleSpecSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewRouteRuleSpec(network.NamespaceName, "bar")))
+}
+
+func TestRouteRuleSpecSuite(t *testing.T) {
+	suite.Run(t, new(RouteRuleSpecSuite))
+}
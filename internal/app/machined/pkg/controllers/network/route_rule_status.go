@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RouteRuleStatusController manages network.RouteRuleStatus based on the kernel routing policy database.
+type RouteRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Name() string {
+	return "network.RouteRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RouteRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RouteRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RouteRuleStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, ruleGroups)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	nc, err := netlink.NewHandle()
+	if err != nil {
+		return fmt.Errorf("error getting netlink handle: %w", err)
+	}
+
+	defer nc.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list resources for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		itemsToDelete := map[resource.ID]struct{}{}
+
+		for _, r := range list.Items {
+			itemsToDelete[r.Metadata().ID()] = struct{}{}
+		}
+
+		for _, family := range []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6} {
+			var rules []netlink.Rule
+
+			rules, err = nc.RuleList(int(family))
+			if err != nil {
+				return fmt.Errorf("error listing routing rules: %w", err)
+			}
+
+			for _, rule := range rules {
+				rule := rule
+
+				// kernel doesn't report zero priority
+				if rule.Priority < 0 {
+					rule.Priority = 0
+				}
+
+				var srcPrefix, dstPrefix netaddr.IPPrefix
+
+				if rule.Src != nil {
+					srcPrefix, _ = netaddr.FromStdIPNet(rule.Src)
+				}
+
+				if rule.Dst != nil {
+					dstPrefix, _ = netaddr.FromStdIPNet(rule.Dst)
+				}
+
+				id := network.RouteRuleID(family, uint32(rule.Priority))
+
+				if err = r.Modify(ctx, network.NewRouteRuleStatus(network.NamespaceName, id), func(r resource.Resource) error {
+					status := r.(*network.RouteRuleStatus).TypedSpec()
+
+					status.Family = family
+					status.Source = srcPrefix
+					status.Destination = dstPrefix
+					status.IIFName = rule.IifName
+					status.OIFName = rule.OifName
+					status.FwMark = 0
+					status.FwMask = 0
+					status.Table = nethelpers.RoutingTable(rule.Table)
+					status.Priority = uint32(rule.Priority)
+
+					if rule.Mark > 0 {
+						status.FwMark = uint32(rule.Mark)
+						status.FwMask = uint32(rule.Mask)
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error modifying resource: %w", err)
+				}
+
+				delete(itemsToDelete, id)
+			}
+		}
+
+		for id := range itemsToDelete {
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.RouteRuleStatusType, id, resource.VersionUndefined)); err != nil {
+				return fmt.Errorf("error deleting routing rule status %q: %w", id, err)
+			}
+		}
+	}
+}
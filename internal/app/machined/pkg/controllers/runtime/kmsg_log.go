@@ -102,7 +102,7 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 		return fmt.Errorf("error parsing %q: %w", constants.KernelParamLoggingKernel, err)
 	}
 
-	sender := logging.NewJSONLines(destURL)
+	sender := logging.NewJSONLines(destURL, nil)
 	defer sender.Close(ctx) //nolint:errcheck
 
 	reader, err := kmsg.NewReader(kmsg.Follow())
@@ -0,0 +1,355 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlekSi/pointer"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// VolumeController provisions and mounts the user volumes from the machine configuration.
+type VolumeController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// volumes which are currently mounted by the controller: name -> mountpoint
+	mounted map[string]string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *VolumeController) Name() string {
+	return "runtime.VolumeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *VolumeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      runtime.MountStatusType,
+			ID:        pointer.To(constants.EphemeralPartitionLabel),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.DiskType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.PartitionType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *VolumeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.VolumeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *VolumeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		// no disks in container mode
+		return nil
+	}
+
+	ctrl.mounted = map[string]string{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// user volumes are mounted under /var, so wait for the EPHEMERAL to be mounted
+		if _, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, runtime.MountStatusType, constants.EphemeralPartitionLabel, resource.VersionUndefined)); err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error reading mount status: %w", err)
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		disks, err := ctrl.volumeDisks(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil {
+			for _, volume := range cfg.(*config.MachineConfig).Config().Machine().Volumes() {
+				touchedIDs[volume.Name()] = struct{}{}
+
+				status := ctrl.reconcileVolume(logger, volume, disks)
+
+				if err = r.Modify(ctx, runtime.NewVolumeStatus(runtime.NamespaceName, volume.Name()), func(res resource.Resource) error {
+					*res.(*runtime.VolumeStatus).TypedSpec() = status
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating volume status: %w", err)
+				}
+			}
+		}
+
+		// the volumes removed from the config are unmounted, but the partitions are kept
+		for name := range ctrl.mounted {
+			if _, ok := touchedIDs[name]; ok {
+				continue
+			}
+
+			if err = mount.UserVolumeUnmount(name); err != nil {
+				logger.Error("error unmounting volume", zap.String("volume", name), zap.Error(err))
+
+				continue
+			}
+
+			logger.Info("unmounted volume", zap.String("volume", name))
+
+			delete(ctrl.mounted, name)
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.VolumeStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up volume status: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// volumeDisk is a disk which might hold the user volumes.
+type volumeDisk struct {
+	spec *block.DiskSpec
+
+	// partition labels on the disk
+	labels map[string]struct{}
+}
+
+// systemPartitionLabels are the labels of the partitions created by Talos on the system disks.
+var systemPartitionLabels = map[string]struct{}{
+	constants.EFIPartitionLabel:       {},
+	constants.BIOSGrubPartitionLabel:  {},
+	constants.BootPartitionLabel:      {},
+	constants.MetaPartitionLabel:      {},
+	constants.StatePartitionLabel:     {},
+	constants.EphemeralPartitionLabel: {},
+}
+
+// volumeDisks returns the disks which might hold the user volumes.
+//
+// The disks with the system partitions (the install disk, the disk holding EPHEMERAL partition),
+// the read-only disks and the CD-ROMs are never used for the user volumes.
+func (ctrl *VolumeController) volumeDisks(ctx context.Context, r controller.Runtime) ([]volumeDisk, error) {
+	disks, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, block.DiskType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error listing disks: %w", err)
+	}
+
+	partitions, err := r.List(ctx, resource.NewMetadata(block.NamespaceName, block.PartitionType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error listing partitions: %w", err)
+	}
+
+	labels := map[string]map[string]struct{}{}
+
+	for _, res := range partitions.Items {
+		partition := res.(*block.Partition).TypedSpec()
+
+		if labels[partition.Parent] == nil {
+			labels[partition.Parent] = map[string]struct{}{}
+		}
+
+		labels[partition.Parent][partition.Label] = struct{}{}
+	}
+
+	result := make([]volumeDisk, 0, len(disks.Items))
+
+	for _, res := range disks.Items {
+		spec := res.(*block.Disk).TypedSpec()
+
+		if spec.Readonly || spec.CDROM {
+			continue
+		}
+
+		diskLabels := labels[res.Metadata().ID()]
+
+		if isSystemDisk(diskLabels) {
+			continue
+		}
+
+		result = append(result, volumeDisk{
+			spec:   spec,
+			labels: diskLabels,
+		})
+	}
+
+	return result, nil
+}
+
+func isSystemDisk(labels map[string]struct{}) bool {
+	for label := range labels {
+		if _, ok := systemPartitionLabels[label]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchDisk finds the disk for the volume.
+//
+// The disk which already holds the volume partition is preferred, otherwise the first matching disk is picked.
+func matchDisk(volume talosconfig.UserVolume, disks []volumeDisk) string {
+	matchers := volume.DiskMatchers()
+	if len(matchers) == 0 {
+		return ""
+	}
+
+	label := mount.UserVolumePartitionLabel(volume.Name())
+
+	var devpath string
+
+	for _, d := range disks {
+		if !diskMatches(d.spec, matchers) {
+			continue
+		}
+
+		if _, ok := d.labels[label]; ok {
+			return d.spec.DevPath
+		}
+
+		if devpath == "" {
+			devpath = d.spec.DevPath
+		}
+	}
+
+	return devpath
+}
+
+func diskMatches(spec *block.DiskSpec, matchers []disk.Matcher) bool {
+	d := &disk.Disk{
+		DeviceName: spec.DevPath,
+		Size:       spec.Size,
+		Model:      spec.Model,
+		Serial:     spec.Serial,
+		Modalias:   spec.Modalias,
+		WWID:       spec.WWID,
+		BusPath:    spec.BusPath,
+	}
+
+	switch {
+	case spec.Transport == block.TransportNVMe:
+		d.Type = disk.TypeNVMe
+	case spec.Transport == block.TransportMMC:
+		d.Type = disk.TypeSD
+	case spec.Rotational:
+		d.Type = disk.TypeHDD
+	default:
+		d.Type = disk.TypeSSD
+	}
+
+	for _, matcher := range matchers {
+		if !matcher(d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ctrl *VolumeController) reconcileVolume(logger *zap.Logger, volume talosconfig.UserVolume, disks []volumeDisk) runtime.VolumeStatusSpec {
+	status := runtime.VolumeStatusSpec{
+		Phase:      runtime.VolumePhaseWaiting,
+		Label:      mount.UserVolumePartitionLabel(volume.Name()),
+		Filesystem: volume.Filesystem(),
+		Encrypted:  volume.Encryption() != nil,
+		MountPoint: volume.MountPoint(),
+	}
+
+	devpath := matchDisk(volume, disks)
+
+	if devpath == "" {
+		status.Error = "no disk matches the disk selector"
+
+		return status
+	}
+
+	status.Disk = devpath
+
+	volumeStatus, err := mount.UserVolumeMount(zap.NewStdLog(logger.With(zap.String("volume", volume.Name()))), devpath, volume)
+	if err != nil {
+		logger.Error("error mounting volume", zap.String("volume", volume.Name()), zap.Error(err))
+
+		status.Phase = runtime.VolumePhaseFailed
+		status.Error = err.Error()
+
+		return status
+	}
+
+	if mountpoint, ok := ctrl.mounted[volume.Name()]; !ok || mountpoint != volumeStatus.MountPoint {
+		logger.Info("mounted volume", zap.String("volume", volume.Name()), zap.String("partition", volumeStatus.Partition), zap.String("mountpoint", volumeStatus.MountPoint))
+	}
+
+	ctrl.mounted[volume.Name()] = volumeStatus.MountPoint
+
+	status.Phase = runtime.VolumePhaseReady
+	status.Partition = volumeStatus.Partition
+	status.Size = volumeStatus.Size
+	status.Filesystem = volumeStatus.Filesystem
+	status.Encrypted = volumeStatus.Encrypted
+	status.MountPoint = volumeStatus.MountPoint
+
+	return status
+}
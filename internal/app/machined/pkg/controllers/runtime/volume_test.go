@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	v1alpha1resource "github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type VolumeSuite struct {
+	RuntimeSuite
+}
+
+func (suite *VolumeSuite) TestWaitingForDisk() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.VolumeController{
+		V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+	}))
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineVolumes: []*v1alpha1.UserVolumeConfig{
+				{
+					VolumeName: "data",
+					VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+						Model: "talos-test-no-such-disk",
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	statusMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.VolumeStatusType, "data", resource.VersionUndefined)
+
+	time.Sleep(500 * time.Millisecond)
+
+	// no status until the EPHEMERAL is mounted
+	_, err := suite.state.Get(suite.ctx, statusMD)
+	suite.Assert().True(state.IsNotFoundError(err))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, runtimeresource.NewMountStatus(v1alpha1resource.NamespaceName, constants.EphemeralPartitionLabel)))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			statusMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.VolumeStatus).TypedSpec()
+
+				return spec.Phase == runtimeresource.VolumePhaseWaiting &&
+					spec.Label == "u-data" &&
+					spec.Filesystem == "xfs" &&
+					spec.MountPoint == "/var/mnt/data" &&
+					spec.Error == "no disk matches the disk selector"
+			},
+		),
+	))
+
+	cfg = config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	old := cfg.Metadata().Version()
+
+	cfg.Metadata().BumpVersion()
+
+	suite.Require().NoError(suite.state.Update(suite.ctx, old, cfg))
+
+	// wait for the status to be removed
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err = suite.state.Get(suite.ctx, statusMD)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			return retry.ExpectedError(fmt.Errorf("resource still exists"))
+		},
+	))
+}
+
+func (suite *VolumeSuite) TestSkipSystemDisk() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.VolumeController{
+		V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+	}))
+
+	suite.startRuntime()
+
+	systemDisk := block.NewDisk(block.NamespaceName, "sda")
+	systemDisk.TypedSpec().DevPath = "/dev/sda"
+	systemDisk.TypedSpec().Model = "talos-test-disk"
+
+	statePartition := block.NewPartition(block.NamespaceName, "sda5")
+	statePartition.TypedSpec().Parent = "sda"
+	statePartition.TypedSpec().Label = constants.StatePartitionLabel
+
+	for _, res := range []resource.Resource{
+		systemDisk,
+		statePartition,
+		runtimeresource.NewMountStatus(v1alpha1resource.NamespaceName, constants.EphemeralPartitionLabel),
+		config.NewMachineConfig(&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineVolumes: []*v1alpha1.UserVolumeConfig{
+					{
+						VolumeName: "data",
+						VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+							Model: "talos-test-*",
+						},
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{},
+		}),
+	} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res))
+	}
+
+	statusMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.VolumeStatusType, "data", resource.VersionUndefined)
+
+	// the only matching disk is the system disk
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			statusMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.VolumeStatus).TypedSpec()
+
+				return spec.Phase == runtimeresource.VolumePhaseWaiting &&
+					spec.Disk == "" &&
+					spec.Error == "no disk matches the disk selector"
+			},
+		),
+	))
+
+	dataDisk := block.NewDisk(block.NamespaceName, "talos-test-nonexistent")
+	dataDisk.TypedSpec().DevPath = "/dev/talos-test-nonexistent"
+	dataDisk.TypedSpec().Model = "talos-test-disk"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, dataDisk))
+
+	// the new disk is picked up, but it can't be opened
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			statusMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.VolumeStatus).TypedSpec()
+
+				return spec.Phase == runtimeresource.VolumePhaseFailed &&
+					spec.Disk == "/dev/talos-test-nonexistent"
+			},
+		),
+	))
+}
+
+func TestVolumeSuite(t *testing.T) {
+	suite.Run(t, new(VolumeSuite))
+}
@@ -85,3 +85,13 @@ type LogSender interface {
 	// Close should be thread-safe.
 	Close(ctx context.Context) error
 }
+
+// LogFilter is implemented by log senders which accept only some of the log events.
+//
+// LoggingManager applies the filter before sending the log event to the sender.
+type LogFilter interface {
+	// Filter returns the log event to be sent (possibly with extra fields), or nil if the event should be skipped.
+	//
+	// Filter should not modify the passed log event, and it should be thread-safe.
+	Filter(e *LogEvent) *LogEvent
+}
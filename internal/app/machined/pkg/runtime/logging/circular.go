@@ -232,24 +232,49 @@ func (handler *circularHandler) runSenders() error {
 }
 
 // resend sends and resends given event until success or ErrDontRetry error.
+//
+// Senders implementing runtime.LogFilter receive the filtered event, or don't receive the event at all.
 func (handler *circularHandler) resend(e *runtime.LogEvent) {
+	// filter the event once per sender, as filters might be stateful (sampling)
+	filtered := map[runtime.LogSender]*runtime.LogEvent{}
+
 	for {
 		senders := handler.manager.getSenders()
 
 		sendCtx, sendCancel := context.WithTimeout(context.TODO(), 5*time.Second)
 		sendErrors := make(chan error, len(senders))
 
+		var sent int
+
 		for _, sender := range senders {
 			sender := sender
 
+			ev, ok := filtered[sender]
+			if !ok {
+				ev = e
+
+				if filter, isFilter := sender.(runtime.LogFilter); isFilter {
+					ev = filter.Filter(e)
+				}
+
+				filtered[sender] = ev
+			}
+
+			if ev == nil {
+				continue
+			}
+
+			sent++
+
 			go func() {
-				sendErrors <- sender.Send(sendCtx, e)
+				sendErrors <- sender.Send(sendCtx, ev)
 			}()
 		}
 
-		var dontRetry bool
+		// all senders filtered out the event
+		dontRetry := sent == 0
 
-		for range senders {
+		for i := 0; i < sent; i++ {
 			err := <-sendErrors
 
 			// don't retry if at least one sender succeed to avoid implementing per-sender queue, etc
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+type mockSender struct {
+	events chan *runtime.LogEvent
+}
+
+func newMockSender() *mockSender {
+	return &mockSender{
+		events: make(chan *runtime.LogEvent, 16),
+	}
+}
+
+func (s *mockSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	s.events <- e
+
+	return nil
+}
+
+func (s *mockSender) Close(ctx context.Context) error {
+	return nil
+}
+
+func (s *mockSender) receive(t *testing.T, n int) []*runtime.LogEvent {
+	t.Helper()
+
+	events := make([]*runtime.LogEvent, 0, n)
+
+	for i := 0; i < n; i++ {
+		select {
+		case e := <-s.events:
+			events = append(events, e)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for log event", "received %d out of %d", i, n)
+		}
+	}
+
+	return events
+}
+
+func messages(events []*runtime.LogEvent) []string {
+	msgs := make([]string, 0, len(events))
+
+	for _, e := range events {
+		msgs = append(msgs, e.Msg)
+	}
+
+	return msgs
+}
+
+func newTestFilteredSender(t *testing.T, dest v1alpha1.LoggingDestination) (*mockSender, runtime.LogSender) {
+	t.Helper()
+
+	filter, err := NewFilter(dest)
+	require.NoError(t, err)
+	require.NotNil(t, filter)
+
+	sender := newMockSender()
+
+	return sender, NewFilteredSender(sender, filter)
+}
+
+func writeLines(t *testing.T, w io.Writer, lines ...string) {
+	t.Helper()
+
+	for _, l := range lines {
+		_, err := w.Write([]byte(l + "\n"))
+		require.NoError(t, err)
+	}
+}
+
+func TestCircularBufferLoggingManagerFilter(t *testing.T) {
+	t.Parallel()
+
+	manager := NewCircularBufferLoggingManager(log.New(io.Discard, "", 0))
+
+	all := newMockSender()
+	filteredMock, filtered := newTestFilteredSender(t, v1alpha1.LoggingDestination{
+		LoggingFilter: &v1alpha1.LoggingFilterConfig{
+			FilterMinLevel:     "warn",
+			FilterMessageRegex: "^etcd",
+		},
+		LoggingExtraFields: map[string]string{
+			"cluster": "prod",
+		},
+	})
+
+	manager.SetSenders([]runtime.LogSender{all, filtered})
+
+	w, err := manager.ServiceLog("etcd").Writer()
+	require.NoError(t, err)
+
+	writeLines(t, w,
+		`{"level":"debug","msg":"etcd debug"}`,
+		`{"level":"warn","msg":"etcd warning"}`,
+		`{"level":"error","msg":"apid error"}`,
+		`{"level":"error","msg":"etcd error","member":"abcd"}`,
+	)
+
+	allEvents := all.receive(t, 4)
+	assert.Equal(t, []string{"etcd debug", "etcd warning", "apid error", "etcd error"}, messages(allEvents))
+
+	filteredEvents := filteredMock.receive(t, 2)
+	assert.Equal(t, []string{"etcd warning", "etcd error"}, messages(filteredEvents))
+
+	assert.Equal(t, zapcore.ErrorLevel, filteredEvents[1].Level)
+	assert.Equal(t, map[string]interface{}{
+		"cluster":       "prod",
+		"member":        "abcd",
+		"talos-service": "etcd",
+	}, filteredEvents[1].Fields)
+
+	// extra fields are not visible to other senders
+	assert.Equal(t, map[string]interface{}{
+		"member":        "abcd",
+		"talos-service": "etcd",
+	}, allEvents[3].Fields)
+
+	assert.Empty(t, filteredMock.events)
+}
+
+func TestCircularBufferLoggingManagerFilterServices(t *testing.T) {
+	t.Parallel()
+
+	manager := NewCircularBufferLoggingManager(log.New(io.Discard, "", 0))
+
+	includeMock, include := newTestFilteredSender(t, v1alpha1.LoggingDestination{
+		LoggingFilter: &v1alpha1.LoggingFilterConfig{
+			FilterIncludeServices: []string{"kubelet"},
+		},
+	})
+
+	excludeMock, exclude := newTestFilteredSender(t, v1alpha1.LoggingDestination{
+		LoggingFilter: &v1alpha1.LoggingFilterConfig{
+			FilterExcludeServices: []string{"kubelet", "etcd"},
+		},
+	})
+
+	manager.SetSenders([]runtime.LogSender{include, exclude})
+
+	for _, service := range []string{"etcd", "kubelet", "apid"} {
+		w, err := manager.ServiceLog(service).Writer()
+		require.NoError(t, err)
+
+		// events filtered out by all senders should not block the following ones
+		writeLines(t, w, service+" 1", service+" 2")
+	}
+
+	assert.Equal(t, []string{"kubelet 1", "kubelet 2"}, messages(includeMock.receive(t, 2)))
+	assert.Equal(t, []string{"apid 1", "apid 2"}, messages(excludeMock.receive(t, 2)))
+}
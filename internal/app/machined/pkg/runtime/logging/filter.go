@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Filter selects the log events sent to the log sender and adds extra fields to them.
+type Filter struct {
+	minLevel        zapcore.Level
+	includeServices map[string]struct{}
+	excludeServices map[string]struct{}
+	messageRegex    *regexp.Regexp
+	extraFields     map[string]interface{}
+	sampler         *sampler
+}
+
+// NewFilter builds the filter from the logging destination configuration.
+//
+// NewFilter returns nil if the configuration doesn't filter or change any log events.
+func NewFilter(dest config.LoggingDestination) (*Filter, error) {
+	cfg := dest.Filter()
+
+	f := &Filter{
+		minLevel: zapcore.DebugLevel,
+	}
+
+	var active bool
+
+	if cfg.MinLevel() != "" {
+		if err := f.minLevel.UnmarshalText([]byte(cfg.MinLevel())); err != nil {
+			return nil, fmt.Errorf("error parsing logging filter level: %w", err)
+		}
+
+		active = true
+	}
+
+	if services := cfg.IncludeServices(); len(services) > 0 {
+		f.includeServices = serviceSet(services)
+		active = true
+	}
+
+	if services := cfg.ExcludeServices(); len(services) > 0 {
+		f.excludeServices = serviceSet(services)
+		active = true
+	}
+
+	if cfg.MessageRegex() != "" {
+		var err error
+
+		if f.messageRegex, err = regexp.Compile(cfg.MessageRegex()); err != nil {
+			return nil, fmt.Errorf("error parsing logging filter message regex: %w", err)
+		}
+
+		active = true
+	}
+
+	if sampling := cfg.Sampling(); sampling.Enabled() {
+		f.sampler = &sampler{
+			initial:    sampling.Initial(),
+			thereafter: sampling.Thereafter(),
+			counters:   map[string]*samplerCounter{},
+		}
+		active = true
+	}
+
+	if extraFields := dest.ExtraFields(); len(extraFields) > 0 {
+		f.extraFields = make(map[string]interface{}, len(extraFields))
+
+		for k, v := range extraFields {
+			f.extraFields[k] = v
+		}
+
+		active = true
+	}
+
+	if !active {
+		return nil, nil
+	}
+
+	return f, nil
+}
+
+func serviceSet(services []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(services))
+
+	for _, service := range services {
+		set[service] = struct{}{}
+	}
+
+	return set
+}
+
+// Filter implements runtime.LogFilter interface.
+//
+// Extra fields don't override the fields of the log event.
+func (f *Filter) Filter(e *runtime.LogEvent) *runtime.LogEvent {
+	if e.Level < f.minLevel {
+		return nil
+	}
+
+	service, _ := e.Fields[serviceField].(string) //nolint:errcheck
+
+	if f.includeServices != nil {
+		if _, ok := f.includeServices[service]; !ok {
+			return nil
+		}
+	}
+
+	if _, ok := f.excludeServices[service]; ok {
+		return nil
+	}
+
+	if f.messageRegex != nil && !f.messageRegex.MatchString(e.Msg) {
+		return nil
+	}
+
+	if f.sampler != nil && !f.sampler.allow(service, e.Time) {
+		return nil
+	}
+
+	if len(f.extraFields) == 0 {
+		return e
+	}
+
+	fields := make(map[string]interface{}, len(e.Fields)+len(f.extraFields))
+
+	for k, v := range f.extraFields {
+		fields[k] = v
+	}
+
+	for k, v := range e.Fields {
+		fields[k] = v
+	}
+
+	filtered := *e
+	filtered.Fields = fields
+
+	return &filtered
+}
+
+// sampler passes the first `initial` log events of each service every second, and every `thereafter`-th event after that.
+type sampler struct {
+	initial    int
+	thereafter int
+
+	mu       sync.Mutex
+	counters map[string]*samplerCounter
+}
+
+type samplerCounter struct {
+	resetAt time.Time
+	count   int
+}
+
+func (s *sampler) allow(service string, t time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.counters[service]
+	if c == nil {
+		c = &samplerCounter{}
+		s.counters[service] = c
+	}
+
+	if !t.Before(c.resetAt) {
+		c.resetAt = t.Add(time.Second)
+		c.count = 0
+	}
+
+	c.count++
+
+	if c.count <= s.initial {
+		return true
+	}
+
+	if s.thereafter == 0 {
+		return false
+	}
+
+	return (c.count-s.initial)%s.thereafter == 0
+}
+
+// filteredSender is a log sender with the filter applied by the LoggingManager.
+type filteredSender struct {
+	runtime.LogSender
+
+	filter *Filter
+}
+
+// NewFilteredSender returns log sender which implements runtime.LogFilter with the given filter.
+func NewFilteredSender(sender runtime.LogSender, filter *Filter) runtime.LogSender {
+	return &filteredSender{
+		LogSender: sender,
+		filter:    filter,
+	}
+}
+
+// Filter implements runtime.LogFilter interface.
+func (s *filteredSender) Filter(e *runtime.LogEvent) *runtime.LogEvent {
+	return s.filter.Filter(e)
+}
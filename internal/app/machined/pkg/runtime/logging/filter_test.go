@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestFilterSampling(t *testing.T) {
+	t.Parallel()
+
+	filter, err := NewFilter(v1alpha1.LoggingDestination{
+		LoggingFilter: &v1alpha1.LoggingFilterConfig{
+			FilterSampling: &v1alpha1.LoggingSamplingConfig{
+				SamplingInitial:    2,
+				SamplingThereafter: 3,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2021, 10, 19, 12, 42, 37, 0, time.UTC)
+
+	sample := func(service string, offset time.Duration, n int) []bool {
+		passed := make([]bool, 0, n)
+
+		for i := 0; i < n; i++ {
+			passed = append(passed, filter.Filter(&runtime.LogEvent{
+				Time:   now.Add(offset),
+				Fields: map[string]interface{}{"talos-service": service},
+			}) != nil)
+		}
+
+		return passed
+	}
+
+	assert.Equal(t, []bool{true, true, false, false, true, false, false, true}, sample("etcd", 0, 8))
+
+	// services are sampled independently
+	assert.Equal(t, []bool{true, true, false}, sample("kubelet", 500*time.Millisecond, 3))
+
+	// counters are reset every second
+	assert.Equal(t, []bool{true, true, false}, sample("etcd", time.Second, 3))
+}
+
+func TestNewFilterNoop(t *testing.T) {
+	t.Parallel()
+
+	filter, err := NewFilter(v1alpha1.LoggingDestination{
+		LoggingFilter: &v1alpha1.LoggingFilterConfig{},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+}
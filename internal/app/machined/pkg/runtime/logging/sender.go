@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// serviceField is the log event field which holds the name of the Talos service.
+const serviceField = "talos-service"
+
+// NewSender returns log sender for the logging destination from the machine config.
+//
+// If the destination has a filter or extra fields configured, the returned sender implements runtime.LogFilter.
+// If spool options are set, the log events are sent via the on-disk spool.
+func NewSender(dest config.LoggingDestination, spoolOpts *SpoolOptions) (runtime.LogSender, error) {
+	endpoint := dest.Endpoint()
+
+	var tlsConfig *tls.Config
+
+	if endpoint.Scheme == "tls" {
+		tlsConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+
+		if ca := dest.TLSCA(); len(ca) > 0 {
+			tlsConfig.RootCAs = x509.NewCertPool()
+
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("failed to parse logging TLS CA certificate")
+			}
+		}
+	}
+
+	var sender runtime.LogSender
+
+	switch format := dest.Format(); format {
+	case constants.LoggingFormatJSONLines:
+		sender = NewJSONLines(endpoint, tlsConfig)
+	case constants.LoggingFormatSyslog:
+		sender = NewSyslog(endpoint, tlsConfig)
+	case constants.LoggingFormatGELF:
+		sender = NewGELF(endpoint, tlsConfig)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+
+	filter, err := NewFilter(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if spoolOpts != nil {
+		spool, spoolErr := OpenSpool(filepath.Join(spoolOpts.Path, SpoolID(dest)), spoolOpts.MaxSize)
+		if spoolErr != nil {
+			return nil, fmt.Errorf("error opening log spool: %w", spoolErr)
+		}
+
+		sender = NewSpooledSender(sender, spool)
+	}
+
+	if filter != nil {
+		sender = NewFilteredSender(sender, filter)
+	}
+
+	return sender, nil
+}
+
+// syslogSeverity maps Talos log level to the syslog severity (RFC 5424, section 6.2.1).
+//
+// GELF uses the same severity values for the level field.
+func syslogSeverity(level zapcore.Level) int {
+	switch level { //nolint:exhaustive
+	case zapcore.DebugLevel:
+		return 7 // debug
+	case zapcore.InfoLevel:
+		return 6 // informational
+	case zapcore.WarnLevel:
+		return 4 // warning
+	case zapcore.ErrorLevel:
+		return 3 // error
+	case zapcore.DPanicLevel, zapcore.PanicLevel:
+		return 2 // critical
+	case zapcore.FatalLevel:
+		return 1 // alert
+	default:
+		return 6
+	}
+}
@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+// connSender manages the connection to the log receiver for the network log senders.
+//
+// Supported endpoint schemes are "tcp", "udp" and "tls".
+type connSender struct {
+	endpoint  *url.URL
+	tlsConfig *tls.Config
+
+	sema chan struct{}
+	conn net.Conn
+}
+
+func newConnSender(endpoint *url.URL, tlsConfig *tls.Config) *connSender {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	return &connSender{
+		endpoint:  endpoint,
+		tlsConfig: tlsConfig,
+		sema:      sema,
+	}
+}
+
+// stream returns true if the connection is a stream (and the messages should be framed).
+func (c *connSender) stream() bool {
+	return c.endpoint.Scheme != "udp"
+}
+
+func (c *connSender) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-c.sema:
+		unlock = func() { c.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return
+}
+
+func (c *connSender) dial(ctx context.Context) (net.Conn, error) {
+	if c.endpoint.Scheme == "tls" {
+		return (&tls.Dialer{Config: c.tlsConfig}).DialContext(ctx, "tcp", c.endpoint.Host)
+	}
+
+	return new(net.Dialer).DialContext(ctx, c.endpoint.Scheme, c.endpoint.Host)
+}
+
+// write sends the packets over the connection.
+//
+// For UDP, each packet is sent as a separate datagram.
+func (c *connSender) write(ctx context.Context, packets ...[]byte) error {
+	unlock := c.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	// Connect (or "connect" for UDP) if no connection is established already.
+	if c.conn == nil {
+		conn, err := c.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.conn = conn
+	}
+
+	d, _ := ctx.Deadline()
+	c.conn.SetWriteDeadline(d) //nolint:errcheck
+
+	var sent bool
+
+	for _, b := range packets {
+		// Close connection on send error.
+		n, err := c.conn.Write(b)
+		if err != nil {
+			c.conn.Close() //nolint:errcheck
+			c.conn = nil
+
+			// skip partially sent events to avoid partial duplicates in the receiver
+			if n > 0 || sent {
+				err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+			}
+
+			return err
+		}
+
+		sent = true
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (c *connSender) Close(ctx context.Context) error {
+	unlock := c.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	conn := c.conn
+	c.conn = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	gelfVersion = "1.1"
+
+	// gelfMaxChunkSize is the maximum size of the UDP datagram (including the chunk header).
+	gelfMaxChunkSize   = 8192
+	gelfChunkHeaderLen = 12
+	gelfMaxChunks      = 128
+
+	// gelfApplicationField follows the naming of the Graylog syslog input for APP-NAME.
+	gelfApplicationField = "_application_name"
+)
+
+type gelfSender struct {
+	*connSender
+}
+
+// NewGELF returns log sender that sends logs in GELF format over TCP or TLS (null byte-delimited)
+// or UDP (chunked if the message doesn't fit into a single packet).
+func NewGELF(endpoint *url.URL, tlsConfig *tls.Config) runtime.LogSender {
+	return &gelfSender{
+		connSender: newConnSender(endpoint, tlsConfig),
+	}
+}
+
+// marshalGELF formats the event as GELF 1.1 message.
+//
+// Talos service name is sent as the application name, other fields are sent as additional fields.
+func marshalGELF(e *runtime.LogEvent, hostname string) ([]byte, error) {
+	if hostname == "" {
+		hostname = "-"
+	}
+
+	shortMessage := e.Msg
+	if shortMessage == "" {
+		shortMessage = "-"
+	}
+
+	m := make(map[string]interface{}, len(e.Fields)+5)
+
+	for k, v := range e.Fields {
+		key := "_" + gelfFieldName(k)
+
+		switch {
+		case k == serviceField:
+			key = gelfApplicationField
+		case key == "_id":
+			// "_id" is reserved by GELF
+			key = "__id"
+		}
+
+		m[key] = gelfFieldValue(v)
+	}
+
+	m["version"] = gelfVersion
+	m["host"] = hostname
+	m["short_message"] = shortMessage
+	m["timestamp"] = float64(e.Time.UnixMicro()) / 1e6
+	m["level"] = syslogSeverity(e.Level)
+
+	return json.Marshal(m)
+}
+
+// gelfFieldName replaces characters not allowed in GELF additional field names.
+func gelfFieldName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// gelfFieldValue converts the value to a string or a number, as GELF doesn't allow other types.
+func gelfFieldValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+
+		return string(b)
+	}
+}
+
+// chunkGELF splits the message into GELF chunks to be sent as separate UDP datagrams.
+func chunkGELF(b []byte) ([][]byte, error) {
+	if len(b) <= gelfMaxChunkSize {
+		return [][]byte{b}, nil
+	}
+
+	const chunkDataSize = gelfMaxChunkSize - gelfChunkHeaderLen
+
+	count := (len(b) + chunkDataSize - 1) / chunkDataSize
+	if count > gelfMaxChunks {
+		return nil, fmt.Errorf("message is too large: %d bytes", len(b))
+	}
+
+	var id [8]byte
+
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	chunks := make([][]byte, 0, count)
+
+	for seq := 0; seq < count; seq++ {
+		data := b[seq*chunkDataSize:]
+		if len(data) > chunkDataSize {
+			data = data[:chunkDataSize]
+		}
+
+		chunk := make([]byte, 0, gelfChunkHeaderLen+len(data))
+		chunk = append(chunk, 0x1e, 0x0f)
+		chunk = append(chunk, id[:]...)
+		chunk = append(chunk, byte(seq), byte(count))
+		chunk = append(chunk, data...)
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+// Send implements LogSender interface.
+func (g *gelfSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	hostname, _ := os.Hostname() //nolint:errcheck
+
+	b, err := marshalGELF(e, hostname)
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	if g.stream() {
+		return g.write(ctx, append(b, 0))
+	}
+
+	chunks, err := chunkGELF(b)
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	return g.write(ctx, chunks...)
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	// syslogFacilityDaemon is the "system daemons" facility.
+	syslogFacilityDaemon = 3
+
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	syslogMaxHostnameLength = 255
+	syslogMaxAppNameLength  = 48
+)
+
+type syslogSender struct {
+	*connSender
+}
+
+// NewSyslog returns log sender that sends logs in RFC 5424 syslog format over TCP or TLS
+// (octet-counted framing per RFC 6587) or UDP (one message per packet).
+func NewSyslog(endpoint *url.URL, tlsConfig *tls.Config) runtime.LogSender {
+	return &syslogSender{
+		connSender: newConnSender(endpoint, tlsConfig),
+	}
+}
+
+// marshalSyslog formats the event as RFC 5424 message.
+//
+// Talos service name is used as APP-NAME, other fields are appended to the message as JSON.
+func marshalSyslog(e *runtime.LogEvent, hostname string) ([]byte, error) {
+	appName := "-"
+	fields := make(map[string]interface{}, len(e.Fields))
+
+	for k, v := range e.Fields {
+		if k == serviceField {
+			if service, ok := v.(string); ok {
+				appName = syslogHeaderValue(service, syslogMaxAppNameLength)
+
+				continue
+			}
+		}
+
+		fields[k] = v
+	}
+
+	msg := e.Msg
+
+	if len(fields) > 0 {
+		b, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		msg += " " + string(b)
+	}
+
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s - - - %s",
+		syslogFacilityDaemon*8+syslogSeverity(e.Level),
+		e.Time.UTC().Format(syslogTimeFormat),
+		syslogHeaderValue(hostname, syslogMaxHostnameLength),
+		appName,
+		msg,
+	)), nil
+}
+
+// syslogHeaderValue converts the value to the printable ASCII string of limited length, "-" is used for empty values.
+func syslogHeaderValue(s string, maxLength int) string {
+	b := make([]byte, 0, len(s))
+
+	for i := 0; i < len(s) && len(b) < maxLength; i++ {
+		if s[i] < 33 || s[i] > 126 {
+			b = append(b, '_')
+		} else {
+			b = append(b, s[i])
+		}
+	}
+
+	if len(b) == 0 {
+		return "-"
+	}
+
+	return string(b)
+}
+
+// Send implements LogSender interface.
+func (s *syslogSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	hostname, _ := os.Hostname() //nolint:errcheck
+
+	b, err := marshalSyslog(e, hostname)
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	if s.stream() {
+		b = append([]byte(strconv.Itoa(len(b))+" "), b...)
+	}
+
+	return s.write(ctx, b)
+}
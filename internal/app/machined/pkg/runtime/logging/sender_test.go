@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestMarshalSyslog(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 10, 19, 12, 42, 37, 123456789, time.FixedZone("EEST", 3*3600))
+
+	for name, tc := range map[string]struct {
+		e        *runtime.LogEvent
+		hostname string
+		expected string
+	}{
+		"service": {
+			e: &runtime.LogEvent{
+				Msg:   "[talos] service[etcd](Running): Health check successful",
+				Time:  now,
+				Level: zapcore.InfoLevel,
+				Fields: map[string]interface{}{
+					"talos-service": "etcd",
+				},
+			},
+			hostname: "talos-default-controlplane-1",
+			expected: "<30>1 2021-10-19T09:42:37.123456Z talos-default-controlplane-1 etcd - - - [talos] service[etcd](Running): Health check successful",
+		},
+		"fields": {
+			e: &runtime.LogEvent{
+				Msg:   "reconfigured wireguard link",
+				Time:  now,
+				Level: zapcore.ErrorLevel,
+				Fields: map[string]interface{}{
+					"component": "controller-runtime",
+					"peers":     4,
+				},
+			},
+			hostname: "",
+			expected: `<27>1 2021-10-19T09:42:37.123456Z - - - - - reconfigured wireguard link {"component":"controller-runtime","peers":4}`,
+		},
+		"sanitized": {
+			e: &runtime.LogEvent{
+				Msg:   "warning",
+				Time:  now,
+				Level: zapcore.WarnLevel,
+				Fields: map[string]interface{}{
+					"talos-service": "ext-my service" + strings.Repeat("x", 50),
+				},
+			},
+			hostname: "node",
+			expected: "<28>1 2021-10-19T09:42:37.123456Z node ext-my_servicexxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx - - - warning",
+		},
+	} {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := marshalSyslog(tc.e, tc.hostname)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, string(b))
+		})
+	}
+}
+
+func TestMarshalGELF(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 10, 19, 12, 42, 37, 123456789, time.UTC)
+
+	b, err := marshalGELF(&runtime.LogEvent{
+		Msg:   "reconfigured wireguard link",
+		Time:  now,
+		Level: zapcore.DebugLevel,
+		Fields: map[string]interface{}{
+			"talos-service": "machined",
+			"id":            "abc",
+			"link name":     "kubespan",
+			"peers":         4,
+			"addresses":     []string{"10.0.0.1"},
+		},
+	}, "node")
+	require.NoError(t, err)
+
+	var m map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(b, &m))
+
+	assert.Equal(t, map[string]interface{}{
+		"version":           "1.1",
+		"host":              "node",
+		"short_message":     "reconfigured wireguard link",
+		"timestamp":         1634647357.123456,
+		"level":             7.0,
+		"_application_name": "machined",
+		"__id":              "abc",
+		"_link_name":        "kubespan",
+		"_peers":            4.0,
+		"_addresses":        `["10.0.0.1"]`,
+	}, m)
+}
+
+func TestChunkGELF(t *testing.T) {
+	t.Parallel()
+
+	small := []byte(`{"version":"1.1"}`)
+
+	chunks, err := chunkGELF(small)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{small}, chunks)
+
+	large := bytes.Repeat([]byte("a"), 2*gelfMaxChunkSize)
+
+	chunks, err = chunkGELF(large)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+
+	var reassembled []byte
+
+	for i, chunk := range chunks {
+		assert.LessOrEqual(t, len(chunk), gelfMaxChunkSize)
+		assert.Equal(t, []byte{0x1e, 0x0f}, chunk[:2])
+		assert.Equal(t, chunks[0][2:10], chunk[2:10])
+		assert.EqualValues(t, i, chunk[10])
+		assert.EqualValues(t, len(chunks), chunk[11])
+
+		reassembled = append(reassembled, chunk[gelfChunkHeaderLen:]...)
+	}
+
+	assert.Equal(t, large, reassembled)
+
+	_, err = chunkGELF(bytes.Repeat([]byte("a"), gelfMaxChunks*gelfMaxChunkSize))
+	assert.Error(t, err)
+}
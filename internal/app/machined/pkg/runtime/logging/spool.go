@@ -0,0 +1,665 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+const (
+	spoolAckFile       = "ack"
+	spoolSegmentSuffix = ".log"
+
+	// spoolSegments is the number of segments the spool size is split into.
+	spoolSegments = 4
+
+	// spoolAckInterval is the interval between the writes of the acknowledged position to the disk.
+	spoolAckInterval = time.Second
+)
+
+// errSpoolClosed is returned when the spool is closed.
+var errSpoolClosed = errors.New("spool is closed")
+
+// SpoolOptions configures the on-disk spool of the log senders.
+type SpoolOptions struct {
+	// Path to the directory with the spools of all destinations.
+	Path string
+	// MaxSize of the spool for each destination.
+	MaxSize uint64
+}
+
+// SpoolID returns the name of the spool directory for the logging destination.
+//
+// The ID is derived from the whole destination config including the filter, so that the log events spooled
+// for a destination are never sent to a destination with different settings.
+func SpoolID(dest config.LoggingDestination) string {
+	filter := dest.Filter()
+
+	// encoding can't fail, and the map keys are sorted, so the encoding is stable
+	data, _ := json.Marshal(struct { //nolint:errchkjson
+		Format             string
+		Endpoint           string
+		TLSCA              []byte
+		ExtraFields        map[string]string
+		MinLevel           string
+		IncludeServices    []string
+		ExcludeServices    []string
+		MessageRegex       string
+		SamplingInitial    int
+		SamplingThereafter int
+	}{
+		Format:             dest.Format(),
+		Endpoint:           dest.Endpoint().String(),
+		TLSCA:              dest.TLSCA(),
+		ExtraFields:        dest.ExtraFields(),
+		MinLevel:           filter.MinLevel(),
+		IncludeServices:    filter.IncludeServices(),
+		ExcludeServices:    filter.ExcludeServices(),
+		MessageRegex:       filter.MessageRegex(),
+		SamplingInitial:    filter.Sampling().Initial(),
+		SamplingThereafter: filter.Sampling().Thereafter(),
+	})
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// RemoveStaleSpools removes the spools of the destinations which are not in the list.
+func RemoveStaleSpools(opts *SpoolOptions, dests []config.LoggingDestination) error {
+	keep := make(map[string]struct{}, len(dests))
+
+	for _, dest := range dests {
+		keep[SpoolID(dest)] = struct{}{}
+	}
+
+	entries, err := os.ReadDir(opts.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, entry := range entries {
+		if _, ok := keep[entry.Name()]; ok {
+			continue
+		}
+
+		if err = os.RemoveAll(filepath.Join(opts.Path, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type spoolPosition struct {
+	segment uint64
+	offset  int64
+}
+
+type spoolSegment struct {
+	id   uint64
+	size int64
+}
+
+// Spool is a durable on-disk queue of the log events for a single logging destination.
+//
+// Log events are appended to the segment files, and the position of the last acknowledged (sent) log event
+// is stored in the ack file, so that the log events not sent yet are replayed after a restart.
+// Delivery is at-least-once: a few log events might be sent again after a restart.
+//
+// When the spool size exceeds the limit, the oldest segments are removed, even if they were not sent.
+type Spool struct {
+	dir         string
+	maxSize     int64
+	segmentSize int64
+
+	mu       sync.Mutex
+	closed   bool
+	appended chan struct{}
+	segments []spoolSegment
+	w        *os.File
+
+	ack      spoolPosition
+	ackDirty bool
+	ackSaved time.Time
+}
+
+// OpenSpool opens (or creates) the spool in the directory.
+func OpenSpool(dir string, maxSize uint64) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	s := &Spool{
+		dir:         dir,
+		maxSize:     int64(maxSize),
+		segmentSize: int64(maxSize) / spoolSegments,
+		appended:    make(chan struct{}),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		id, ok := parseSegmentName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		s.segments = append(s.segments, spoolSegment{id: id, size: info.Size()})
+	}
+
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i].id < s.segments[j].id })
+
+	if s.ack, err = s.loadAck(); err != nil {
+		return nil, err
+	}
+
+	// always start a new segment, as the last one might end with a partially written log event
+	if err = s.rotate(); err != nil {
+		return nil, err
+	}
+
+	if s.ack.segment < s.segments[0].id {
+		s.ack = spoolPosition{segment: s.segments[0].id}
+	}
+
+	return s, nil
+}
+
+func segmentName(id uint64) string {
+	return fmt.Sprintf("%016x%s", id, spoolSegmentSuffix)
+}
+
+func parseSegmentName(name string) (uint64, bool) {
+	if !strings.HasSuffix(name, spoolSegmentSuffix) {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(strings.TrimSuffix(name, spoolSegmentSuffix), 16, 64)
+
+	return id, err == nil
+}
+
+func (s *Spool) segmentPath(id uint64) string {
+	return filepath.Join(s.dir, segmentName(id))
+}
+
+func (s *Spool) loadAck() (spoolPosition, error) {
+	var pos spoolPosition
+
+	contents, err := os.ReadFile(filepath.Join(s.dir, spoolAckFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return pos, nil
+		}
+
+		return pos, err
+	}
+
+	if _, err = fmt.Sscanf(string(contents), "%d %d", &pos.segment, &pos.offset); err != nil {
+		// corrupted ack file, replay the whole spool
+		return spoolPosition{}, nil //nolint:nilerr
+	}
+
+	return pos, nil
+}
+
+// saveAck should be called with the mutex held.
+func (s *Spool) saveAck() error {
+	tmpPath := filepath.Join(s.dir, spoolAckFile+".tmp")
+
+	if err := os.WriteFile(tmpPath, []byte(fmt.Sprintf("%d %d\n", s.ack.segment, s.ack.offset)), 0o600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, filepath.Join(s.dir, spoolAckFile)); err != nil {
+		return err
+	}
+
+	s.ackDirty = false
+	s.ackSaved = time.Now()
+
+	return nil
+}
+
+// rotate starts a new segment, it should be called with the mutex held.
+func (s *Spool) rotate() error {
+	var id uint64 = 1
+
+	if len(s.segments) > 0 {
+		id = s.segments[len(s.segments)-1].id + 1
+	}
+
+	w, err := os.OpenFile(s.segmentPath(id), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if s.w != nil {
+		s.w.Close() //nolint:errcheck
+	}
+
+	s.w = w
+	s.segments = append(s.segments, spoolSegment{id: id})
+
+	return nil
+}
+
+// trim removes the oldest segments while the spool is over the size limit, it should be called with the mutex held.
+func (s *Spool) trim() error {
+	var size int64
+
+	for _, segment := range s.segments {
+		size += segment.size
+	}
+
+	for size > s.maxSize && len(s.segments) > 1 {
+		if err := os.Remove(s.segmentPath(s.segments[0].id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		size -= s.segments[0].size
+		s.segments = s.segments[1:]
+
+		if s.ack.segment < s.segments[0].id {
+			s.ack = spoolPosition{segment: s.segments[0].id}
+			s.ackDirty = true
+		}
+	}
+
+	return nil
+}
+
+type spoolEvent struct {
+	Msg    string                 `json:"msg"`
+	Time   time.Time              `json:"time"`
+	Level  zapcore.Level          `json:"level"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// Append adds the log event to the spool.
+func (s *Spool) Append(e *runtime.LogEvent) error {
+	b, err := json.Marshal(spoolEvent{
+		Msg:    e.Msg,
+		Time:   e.Time,
+		Level:  e.Level,
+		Fields: e.Fields,
+	})
+	if err != nil {
+		return err
+	}
+
+	b = append(b, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errSpoolClosed
+	}
+
+	last := &s.segments[len(s.segments)-1]
+
+	if last.size > 0 && last.size+int64(len(b)) > s.segmentSize {
+		if err = s.rotate(); err != nil {
+			return err
+		}
+
+		last = &s.segments[len(s.segments)-1]
+	}
+
+	n, err := s.w.Write(b)
+	last.size += int64(n)
+
+	if err != nil {
+		return err
+	}
+
+	if err = s.trim(); err != nil {
+		return err
+	}
+
+	close(s.appended)
+	s.appended = make(chan struct{})
+
+	return nil
+}
+
+// acknowledge marks the log events up to the position as sent.
+//
+// Segments which were completely sent are removed.
+func (s *Spool) acknowledge(pos spoolPosition) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errSpoolClosed
+	}
+
+	s.ack = pos
+	s.ackDirty = true
+
+	for len(s.segments) > 1 && s.segments[0].id < pos.segment {
+		if err := os.Remove(s.segmentPath(s.segments[0].id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		s.segments = s.segments[1:]
+	}
+
+	if time.Since(s.ackSaved) < spoolAckInterval {
+		return nil
+	}
+
+	return s.saveAck()
+}
+
+// state returns the channel which is closed on the next append, and whether there is a segment after the given one.
+func (s *Spool) state(segment uint64) (appended <-chan struct{}, hasNext bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, false, errSpoolClosed
+	}
+
+	return s.appended, s.segments[len(s.segments)-1].id > segment, nil
+}
+
+// nextSegment returns the first segment after the given one.
+func (s *Spool) nextSegment(segment uint64) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, seg := range s.segments {
+		if seg.id > segment {
+			return seg.id
+		}
+	}
+
+	return s.segments[len(s.segments)-1].id
+}
+
+// Close the spool storing the acknowledged position.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+	close(s.appended)
+
+	var err error
+
+	if s.ackDirty {
+		err = s.saveAck()
+	}
+
+	if closeErr := s.w.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// spoolReader reads the log events from the spool starting at the acknowledged position.
+type spoolReader struct {
+	spool *Spool
+	pos   spoolPosition
+
+	f *os.File
+	r *bufio.Reader
+}
+
+func (s *Spool) reader() *spoolReader {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return &spoolReader{
+		spool: s,
+		pos:   s.ack,
+	}
+}
+
+func (r *spoolReader) close() {
+	if r.f != nil {
+		r.f.Close() //nolint:errcheck
+
+		r.f = nil
+	}
+}
+
+func (r *spoolReader) open() error {
+	f, err := os.Open(r.spool.segmentPath(r.pos.segment))
+	if err != nil {
+		if next := r.spool.nextSegment(r.pos.segment); errors.Is(err, os.ErrNotExist) && next != r.pos.segment {
+			// the segment was removed as the spool was full, skip to the next one
+			r.pos = spoolPosition{segment: next}
+
+			return nil
+		}
+
+		return err
+	}
+
+	if _, err = f.Seek(r.pos.offset, io.SeekStart); err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	r.f = f
+	r.r = bufio.NewReader(f)
+
+	return nil
+}
+
+// next returns the next log event and the position after it, waiting for the log event to be appended if needed.
+func (r *spoolReader) next(ctx context.Context) (*runtime.LogEvent, spoolPosition, error) {
+	for {
+		// the state should be captured before reading: if there is a next segment,
+		// the current one is not going to be appended anymore
+		appended, hasNext, err := r.spool.state(r.pos.segment)
+		if err != nil {
+			return nil, r.pos, err
+		}
+
+		if r.f == nil {
+			if err = r.open(); err != nil {
+				return nil, r.pos, err
+			}
+
+			if r.f == nil {
+				continue
+			}
+		}
+
+		line, err := r.r.ReadBytes('\n')
+		if err == nil {
+			r.pos.offset += int64(len(line))
+
+			var e spoolEvent
+
+			if err = json.Unmarshal(line, &e); err != nil {
+				// skip corrupted log events
+				continue
+			}
+
+			return &runtime.LogEvent{
+				Msg:    e.Msg,
+				Time:   e.Time,
+				Level:  e.Level,
+				Fields: e.Fields,
+			}, r.pos, nil
+		}
+
+		if !errors.Is(err, io.EOF) {
+			return nil, r.pos, err
+		}
+
+		if hasNext {
+			r.close()
+
+			r.pos = spoolPosition{segment: r.spool.nextSegment(r.pos.segment)}
+
+			continue
+		}
+
+		// partial line might have been read, re-read it once it's complete
+		if _, err = r.f.Seek(r.pos.offset, io.SeekStart); err != nil {
+			return nil, r.pos, err
+		}
+
+		r.r.Reset(r.f)
+
+		select {
+		case <-ctx.Done():
+			return nil, r.pos, ctx.Err()
+		case <-appended:
+		}
+	}
+}
+
+// spooledSender writes the log events to the spool, and sends them from the spool in the background.
+type spooledSender struct {
+	sender runtime.LogSender
+	spool  *Spool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSpooledSender returns log sender which sends the log events via the spool.
+func NewSpooledSender(sender runtime.LogSender, spool *Spool) runtime.LogSender {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &spooledSender{
+		sender: sender,
+		spool:  spool,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go s.run(ctx)
+
+	return s
+}
+
+func (s *spooledSender) run(ctx context.Context) {
+	defer close(s.done)
+
+	r := s.spool.reader()
+	defer r.close()
+
+	for {
+		e, pos, err := r.next(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, errSpoolClosed) {
+				return
+			}
+
+			// try to re-open the segment after a delay
+			r.close()
+
+			if !sleepCtx(ctx, time.Second) {
+				return
+			}
+
+			continue
+		}
+
+		for {
+			sendCtx, sendCancel := context.WithTimeout(ctx, 5*time.Second)
+			err = s.sender.Send(sendCtx, e)
+
+			sendCancel()
+
+			if err == nil || errors.Is(err, runtime.ErrDontRetry) {
+				break
+			}
+
+			if !sleepCtx(ctx, time.Second) {
+				return
+			}
+		}
+
+		if err = s.spool.acknowledge(pos); err != nil && errors.Is(err, errSpoolClosed) {
+			return
+		}
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Send implements LogSender interface.
+func (s *spooledSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	if err := s.spool.Append(e); err != nil {
+		// spool is not available (e.g. the disk is full), fall back to sending directly
+		return s.sender.Send(ctx, e)
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (s *spooledSender) Close(ctx context.Context) error {
+	s.cancel()
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := s.spool.Close(); err != nil {
+		return err
+	}
+
+	return s.sender.Close(ctx)
+}
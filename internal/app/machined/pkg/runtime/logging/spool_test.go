@@ -0,0 +1,277 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func spoolTestEvent(i int) *runtime.LogEvent {
+	return &runtime.LogEvent{
+		Msg:   fmt.Sprintf("event %d", i),
+		Time:  time.Date(2021, 10, 19, 12, 42, 37, i, time.UTC),
+		Level: zapcore.WarnLevel,
+		Fields: map[string]interface{}{
+			"talos-service": "etcd",
+		},
+	}
+}
+
+func readSpool(ctx context.Context, t *testing.T, r *spoolReader, n int) ([]string, spoolPosition) {
+	t.Helper()
+
+	var (
+		msgs []string
+		pos  spoolPosition
+	)
+
+	for i := 0; i < n; i++ {
+		var (
+			e   *runtime.LogEvent
+			err error
+		)
+
+		e, pos, err = r.next(ctx)
+		require.NoError(t, err)
+
+		msgs = append(msgs, e.Msg)
+	}
+
+	return msgs, pos
+}
+
+func TestSpoolReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+
+	spool, err := OpenSpool(dir, 1024*1024)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, spool.Append(spoolTestEvent(i)))
+	}
+
+	r := spool.reader()
+
+	e, pos, err := r.next(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, spoolTestEvent(0), e)
+
+	require.NoError(t, spool.acknowledge(pos))
+
+	r.close()
+	require.NoError(t, spool.Close())
+
+	_, _, err = r.next(ctx)
+	assert.ErrorIs(t, err, errSpoolClosed)
+
+	// reopen the spool, unacknowledged events should be replayed
+	spool, err = OpenSpool(dir, 1024*1024)
+	require.NoError(t, err)
+
+	defer spool.Close() //nolint:errcheck
+
+	require.NoError(t, spool.Append(spoolTestEvent(3)))
+
+	r = spool.reader()
+	defer r.close()
+
+	msgs, _ := readSpool(ctx, t, r, 3)
+	assert.Equal(t, []string{"event 1", "event 2", "event 3"}, msgs)
+
+	// reader waits for new events
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		spool.Append(spoolTestEvent(4)) //nolint:errcheck
+	}()
+
+	msgs, _ = readSpool(ctx, t, r, 1)
+	assert.Equal(t, []string{"event 4"}, msgs)
+
+	shortCtx, shortCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer shortCancel()
+
+	_, _, err = r.next(shortCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSpoolMaxSize(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+
+	const maxSize = 4096
+
+	spool, err := OpenSpool(dir, maxSize)
+	require.NoError(t, err)
+
+	defer spool.Close() //nolint:errcheck
+
+	for i := 0; i < 200; i++ {
+		require.NoError(t, spool.Append(spoolTestEvent(i)))
+	}
+
+	var size int64
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), spoolSegmentSuffix) {
+			continue
+		}
+
+		info, err := entry.Info()
+		require.NoError(t, err)
+
+		size += info.Size()
+	}
+
+	assert.LessOrEqual(t, size, int64(maxSize))
+
+	r := spool.reader()
+	defer r.close()
+
+	// oldest events were dropped, the rest is in order
+	msgs, pos := readSpool(ctx, t, r, 10)
+
+	var first int
+
+	_, err = fmt.Sscanf(msgs[0], "event %d", &first)
+	require.NoError(t, err)
+
+	assert.Greater(t, first, 100)
+
+	for i, msg := range msgs {
+		assert.Equal(t, fmt.Sprintf("event %d", first+i), msg)
+	}
+
+	// acknowledged segments are removed
+	require.NoError(t, spool.acknowledge(pos))
+
+	_, err = os.Stat(filepath.Join(dir, spoolAckFile))
+	require.NoError(t, err)
+}
+
+func TestSpoolID(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse("tcp://10.5.0.1:3000")
+	require.NoError(t, err)
+
+	dest := func(filter *v1alpha1.LoggingFilterConfig) v1alpha1.LoggingDestination {
+		return v1alpha1.LoggingDestination{
+			LoggingEndpoint: &v1alpha1.Endpoint{URL: u},
+			LoggingFormat:   "json_lines",
+			LoggingFilter:   filter,
+		}
+	}
+
+	id := SpoolID(dest(nil))
+
+	assert.Equal(t, id, SpoolID(dest(nil)))
+	assert.Equal(t, id, SpoolID(dest(&v1alpha1.LoggingFilterConfig{})))
+
+	ids := map[string]struct{}{id: {}}
+
+	for _, filter := range []*v1alpha1.LoggingFilterConfig{
+		{FilterMinLevel: "warn"},
+		{FilterIncludeServices: []string{"etcd"}},
+		{FilterExcludeServices: []string{"etcd"}},
+		{FilterMessageRegex: "error"},
+		{FilterSampling: &v1alpha1.LoggingSamplingConfig{SamplingInitial: 10, SamplingThereafter: 100}},
+	} {
+		id := SpoolID(dest(filter))
+
+		assert.NotContains(t, ids, id)
+
+		ids[id] = struct{}{}
+	}
+}
+
+type flakySender struct {
+	mockSender
+
+	down atomic.Value
+}
+
+func (s *flakySender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	if s.down.Load().(bool) {
+		return errors.New("connection refused")
+	}
+
+	return s.mockSender.Send(ctx, e)
+}
+
+func TestSpooledSender(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	spool, err := OpenSpool(dir, 1024*1024)
+	require.NoError(t, err)
+
+	sender := &flakySender{
+		mockSender: *newMockSender(),
+	}
+	sender.down.Store(true)
+
+	spooled := NewSpooledSender(sender, spool)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, spooled.Send(context.Background(), spoolTestEvent(i)))
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, sender.events)
+
+	// destination is reachable again, all events are delivered in order
+	sender.down.Store(false)
+
+	events := sender.receive(t, 10)
+
+	for i, e := range events {
+		assert.Equal(t, fmt.Sprintf("event %d", i), e.Msg)
+	}
+
+	require.NoError(t, spooled.Close(context.Background()))
+
+	// everything was acknowledged, nothing is replayed
+	spool, err = OpenSpool(dir, 1024*1024)
+	require.NoError(t, err)
+
+	spooled = NewSpooledSender(sender, spool)
+
+	require.NoError(t, spooled.Send(context.Background(), spoolTestEvent(10)))
+
+	assert.Equal(t, "event 10", sender.receive(t, 1)[0].Msg)
+
+	require.NoError(t, spooled.Close(context.Background()))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // AWS is the concrete type that implements the runtime.Platform interface.
@@ -136,6 +137,36 @@ func (a *AWS) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.Platf
 		networkConfig.ExternalIPs = append(networkConfig.ExternalIPs, ip)
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform: a.Name(),
+		Hostname: hostname,
+	}
+
+	for _, field := range []struct {
+		key  string
+		dest *string
+	}{
+		{"placement/region", &networkConfig.Metadata.Region},
+		{"placement/availability-zone", &networkConfig.Metadata.Zone},
+		{"instance-type", &networkConfig.Metadata.InstanceType},
+		{"instance-id", &networkConfig.Metadata.InstanceID},
+	} {
+		if *field.dest, err = getMetadataKey(field.key); err != nil {
+			return err
+		}
+	}
+
+	lifecycle, err := getMetadataKey("instance-life-cycle")
+	if err != nil {
+		return err
+	}
+
+	networkConfig.Metadata.Spot = lifecycle == "spot"
+
+	if networkConfig.Metadata.InstanceID != "" {
+		networkConfig.Metadata.ProviderID = fmt.Sprintf("aws:///%s/%s", networkConfig.Metadata.Zone, networkConfig.Metadata.InstanceID)
+	}
+
 	select {
 	case ch <- networkConfig:
 	case <-ctx.Done():
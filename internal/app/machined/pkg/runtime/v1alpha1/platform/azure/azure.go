@@ -26,12 +26,15 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
 	// AzureInternalEndpoint is the Azure Internal Channel IP
 	// https://blogs.msdn.microsoft.com/mast/2015/05/18/what-is-the-ip-address-168-63-129-16/
 	AzureInternalEndpoint = "http://168.63.129.16"
+	// AzureComputeEndpoint is the local endpoint for the instance compute metadata.
+	AzureComputeEndpoint = "http://169.254.169.254/metadata/instance/compute?api-version=2021-12-13&format=json"
 	// AzureHostnameEndpoint is the local endpoint for the hostname.
 	AzureHostnameEndpoint = "http://169.254.169.254/metadata/instance/compute/osProfile/computerName?api-version=2021-12-13&format=text"
 	// AzureInterfacesEndpoint is the local endpoint to get external IPs.
@@ -68,6 +71,17 @@ type LoadBalancerMetadata struct {
 	} `json:"loadbalancer,omitempty"`
 }
 
+// ComputeMetadata represents instance compute metadata in IMDS.
+type ComputeMetadata struct {
+	Name       string `json:"name,omitempty"`
+	Location   string `json:"location,omitempty"`
+	Zone       string `json:"zone,omitempty"`
+	VMSize     string `json:"vmSize,omitempty"`
+	VMID       string `json:"vmId,omitempty"`
+	ResourceID string `json:"resourceId,omitempty"`
+	Priority   string `json:"priority,omitempty"`
+}
+
 // Azure is the concrete type that implements the platform.Platform interface.
 type Azure struct{}
 
@@ -139,6 +153,27 @@ func (a *Azure) ParseMetadata(interfaceAddresses []NetworkConfig, host []byte) (
 	return &networkConfig, nil
 }
 
+// ParseComputeMetadata converts Azure compute metadata to the platform metadata.
+func (a *Azure) ParseComputeMetadata(compute *ComputeMetadata, host []byte) *runtimeres.PlatformMetadataSpec {
+	zone := compute.Zone
+
+	// availability zones are reported as a number, Kubernetes expects <location>-<zone>
+	if zone != "" && compute.Location != "" {
+		zone = compute.Location + "-" + zone
+	}
+
+	return &runtimeres.PlatformMetadataSpec{
+		Platform:     a.Name(),
+		Hostname:     string(host),
+		Region:       compute.Location,
+		Zone:         zone,
+		InstanceType: compute.VMSize,
+		InstanceID:   compute.VMID,
+		ProviderID:   "azure://" + compute.ResourceID,
+		Spot:         strings.EqualFold(compute.Priority, "spot") || strings.EqualFold(compute.Priority, "low"),
+	}
+}
+
 // ParseLoadBalancerIP parses Azure LoadBalancer metadata into the platform external ip list.
 func (a *Azure) ParseLoadBalancerIP(lbConfig LoadBalancerMetadata, exIP []netaddr.IP) ([]netaddr.IP, error) {
 	lbAddresses := exIP
@@ -278,6 +313,22 @@ func (a *Azure) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.Pla
 		return fmt.Errorf("failed to parse network metadata: %w", err)
 	}
 
+	log.Printf("fetching compute metadata from: %q", AzureComputeEndpoint)
+
+	computeMetadata, err := download.Download(ctx, AzureComputeEndpoint,
+		download.WithHeaders(map[string]string{"Metadata": "true"}))
+	if err != nil {
+		return fmt.Errorf("failed to fetch compute metadata from metadata service: %w", err)
+	}
+
+	var compute ComputeMetadata
+
+	if err = json.Unmarshal(computeMetadata, &compute); err != nil {
+		return fmt.Errorf("failed to parse compute metadata: %w", err)
+	}
+
+	networkConfig.Metadata = a.ParseComputeMetadata(&compute, host)
+
 	log.Printf("fetching load balancer metadata from: %q", AzureLoadbalancerEndpoint)
 
 	var loadBalancerAddresses LoadBalancerMetadata
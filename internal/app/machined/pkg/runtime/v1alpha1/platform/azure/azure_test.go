@@ -14,6 +14,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/azure"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 //go:embed testdata/metadata.json
@@ -22,6 +23,9 @@ var rawMetadata []byte
 //go:embed testdata/loadbalancer.json
 var rawLoadBalancerMetadata []byte
 
+//go:embed testdata/compute.json
+var rawComputeMetadata []byte
+
 //go:embed testdata/expected.yaml
 var expectedNetworkConfig string
 
@@ -47,3 +51,22 @@ func TestParseMetadata(t *testing.T) {
 
 	assert.Equal(t, expectedNetworkConfig, string(marshaled))
 }
+
+func TestParseComputeMetadata(t *testing.T) {
+	a := &azure.Azure{}
+
+	var compute azure.ComputeMetadata
+
+	require.NoError(t, json.Unmarshal(rawComputeMetadata, &compute))
+
+	assert.Equal(t, &runtimeres.PlatformMetadataSpec{
+		Platform:     "azure",
+		Hostname:     "some.fqdn",
+		Region:       "westeurope",
+		Zone:         "westeurope-2",
+		InstanceType: "Standard_D2s_v3",
+		InstanceID:   "02aab8a4-74ef-476e-8182-f6d2ba4166a6",
+		ProviderID:   "azure:///subscriptions/8d10da13-8125-4ba9-a717-bf7490507b3d/resourceGroups/talos/providers/Microsoft.Compute/virtualMachines/talos-controlplane-1",
+		Spot:         true,
+	}, a.ParseComputeMetadata(&compute, []byte("some.fqdn")))
+}
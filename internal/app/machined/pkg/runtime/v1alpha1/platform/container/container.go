@@ -17,6 +17,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Container is a platform for installing Talos via an Container image.
@@ -73,6 +74,11 @@ func (c *Container) NetworkConfiguration(ctx context.Context, ch chan<- *runtime
 
 	networkConfig.Hostnames = append(networkConfig.Hostnames, hostnameSpec)
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform: c.Name(),
+		Hostname: hostnameSpec.FQDN(),
+	}
+
 	select {
 	case ch <- networkConfig:
 	case <-ctx.Done():
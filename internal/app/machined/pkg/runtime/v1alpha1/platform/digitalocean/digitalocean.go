@@ -16,13 +16,18 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
 	// DigitalOceanExternalIPEndpoint displays all external addresses associated with the instance.
 	DigitalOceanExternalIPEndpoint = "http://169.254.169.254/metadata/v1/interfaces/public/0/ipv4/address"
+	// DigitalOceanDropletIDEndpoint is the local endpoint for the droplet ID.
+	DigitalOceanDropletIDEndpoint = "http://169.254.169.254/metadata/v1/id"
 	// DigitalOceanHostnameEndpoint is the local endpoint for the hostname.
 	DigitalOceanHostnameEndpoint = "http://169.254.169.254/metadata/v1/hostname"
+	// DigitalOceanRegionEndpoint is the local endpoint for the region.
+	DigitalOceanRegionEndpoint = "http://169.254.169.254/metadata/v1/region"
 	// DigitalOceanUserDataEndpoint is the local endpoint for the config.
 	DigitalOceanUserDataEndpoint = "http://169.254.169.254/metadata/v1/user-data"
 )
@@ -74,6 +79,16 @@ func (d *DigitalOcean) NetworkConfiguration(ctx context.Context, ch chan<- *runt
 		return err
 	}
 
+	dropletID, err := download.Download(ctx, DigitalOceanDropletIDEndpoint)
+	if err != nil {
+		return err
+	}
+
+	region, err := download.Download(ctx, DigitalOceanRegionEndpoint)
+	if err != nil {
+		return err
+	}
+
 	networkConfig := &runtime.PlatformNetworkConfig{}
 
 	if len(host) > 0 {
@@ -94,6 +109,14 @@ func (d *DigitalOcean) NetworkConfiguration(ctx context.Context, ch chan<- *runt
 		}
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:   d.Name(),
+		Hostname:   string(host),
+		Region:     string(region),
+		InstanceID: string(dropletID),
+		ProviderID: "digitalocean://" + string(dropletID),
+	}
+
 	select {
 	case ch <- networkConfig:
 	case <-ctx.Done():
@@ -26,6 +26,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Event holds data to pass to the Equinix Metal event URL.
@@ -36,7 +37,11 @@ type Event struct {
 
 // Metadata holds equinixmetal metadata info.
 type Metadata struct {
+	ID             string   `json:"id"`
 	Hostname       string   `json:"hostname"`
+	Plan           string   `json:"plan"`
+	Metro          string   `json:"metro"`
+	Facility       string   `json:"facility"`
 	Network        Network  `json:"network"`
 	PrivateSubnets []string `json:"private_subnets"`
 }
@@ -316,6 +321,18 @@ func (p *EquinixMetal) ParseMetadata(equinixMetadata *Metadata) (*runtime.Platfo
 		networkConfig.Hostnames = append(networkConfig.Hostnames, hostnameSpec)
 	}
 
+	// 5. platform metadata
+
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:     p.Name(),
+		Hostname:     equinixMetadata.Hostname,
+		Region:       equinixMetadata.Metro,
+		Zone:         equinixMetadata.Facility,
+		InstanceType: equinixMetadata.Plan,
+		InstanceID:   equinixMetadata.ID,
+		ProviderID:   "equinixmetal://" + equinixMetadata.ID,
+	}
+
 	return networkConfig, nil
 }
 
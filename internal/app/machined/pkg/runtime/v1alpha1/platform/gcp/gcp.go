@@ -6,6 +6,7 @@ package gcp
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"cloud.google.com/go/compute/metadata"
@@ -15,6 +16,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // GCP is the concrete type that implements the platform.Platform interface.
@@ -92,6 +94,11 @@ func (g *GCP) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.Platf
 		networkConfig.ExternalIPs = append(networkConfig.ExternalIPs, ip)
 	}
 
+	networkConfig.Metadata, err = g.metadata(hostname)
+	if err != nil {
+		return err
+	}
+
 	select {
 	case ch <- networkConfig:
 	case <-ctx.Done():
@@ -100,3 +107,56 @@ func (g *GCP) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.Platf
 
 	return nil
 }
+
+func (g *GCP) metadata(hostname string) (*runtimeres.PlatformMetadataSpec, error) {
+	projectID, err := metadata.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := metadata.Zone()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID, err := metadata.InstanceID()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceName, err := metadata.InstanceName()
+	if err != nil {
+		return nil, err
+	}
+
+	// machine type is reported as projects/<project-number>/machineTypes/<type>
+	machineType, err := metadata.Get("instance/machine-type")
+	if err != nil {
+		return nil, err
+	}
+
+	preemptible, err := metadata.Get("instance/scheduling/preemptible")
+	if err != nil {
+		if _, ok := err.(metadata.NotDefinedError); !ok {
+			return nil, err
+		}
+	}
+
+	region := zone
+
+	// zone is <region>-<letter>
+	if idx := strings.LastIndex(zone, "-"); idx > 0 {
+		region = zone[:idx]
+	}
+
+	return &runtimeres.PlatformMetadataSpec{
+		Platform:     g.Name(),
+		Hostname:     hostname,
+		Region:       region,
+		Zone:         zone,
+		InstanceType: machineType[strings.LastIndex(machineType, "/")+1:],
+		InstanceID:   instanceID,
+		ProviderID:   fmt.Sprintf("gce://%s/%s/%s", projectID, zone, instanceName),
+		Spot:         strings.EqualFold(strings.TrimSpace(preemptible), "true"),
+	}, nil
+}
@@ -19,12 +19,16 @@ import (
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
 	// HCloudExternalIPEndpoint is the local hcloud endpoint for the external IP.
 	HCloudExternalIPEndpoint = "http://169.254.169.254/hetzner/v1/metadata/public-ipv4"
 
+	// HCloudMetadataEndpoint is the local hcloud endpoint for the instance metadata.
+	HCloudMetadataEndpoint = "http://169.254.169.254/hetzner/v1/metadata"
+
 	// HCloudNetworkEndpoint is the local hcloud endpoint for the network-config.
 	HCloudNetworkEndpoint = "http://169.254.169.254/hetzner/v1/metadata/network-config"
 
@@ -35,6 +39,14 @@ const (
 	HCloudUserDataEndpoint = "http://169.254.169.254/hetzner/v1/userdata"
 )
 
+// MetadataConfig holds hcloud instance metadata.
+type MetadataConfig struct {
+	Hostname         string `yaml:"hostname,omitempty"`
+	InstanceID       string `yaml:"instance-id,omitempty"`
+	Region           string `yaml:"region,omitempty"`
+	AvailabilityZone string `yaml:"availability-zone,omitempty"`
+}
+
 // NetworkConfig holds hcloud network-config info.
 type NetworkConfig struct {
 	Version int `yaml:"version"`
@@ -64,7 +76,7 @@ func (h *Hcloud) Name() string {
 // ParseMetadata converts HCloud metadata to platform network configuration.
 //
 //nolint:gocyclo
-func (h *Hcloud) ParseMetadata(unmarshalledNetworkConfig *NetworkConfig, host, extIP []byte) (*runtime.PlatformNetworkConfig, error) {
+func (h *Hcloud) ParseMetadata(unmarshalledMetadataConfig *MetadataConfig, unmarshalledNetworkConfig *NetworkConfig, host, extIP []byte) (*runtime.PlatformNetworkConfig, error) {
 	networkConfig := &runtime.PlatformNetworkConfig{}
 
 	if len(host) > 0 {
@@ -154,6 +166,18 @@ func (h *Hcloud) ParseMetadata(unmarshalledNetworkConfig *NetworkConfig, host, e
 		}
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:   h.Name(),
+		Hostname:   string(host),
+		Region:     unmarshalledMetadataConfig.Region,
+		Zone:       unmarshalledMetadataConfig.AvailabilityZone,
+		InstanceID: unmarshalledMetadataConfig.InstanceID,
+	}
+
+	if unmarshalledMetadataConfig.InstanceID != "" {
+		networkConfig.Metadata.ProviderID = "hcloud://" + unmarshalledMetadataConfig.InstanceID
+	}
+
 	return networkConfig, nil
 }
 
@@ -217,7 +241,20 @@ func (h *Hcloud) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.Pl
 		return err
 	}
 
-	networkConfig, err := h.ParseMetadata(&unmarshalledNetworkConfig, host, extIP)
+	log.Printf("fetching instance metadata from: %q", HCloudMetadataEndpoint)
+
+	metadataConfig, err := download.Download(ctx, HCloudMetadataEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to fetch instance metadata from metadata service: %w", err)
+	}
+
+	var unmarshalledMetadataConfig MetadataConfig
+
+	if err = yaml.Unmarshal(metadataConfig, &unmarshalledMetadataConfig); err != nil {
+		return err
+	}
+
+	networkConfig, err := h.ParseMetadata(&unmarshalledMetadataConfig, &unmarshalledNetworkConfig, host, extIP)
 	if err != nil {
 		return err
 	}
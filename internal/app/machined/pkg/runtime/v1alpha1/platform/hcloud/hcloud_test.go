@@ -19,17 +19,24 @@ import (
 //go:embed testdata/metadata.yaml
 var rawMetadata []byte
 
+//go:embed testdata/instance.yaml
+var rawInstanceMetadata []byte
+
 //go:embed testdata/expected.yaml
 var expectedNetworkConfig string
 
 func TestParseMetadata(t *testing.T) {
 	h := &hcloud.Hcloud{}
 
+	var md hcloud.MetadataConfig
+
+	require.NoError(t, yaml.Unmarshal(rawInstanceMetadata, &md))
+
 	var m hcloud.NetworkConfig
 
 	require.NoError(t, yaml.Unmarshal(rawMetadata, &m))
 
-	networkConfig, err := h.ParseMetadata(&m, []byte("some.fqdn"), []byte("1.2.3.4"))
+	networkConfig, err := h.ParseMetadata(&md, &m, []byte("some.fqdn"), []byte("1.2.3.4"))
 	require.NoError(t, err)
 
 	marshaled, err := yaml.Marshal(networkConfig)
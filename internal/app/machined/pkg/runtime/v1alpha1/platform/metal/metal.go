@@ -23,6 +23,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
@@ -152,6 +153,27 @@ func (m *Metal) KernelArgs() procfs.Parameters {
 }
 
 // NetworkConfiguration implements the runtime.Platform interface.
+//
+// Metal platform doesn't provide any network configuration, only the platform metadata is reported.
+// The instance ID is the SMBIOS system UUID, the hostname is not known to the platform.
 func (m *Metal) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.PlatformNetworkConfig) error {
+	networkConfig := &runtime.PlatformNetworkConfig{
+		Metadata: &runtimeres.PlatformMetadataSpec{
+			Platform: m.Name(),
+		},
+	}
+
+	if uuid, err := getSystemUUID(); err == nil {
+		networkConfig.Metadata.InstanceID = uuid
+	} else {
+		log.Printf("failed to read system UUID: %s", err)
+	}
+
+	select {
+	case ch <- networkConfig:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Nocloud is the concrete type that implements the runtime.Platform interface.
@@ -27,9 +28,11 @@ func (n *Nocloud) Name() string {
 }
 
 // ParseMetadata converts nocloud metadata to platform network config.
-func (n *Nocloud) ParseMetadata(unmarshalledNetworkConfig *NetworkConfig, hostname string) (*runtime.PlatformNetworkConfig, error) {
+func (n *Nocloud) ParseMetadata(unmarshalledNetworkConfig *NetworkConfig, metadata *MetadataConfig) (*runtime.PlatformNetworkConfig, error) {
 	networkConfig := &runtime.PlatformNetworkConfig{}
 
+	hostname := metadata.Hostname
+
 	if hostname != "" {
 		hostnameSpec := network.HostnameSpecSpec{
 			ConfigLayer: network.ConfigPlatform,
@@ -55,6 +58,12 @@ func (n *Nocloud) ParseMetadata(unmarshalledNetworkConfig *NetworkConfig, hostna
 		return nil, fmt.Errorf("network-config metadata version=%d is not supported", unmarshalledNetworkConfig.Version)
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:   n.Name(),
+		Hostname:   hostname,
+		InstanceID: metadata.InstanceID,
+	}
+
 	return networkConfig, nil
 }
 
@@ -117,11 +126,11 @@ func (n *Nocloud) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.P
 		}
 	}
 
-	if hostname == "" {
-		hostname = unmarshalledMetadataConfig.Hostname
+	if hostname != "" {
+		unmarshalledMetadataConfig.Hostname = hostname
 	}
 
-	networkConfig, err := n.ParseMetadata(&unmarshalledNetworkConfig, hostname)
+	networkConfig, err := n.ParseMetadata(&unmarshalledNetworkConfig, &unmarshalledMetadataConfig)
 	if err != nil {
 		return err
 	}
@@ -32,18 +32,24 @@ func TestParseMetadata(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
 		raw      []byte
-		hostname string
+		metadata nocloud.MetadataConfig
 		expected string
 	}{
 		{
-			name:     "V1",
-			raw:      rawMetadataV1,
-			hostname: "talos",
+			name: "V1",
+			raw:  rawMetadataV1,
+			metadata: nocloud.MetadataConfig{
+				Hostname:   "talos",
+				InstanceID: "0",
+			},
 			expected: expectedNetworkConfigV1,
 		},
 		{
-			name:     "V2",
-			raw:      rawMetadataV2,
+			name: "V2",
+			raw:  rawMetadataV2,
+			metadata: nocloud.MetadataConfig{
+				InstanceID: "i-1234567890",
+			},
 			expected: expectedNetworkConfigV2,
 		},
 	} {
@@ -56,7 +62,7 @@ func TestParseMetadata(t *testing.T) {
 
 			require.NoError(t, yaml.Unmarshal(tt.raw, &m))
 
-			networkConfig, err := n.ParseMetadata(&m, tt.hostname)
+			networkConfig, err := n.ParseMetadata(&m, &tt.metadata)
 			require.NoError(t, err)
 
 			marshaled, err := yaml.Marshal(networkConfig)
@@ -71,7 +71,9 @@ type NetworkConfig struct {
 
 // MetadataConfig holds meta info.
 type MetadataConfig struct {
-	Hostname string `json:"hostname,omitempty"`
+	Hostname         string `json:"hostname,omitempty"`
+	UUID             string `json:"uuid,omitempty"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
 }
 
 func (o *Openstack) configFromNetwork(ctx context.Context) (metaConfig []byte, networkConfig []byte, machineConfig []byte, err error) {
@@ -20,6 +20,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/utils"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Openstack is the concrete type that implements the runtime.Platform interface.
@@ -205,6 +206,17 @@ func (o *Openstack) ParseMetadata(unmarshalledMetadataConfig *MetadataConfig, un
 		}
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:   o.Name(),
+		Hostname:   hostname,
+		Zone:       unmarshalledMetadataConfig.AvailabilityZone,
+		InstanceID: unmarshalledMetadataConfig.UUID,
+	}
+
+	if unmarshalledMetadataConfig.UUID != "" {
+		networkConfig.Metadata.ProviderID = "openstack:///" + unmarshalledMetadataConfig.UUID
+	}
+
 	return networkConfig, nil
 }
 
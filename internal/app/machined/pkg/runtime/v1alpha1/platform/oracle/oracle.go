@@ -17,12 +17,15 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Ref: https://docs.oracle.com/en-us/iaas/Content/Compute/Tasks/gettingmetadata.htm
 const (
 	// OracleHostnameEndpoint is the local metadata endpoint for the hostname.
 	OracleHostnameEndpoint = "http://169.254.169.254/opc/v2/instance/hostname"
+	// OracleInstanceEndpoint is the local metadata endpoint for the instance information.
+	OracleInstanceEndpoint = "http://169.254.169.254/opc/v2/instance/"
 	// OracleUserDataEndpoint is the local metadata endpoint inside of Oracle Cloud.
 	OracleUserDataEndpoint = "http://169.254.169.254/opc/v2/instance/metadata/user_data"
 	// OracleNetworkEndpoint is the local network metadata endpoint inside of Oracle Cloud.
@@ -39,6 +42,14 @@ type NetworkConfig struct {
 	Ipv6VirtualRouterIP string `json:"ipv6VirtualRouterIp,omitempty"`
 }
 
+// InstanceMetadata holds instance meta info.
+type InstanceMetadata struct {
+	ID                  string `json:"id"`
+	CanonicalRegionName string `json:"canonicalRegionName"`
+	AvailabilityDomain  string `json:"availabilityDomain"`
+	Shape               string `json:"shape"`
+}
+
 // Oracle is the concrete type that implements the platform.Platform interface.
 type Oracle struct{}
 
@@ -142,6 +153,30 @@ func (o *Oracle) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.Pl
 		return err
 	}
 
+	log.Printf("fetching instance metadata from: %q", OracleInstanceEndpoint)
+
+	instanceMetadata, err := download.Download(ctx, OracleInstanceEndpoint,
+		download.WithHeaders(map[string]string{"Authorization": "Bearer Oracle"}))
+	if err != nil {
+		return fmt.Errorf("failed to fetch instance metadata from metadata service: %w", err)
+	}
+
+	var instance InstanceMetadata
+
+	if err = json.Unmarshal(instanceMetadata, &instance); err != nil {
+		return err
+	}
+
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:     o.Name(),
+		Hostname:     string(hostname),
+		Region:       instance.CanonicalRegionName,
+		Zone:         instance.AvailabilityDomain,
+		InstanceType: instance.Shape,
+		InstanceID:   instance.ID,
+		ProviderID:   "oci://" + instance.ID,
+	}
+
 	select {
 	case ch <- networkConfig:
 	case <-ctx.Done():
@@ -7,8 +7,10 @@ package scaleway
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/talos-systems/go-procfs/procfs"
@@ -19,6 +21,7 @@ import (
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
@@ -137,6 +140,24 @@ func (s *Scaleway) ParseMetadata(metadataConfig *instance.Metadata) (*runtime.Pl
 		networkConfig.Routes = append(networkConfig.Routes, route)
 	}
 
+	zone := metadataConfig.Location.ZoneID
+	region := zone
+
+	// zone is <region>-<number>
+	if idx := strings.LastIndex(zone, "-"); idx > 0 {
+		region = zone[:idx]
+	}
+
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:     s.Name(),
+		Hostname:     metadataConfig.Hostname,
+		Region:       region,
+		Zone:         zone,
+		InstanceType: metadataConfig.CommercialType,
+		InstanceID:   metadataConfig.ID,
+		ProviderID:   fmt.Sprintf("scaleway://instance/%s/%s", zone, metadataConfig.ID),
+	}
+
 	return networkConfig, nil
 }
 
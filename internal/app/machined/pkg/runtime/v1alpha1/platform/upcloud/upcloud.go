@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
@@ -190,6 +191,14 @@ func (u *UpCloud) ParseMetadata(meta *MetaData) (*runtime.PlatformNetworkConfig,
 		})
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:   u.Name(),
+		Hostname:   meta.Hostname,
+		Region:     meta.Region,
+		InstanceID: meta.InstanceID,
+		ProviderID: "upcloud://" + meta.InstanceID,
+	}
+
 	return networkConfig, nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"log"
 
 	"github.com/talos-systems/go-procfs/procfs"
+	"github.com/talos-systems/go-smbios/smbios"
 	"github.com/vmware/govmomi/ovf"
 	"github.com/vmware/vmw-guestinfo/rpcvmx"
 	"github.com/vmware/vmw-guestinfo/vmcheck"
@@ -23,6 +24,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	platformerrors "github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // VMware is the concrete type that implements the platform.Platform interface.
@@ -199,6 +201,27 @@ func (v *VMware) KernelArgs() procfs.Parameters {
 }
 
 // NetworkConfiguration implements the runtime.Platform interface.
+//
+// VMware platform doesn't provide any network configuration, only the platform metadata is reported.
+// The instance ID is the SMBIOS system UUID (VM BIOS UUID), the hostname is not known to the platform.
 func (v *VMware) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.PlatformNetworkConfig) error {
+	networkConfig := &runtime.PlatformNetworkConfig{
+		Metadata: &runtimeres.PlatformMetadataSpec{
+			Platform: v.Name(),
+		},
+	}
+
+	if s, err := smbios.New(); err == nil {
+		networkConfig.Metadata.InstanceID = s.SystemInformation.UUID
+	} else {
+		log.Printf("failed to read system UUID: %s", err)
+	}
+
+	select {
+	case ch <- networkConfig:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
@@ -117,6 +118,13 @@ func (v *Vultr) ParseMetadata(meta *metadata.MetaData, extIP []byte) (*runtime.P
 		}
 	}
 
+	networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+		Platform:   v.Name(),
+		Hostname:   meta.Hostname,
+		InstanceID: meta.InstanceV2ID,
+		ProviderID: "vultr://" + meta.InstanceV2ID,
+	}
+
 	return networkConfig, nil
 }
 
@@ -132,6 +132,8 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 	// * .machine.kernel
 	// * .machine.registries (note that auth is not applied immediately, containerd limitation)
 	// * .machine.pods
+	// * .machine.volumes
+	// * auxiliary documents which are applied at runtime (see config.RuntimeDocument)
 	newConfig.ConfigDebug = currentConfig.ConfigDebug
 	newConfig.ClusterConfig = currentConfig.ClusterConfig
 
@@ -148,6 +150,7 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 		newConfig.MachineConfig.MachineKernel = currentConfig.MachineConfig.MachineKernel
 		newConfig.MachineConfig.MachineRegistries = currentConfig.MachineConfig.MachineRegistries
 		newConfig.MachineConfig.MachinePods = currentConfig.MachineConfig.MachinePods
+		newConfig.MachineConfig.MachineVolumes = currentConfig.MachineConfig.MachineVolumes
 	}
 
 	if !reflect.DeepEqual(currentConfig, newConfig) {
@@ -156,6 +159,47 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 		return fmt.Errorf("this config change can't be applied in immediate mode\ndiff: %s", diff)
 	}
 
+	return canApplyDocumentsImmediate(r.Config().Documents(), cfg.Documents())
+}
+
+// canApplyDocumentsImmediate checks that auxiliary documents which are not applied at runtime are not changed.
+func canApplyDocumentsImmediate(currentDocs, newDocs []config.Document) error {
+	current := make(map[string]config.Document, len(currentDocs))
+
+	for _, doc := range currentDocs {
+		current[config.DocumentID(doc)] = doc
+	}
+
+	updated := make(map[string]config.Document, len(newDocs))
+
+	for _, doc := range newDocs {
+		updated[config.DocumentID(doc)] = doc
+	}
+
+	check := func(id string, doc config.Document, other map[string]config.Document) error {
+		if runtimeDoc, ok := doc.(config.RuntimeDocument); ok && runtimeDoc.AppliedAtRuntime() {
+			return nil
+		}
+
+		if otherDoc, ok := other[id]; ok && reflect.DeepEqual(doc, otherDoc) {
+			return nil
+		}
+
+		return fmt.Errorf("this config change can't be applied in immediate mode: document %q changed", id)
+	}
+
+	for id, doc := range current {
+		if err := check(id, doc, updated); err != nil {
+			return err
+		}
+	}
+
+	for id, doc := range updated {
+		if err := check(id, doc, current); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
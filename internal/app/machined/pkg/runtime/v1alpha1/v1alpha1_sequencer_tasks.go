@@ -45,6 +45,7 @@ import (
 	installer "github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/internal/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/disk"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
@@ -55,6 +56,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
 	"github.com/talos-systems/talos/internal/app/maintenance"
 	"github.com/talos-systems/talos/internal/pkg/cri"
+	"github.com/talos-systems/talos/internal/pkg/encryption/keys"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/internal/pkg/partition"
@@ -1154,6 +1156,10 @@ func UnmountOverlayFilesystems(seq runtime.Sequence, data interface{}) (runtime.
 // UnmountUserDisks represents the UnmountUserDisks task.
 func UnmountUserDisks(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		if err = mount.UserVolumeUnmountAll(); err != nil {
+			return err
+		}
+
 		return unmountDisks(r)
 	}, "unmountUserDisks"
 }
@@ -1198,7 +1204,12 @@ func UnmountPodMounts(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 // UnmountSystemDiskBindMounts represents the UnmountSystemDiskBindMounts task.
 func UnmountSystemDiskBindMounts(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		devname := r.State().Machine().Disk().BlockDevice.Device().Name()
+		devnames := []string{r.State().Machine().Disk().BlockDevice.Device().Name()}
+
+		// EPHEMERAL might be placed on a separate disk
+		if ephemeral := r.State().Machine().Disk(disk.WithPartitionLabel(constants.EphemeralPartitionLabel)); ephemeral != nil {
+			devnames = append(devnames, ephemeral.BlockDevice.Device().Name())
+		}
 
 		f, err := os.Open("/proc/mounts")
 		if err != nil {
@@ -1218,7 +1229,11 @@ func UnmountSystemDiskBindMounts(seq runtime.Sequence, data interface{}) (runtim
 			device := strings.ReplaceAll(fields[0], "/dev/mapper", "/dev")
 			mountpoint := fields[1]
 
-			if strings.HasPrefix(device, devname) && device != devname {
+			for _, devname := range devnames {
+				if !strings.HasPrefix(device, devname) || device == devname {
+					continue
+				}
+
 				logger.Printf("unmounting %s\n", mountpoint)
 
 				if err = unix.Unmount(mountpoint, 0); err != nil {
@@ -1228,6 +1243,8 @@ func UnmountSystemDiskBindMounts(seq runtime.Sequence, data interface{}) (runtim
 						return fmt.Errorf("error unmounting %s: %w", mountpoint, err)
 					}
 				}
+
+				break
 			}
 		}
 
@@ -1392,9 +1409,18 @@ func stopAndRemoveAllPods(stopAction cri.StopAction) runtime.TaskExecutionFunc {
 // ResetSystemDisk represents the task to reset the system disk.
 func ResetSystemDisk(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		devname := r.State().Machine().Disk().Device().Name()
+
+		// EPHEMERAL on a separate disk is removed, keeping other partitions on that disk
+		if ephemeral := r.State().Machine().Disk(disk.WithPartitionLabel(constants.EphemeralPartitionLabel)); ephemeral != nil && ephemeral.Device().Name() != devname {
+			if err = wipeAndDeletePartition(logger, ephemeral.Device().Name(), constants.EphemeralPartitionLabel); err != nil {
+				return err
+			}
+		}
+
 		var dev *blockdevice.BlockDevice
 
-		dev, err = blockdevice.Open(r.State().Machine().Disk().Device().Name())
+		dev, err = blockdevice.Open(devname)
 		if err != nil {
 			return err
 		}
@@ -1414,6 +1440,17 @@ func ResetSystemDiskSpec(seq runtime.Sequence, data interface{}) (runtime.TaskEx
 		}
 
 		for _, target := range in.GetSystemDiskTargets() {
+			if target.GetLabel() == constants.EphemeralPartitionLabel {
+				if devname := misplacedEphemeralDisk(r); devname != "" {
+					// EPHEMERAL is created on the disk matching the disk selector on the next boot
+					if err = wipeAndDeletePartition(logger, devname, target.GetLabel()); err != nil {
+						return fmt.Errorf("failed deleting partition %s: %w", target, err)
+					}
+
+					continue
+				}
+			}
+
 			if err = target.Format(); err != nil {
 				return fmt.Errorf("failed wiping partition %s: %w", target, err)
 			}
@@ -1425,6 +1462,63 @@ func ResetSystemDiskSpec(seq runtime.Sequence, data interface{}) (runtime.TaskEx
 	}, "resetSystemDiskSpec"
 }
 
+// misplacedEphemeralDisk returns the disk holding the EPHEMERAL partition if it doesn't match the EPHEMERAL disk selector.
+func misplacedEphemeralDisk(r runtime.Runtime) string {
+	if r.Config() == nil {
+		return ""
+	}
+
+	devname, err := r.Config().Machine().Install().Ephemeral().Disk()
+	if err != nil || devname == "" {
+		// keep EPHEMERAL in place if the selected disk is not available
+		return ""
+	}
+
+	ephemeral := r.State().Machine().Disk(disk.WithPartitionLabel(constants.EphemeralPartitionLabel))
+	if ephemeral == nil || ephemeral.Device().Name() == devname {
+		return ""
+	}
+
+	return ephemeral.Device().Name()
+}
+
+// wipeAndDeletePartition wipes the partition and removes it from the partition table, keeping other partitions on the disk.
+func wipeAndDeletePartition(logger *log.Logger, devname, label string) error {
+	bd, err := blockdevice.Open(devname, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		return err
+	}
+
+	part := pt.Partitions().FindByName(label)
+	if part == nil {
+		return nil
+	}
+
+	partPath, err := part.Path()
+	if err != nil {
+		return err
+	}
+
+	if err = partition.Format(partPath, &partition.FormatOptions{FileSystemType: partition.FilesystemTypeNone}); err != nil {
+		return err
+	}
+
+	logger.Printf("deleting partition %s on %s", label, devname)
+
+	if err = pt.Delete(part); err != nil {
+		return err
+	}
+
+	return pt.Write()
+}
+
 // VerifyDiskAvailability represents the task for verifying that the system
 // disk is not in use.
 func VerifyDiskAvailability(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
@@ -1731,7 +1825,7 @@ func MountStatePartition(seq runtime.Sequence, data interface{}) (runtime.TaskEx
 			opts = append(opts, mount.WithEncryptionConfig(encryption))
 		}
 
-		return mount.SystemPartitionMount(r, logger, constants.StatePartitionLabel, opts...)
+		return systemPartitionMount(ctx, r, logger, constants.StatePartitionLabel, opts...)
 	}, "mountStatePartition"
 }
 
@@ -1745,13 +1839,99 @@ func UnmountStatePartition(seq runtime.Sequence, data interface{}) (runtime.Task
 // MountEphemeralPartition mounts the ephemeral partition.
 func MountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		return mount.SystemPartitionMount(r, logger, constants.EphemeralPartitionLabel, mount.WithFlags(mount.Resize))
+		opts := []mount.Option{mount.WithFlags(mount.Resize)}
+
+		if r.Config() != nil {
+			ephemeral := r.Config().Machine().Install().Ephemeral()
+
+			if err := createEphemeralPartition(logger, r, ephemeral); err != nil {
+				return err
+			}
+
+			// EPHEMERAL limited by the maximum size is not grown to the end of the disk
+			if ephemeral.MaxSize() != 0 {
+				opts = nil
+			}
+		}
+
+		return systemPartitionMount(ctx, r, logger, constants.EphemeralPartitionLabel, opts...)
 	}, "mountEphemeralPartition"
 }
 
+// createEphemeralPartition creates the EPHEMERAL partition on the disk matching the EPHEMERAL disk selector
+// if the partition is missing, e.g. after the reset which moves EPHEMERAL to another disk.
+//
+// The existing EPHEMERAL partition is always used, so the matching disk is required only to create the partition.
+func createEphemeralPartition(logger *log.Logger, r runtime.Runtime, ephemeral config.InstallEphemeral) error {
+	if dev := r.State().Machine().Disk(disk.WithPartitionLabel(constants.EphemeralPartitionLabel)); dev != nil {
+		// as with the reset, the disk lookup errors are ignored, e.g. the selected disk might be detached
+		if devname, err := ephemeral.Disk(); err == nil && devname != "" && devname != dev.Device().Name() {
+			logger.Printf("WARNING: %s partition was found on %s, while the disk selector matches %s, reset the %s partition to move it",
+				constants.EphemeralPartitionLabel, dev.Device().Name(), devname, constants.EphemeralPartitionLabel)
+		}
+
+		return nil
+	}
+
+	devname, err := ephemeral.Disk()
+	if err != nil {
+		return fmt.Errorf("error looking up the EPHEMERAL disk: %w", err)
+	}
+
+	if devname == "" {
+		return nil
+	}
+
+	logger.Printf("creating %s partition on %s", constants.EphemeralPartitionLabel, devname)
+
+	target := installer.EphemeralTarget(devname, installer.NoFilesystem)
+	target.MinSize = ephemeral.MinSize()
+	target.MaxSize = ephemeral.MaxSize()
+
+	m := &installer.Manifest{
+		Devices: map[string]installer.Device{
+			devname: {
+				Device:                  devname,
+				PreserveOtherPartitions: true,
+			},
+		},
+		Targets: map[string][]*installer.Target{
+			devname: {target},
+		},
+	}
+
+	return m.Execute()
+}
+
+// systemPartitionMount mounts the system partition retrying while the KMS holding the encryption key is unavailable.
+func systemPartitionMount(ctx context.Context, r runtime.Runtime, logger *log.Logger, label string, opts ...mount.Option) error {
+	return retry.Constant(constants.KMSRetryTimeout, retry.WithUnits(5*time.Second), retry.WithErrorLogging(true)).RetryWithContext(ctx,
+		func(ctx context.Context) error {
+			err := mount.SystemPartitionMount(r, logger, label, opts...)
+			if errors.Is(err, keys.ErrKMSUnavailable) {
+				return retry.ExpectedError(err)
+			}
+
+			return err
+		})
+}
+
 // UnmountEphemeralPartition unmounts the ephemeral partition.
 func UnmountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		if r.Config() != nil && r.Config().Machine().Logging().Spool().Enabled() {
+			// log senders keep the spool open on the EPHEMERAL partition,
+			// they are re-created without the spool once the partition is unmounted
+			closeCtx, closeCancel := context.WithTimeout(ctx, 3*time.Second)
+			defer closeCancel()
+
+			for _, sender := range r.Logging().SetSenders(nil) {
+				if err = sender.Close(closeCtx); err != nil {
+					logger.Printf("failed to close log sender: %s", err)
+				}
+			}
+		}
+
 		return mount.SystemPartitionUnmount(r, logger, constants.EphemeralPartitionLabel)
 	}, "unmountEphemeralPartition"
 }
@@ -168,9 +168,12 @@ func (s *MachineState) probeMeta() {
 }
 
 // Disk implements the machine state interface.
+//
+// By default, the system disk is returned, which is the disk holding the STATE partition,
+// as the EPHEMERAL partition might be placed on a separate disk.
 func (s *MachineState) Disk(options ...disk.Option) *probe.ProbedBlockDevice {
 	opts := &disk.Options{
-		Label: constants.EphemeralPartitionLabel,
+		Label: constants.StatePartitionLabel,
 	}
 
 	for _, opt := range options {
@@ -202,8 +205,9 @@ func (s *MachineState) Close() error {
 
 // Installed implements the machine state interface.
 func (s *MachineState) Installed() bool {
+	// the EPHEMERAL partition placed on a separate disk might be missing until it is created on boot
 	return s.Disk(
-		disk.WithPartitionLabel(constants.EphemeralPartitionLabel),
+		disk.WithPartitionLabel(constants.StatePartitionLabel),
 	) != nil
 }
 
@@ -5,9 +5,9 @@
 package v1alpha2
 
 import (
+	"bytes"
 	"context"
-	"fmt"
-	"net/url"
+	"reflect"
 	"sync"
 	"time"
 
@@ -15,12 +15,15 @@ import (
 	osruntime "github.com/cosi-project/runtime/pkg/controller/runtime"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/talos-systems/go-procfs/procfs"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/block"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/config"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/etcd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/files"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/hardware"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
@@ -38,8 +41,8 @@ import (
 	"github.com/talos-systems/talos/pkg/logging"
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
-	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
 	configresource "github.com/talos-systems/talos/pkg/machinery/resources/config"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Controller implements runtime.V1alpha2Controller.
@@ -72,8 +75,15 @@ func NewController(v1alpha1Runtime runtime.Runtime) (*Controller, error) {
 	).With(logging.Component("controller-runtime"))
 
 	ctrl.controllerRuntime, err = osruntime.NewRuntime(v1alpha1Runtime.State().V1Alpha2().Resources(), ctrl.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = prometheus.Register(newPerfCollector(v1alpha1Runtime.State().V1Alpha2().Resources())); err != nil {
+		return nil, err
+	}
 
-	return ctrl, err
+	return ctrl, nil
 }
 
 // Run the controller runtime.
@@ -89,6 +99,18 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&timecontrollers.SyncController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&block.DevicesController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			SysfsPath:    "/sys",
+		},
+		&block.DiscoveryController{
+			SysfsPath:    "/sys",
+			UdevDataPath: "/run/udev/data",
+		},
+		&block.DisksController{
+			SysfsPath:    "/sys",
+			UdevDataPath: "/run/udev/data",
+		},
 		&cluster.AffiliateMergeController{},
 		&cluster.ConfigController{},
 		&cluster.DiscoveryServiceController{},
@@ -103,6 +125,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&config.MachineTypeController{},
 		&config.K8sAddressFilterController{},
 		&config.K8sControlPlaneController{},
+		&etcd.BackupController{},
 		&files.CRIConfigPartsController{},
 		&files.CRIRegistryConfigController{},
 		&files.EtcFileController{
@@ -124,9 +147,12 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.KubeletStaticPodController{},
 		&k8s.ManifestController{},
 		&k8s.ManifestApplyController{},
+		&k8s.NodeApplyController{},
 		&k8s.NodeIPController{},
 		&k8s.NodeIPConfigController{},
+		&k8s.NodeLabelSpecController{},
 		&k8s.NodenameController{},
+		&k8s.NodeTaintSpecController{},
 		&k8s.RenderConfigsStaticPodController{},
 		&k8s.RenderSecretsStaticPodController{},
 		&k8s.StaticPodConfigController{},
@@ -146,8 +172,12 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.AddressSpecController{},
 		&network.AddressStatusController{},
 		&network.DeviceConfigController{},
-		&network.EtcFileController{},
+		&network.EtcFileController{
+			PodResolvConfPath: constants.PodResolvConfPath,
+		},
 		&network.HardwareAddrController{},
+		&network.HostDNSConfigController{},
+		&network.HostDNSController{},
 		&network.HostnameConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -161,6 +191,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.LinkMergeController{},
 		&network.LinkStatusController{},
 		&network.LinkSpecController{},
+		&network.NfTablesChainConfigController{},
+		&network.NfTablesChainController{},
 		&network.NodeAddressController{},
 		&network.OperatorConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -187,6 +219,10 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.RouteMergeController{},
 		&network.RouteStatusController{},
 		&network.RouteSpecController{},
+		&network.RouteRuleConfigController{},
+		&network.RouteRuleMergeController{},
+		&network.RouteRuleStatusController{},
+		&network.RouteRuleSpecController{},
 		&network.StatusController{},
 		&network.TimeServerConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -217,6 +253,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 			Drainer: drainer,
 		},
+		&runtimecontrollers.VolumeController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&secrets.APIController{},
 		&secrets.APICertSANsController{},
 		&secrets.EtcdController{},
@@ -228,7 +267,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 		},
 	} {
-		if err := ctrl.controllerRuntime.RegisterController(c); err != nil {
+		if err := ctrl.controllerRuntime.RegisterController(instrumentedController{c}); err != nil {
 			return err
 		}
 	}
@@ -254,24 +293,50 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		return
 	}
 
-	var loggingEndpoints []*url.URL
+	// log spool is stored on the EPHEMERAL partition
+	if err := ctrl.v1alpha1Runtime.State().V1Alpha2().Resources().Watch(
+		ctx,
+		resource.NewMetadata(runtimeres.NamespaceName, runtimeres.MountStatusType, constants.EphemeralPartitionLabel, resource.VersionUndefined),
+		watchCh,
+	); err != nil {
+		ctrl.logger.Warn("error watching EPHEMERAL mount status", zap.Error(err))
+
+		return
+	}
+
+	var (
+		cfg              talosconfig.Provider
+		ephemeralMounted bool
+		appliedLogging   loggingState
+		appliedMetrics   metricsServer
+	)
 
 	for {
-		var cfg talosconfig.Provider
 		select {
 		case event := <-watchCh:
-			if event.Type == state.Destroyed {
-				continue
+			switch res := event.Resource.(type) {
+			case *configresource.MachineConfig:
+				if event.Type == state.Destroyed {
+					continue
+				}
+
+				cfg = res.Config()
+
+				ctrl.updateConsoleLoggingConfig(cfg)
+				ctrl.updateMetricsConfig(ctx, cfg, &appliedMetrics)
+			case *runtimeres.MountStatus:
+				ephemeralMounted = event.Type != state.Destroyed
 			}
 
-			cfg = event.Resource.(*configresource.MachineConfig).Config()
-
 		case <-ctx.Done():
 			return
 		}
 
-		ctrl.updateConsoleLoggingConfig(cfg)
-		ctrl.updateLoggingConfig(ctx, cfg, &loggingEndpoints)
+		if cfg == nil {
+			continue
+		}
+
+		ctrl.updateLoggingConfig(ctx, cfg, ephemeralMounted, &appliedLogging)
 	}
 }
 
@@ -287,24 +352,26 @@ func (ctrl *Controller) updateConsoleLoggingConfig(cfg talosconfig.Provider) {
 	}
 }
 
-func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig.Provider, prevLoggingEndpoints *[]*url.URL) {
-	dests := cfg.Machine().Logging().Destinations()
-	loggingEndpoints := make([]*url.URL, len(dests))
+// loggingState is the logging configuration applied to the logging manager.
+type loggingState struct {
+	destinations []talosconfig.LoggingDestination
+	// spoolMaxSize is zero if the spool is disabled
+	spoolMaxSize uint64
+}
 
-	for i, dest := range dests {
-		switch f := dest.Format(); f {
-		case constants.LoggingFormatJSONLines:
-			loggingEndpoints[i] = dest.Endpoint()
-		default:
-			// should not be possible due to validation
-			panic(fmt.Sprintf("unhandled log destination format %q", f))
-		}
+func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig.Provider, ephemeralMounted bool, prev *loggingState) {
+	next := loggingState{
+		destinations: cfg.Machine().Logging().Destinations(),
 	}
 
-	loggingChanged := len(*prevLoggingEndpoints) != len(loggingEndpoints)
+	if spool := cfg.Machine().Logging().Spool(); spool.Enabled() && ephemeralMounted {
+		next.spoolMaxSize = spool.MaxSize()
+	}
+
+	loggingChanged := len(prev.destinations) != len(next.destinations) || prev.spoolMaxSize != next.spoolMaxSize
 	if !loggingChanged {
-		for i, u := range *prevLoggingEndpoints {
-			if u.String() != loggingEndpoints[i].String() {
+		for i, dest := range prev.destinations {
+			if !loggingDestinationEqual(dest, next.destinations[i]) {
 				loggingChanged = true
 
 				break
@@ -316,26 +383,61 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig
 		return
 	}
 
-	*prevLoggingEndpoints = loggingEndpoints
+	*prev = next
+
+	// close previous senders before creating new ones, as the senders for the same destination share the spool
+	ctrl.closeLogSenders(ctx, ctrl.loggingManager.SetSenders(nil))
+
+	var spoolOpts *runtimelogging.SpoolOptions
+
+	if next.spoolMaxSize > 0 {
+		spoolOpts = &runtimelogging.SpoolOptions{
+			Path:    constants.LogSpoolPath,
+			MaxSize: next.spoolMaxSize,
+		}
+	}
+
+	senders := make([]runtime.LogSender, 0, len(next.destinations))
+
+	for _, dest := range next.destinations {
+		sender, err := runtimelogging.NewSender(dest, spoolOpts)
+		if err != nil && spoolOpts != nil {
+			ctrl.logger.Warn("failed to create spooled log sender, sending without spool", zap.Stringer("endpoint", dest.Endpoint()), zap.Error(err))
 
-	var prevSenders []runtime.LogSender
+			sender, err = runtimelogging.NewSender(dest, nil)
+		}
+
+		if err != nil {
+			// should not be possible due to validation
+			ctrl.logger.Error("failed to create log sender", zap.Stringer("endpoint", dest.Endpoint()), zap.Error(err))
 
-	if len(loggingEndpoints) > 0 {
-		senders := slices.Map(loggingEndpoints, runtimelogging.NewJSONLines)
+			continue
+		}
+
+		senders = append(senders, sender)
+	}
 
-		ctrl.logger.Info("enabling JSON logging")
-		prevSenders = ctrl.loggingManager.SetSenders(senders)
+	if len(senders) > 0 {
+		ctrl.logger.Info("enabling network logging", zap.Bool("spool", spoolOpts != nil))
+		ctrl.loggingManager.SetSenders(senders)
 	} else {
-		ctrl.logger.Info("disabling JSON logging")
-		prevSenders = ctrl.loggingManager.SetSenders(nil)
+		ctrl.logger.Info("disabling network logging")
 	}
 
+	if spoolOpts != nil {
+		if err := runtimelogging.RemoveStaleSpools(spoolOpts, next.destinations); err != nil {
+			ctrl.logger.Warn("failed to remove stale log spools", zap.Error(err))
+		}
+	}
+}
+
+func (ctrl *Controller) closeLogSenders(ctx context.Context, senders []runtime.LogSender) {
 	closeCtx, closeCancel := context.WithTimeout(ctx, 3*time.Second)
 	defer closeCancel()
 
 	var wg sync.WaitGroup
 
-	for _, sender := range prevSenders {
+	for _, sender := range senders {
 		sender := sender
 
 		wg.Add(1)
@@ -350,3 +452,11 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig
 
 	wg.Wait()
 }
+
+func loggingDestinationEqual(a, b talosconfig.LoggingDestination) bool {
+	return a.Endpoint().String() == b.Endpoint().String() &&
+		a.Format() == b.Format() &&
+		bytes.Equal(a.TLSCA(), b.TLSCA()) &&
+		reflect.DeepEqual(a.Filter(), b.Filter()) &&
+		reflect.DeepEqual(a.ExtraFields(), b.ExtraFields())
+}
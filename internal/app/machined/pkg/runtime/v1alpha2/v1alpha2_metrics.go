@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/perf"
+)
+
+var (
+	controllerReconciles = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "controller",
+			Name:      "reconciles_total",
+			Help:      "Number of the reconcile events delivered to the controller.",
+		},
+		[]string{"controller"},
+	)
+
+	controllerCrashes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "controller",
+			Name:      "crashes_total",
+			Help:      "Number of the controller failures, the controller is restarted after each failure.",
+		},
+		[]string{"controller"},
+	)
+)
+
+// instrumentedController wraps the controller to count the reconcile events and the failures.
+type instrumentedController struct {
+	controller.Controller
+}
+
+// Run implements controller.Controller interface.
+func (ctrl instrumentedController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	name := ctrl.Name()
+	eventCh := make(chan controller.ReconcileEvent, 1)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-r.EventCh():
+				controllerReconciles.WithLabelValues(name).Inc()
+
+				select {
+				case eventCh <- event:
+				default:
+					// reconcile is already queued
+				}
+			}
+		}
+	}()
+
+	err := ctrl.Controller.Run(ctx, instrumentedRuntime{Runtime: r, eventCh: eventCh}, logger)
+	if err != nil {
+		controllerCrashes.WithLabelValues(name).Inc()
+	}
+
+	return err
+}
+
+type instrumentedRuntime struct {
+	controller.Runtime
+
+	eventCh chan controller.ReconcileEvent
+}
+
+// EventCh implements controller.Runtime interface.
+func (r instrumentedRuntime) EventCh() <-chan controller.ReconcileEvent {
+	return r.eventCh
+}
+
+// perfCollector exposes the perf.CPU and perf.Memory resources as metrics.
+type perfCollector struct {
+	state state.State
+
+	cpuSeconds       *prometheus.Desc
+	contextSwitches  *prometheus.Desc
+	processesCreated *prometheus.Desc
+	processesRunning *prometheus.Desc
+	processesBlocked *prometheus.Desc
+	memoryTotal      *prometheus.Desc
+	memoryUsed       *prometheus.Desc
+	memoryAvailable  *prometheus.Desc
+	memoryBuffers    *prometheus.Desc
+	memoryCached     *prometheus.Desc
+	memorySwapTotal  *prometheus.Desc
+	memorySwapFree   *prometheus.Desc
+}
+
+func newPerfCollector(st state.State) *perfCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "", name), help, labels, nil)
+	}
+
+	return &perfCollector{
+		state: st,
+
+		cpuSeconds:       desc("cpu_seconds_total", "Time spent by all CPUs in each mode.", "mode"),
+		contextSwitches:  desc("context_switches_total", "Number of the context switches."),
+		processesCreated: desc("processes_created_total", "Number of the processes created."),
+		processesRunning: desc("processes_running", "Number of the processes in the runnable state."),
+		processesBlocked: desc("processes_blocked", "Number of the processes blocked waiting for I/O."),
+		memoryTotal:      desc("memory_total_bytes", "Total usable memory."),
+		memoryUsed:       desc("memory_used_bytes", "Memory in use, total memory minus free memory."),
+		memoryAvailable:  desc("memory_available_bytes", "Memory available for starting new applications."),
+		memoryBuffers:    desc("memory_buffers_bytes", "Memory used by the kernel buffers."),
+		memoryCached:     desc("memory_cached_bytes", "Memory used by the page cache."),
+		memorySwapTotal:  desc("memory_swap_total_bytes", "Total swap space."),
+		memorySwapFree:   desc("memory_swap_free_bytes", "Unused swap space."),
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (c *perfCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (c *perfCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if r, err := c.state.Get(ctx, perf.NewCPU().Metadata()); err == nil {
+		if cpu, ok := r.(*perf.CPU); ok {
+			spec := cpu.TypedSpec()
+
+			for mode, value := range map[string]float64{
+				"user":       spec.CPUTotal.User,
+				"nice":       spec.CPUTotal.Nice,
+				"system":     spec.CPUTotal.System,
+				"idle":       spec.CPUTotal.Idle,
+				"iowait":     spec.CPUTotal.Iowait,
+				"irq":        spec.CPUTotal.Irq,
+				"softirq":    spec.CPUTotal.SoftIrq,
+				"steal":      spec.CPUTotal.Steal,
+				"guest":      spec.CPUTotal.Guest,
+				"guest_nice": spec.CPUTotal.GuestNice,
+			} {
+				ch <- prometheus.MustNewConstMetric(c.cpuSeconds, prometheus.CounterValue, value, mode)
+			}
+
+			ch <- prometheus.MustNewConstMetric(c.contextSwitches, prometheus.CounterValue, float64(spec.ContextSwitches))
+			ch <- prometheus.MustNewConstMetric(c.processesCreated, prometheus.CounterValue, float64(spec.ProcessCreated))
+			ch <- prometheus.MustNewConstMetric(c.processesRunning, prometheus.GaugeValue, float64(spec.ProcessRunning))
+			ch <- prometheus.MustNewConstMetric(c.processesBlocked, prometheus.GaugeValue, float64(spec.ProcessBlocked))
+		}
+	}
+
+	if r, err := c.state.Get(ctx, perf.NewMemory().Metadata()); err == nil {
+		if mem, ok := r.(*perf.Memory); ok {
+			spec := mem.TypedSpec()
+
+			// memory stats are in kilobytes
+			for desc, value := range map[*prometheus.Desc]uint64{
+				c.memoryTotal:     spec.MemTotal,
+				c.memoryUsed:      spec.MemUsed,
+				c.memoryAvailable: spec.MemAvailable,
+				c.memoryBuffers:   spec.Buffers,
+				c.memoryCached:    spec.Cached,
+				c.memorySwapTotal: spec.SwapTotal,
+				c.memorySwapFree:  spec.SwapFree,
+			} {
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(value*1024))
+			}
+		}
+	}
+}
+
+// metricsServer is the machined metrics endpoint started for the machine configuration.
+type metricsServer struct {
+	address string
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func (ctrl *Controller) updateMetricsConfig(ctx context.Context, cfg talosconfig.Provider, srv *metricsServer) {
+	var address string
+
+	if metricsConfig := cfg.Machine().Metrics(); metricsConfig.Enabled() {
+		address = metrics.Address(metricsConfig.BindAddress(), constants.MachinedMetricsPort)
+	}
+
+	if address == srv.address {
+		return
+	}
+
+	if srv.cancel != nil {
+		srv.cancel()
+		<-srv.done
+	}
+
+	*srv = metricsServer{
+		address: address,
+	}
+
+	if address == "" {
+		return
+	}
+
+	ctrl.logger.Info("starting metrics server", zap.String("address", address))
+
+	serverCtx, serverCancel := context.WithCancel(ctx)
+
+	srv.cancel = serverCancel
+	srv.done = make(chan struct{})
+
+	go func(done chan<- struct{}) {
+		defer close(done)
+
+		if err := metrics.ListenAndServe(serverCtx, address, ctrl.logger.Sugar().Warnf); err != nil {
+			ctrl.logger.Error("metrics server failed", zap.Error(err))
+		}
+	}(srv.done)
+}
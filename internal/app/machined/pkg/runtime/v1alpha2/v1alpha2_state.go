@@ -14,8 +14,10 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/registry"
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/block"
 	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
 	"github.com/talos-systems/talos/pkg/machinery/resources/files"
 	"github.com/talos-systems/talos/pkg/machinery/resources/hardware"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
@@ -60,9 +62,11 @@ func NewState() (*State, error) {
 		description string
 	}{
 		{v1alpha1.NamespaceName, "Talos v1alpha1 subsystems glue resources."},
+		{block.NamespaceName, "Block devices and volumes resources."},
 		{cluster.NamespaceName, "Cluster configuration and discovery resources."},
 		{cluster.RawNamespaceName, "Cluster unmerged raw resources."},
 		{config.NamespaceName, "Talos node configuration."},
+		{etcd.NamespaceName, "etcd resources."},
 		{files.NamespaceName, "Files and file-like resources."},
 		{hardware.NamespaceName, "Hardware resources."},
 		{k8s.NamespaceName, "Kubernetes all node types resources."},
@@ -81,12 +85,17 @@ func NewState() (*State, error) {
 	// register Talos resources
 	for _, r := range []resource.Resource{
 		&v1alpha1.Service{},
+		&block.BlockDevice{},
+		&block.DiscoveredVolume{},
+		&block.Disk{},
+		&block.Partition{},
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
 		&cluster.Member{},
 		&config.MachineConfig{},
 		&config.MachineType{},
+		&etcd.Backup{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
 		&hardware.Processor{},
@@ -105,7 +114,9 @@ func NewState() (*State, error) {
 		&k8s.BootstrapManifestsConfig{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
+		&k8s.NodeLabelSpec{},
 		&k8s.Nodename{},
+		&k8s.NodeTaintSpec{},
 		&k8s.SchedulerConfig{},
 		&k8s.StaticPod{},
 		&k8s.StaticPodStatus{},
@@ -119,11 +130,14 @@ func NewState() (*State, error) {
 		&network.AddressSpec{},
 		&network.DeviceConfigSpec{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
+		&network.HostDNSStatus{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -131,17 +145,22 @@ func NewState() (*State, error) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RouteRuleStatus{},
+		&network.RouteRuleSpec{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
 		&perf.CPU{},
 		&perf.Memory{},
+		&runtime.EncryptionStatus{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamDefaultSpec{},
 		&runtime.KernelParamStatus{},
 		&runtime.MountStatus{},
+		&runtime.PlatformMetadata{},
+		&runtime.VolumeStatus{},
 		&secrets.API{},
 		&secrets.CertSAN{},
 		&secrets.Etcd{},
@@ -0,0 +1,22 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+)
+
+var serviceStateTransitions = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "service",
+		Name:      "state_transitions_total",
+		Help:      "Number of the service state transitions by the new state.",
+	},
+	[]string{"service", "state"},
+)
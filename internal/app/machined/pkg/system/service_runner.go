@@ -84,6 +84,8 @@ func (svcrunner *ServiceRunner) UpdateState(newstate events.ServiceState, messag
 
 	log.Printf("service[%s](%s): %s", svcrunner.id, svcrunner.state, event.Message)
 
+	serviceStateTransitions.WithLabelValues(svcrunner.id, newstate.String()).Inc()
+
 	isUp := svcrunner.inStateLocked(StateEventUp)
 	isDown := svcrunner.inStateLocked(StateEventDown)
 	isFinished := svcrunner.inStateLocked(StateEventFinished)
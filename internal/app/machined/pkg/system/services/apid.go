@@ -11,6 +11,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/containerd/containerd/oci"
@@ -28,8 +29,11 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/metrics"
 	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/generic/maps"
 	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
 )
 
@@ -136,6 +140,29 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args.ProcessArgs = append(args.ProcessArgs, "--enable-rbac")
 	}
 
+	if metricsConfig := r.Config().Machine().Metrics(); metricsConfig.Enabled() {
+		args.ProcessArgs = append(args.ProcessArgs, "--metrics-address="+metrics.Address(metricsConfig.BindAddress(), constants.ApidMetricsPort))
+	}
+
+	// client certificates can be issued only on the control plane nodes
+	if oidcConfig := r.Config().Machine().Features().OIDC(); oidcConfig.Enabled() && r.Config().Machine().Type() != machine.TypeWorker {
+		args.ProcessArgs = append(args.ProcessArgs,
+			"--oidc-issuer-url="+oidcConfig.IssuerURL(),
+			"--oidc-client-id="+oidcConfig.ClientID(),
+			"--oidc-groups-claim="+oidcConfig.GroupsClaim(),
+			"--oidc-cert-lifetime="+oidcConfig.CertLifetime().String(),
+		)
+
+		groupRoles := oidcConfig.GroupRoles()
+
+		groups := maps.Keys(groupRoles)
+		sort.Strings(groups)
+
+		for _, group := range groups {
+			args.ProcessArgs = append(args.ProcessArgs, "--oidc-group-roles="+group+"="+strings.Join(groupRoles[group], ","))
+		}
+	}
+
 	// Set the mounts.
 	mounts := []specs.Mount{
 		{Type: "bind", Destination: "/etc/ssl", Source: "/etc/ssl", Options: []string{"bind", "ro"}},
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	containerdapi "github.com/containerd/containerd"
@@ -52,6 +53,11 @@ func (k *Kubelet) PreFunc(ctx context.Context, r runtime.Runtime) error {
 
 	spec := specResource.(*k8s.KubeletSpec).TypedSpec()
 
+	// pod resolv.conf is written by the network controllers, make sure the directory exists for the bind mount
+	if err = os.MkdirAll(filepath.Dir(constants.PodResolvConfPath), 0o755); err != nil {
+		return err
+	}
+
 	client, err := containerdapi.New(constants.CRIContainerdAddress)
 	if err != nil {
 		return err
@@ -117,6 +123,7 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: "/etc/kubernetes", Source: "/etc/kubernetes", Options: []string{"bind", "rshared", "rw"}},
 		{Type: "bind", Destination: "/etc/machine-id", Source: "/etc/machine-id", Options: []string{"bind", "ro"}},
 		{Type: "bind", Destination: "/etc/os-release", Source: "/etc/os-release", Options: []string{"bind", "ro"}},
+		{Type: "bind", Destination: filepath.Dir(constants.PodResolvConfPath), Source: filepath.Dir(constants.PodResolvConfPath), Options: []string{"bind", "ro"}},
 		{Type: "bind", Destination: "/etc/cni", Source: "/etc/cni", Options: []string{"rbind", "rshared", "rw"}},
 		{Type: "bind", Destination: "/usr/libexec/kubernetes", Source: "/usr/libexec/kubernetes", Options: []string{"rbind", "rshared", "rw"}},
 		{Type: "bind", Destination: "/var/run", Source: "/run", Options: []string{"rbind", "rshared", "rw"}},
@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/role"
@@ -31,17 +33,22 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                     role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Cgroups":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Containers":                  role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Copy":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/DiskStats":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/DiskUsage":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Dmesg":                       role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/EtcdAlarmDisarm":             role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdAlarmList":               role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/EtcdDefragment":              role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdForfeitLeadership":       role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdLeaveCluster":            role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdMemberList":              role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/EtcdRecover":                 role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdRemoveMember":            role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdSnapshot":                role.MakeSet(role.Admin, role.EtcdBackup),
+	"/machine.MachineService/EtcdStatus":                  role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Events":                      role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/GenerateClientConfiguration": role.MakeSet(role.Admin),
 	"/machine.MachineService/GenerateConfiguration":       role.MakeSet(role.Admin),
@@ -51,8 +58,11 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/LoadAvg":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Logs":                        role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Memory":                      role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Metrics":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Mounts":                      role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Netstat":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/NetworkDeviceStats":          role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/PacketCapture":               role.MakeSet(role.Admin),
 	"/machine.MachineService/Processes":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Read":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Reboot":                      role.MakeSet(role.Admin),
@@ -66,6 +76,7 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Shutdown":                    role.MakeSet(role.Admin),
 	"/machine.MachineService/Stats":                       role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/SystemStat":                  role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/UpdateEncryptionKeys":        role.MakeSet(role.Admin),
 	"/machine.MachineService/Upgrade":                     role.MakeSet(role.Admin),
 	"/machine.MachineService/Version":                     role.MakeSet(role.Admin, role.Reader),
 
@@ -74,12 +85,28 @@ var rules = map[string]role.Set{
 	"/resource.ResourceService/List":  role.MakeSet(role.Admin, role.Reader),
 	"/resource.ResourceService/Watch": role.MakeSet(role.Admin, role.Reader),
 
-	"/storage.StorageService/Disks": role.MakeSet(role.Admin, role.Reader),
+	"/storage.StorageService/BlockDeviceWipe": role.MakeSet(role.Admin),
+	"/storage.StorageService/Disks":           role.MakeSet(role.Admin, role.Reader),
 
 	"/time.TimeService/Time":      role.MakeSet(role.Admin, role.Reader),
 	"/time.TimeService/TimeCheck": role.MakeSet(role.Admin, role.Reader),
 }
 
+// customRoles returns the custom RBAC roles defined in the machine config.
+func customRoles(r runtime.Runtime) map[role.Role][]string {
+	if r.Config() == nil {
+		return nil
+	}
+
+	roles := map[role.Role][]string{}
+
+	for _, customRole := range r.Config().Machine().Features().RBACRoles() {
+		roles[role.Role(customRole.Name())] = customRole.Allow()
+	}
+
+	return roles
+}
+
 type machinedService struct {
 	c runtime.Controller
 }
@@ -94,9 +121,25 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 	authorizer := &authz.Authorizer{
 		Rules:         rules,
 		FallbackRoles: role.MakeSet(role.Admin),
+		CustomRoles:   func() map[role.Role][]string { return customRoles(r) },
 		Logger:        log.New(logWriter, "machined/authz/authorizer ", log.Flags()).Printf,
 	}
 
+	auditWriter, err := r.Logging().ServiceLog("audit").Writer()
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer auditWriter.Close()
+
+	auditor := &audit.Auditor{
+		Component:      "machined",
+		Writer:         auditWriter,
+		RecordRequests: true,
+		Logger:         log.New(logWriter, "machined/audit ", log.Flags()).Printf,
+	}
+
 	// Start the API server.
 	server := factory.NewServer(
 		&v1alpha1server.Server{
@@ -107,6 +150,9 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 		factory.WithStreamInterceptor(injector.StreamInterceptor()),
 
+		factory.WithUnaryInterceptor(auditor.UnaryInterceptor()),
+		factory.WithStreamInterceptor(auditor.StreamInterceptor()),
+
 		factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
 		factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
 	)
@@ -138,6 +184,30 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		server.Serve(listener)
 	}()
 
+	// clean up the socket if it already exists (important for Talos in a container)
+	if err := os.RemoveAll(constants.MachineAuditSocketPath); err != nil {
+		return err
+	}
+
+	auditListener, err := net.Listen("unix", constants.MachineAuditSocketPath)
+	if err != nil {
+		return err
+	}
+
+	//nolint:errcheck
+	defer auditListener.Close()
+
+	// chown the socket path to make it accessible to the apid
+	if err := os.Chown(constants.MachineAuditSocketPath, constants.ApidUserID, constants.ApidUserID); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := auditor.Serve(auditListener); err != nil {
+			log.Printf("audit socket server failed: %s", err)
+		}
+	}()
+
 	<-ctx.Done()
 
 	return nil
@@ -23,6 +23,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/metrics"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
@@ -69,6 +70,10 @@ func (t *Trustd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		ProcessArgs: []string{"/trustd"},
 	}
 
+	if metricsConfig := r.Config().Machine().Metrics(); metricsConfig.Enabled() {
+		args.ProcessArgs = append(args.ProcessArgs, "--metrics-address="+metrics.Address(metricsConfig.BindAddress(), constants.TrustdMetricsPort))
+	}
+
 	// Set the mounts.
 	mounts := []specs.Mount{
 		{Type: "bind", Destination: "/tmp", Source: "/tmp", Options: []string{"rbind", "rshared", "rw"}},
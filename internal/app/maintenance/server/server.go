@@ -54,11 +54,22 @@ func New(r runtime.Runtime, logger *log.Logger, cfgCh chan []byte) *Server {
 func (s *Server) Register(obj *grpc.Server) {
 	s.server = obj
 
-	storage.RegisterStorageServiceServer(obj, &storaged.Server{})
+	storage.RegisterStorageServiceServer(obj, &storageServer{})
 	machine.RegisterMachineServiceServer(obj, s)
 	resource.RegisterResourceServiceServer(obj, &resources.Server{Resources: s.runtime.State().V1Alpha2().Resources()})
 }
 
+// storageServer implements storage.StorageService, the block devices can't be wiped in the maintenance mode,
+// as the maintenance API is not authenticated.
+type storageServer struct {
+	storaged.Server
+}
+
+// BlockDeviceWipe implements storage.StorageService.
+func (s *storageServer) BlockDeviceWipe(ctx context.Context, in *storage.BlockDeviceWipeRequest) (*storage.BlockDeviceWipeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "block devices can't be wiped in the maintenance mode")
+}
+
 // ApplyConfiguration implements machine.MachineService.
 func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfigurationRequest) (*machine.ApplyConfigurationResponse, error) {
 	//nolint:exhaustive
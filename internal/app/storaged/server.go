@@ -6,8 +6,12 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/talos-systems/talos/pkg/machinery/api/storage"
@@ -52,3 +56,42 @@ func (s *Server) Disks(ctx context.Context, in *emptypb.Empty) (reply *storage.D
 
 	return reply, nil
 }
+
+// BlockDeviceWipe implements storage.StorageService.
+//
+// All the devices are verified before any of them is wiped.
+// The devices are kept open exclusively until the wipe is finished, so they can't be mounted or claimed in between.
+func (s *Server) BlockDeviceWipe(ctx context.Context, in *storage.BlockDeviceWipeRequest) (*storage.BlockDeviceWipeResponse, error) {
+	devices := make([]*os.File, 0, len(in.GetDevices()))
+
+	defer func() {
+		for _, f := range devices {
+			f.Close() //nolint:errcheck
+		}
+	}()
+
+	for _, device := range in.GetDevices() {
+		f, err := openWipeDevice(device.GetDevice())
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "blockdevice %q can't be wiped: %s", device.GetDevice(), err)
+		}
+
+		devices = append(devices, f)
+	}
+
+	for i, device := range in.GetDevices() {
+		if err := wipeDevice(ctx, devices[i], device.GetMethod()); err != nil {
+			if ctx.Err() != nil {
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+
+			return nil, fmt.Errorf("error wiping blockdevice %q: %w", device.GetDevice(), err)
+		}
+	}
+
+	return &storage.BlockDeviceWipeResponse{
+		Messages: []*storage.BlockDeviceWipe{
+			{},
+		},
+	}, nil
+}
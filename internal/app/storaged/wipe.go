@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"github.com/talos-systems/go-blockdevice/blockdevice"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/storage"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
+)
+
+// systemPartitionLabels are the labels of the partitions created by Talos on the system disk.
+var systemPartitionLabels = []string{
+	constants.EFIPartitionLabel,
+	constants.BIOSGrubPartitionLabel,
+	constants.BootPartitionLabel,
+	constants.MetaPartitionLabel,
+	constants.StatePartitionLabel,
+	constants.EphemeralPartitionLabel,
+}
+
+const (
+	// wipeChunkSize is the amount of data wiped between the checks for the request cancellation.
+	wipeChunkSize = 64 * 1024 * 1024
+
+	// zeroesBufferSize is the size of the buffer used to write zeroes if the device doesn't support zeroout.
+	zeroesBufferSize = 1024 * 1024
+
+	// ioctls from linux/fs.h which are not defined in x/sys/unix.
+	blkSecDiscard = 0x127d
+	blkZeroOut    = 0x127f
+)
+
+// openWipeDevice opens the whole disk exclusively and checks that it is not used by Talos.
+//
+// O_EXCL open fails with EBUSY if the disk or any of its partitions is mounted or held by another device (e.g. dm-crypt),
+// and while the returned file is open, the disk can't be mounted or claimed by anyone else.
+//
+//nolint:gocyclo
+func openWipeDevice(name string) (*os.File, error) {
+	if name == "" || strings.ContainsAny(name, "/.") {
+		return nil, fmt.Errorf("invalid device name")
+	}
+
+	if _, err := os.Stat(filepath.Join("/sys/class/block", name)); err != nil {
+		return nil, fmt.Errorf("device not found")
+	}
+
+	if _, err := os.Stat(filepath.Join("/sys/class/block", name, "partition")); err == nil {
+		return nil, fmt.Errorf("device is a partition, only whole disks can be wiped")
+	}
+
+	devpath := filepath.Join("/dev", name)
+
+	f, err := os.OpenFile(devpath, os.O_RDWR|unix.O_EXCL, 0)
+	if err != nil {
+		if errors.Is(err, unix.EBUSY) {
+			return nil, fmt.Errorf("device is in use")
+		}
+
+		return nil, err
+	}
+
+	if err = verifyNotSystemDisk(devpath); err != nil {
+		f.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// verifyNotSystemDisk checks that the disk doesn't contain Talos system partitions.
+func verifyNotSystemDisk(devpath string) error {
+	bd, err := blockdevice.Open(devpath)
+	if err != nil {
+		return err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		if errors.Is(err, blockdevice.ErrMissingPartitionTable) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, part := range pt.Partitions().Items() {
+		if slices.Contains(systemPartitionLabels, func(label string) bool { return label == part.Name }) {
+			return fmt.Errorf("device is a system disk (contains %s partition)", part.Name)
+		}
+	}
+
+	return nil
+}
+
+// wipeDevice wipes the disk opened with openWipeDevice.
+func wipeDevice(ctx context.Context, f *os.File, method storage.BlockDeviceWipeDescriptor_Method) error {
+	devpath := f.Name()
+
+	switch method {
+	case storage.BlockDeviceWipeDescriptor_FAST:
+		log.Printf("fast wiping %q", devpath)
+
+		if err := fastWipe(devpath); err != nil {
+			return err
+		}
+	case storage.BlockDeviceWipeDescriptor_ZEROES:
+		log.Printf("wiping %q", devpath)
+
+		wipeMethod, err := zeroWipe(ctx, f)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("wiped %q with %q", devpath, wipeMethod)
+	default:
+		return fmt.Errorf("unsupported wipe method %s", method)
+	}
+
+	// make the kernel drop the partitions of the wiped disk, the ioctl should be issued via the exclusive file
+	if err := unix.IoctlSetInt(int(f.Fd()), unix.BLKRRPART, 0); err != nil {
+		log.Printf("failed to re-read partition table of %q: %s", devpath, err)
+	}
+
+	return nil
+}
+
+func fastWipe(devpath string) error {
+	bd, err := blockdevice.Open(devpath, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	if err = bd.FastWipe(); err != nil {
+		return err
+	}
+
+	return bd.Close()
+}
+
+type rangeWiper struct {
+	name string
+	wipe func(f *os.File, offset, length uint64) error
+}
+
+// zeroWipe wipes the whole disk chunk by chunk, so that the wipe is aborted when the request is canceled.
+//
+// Secure discard and zeroout are used if supported by the device, otherwise zeroes are written.
+// The method is picked on the first chunk and used for the rest of the disk.
+func zeroWipe(ctx context.Context, f *os.File) (string, error) {
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", err
+	}
+
+	size := uint64(end)
+
+	zeroes := make([]byte, zeroesBufferSize)
+
+	wipers := []rangeWiper{
+		{name: "blksecdiscard", wipe: ioctlRange(blkSecDiscard)},
+		{name: "blkzeroout", wipe: ioctlRange(blkZeroOut)},
+		{name: "writezeroes", wipe: func(f *os.File, offset, length uint64) error { return writeZeroes(f, zeroes, offset, length) }},
+	}
+
+	for offset := uint64(0); offset < size; offset += wipeChunkSize {
+		if err = ctx.Err(); err != nil {
+			return "", err
+		}
+
+		length := size - offset
+		if length > wipeChunkSize {
+			length = wipeChunkSize
+		}
+
+		// the remaining methods are tried only until the first one succeeds
+		for {
+			if err = wipers[0].wipe(f, offset, length); err == nil {
+				break
+			}
+
+			if offset > 0 || len(wipers) == 1 {
+				return "", fmt.Errorf("error wiping with %s at offset %d: %w", wipers[0].name, offset, err)
+			}
+
+			wipers = wipers[1:]
+		}
+	}
+
+	if err = f.Sync(); err != nil {
+		return "", err
+	}
+
+	return wipers[0].name, nil
+}
+
+func ioctlRange(req uintptr) func(f *os.File, offset, length uint64) error {
+	return func(f *os.File, offset, length uint64) error {
+		r := [2]uint64{offset, length}
+
+		if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), req, uintptr(unsafe.Pointer(&r))); errno != 0 {
+			return errno
+		}
+
+		return nil
+	}
+}
+
+func writeZeroes(f *os.File, zeroes []byte, offset, length uint64) error {
+	for length > 0 {
+		n := uint64(len(zeroes))
+		if n > length {
+			n = length
+		}
+
+		if _, err := f.WriteAt(zeroes[:n], int64(offset)); err != nil {
+			return err
+		}
+
+		offset += n
+		length -= n
+	}
+
+	return nil
+}
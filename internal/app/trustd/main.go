@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/talos-systems/talos/internal/app/trustd/internal/reg"
+	"github.com/talos-systems/talos/internal/pkg/metrics"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
 	"github.com/talos-systems/talos/pkg/grpc/gen"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/auth/basic"
@@ -39,16 +40,28 @@ func runDebugServer(ctx context.Context) {
 	}
 }
 
+func runMetricsServer(ctx context.Context, address string) {
+	if err := metrics.ListenAndServe(ctx, address, log.Printf); err != nil {
+		log.Fatalf("failed to start metrics server: %s", err)
+	}
+}
+
 // Main is the entrypoint into trustd.
 //
 //nolint:gocyclo
 func Main() {
 	log.SetFlags(log.Lshortfile | log.Ldate | log.Lmicroseconds | log.Ltime)
 
+	metricsAddress := flag.String("metrics-address", "", "address to serve Prometheus metrics on, disabled if empty")
+
 	flag.Parse()
 
 	go runDebugServer(context.TODO())
 
+	if *metricsAddress != "" {
+		go runMetricsServer(context.TODO(), *metricsAddress)
+	}
+
 	var err error
 
 	if err = startup.RandSeed(); err != nil {
@@ -110,10 +123,13 @@ func Main() {
 
 	creds := basic.NewTokenCredentials(config.Machine().Security().Token())
 
+	requestDuration := metrics.NewRequestDuration("trustd")
+
 	err = factory.ListenAndServe(
 		&reg.Registrator{Config: config},
 		factory.Port(constants.TrustdPort),
 		factory.WithDefaultLog(),
+		factory.WithUnaryInterceptor(requestDuration.UnaryInterceptor()),
 		factory.WithUnaryInterceptor(creds.UnaryInterceptor()),
 		factory.ServerOptions(
 			grpc.Creds(
@@ -0,0 +1,405 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cgroups implements reading the cgroup v2 hierarchy with the resource usage.
+package cgroups
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// Unlimited is the value of the limits which are not set ("max").
+const Unlimited = math.MaxUint64
+
+// Tree reads the cgroup v2 hierarchy.
+type Tree struct {
+	// Root is the mount point of the cgroup v2 hierarchy, e.g. /sys/fs/cgroup.
+	Root string
+	// ProcPath is used to resolve the process names, e.g. /proc.
+	ProcPath string
+	// DevBlockPath is used to resolve the block device names, e.g. /sys/dev/block.
+	DevBlockPath string
+}
+
+// Walk reads the cgroup and all its descendants.
+//
+// The cgroup path is relative to the cgroup root, the parent cgroups are returned before their children.
+func (t *Tree) Walk(cgroupPath string) ([]*machine.CgroupInfo, error) {
+	cgroupPath = path.Clean("/" + cgroupPath)
+
+	if _, err := os.Stat(filepath.Join(t.Root, "cgroup.controllers")); err != nil {
+		return nil, fmt.Errorf("cgroup v2 hierarchy is not mounted at %q: %s", t.Root, err)
+	}
+
+	var cgroups []*machine.CgroupInfo
+
+	err := filepath.WalkDir(filepath.Join(t.Root, cgroupPath), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// cgroup might have been removed while walking
+			if errors.Is(err, fs.ErrNotExist) && p != filepath.Join(t.Root, cgroupPath) {
+				return nil
+			}
+
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(t.Root, p)
+		if err != nil {
+			return err
+		}
+
+		info, err := t.Read(rel)
+		if err != nil {
+			return err
+		}
+
+		cgroups = append(cgroups, info)
+
+		return nil
+	})
+
+	return cgroups, err
+}
+
+// Read reads the cgroup.
+//
+// Controller files which are missing (e.g. the controller is not enabled) are skipped.
+func (t *Tree) Read(cgroupPath string) (*machine.CgroupInfo, error) {
+	cgroupPath = path.Clean("/" + cgroupPath)
+
+	r := &reader{
+		dir: filepath.Join(t.Root, cgroupPath),
+	}
+
+	info := &machine.CgroupInfo{
+		Path:           cgroupPath,
+		Processes:      t.readProcesses(r),
+		Cpu:            readCPU(r),
+		Memory:         readMemory(r),
+		Io:             t.readIO(r),
+		CpuPressure:    readPressure(r, "cpu.pressure"),
+		MemoryPressure: readPressure(r, "memory.pressure"),
+		IoPressure:     readPressure(r, "io.pressure"),
+	}
+
+	if r.err != nil {
+		return nil, fmt.Errorf("error reading cgroup %q: %w", cgroupPath, r.err)
+	}
+
+	return info, nil
+}
+
+func (t *Tree) readProcesses(r *reader) []*machine.CgroupProcess {
+	contents, ok := r.read("cgroup.procs")
+	if !ok {
+		return nil
+	}
+
+	var processes []*machine.CgroupProcess
+
+	for _, line := range strings.Fields(string(contents)) {
+		pid, err := strconv.ParseInt(line, 10, 32)
+		if err != nil {
+			r.fail(fmt.Errorf("error parsing cgroup.procs: %w", err))
+
+			return nil
+		}
+
+		process := &machine.CgroupProcess{
+			Pid: int32(pid),
+		}
+
+		// process might have exited already
+		if comm, err := os.ReadFile(filepath.Join(t.ProcPath, line, "comm")); err == nil {
+			process.Command = string(bytes.TrimSpace(comm))
+		}
+
+		processes = append(processes, process)
+	}
+
+	return processes
+}
+
+func readCPU(r *reader) *machine.CgroupCPU {
+	stat, ok := r.readKeyValues("cpu.stat")
+	if !ok {
+		return nil
+	}
+
+	cpu := &machine.CgroupCPU{
+		MaxQuota:      Unlimited,
+		UsageUsec:     stat["usage_usec"],
+		UserUsec:      stat["user_usec"],
+		SystemUsec:    stat["system_usec"],
+		NrPeriods:     stat["nr_periods"],
+		NrThrottled:   stat["nr_throttled"],
+		ThrottledUsec: stat["throttled_usec"],
+	}
+
+	if weight, ok := r.read("cpu.weight"); ok {
+		cpu.Weight = r.parseLimit("cpu.weight", string(weight))
+	}
+
+	// cpu.max is "$MAX $PERIOD"
+	if max, ok := r.read("cpu.max"); ok {
+		fields := strings.Fields(string(max))
+		if len(fields) != 2 {
+			r.fail(fmt.Errorf("unexpected cpu.max format %q", string(max)))
+
+			return nil
+		}
+
+		cpu.MaxQuota = r.parseLimit("cpu.max", fields[0])
+		cpu.MaxPeriod = r.parseLimit("cpu.max", fields[1])
+	}
+
+	return cpu
+}
+
+func readMemory(r *reader) *machine.CgroupMemory {
+	current, ok := r.read("memory.current")
+	if !ok {
+		return nil
+	}
+
+	memory := &machine.CgroupMemory{
+		Current: r.parseLimit("memory.current", string(current)),
+		Max:     Unlimited,
+		High:    Unlimited,
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value *uint64
+	}{
+		{"memory.min", &memory.Min},
+		{"memory.low", &memory.Low},
+		{"memory.high", &memory.High},
+		{"memory.max", &memory.Max},
+	} {
+		if contents, ok := r.read(limit.name); ok {
+			*limit.value = r.parseLimit(limit.name, string(contents))
+		}
+	}
+
+	if stat, ok := r.readKeyValues("memory.stat"); ok {
+		memory.Anon = stat["anon"]
+		memory.File = stat["file"]
+	}
+
+	if events, ok := r.readKeyValues("memory.events"); ok {
+		memory.OomKill = events["oom_kill"]
+	}
+
+	return memory
+}
+
+// readIO parses io.stat:
+//
+//	8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0
+func (t *Tree) readIO(r *reader) []*machine.CgroupIOStat {
+	contents, ok := r.read("io.stat")
+	if !ok {
+		return nil
+	}
+
+	var stats []*machine.CgroupIOStat
+
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		stat := &machine.CgroupIOStat{
+			Device: fields[0],
+		}
+
+		if link, err := os.Readlink(filepath.Join(t.DevBlockPath, stat.Device)); err == nil {
+			stat.DeviceName = filepath.Base(link)
+		}
+
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				r.fail(fmt.Errorf("error parsing io.stat: %w", err))
+
+				return nil
+			}
+
+			switch key {
+			case "rbytes":
+				stat.Rbytes = v
+			case "wbytes":
+				stat.Wbytes = v
+			case "rios":
+				stat.Rios = v
+			case "wios":
+				stat.Wios = v
+			case "dbytes":
+				stat.Dbytes = v
+			case "dios":
+				stat.Dios = v
+			}
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats
+}
+
+// readPressure parses the PSI file:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPressure(r *reader, name string) *machine.CgroupPressure {
+	contents, ok := r.read(name)
+	if !ok {
+		return nil
+	}
+
+	pressure := &machine.CgroupPressure{}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		stat := &machine.PressureStat{}
+
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+
+			var err error
+
+			switch key {
+			case "avg10":
+				stat.Avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stat.Avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stat.Avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				stat.Total, err = strconv.ParseUint(value, 10, 64)
+			}
+
+			if err != nil {
+				r.fail(fmt.Errorf("error parsing %s: %w", name, err))
+
+				return nil
+			}
+		}
+
+		switch fields[0] {
+		case "some":
+			pressure.Some = stat
+		case "full":
+			pressure.Full = stat
+		}
+	}
+
+	return pressure
+}
+
+// reader reads the cgroup files recording the first error.
+type reader struct {
+	dir string
+	err error
+}
+
+func (r *reader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// read returns the contents of the file, false if the file is missing.
+func (r *reader) read(name string) ([]byte, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	contents, err := os.ReadFile(filepath.Join(r.dir, name))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			r.fail(err)
+		}
+
+		return nil, false
+	}
+
+	return contents, true
+}
+
+// readKeyValues parses the flat keyed files like cpu.stat.
+func (r *reader) readKeyValues(name string) (map[string]uint64, bool) {
+	contents, ok := r.read(name)
+	if !ok {
+		return nil, false
+	}
+
+	values := map[string]uint64{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			r.fail(fmt.Errorf("error parsing %s: %w", name, err))
+
+			return nil, false
+		}
+
+		values[fields[0]] = v
+	}
+
+	return values, true
+}
+
+// parseLimit parses the value which might be "max".
+func (r *reader) parseLimit(name, value string) uint64 {
+	value = strings.TrimSpace(value)
+
+	if value == "max" {
+		return Unlimited
+	}
+
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		r.fail(fmt.Errorf("error parsing %s: %w", name, err))
+	}
+
+	return v
+}
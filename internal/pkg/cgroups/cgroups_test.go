@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cgroups_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/cgroups"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func testTree() *cgroups.Tree {
+	return &cgroups.Tree{
+		Root:         "testdata/cgroup",
+		ProcPath:     "testdata/proc",
+		DevBlockPath: "testdata/dev/block",
+	}
+}
+
+func TestWalk(t *testing.T) {
+	infos, err := testTree().Walk("")
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(infos))
+
+	for _, info := range infos {
+		paths = append(paths, info.Path)
+	}
+
+	assert.Equal(t, []string{"/", "/init", "/system", "/system/runtime"}, paths)
+
+	infos, err = testTree().Walk("system")
+	require.NoError(t, err)
+
+	require.Len(t, infos, 2)
+	assert.Equal(t, "/system", infos[0].Path)
+	assert.Equal(t, "/system/runtime", infos[1].Path)
+
+	_, err = testTree().Walk("/kubepods")
+	assert.Error(t, err)
+
+	_, err = (&cgroups.Tree{Root: "testdata/proc"}).Walk("")
+	assert.ErrorContains(t, err, "cgroup v2 hierarchy is not mounted")
+}
+
+func TestReadRoot(t *testing.T) {
+	info, err := testTree().Read("/")
+	require.NoError(t, err)
+
+	assert.Empty(t, info.Processes)
+	assert.Nil(t, info.Memory)
+	assert.Nil(t, info.MemoryPressure)
+
+	assert.Equal(t, &machine.CgroupCPU{
+		MaxQuota:   cgroups.Unlimited,
+		UsageUsec:  1234567890,
+		UserUsec:   834567890,
+		SystemUsec: 400000000,
+	}, info.Cpu)
+
+	assert.Equal(t, &machine.CgroupPressure{
+		Some: &machine.PressureStat{Avg10: 1.5, Avg60: 0.75, Avg300: 0.1, Total: 123456},
+	}, info.CpuPressure)
+
+	assert.Equal(t, &machine.CgroupPressure{
+		Some: &machine.PressureStat{Total: 42},
+		Full: &machine.PressureStat{Total: 40},
+	}, info.IoPressure)
+
+	assert.Equal(t, []*machine.CgroupIOStat{
+		{Device: "8:0", DeviceName: "sda", Rbytes: 1459200, Wbytes: 314773504, Rios: 192, Wios: 353},
+	}, info.Io)
+}
+
+func TestRead(t *testing.T) {
+	info, err := testTree().Read("system/runtime")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/system/runtime", info.Path)
+
+	assert.Equal(t, []*machine.CgroupProcess{
+		{Pid: 1042, Command: "containerd"},
+		{Pid: 1043, Command: "udevd"},
+	}, info.Processes)
+
+	assert.Equal(t, &machine.CgroupCPU{
+		Weight:        50,
+		MaxQuota:      50000,
+		MaxPeriod:     100000,
+		UsageUsec:     7000000,
+		UserUsec:      4000000,
+		SystemUsec:    3000000,
+		NrPeriods:     100,
+		NrThrottled:   5,
+		ThrottledUsec: 25000,
+	}, info.Cpu)
+
+	assert.Equal(t, &machine.CgroupMemory{
+		Current: 104857600,
+		High:    cgroups.Unlimited,
+		Max:     536870912,
+		OomKill: 1,
+	}, info.Memory)
+
+	assert.Equal(t, []*machine.CgroupIOStat{
+		{Device: "8:0", DeviceName: "sda", Rbytes: 4096, Wbytes: 8192, Rios: 1, Wios: 2},
+		{Device: "253:0", Rbytes: 1024, Rios: 1},
+	}, info.Io)
+
+	info, err = testTree().Read("/init")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*machine.CgroupProcess{{Pid: 1, Command: "machined"}}, info.Processes)
+	assert.Equal(t, uint64(cgroups.Unlimited), info.Cpu.MaxQuota)
+	assert.Equal(t, uint64(100000), info.Cpu.MaxPeriod)
+
+	assert.Equal(t, &machine.CgroupMemory{
+		Current: 52428800,
+		Min:     100663296,
+		Low:     201326592,
+		High:    cgroups.Unlimited,
+		Max:     cgroups.Unlimited,
+		Anon:    41943040,
+		File:    10485760,
+	}, info.Memory)
+}
@@ -0,0 +1,243 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// maxCacheTTL caps the time a response is cached for.
+const maxCacheTTL = time.Hour
+
+// CacheStats describes the cache state.
+type CacheStats struct {
+	Size    int
+	Entries int
+	Hits    uint64
+	Misses  uint64
+}
+
+// Cache is a size-limited LRU cache of DNS responses which honors the TTLs of the records.
+type Cache struct {
+	mu sync.Mutex
+
+	size    int
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+
+	hits   uint64
+	misses uint64
+
+	now func() time.Time
+}
+
+type cacheKey struct {
+	name   string
+	qtype  uint16
+	qclass uint16
+	do     bool
+	cd     bool
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	msg     *dns.Msg
+	stored  time.Time
+	expires time.Time
+}
+
+// NewCache initializes a new cache which holds at most size responses.
+func NewCache(size int) *Cache {
+	return &Cache{
+		size:    size,
+		entries: map[cacheKey]*list.Element{},
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+func keyFor(msg *dns.Msg) (cacheKey, bool) {
+	if len(msg.Question) != 1 {
+		return cacheKey{}, false
+	}
+
+	q := msg.Question[0]
+
+	key := cacheKey{
+		name:   strings.ToLower(q.Name),
+		qtype:  q.Qtype,
+		qclass: q.Qclass,
+		cd:     msg.CheckingDisabled,
+	}
+
+	if opt := msg.IsEdns0(); opt != nil {
+		key.do = opt.Do()
+	}
+
+	return key, true
+}
+
+// Get returns the cached response for the request.
+//
+// The TTLs of the records are decreased by the time the response spent in the cache.
+func (c *Cache) Get(req *dns.Msg) *dns.Msg {
+	key, ok := keyFor(req)
+	if !ok {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	el, ok := c.entries[key]
+	if !ok {
+		c.misses++
+
+		return nil
+	}
+
+	entry := el.Value.(*cacheEntry) //nolint:forcetypeassert
+
+	if !now.Before(entry.expires) {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+
+		c.misses++
+
+		return nil
+	}
+
+	c.lru.MoveToFront(el)
+	c.hits++
+
+	resp := entry.msg.Copy()
+	resp.Id = req.Id
+	resp.Question = append([]dns.Question(nil), req.Question...)
+
+	elapsed := uint32(now.Sub(entry.stored) / time.Second)
+
+	for _, section := range [][]dns.RR{resp.Answer, resp.Ns, resp.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+
+			if rr.Header().Ttl > elapsed {
+				rr.Header().Ttl -= elapsed
+			} else {
+				rr.Header().Ttl = 0
+			}
+		}
+	}
+
+	return resp
+}
+
+// Put stores the response in the cache.
+//
+// Only successful and NXDOMAIN responses are cached.
+func (c *Cache) Put(resp *dns.Msg) {
+	key, ok := keyFor(resp)
+	if !ok {
+		return
+	}
+
+	ttl, ok := cacheTTL(resp)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+
+	now := c.now()
+
+	entry := &cacheEntry{
+		key:     key,
+		msg:     resp.Copy(),
+		stored:  now,
+		expires: now.Add(ttl),
+	}
+
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.lru.MoveToFront(el)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+
+		c.lru.Remove(el)
+		delete(c.entries, el.Value.(*cacheEntry).key) //nolint:forcetypeassert
+	}
+}
+
+// Stats returns the cache stats.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStats{
+		Size:    c.size,
+		Entries: c.lru.Len(),
+		Hits:    c.hits,
+		Misses:  c.misses,
+	}
+}
+
+// cacheTTL returns the time the response can be cached for.
+//
+// For negative responses the TTL is derived from the SOA record in the authority section (RFC 2308).
+func cacheTTL(resp *dns.Msg) (time.Duration, bool) {
+	if resp.Truncated {
+		return 0, false
+	}
+
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return 0, false
+	}
+
+	var (
+		minTTL = uint32(maxCacheTTL / time.Second)
+		found  bool
+	)
+
+	for _, section := range [][]dns.RR{resp.Answer, resp.Ns} {
+		for _, rr := range section {
+			ttl := rr.Header().Ttl
+
+			if soa, ok := rr.(*dns.SOA); ok && len(resp.Answer) == 0 && soa.Minttl < ttl {
+				ttl = soa.Minttl
+			}
+
+			if ttl < minTTL {
+				minTTL = ttl
+			}
+
+			found = true
+		}
+	}
+
+	if !found || minTTL == 0 {
+		return 0, false
+	}
+
+	return time.Duration(minTTL) * time.Second, true
+}
@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	talosdns "github.com/talos-systems/talos/internal/pkg/dns"
+)
+
+func answer(name string, ttl uint32) *dns.Msg {
+	req := new(dns.Msg)
+	req.SetQuestion(name, dns.TypeA)
+
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Answer = append(resp.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl},
+		A:   net.ParseIP("10.5.0.1"),
+	})
+
+	return resp
+}
+
+func TestCache(t *testing.T) {
+	now := time.Now()
+
+	cache := talosdns.NewCache(2)
+	cache.SetNow(func() time.Time { return now })
+
+	req := new(dns.Msg)
+	req.SetQuestion("Example.com.", dns.TypeA)
+
+	assert.Nil(t, cache.Get(req))
+
+	cache.Put(answer("example.com.", 60))
+
+	now = now.Add(10 * time.Second)
+
+	resp := cache.Get(req)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, req.Id, resp.Id)
+	assert.Equal(t, "Example.com.", resp.Question[0].Name)
+	require.Len(t, resp.Answer, 1)
+	assert.EqualValues(t, 50, resp.Answer[0].Header().Ttl)
+
+	// other query types are not matched
+	req6 := new(dns.Msg)
+	req6.SetQuestion("example.com.", dns.TypeAAAA)
+
+	assert.Nil(t, cache.Get(req6))
+
+	// entry expires
+	now = now.Add(50 * time.Second)
+
+	assert.Nil(t, cache.Get(req))
+
+	assert.Equal(t, talosdns.CacheStats{
+		Size:    2,
+		Entries: 0,
+		Hits:    1,
+		Misses:  3,
+	}, cache.Stats())
+}
+
+func TestCacheEviction(t *testing.T) {
+	cache := talosdns.NewCache(2)
+
+	for _, name := range []string{"a.example.com.", "b.example.com.", "c.example.com."} {
+		cache.Put(answer(name, 60))
+	}
+
+	assert.Equal(t, 2, cache.Stats().Entries)
+
+	req := new(dns.Msg)
+	req.SetQuestion("a.example.com.", dns.TypeA)
+
+	assert.Nil(t, cache.Get(req))
+
+	req.SetQuestion("c.example.com.", dns.TypeA)
+
+	assert.NotNil(t, cache.Get(req))
+}
+
+func TestCacheNotCacheable(t *testing.T) {
+	cache := talosdns.NewCache(10)
+
+	// zero TTL
+	cache.Put(answer("a.example.com.", 0))
+
+	// server failure
+	resp := answer("b.example.com.", 60)
+	resp.Rcode = dns.RcodeServerFailure
+
+	cache.Put(resp)
+
+	// truncated
+	resp = answer("c.example.com.", 60)
+	resp.Truncated = true
+
+	cache.Put(resp)
+
+	assert.Equal(t, 0, cache.Stats().Entries)
+
+	// negative response is cached for SOA minimum TTL
+	req := new(dns.Msg)
+	req.SetQuestion("d.example.com.", dns.TypeA)
+
+	resp = new(dns.Msg)
+	resp.SetRcode(req, dns.RcodeNameError)
+	resp.Ns = append(resp.Ns, &dns.SOA{
+		Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 3600},
+		Ns:     "ns.example.com.",
+		Mbox:   "hostmaster.example.com.",
+		Minttl: 30,
+	})
+
+	cache.Put(resp)
+
+	cached := cache.Get(req)
+	require.NotNil(t, cached)
+
+	assert.Equal(t, dns.RcodeNameError, cached.Rcode)
+}
@@ -2,13 +2,11 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-package encryption_test
+package dns
 
-import "testing"
+import "time"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+// SetNow overrides the clock of the cache.
+func (c *Cache) SetNow(now func() time.Time) {
+	c.now = now
 }
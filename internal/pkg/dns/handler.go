@@ -0,0 +1,309 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+)
+
+// staticTTL is the TTL of the static answers.
+const staticTTL = 60
+
+// Handler is a caching DNS forwarder.
+//
+// Queries are answered from the static hosts first, then from the cache, and finally they are
+// forwarded to the upstreams of the longest matching domain, or to the default upstreams.
+type Handler struct {
+	logger *zap.Logger
+
+	mu               sync.Mutex
+	cache            *Cache
+	defaultUpstreams []*upstream
+	domainUpstreams  []domainUpstreams
+	staticHosts      map[string][]netaddr.IP
+}
+
+type domainUpstreams struct {
+	domain    string
+	upstreams []*upstream
+}
+
+// NewHandler initializes a new Handler.
+func NewHandler(logger *zap.Logger) *Handler {
+	return &Handler{
+		logger: logger,
+		cache:  NewCache(0),
+	}
+}
+
+// SetCacheSize sets the cache size, the cache is flushed if the size changes.
+func (h *Handler) SetCacheSize(size int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cache.Stats().Size != size {
+		h.cache = NewCache(size)
+	}
+}
+
+// SetUpstreams sets the default and per-domain upstreams.
+//
+// Health and stats of the upstreams which are not changed are preserved.
+func (h *Handler) SetUpstreams(defaults []netaddr.IPPort, domains map[string][]netaddr.IPPort) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	type upstreamKey struct {
+		addr   netaddr.IPPort
+		domain string
+	}
+
+	existing := map[upstreamKey]*upstream{}
+
+	for _, u := range h.allUpstreams() {
+		existing[upstreamKey{u.addr, u.domain}] = u
+	}
+
+	build := func(addrs []netaddr.IPPort, domain string) []*upstream {
+		upstreams := make([]*upstream, 0, len(addrs))
+
+		for _, addr := range addrs {
+			u, ok := existing[upstreamKey{addr, domain}]
+			if !ok {
+				u = newUpstream(addr, domain)
+			}
+
+			upstreams = append(upstreams, u)
+		}
+
+		return upstreams
+	}
+
+	h.defaultUpstreams = build(defaults, "")
+	h.domainUpstreams = make([]domainUpstreams, 0, len(domains))
+
+	for domain, addrs := range domains {
+		domain = dns.Fqdn(strings.ToLower(domain))
+
+		h.domainUpstreams = append(h.domainUpstreams, domainUpstreams{
+			domain:    domain,
+			upstreams: build(addrs, domain),
+		})
+	}
+
+	// the longest domain wins
+	sort.Slice(h.domainUpstreams, func(i, j int) bool {
+		return len(h.domainUpstreams[i].domain) > len(h.domainUpstreams[j].domain)
+	})
+}
+
+// SetStaticHosts sets the static answers, the hosts map is keyed by the host name.
+func (h *Handler) SetStaticHosts(hosts map[string][]netaddr.IP) {
+	staticHosts := make(map[string][]netaddr.IP, len(hosts))
+
+	for name, ips := range hosts {
+		name = dns.Fqdn(strings.ToLower(name))
+
+		staticHosts[name] = append(staticHosts[name], ips...)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.staticHosts = staticHosts
+}
+
+// Stats returns the cache stats and the upstream health.
+func (h *Handler) Stats() (CacheStats, []UpstreamStats) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	upstreams := h.allUpstreams()
+
+	stats := make([]UpstreamStats, 0, len(upstreams))
+
+	for _, u := range upstreams {
+		stats = append(stats, u.stats())
+	}
+
+	return h.cache.Stats(), stats
+}
+
+func (h *Handler) allUpstreams() []*upstream {
+	upstreams := append([]*upstream(nil), h.defaultUpstreams...)
+
+	for _, d := range h.domainUpstreams {
+		upstreams = append(upstreams, d.upstreams...)
+	}
+
+	return upstreams
+}
+
+// ServeDNS implements dns.Handler interface.
+func (h *Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	if len(req.Question) != 1 {
+		resp := new(dns.Msg)
+		resp.SetRcode(req, dns.RcodeFormatError)
+
+		h.write(w, req, resp)
+
+		return
+	}
+
+	h.mu.Lock()
+	cache := h.cache
+	static := h.answerStatic(req)
+	upstreams := h.pickUpstreams(req.Question[0].Name)
+	h.mu.Unlock()
+
+	if static != nil {
+		h.write(w, req, static)
+
+		return
+	}
+
+	if resp := cache.Get(req); resp != nil {
+		h.write(w, req, resp)
+
+		return
+	}
+
+	proto := "udp"
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		proto = "tcp"
+	}
+
+	resp, err := h.forward(proto, req, upstreams)
+	if err != nil {
+		h.logger.Debug("failed to forward DNS query", zap.String("name", req.Question[0].Name), zap.Error(err))
+
+		resp = new(dns.Msg)
+		resp.SetRcode(req, dns.RcodeServerFailure)
+
+		h.write(w, req, resp)
+
+		return
+	}
+
+	cache.Put(resp)
+
+	h.write(w, req, resp)
+}
+
+func (h *Handler) write(w dns.ResponseWriter, req, resp *dns.Msg) {
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+
+		if opt := req.IsEdns0(); opt != nil && int(opt.UDPSize()) > size {
+			size = int(opt.UDPSize())
+		}
+
+		resp.Truncate(size)
+	}
+
+	if err := w.WriteMsg(resp); err != nil {
+		h.logger.Debug("failed to write DNS response", zap.Error(err))
+	}
+}
+
+// answerStatic answers the A and AAAA queries for the static hosts.
+//
+// Should be called with h.mu locked.
+func (h *Handler) answerStatic(req *dns.Msg) *dns.Msg {
+	q := req.Question[0]
+
+	if q.Qclass != dns.ClassINET {
+		return nil
+	}
+
+	ips, ok := h.staticHosts[strings.ToLower(q.Name)]
+	if !ok {
+		return nil
+	}
+
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Authoritative = true
+
+	for _, ip := range ips {
+		hdr := dns.RR_Header{
+			Name:   q.Name,
+			Rrtype: q.Qtype,
+			Class:  dns.ClassINET,
+			Ttl:    staticTTL,
+		}
+
+		switch {
+		case q.Qtype == dns.TypeA && ip.Is4():
+			resp.Answer = append(resp.Answer, &dns.A{Hdr: hdr, A: ip.IPAddr().IP})
+		case q.Qtype == dns.TypeAAAA && ip.Is6():
+			resp.Answer = append(resp.Answer, &dns.AAAA{Hdr: hdr, AAAA: ip.IPAddr().IP})
+		}
+	}
+
+	return resp
+}
+
+// pickUpstreams returns the upstreams for the name, healthy upstreams go first.
+//
+// Should be called with h.mu locked.
+func (h *Handler) pickUpstreams(name string) []*upstream {
+	name = strings.ToLower(name)
+
+	candidates := h.defaultUpstreams
+
+	for _, d := range h.domainUpstreams {
+		if name == d.domain || strings.HasSuffix(name, "."+d.domain) {
+			candidates = d.upstreams
+
+			break
+		}
+	}
+
+	upstreams := make([]*upstream, 0, len(candidates))
+
+	for _, u := range candidates {
+		if u.isHealthy() {
+			upstreams = append(upstreams, u)
+		}
+	}
+
+	for _, u := range candidates {
+		if !u.isHealthy() {
+			upstreams = append(upstreams, u)
+		}
+	}
+
+	return upstreams
+}
+
+func (h *Handler) forward(proto string, req *dns.Msg, upstreams []*upstream) (*dns.Msg, error) {
+	if len(upstreams) == 0 {
+		return nil, errors.New("no upstreams configured")
+	}
+
+	var (
+		resp *dns.Msg
+		err  error
+	)
+
+	for _, u := range upstreams {
+		resp, err = u.exchange(context.Background(), proto, req)
+		if err == nil {
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"inet.af/netaddr"
+
+	talosdns "github.com/talos-systems/talos/internal/pkg/dns"
+)
+
+// fakeUpstream answers all A queries with the configured address.
+type fakeUpstream struct {
+	addr    netaddr.IPPort
+	ip      net.IP
+	queries uint64
+}
+
+func startFakeUpstream(t *testing.T, ip string) *fakeUpstream {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	u := &fakeUpstream{
+		addr: netaddr.MustParseIPPort(pc.LocalAddr().String()),
+		ip:   net.ParseIP(ip),
+	}
+
+	started := make(chan struct{})
+
+	srv := &dns.Server{
+		PacketConn:        pc,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			atomic.AddUint64(&u.queries, 1)
+
+			resp := new(dns.Msg)
+			resp.SetReply(req)
+			resp.Answer = append(resp.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   u.ip,
+			})
+
+			w.WriteMsg(resp) //nolint:errcheck
+		}),
+	}
+
+	go srv.ActivateAndServe() //nolint:errcheck
+
+	<-started
+
+	t.Cleanup(func() { srv.Shutdown() }) //nolint:errcheck
+
+	return u
+}
+
+// deadUpstream returns an address nobody listens on.
+func deadUpstream(t *testing.T) netaddr.IPPort {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := netaddr.MustParseIPPort(pc.LocalAddr().String())
+
+	require.NoError(t, pc.Close())
+
+	return addr
+}
+
+func startServer(t *testing.T, handler *talosdns.Handler) string {
+	t.Helper()
+
+	srv, err := talosdns.NewServer(netaddr.MustParseIPPort("127.0.0.1:0"), handler, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	srv.Start()
+
+	t.Cleanup(srv.Stop)
+
+	return srv.Addr().String()
+}
+
+func query(t *testing.T, proto, addr, name string, qtype uint16) *dns.Msg {
+	t.Helper()
+
+	req := new(dns.Msg)
+	req.SetQuestion(name, qtype)
+
+	client := &dns.Client{Net: proto}
+
+	resp, _, err := client.Exchange(req, addr)
+	require.NoError(t, err)
+
+	return resp
+}
+
+func TestHandler(t *testing.T) {
+	defaultUpstream := startFakeUpstream(t, "10.0.0.1")
+	corpUpstream := startFakeUpstream(t, "10.0.0.2")
+	dead := deadUpstream(t)
+
+	handler := talosdns.NewHandler(zaptest.NewLogger(t))
+	handler.SetCacheSize(10)
+	handler.SetUpstreams(
+		[]netaddr.IPPort{dead, defaultUpstream.addr},
+		map[string][]netaddr.IPPort{
+			"corp.example.com": {corpUpstream.addr},
+		},
+	)
+	handler.SetStaticHosts(map[string][]netaddr.IP{
+		"gateway": {netaddr.MustParseIP("10.5.0.1"), netaddr.MustParseIP("fd00::1")},
+	})
+
+	addr := startServer(t, handler)
+
+	for _, proto := range []string{"udp", "tcp"} {
+		proto := proto
+
+		t.Run(proto, func(t *testing.T) {
+			resp := query(t, proto, addr, "gateway.", dns.TypeA)
+			require.Len(t, resp.Answer, 1)
+			assert.True(t, resp.Authoritative)
+			assert.Equal(t, "10.5.0.1", resp.Answer[0].(*dns.A).A.String())
+
+			resp = query(t, proto, addr, "GATEWAY.", dns.TypeAAAA)
+			require.Len(t, resp.Answer, 1)
+			assert.Equal(t, "fd00::1", resp.Answer[0].(*dns.AAAA).AAAA.String())
+
+			resp = query(t, proto, addr, "host.corp.example.com.", dns.TypeA)
+			require.Len(t, resp.Answer, 1)
+			assert.Equal(t, "10.0.0.2", resp.Answer[0].(*dns.A).A.String())
+		})
+	}
+
+	// dead upstream is skipped
+	resp := query(t, "udp", addr, "example.com.", dns.TypeA)
+	require.Len(t, resp.Answer, 1)
+	assert.Equal(t, "10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+
+	// second query is answered from the cache
+	resp = query(t, "udp", addr, "example.com.", dns.TypeA)
+	require.Len(t, resp.Answer, 1)
+
+	assert.EqualValues(t, 1, atomic.LoadUint64(&defaultUpstream.queries))
+	assert.EqualValues(t, 1, atomic.LoadUint64(&corpUpstream.queries))
+
+	cacheStats, upstreamStats := handler.Stats()
+
+	assert.Equal(t, 2, cacheStats.Entries)
+	assert.EqualValues(t, 2, cacheStats.Hits)
+	assert.EqualValues(t, 2, cacheStats.Misses)
+
+	require.Len(t, upstreamStats, 3)
+
+	assert.Equal(t, dead, upstreamStats[0].Addr)
+	assert.False(t, upstreamStats[0].Healthy)
+	assert.EqualValues(t, 1, upstreamStats[0].Failures)
+	assert.NotEmpty(t, upstreamStats[0].LastError)
+
+	assert.Equal(t, defaultUpstream.addr, upstreamStats[1].Addr)
+	assert.True(t, upstreamStats[1].Healthy)
+
+	assert.Equal(t, corpUpstream.addr, upstreamStats[2].Addr)
+	assert.Equal(t, "corp.example.com.", upstreamStats[2].Domain)
+	assert.True(t, upstreamStats[2].Healthy)
+
+	// stats are preserved when upstreams are updated
+	handler.SetUpstreams([]netaddr.IPPort{defaultUpstream.addr}, nil)
+
+	_, upstreamStats = handler.Stats()
+
+	require.Len(t, upstreamStats, 1)
+	assert.EqualValues(t, 1, upstreamStats[0].Queries)
+}
+
+func TestHandlerNoUpstreams(t *testing.T) {
+	handler := talosdns.NewHandler(zaptest.NewLogger(t))
+	handler.SetCacheSize(10)
+
+	addr := startServer(t, handler)
+
+	resp := query(t, "udp", addr, "example.com.", dns.TypeA)
+	assert.Equal(t, dns.RcodeServerFailure, resp.Rcode)
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+	"inet.af/netaddr"
+)
+
+// Server serves DNS queries over UDP and TCP on a single address.
+type Server struct {
+	addr    netaddr.IPPort
+	logger  *zap.Logger
+	servers []*dns.Server
+	wg      sync.WaitGroup
+}
+
+// NewServer opens UDP and TCP listeners on the address.
+//
+// Listeners are bound with IP_FREEBIND, so the address doesn't have to be assigned to the link yet.
+func NewServer(addr netaddr.IPPort, handler dns.Handler, logger *zap.Logger) (*Server, error) {
+	lc := net.ListenConfig{
+		Control: freebind,
+	}
+
+	pc, err := lc.ListenPacket(context.Background(), "udp", addr.String())
+	if err != nil {
+		return nil, fmt.Errorf("error listening on UDP %s: %w", addr, err)
+	}
+
+	// pick the same port for TCP if the port was allocated dynamically
+	addr = netaddr.IPPortFrom(addr.IP(), uint16(pc.LocalAddr().(*net.UDPAddr).Port)) //nolint:forcetypeassert
+
+	l, err := lc.Listen(context.Background(), "tcp", addr.String())
+	if err != nil {
+		pc.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error listening on TCP %s: %w", addr, err)
+	}
+
+	return &Server{
+		addr:   addr,
+		logger: logger,
+		servers: []*dns.Server{
+			{
+				PacketConn: pc,
+				Handler:    handler,
+			},
+			{
+				Listener: l,
+				Handler:  handler,
+			},
+		},
+	}, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() netaddr.IPPort {
+	return s.addr
+}
+
+// Start the servers and wait for them to be ready.
+func (s *Server) Start() {
+	for _, srv := range s.servers {
+		srv := srv
+		started, done := make(chan struct{}), make(chan struct{})
+
+		srv.NotifyStartedFunc = func() { close(started) }
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+			defer close(done)
+
+			if err := srv.ActivateAndServe(); err != nil {
+				s.logger.Error("DNS server failed", zap.Error(err))
+			}
+		}()
+
+		select {
+		case <-started:
+		case <-done:
+		}
+	}
+}
+
+// Stop the servers and wait for them to finish.
+func (s *Server) Stop() {
+	for _, srv := range s.servers {
+		if err := srv.Shutdown(); err != nil {
+			s.logger.Error("error shutting down DNS server", zap.Error(err))
+		}
+	}
+
+	s.wg.Wait()
+}
+
+func freebind(network, address string, c syscall.RawConn) error {
+	var sockErr error
+
+	if err := c.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptInt(int(fd), unix.SOL_IP, unix.IP_FREEBIND, 1)
+	}); err != nil {
+		return err
+	}
+
+	return sockErr
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"inet.af/netaddr"
+)
+
+// upstreamTimeout is the timeout for a single upstream exchange.
+const upstreamTimeout = 2 * time.Second
+
+// UpstreamStats describes the health of an upstream.
+type UpstreamStats struct {
+	Addr      netaddr.IPPort
+	Domain    string
+	Healthy   bool
+	Queries   uint64
+	Failures  uint64
+	LastError string
+}
+
+// upstream is a nameserver the queries are forwarded to.
+//
+// Upstream is considered unhealthy after a failed exchange, and it becomes healthy again
+// after the first successful one.
+type upstream struct {
+	addr   netaddr.IPPort
+	domain string
+
+	mu        sync.Mutex
+	healthy   bool
+	queries   uint64
+	failures  uint64
+	lastError string
+}
+
+func newUpstream(addr netaddr.IPPort, domain string) *upstream {
+	return &upstream{
+		addr:    addr,
+		domain:  domain,
+		healthy: true,
+	}
+}
+
+// exchange forwards the request to the upstream.
+//
+// Truncated UDP responses are retried over TCP.
+func (u *upstream) exchange(ctx context.Context, proto string, req *dns.Msg) (*dns.Msg, error) {
+	resp, err := u.exchangeProto(ctx, proto, req)
+	if err == nil && resp.Truncated && proto == "udp" {
+		resp, err = u.exchangeProto(ctx, "tcp", req)
+	}
+
+	if err == nil {
+		switch resp.Rcode {
+		case dns.RcodeServerFailure, dns.RcodeRefused:
+			err = fmt.Errorf("upstream responded with %s", dns.RcodeToString[resp.Rcode])
+		}
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.queries++
+
+	if err != nil {
+		u.failures++
+		u.healthy = false
+		u.lastError = err.Error()
+
+		return resp, err
+	}
+
+	u.healthy = true
+
+	return resp, nil
+}
+
+func (u *upstream) exchangeProto(ctx context.Context, proto string, req *dns.Msg) (*dns.Msg, error) {
+	ctx, cancel := context.WithTimeout(ctx, upstreamTimeout)
+	defer cancel()
+
+	client := &dns.Client{
+		Net: proto,
+	}
+
+	resp, _, err := client.ExchangeContext(ctx, req, u.addr.String())
+
+	return resp, err
+}
+
+func (u *upstream) isHealthy() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.healthy
+}
+
+func (u *upstream) stats() UpstreamStats {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return UpstreamStats{
+		Addr:      u.addr,
+		Domain:    u.domain,
+		Healthy:   u.healthy,
+		Queries:   u.queries,
+		Failures:  u.failures,
+		LastError: u.lastError,
+	}
+}
@@ -6,10 +6,15 @@
 package encryption
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sort"
 	"strconv"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/encryption"
@@ -18,11 +23,12 @@ import (
 
 	"github.com/talos-systems/talos/internal/pkg/encryption/keys"
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // NewHandler creates new Handler.
 func NewHandler(device *blockdevice.BlockDevice, partition *gpt.Partition, encryptionConfig config.Encryption) (*Handler, error) {
-	keys, err := getKeys(encryptionConfig, partition)
+	keys, err := getKeyHandlers(encryptionConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -75,17 +81,29 @@ func NewHandler(device *blockdevice.BlockDevice, partition *gpt.Partition, encry
 // Handler reads encryption config, creates appropriate
 // encryption provider, handles encrypted partition open and close.
 type Handler struct {
+	mu sync.Mutex
+
 	device             *blockdevice.BlockDevice
 	partition          *gpt.Partition
 	encryptionConfig   config.Encryption
-	keys               []*encryption.Key
+	keys               []*keyHandler
 	encryptionProvider encryption.Provider
 	encryptedPath      string
 }
 
+// keyHandler handles the key of the key slot.
+type keyHandler struct {
+	slot    int
+	kind    string
+	handler keys.Handler
+}
+
 // Open encrypted partition.
+//
+// If the key can't be fetched because the KMS is unavailable, the returned error wraps keys.ErrKMSUnavailable.
+//
 //nolint:gocyclo
-func (h *Handler) Open() (string, error) {
+func (h *Handler) Open(ctx context.Context) (string, error) {
 	partPath, err := h.partition.Path()
 	if err != nil {
 		return "", err
@@ -100,7 +118,7 @@ func (h *Handler) Open() (string, error) {
 
 	// encrypt if partition is not encrypted and empty
 	if sb == nil {
-		err = h.formatAndEncrypt(partPath)
+		err = h.formatAndEncrypt(ctx, partPath)
 		if err != nil {
 			return "", err
 		}
@@ -108,9 +126,28 @@ func (h *Handler) Open() (string, error) {
 		return "", fmt.Errorf("failed to encrypt the partition %s, because it is not empty", partPath)
 	}
 
-	var k *encryption.Key
+	tokens, err := readTokens(partPath)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		k       *encryption.Key
+		keyErrs *multierror.Error
+	)
+
+	for _, kh := range h.keys {
+		var key []byte
+
+		key, err = kh.handler.GetKey(ctx, tokens[kh.slot], keys.WithPartitionLabel(h.partition.Name))
+		if err != nil {
+			keyErrs = multierror.Append(keyErrs, fmt.Errorf("failed to get the key for slot %d: %w", kh.slot, err))
+
+			continue
+		}
+
+		k = encryption.NewKey(kh.slot, key)
 
-	for _, k = range h.keys {
 		path, err = h.encryptionProvider.Open(partPath, k)
 		if err != nil {
 			if err == encryption.ErrEncryptionKeyRejected {
@@ -124,12 +161,16 @@ func (h *Handler) Open() (string, error) {
 	}
 
 	if path == "" {
+		if keyErrs != nil {
+			return "", fmt.Errorf("failed to open encrypted device %s, no key matched: %w", partPath, keyErrs)
+		}
+
 		return "", fmt.Errorf("failed to open encrypted device %s, no key matched", partPath)
 	}
 
 	log.Printf("mapped encrypted partition %s -> %s", partPath, path)
 
-	if err = h.syncKeys(k, partPath); err != nil {
+	if err = h.syncKeys(ctx, k, partPath, tokens); err != nil {
 		return "", err
 	}
 
@@ -153,60 +194,116 @@ func (h *Handler) Close() error {
 	return nil
 }
 
-func (h *Handler) formatAndEncrypt(path string) error {
+func (h *Handler) formatAndEncrypt(ctx context.Context, path string) error {
 	log.Printf("encrypting the partition %s (%s)", path, h.partition.Name)
 
 	if len(h.keys) == 0 {
 		return fmt.Errorf("no encryption keys found")
 	}
 
-	key := h.keys[0]
+	// generate all the keys before touching the partition, as generating the key might fail
+	encryptionKeys := make([]*encryption.Key, len(h.keys))
+	tokens := make([][]byte, len(h.keys))
+
+	for i, kh := range h.keys {
+		key, token, err := kh.handler.NewKey(ctx, keys.WithPartitionLabel(h.partition.Name))
+		if err != nil {
+			return fmt.Errorf("failed to generate the key for slot %d: %w", kh.slot, err)
+		}
+
+		encryptionKeys[i] = encryption.NewKey(kh.slot, key)
+		tokens[i] = token
+	}
+
+	key := encryptionKeys[0]
 
 	err := h.encryptionProvider.Encrypt(path, key)
 	if err != nil {
 		return err
 	}
 
-	for _, extraKey := range h.keys[1:] {
+	for _, extraKey := range encryptionKeys[1:] {
 		if err = h.encryptionProvider.AddKey(path, key, extraKey); err != nil {
 			return err
 		}
 	}
 
+	for i, token := range tokens {
+		if token == nil {
+			continue
+		}
+
+		if err = setToken(ctx, path, encryptionKeys[i].Slot, token); err != nil {
+			return fmt.Errorf("failed to store the token for slot %d: %w", encryptionKeys[i].Slot, err)
+		}
+	}
+
 	return nil
 }
 
-//nolint:gocyclo
-func (h *Handler) syncKeys(k *encryption.Key, path string) error {
+//nolint:gocyclo,cyclop
+func (h *Handler) syncKeys(ctx context.Context, k *encryption.Key, path string, tokens map[int][]byte) error {
 	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
 	if err != nil {
 		return err
 	}
 
+	// slots which are either in use or reserved by the configuration
+	usedSlots := map[int]struct{}{}
+
+	for slot := range keyslots.Keyslots {
+		s, err := strconv.Atoi(slot)
+		if err != nil {
+			return err
+		}
+
+		usedSlots[s] = struct{}{}
+	}
+
+	for _, kh := range h.keys {
+		usedSlots[kh.slot] = struct{}{}
+	}
+
 	visited := map[string]bool{}
 
-	for _, key := range h.keys {
-		slot := fmt.Sprintf("%d", key.Slot)
+	for _, kh := range h.keys {
+		slot := fmt.Sprintf("%d", kh.slot)
 		visited[slot] = true
 		// no need to update the key which we already detected as unchanged
-		if k.Slot == key.Slot {
+		if k.Slot == kh.slot {
 			continue
 		}
 
 		// keyslot exists
 		if _, ok := keyslots.Keyslots[slot]; ok {
-			if err = h.updateKey(k, key, path); err != nil {
+			var updated bool
+
+			if updated, err = h.updateKey(ctx, k, kh, path, tokens, usedSlots); err != nil {
+				if errors.Is(err, keys.ErrKMSUnavailable) {
+					log.Printf("skipped syncing encryption key at slot %d: %s", kh.slot, err)
+
+					continue
+				}
+
 				return err
 			}
 
-			log.Printf("updated encryption key at slot %d", key.Slot)
+			if updated {
+				log.Printf("updated encryption key at slot %d", kh.slot)
+			}
 		} else {
 			// keyslot does not exist so just add the key
-			if err = h.encryptionProvider.AddKey(path, k, key); err != nil {
+			if err = h.addKey(ctx, k, kh, path, tokens); err != nil {
+				if errors.Is(err, keys.ErrKMSUnavailable) {
+					log.Printf("skipped adding encryption key to slot %d: %s", kh.slot, err)
+
+					continue
+				}
+
 				return err
 			}
 
-			log.Printf("added encryption key to slot %d", key.Slot)
+			log.Printf("added encryption key to slot %d", kh.slot)
 		}
 	}
 
@@ -222,53 +319,449 @@ func (h *Handler) syncKeys(k *encryption.Key, path string) error {
 				return err
 			}
 
-			log.Printf("removed key at slot %d", k.Slot)
+			if _, ok := tokens[int(s)]; ok {
+				if err = removeToken(ctx, path, int(s)); err != nil {
+					return err
+				}
+			}
+
+			log.Printf("removed key at slot %d", s)
 		}
 	}
 
 	return nil
 }
 
-func (h *Handler) updateKey(existingKey, newKey *encryption.Key, path string) error {
-	if valid, err := h.encryptionProvider.CheckKey(path, newKey); err != nil {
-		return err
-	} else if !valid {
-		// re-add the key to the slot
-		err = h.encryptionProvider.RemoveKey(path, newKey.Slot, existingKey)
+// updateKey checks the key at the existing slot, and replaces it if it is definitely invalid.
+//
+// If the key can't be fetched for other reasons (e.g. the KMS rejected the request), the key slot is kept as is.
+func (h *Handler) updateKey(ctx context.Context, existingKey *encryption.Key, kh *keyHandler, path string, tokens map[int][]byte, usedSlots map[int]struct{}) (bool, error) {
+	key, err := kh.handler.GetKey(ctx, tokens[kh.slot], keys.WithPartitionLabel(h.partition.Name))
+
+	switch {
+	case err == nil:
+		valid, err := h.encryptionProvider.CheckKey(path, encryption.NewKey(kh.slot, key))
 		if err != nil {
-			return fmt.Errorf("failed to drop old key during key update %w", err)
+			return false, err
+		}
+
+		if valid {
+			return false, nil
+		}
+	case errors.Is(err, keys.ErrKMSUnavailable):
+		return false, err
+	case errors.Is(err, keys.ErrKeyInvalid):
+		// the key can't be fetched, e.g. key kind was changed, so the key should be replaced
+		log.Printf("encryption key at slot %d is invalid, replacing it: %s", kh.slot, err)
+	default:
+		log.Printf("failed to get the encryption key at slot %d, keeping it: %s", kh.slot, err)
+
+		return false, nil
+	}
+
+	if err = h.replaceKey(ctx, existingKey, kh, path, tokens, usedSlots); err != nil {
+		return false, fmt.Errorf("failed to replace the key during key update: %w", err)
+	}
+
+	return true, nil
+}
+
+// replaceKey generates the new key and replaces the key at the slot with it.
+//
+// The new key is added to a free key slot before the old key is removed,
+// so a failure to generate or to add the key never leaves the slot empty.
+// Once staged, the new key authorizes the rest of the changes, so the existing key might be the one being replaced.
+func (h *Handler) replaceKey(ctx context.Context, existingKey *encryption.Key, kh *keyHandler, path string, tokens map[int][]byte, usedSlots map[int]struct{}) error {
+	tempSlot := -1
+
+	for slot := 0; slot < luks2MaxKeyslots; slot++ {
+		if _, used := usedSlots[slot]; !used {
+			tempSlot = slot
+
+			break
+		}
+	}
+
+	if tempSlot < 0 {
+		return fmt.Errorf("no free key slot to stage the new key")
+	}
+
+	key, token, err := kh.handler.NewKey(ctx, keys.WithPartitionLabel(h.partition.Name))
+	if err != nil {
+		return err
+	}
+
+	stagedKey := encryption.NewKey(tempSlot, key)
+
+	if err = h.encryptionProvider.AddKey(path, existingKey, stagedKey); err != nil {
+		return fmt.Errorf("failed to stage the new key: %w", err)
+	}
+
+	if err = h.encryptionProvider.RemoveKey(path, kh.slot, stagedKey); err != nil {
+		return fmt.Errorf("failed to drop the old key: %w", err)
+	}
+
+	newKey := encryption.NewKey(kh.slot, key)
+
+	if err = h.encryptionProvider.AddKey(path, stagedKey, newKey); err != nil {
+		return fmt.Errorf("failed to add the new key: %w", err)
+	}
+
+	if err = h.encryptionProvider.RemoveKey(path, tempSlot, newKey); err != nil {
+		return fmt.Errorf("failed to drop the staged key: %w", err)
+	}
+
+	return h.updateToken(ctx, kh.slot, path, token, tokens)
+}
+
+// addKey generates the new key and adds it to the slot along with the token.
+func (h *Handler) addKey(ctx context.Context, existingKey *encryption.Key, kh *keyHandler, path string, tokens map[int][]byte) error {
+	key, token, err := kh.handler.NewKey(ctx, keys.WithPartitionLabel(h.partition.Name))
+	if err != nil {
+		return err
+	}
+
+	if err = h.encryptionProvider.AddKey(path, existingKey, encryption.NewKey(kh.slot, key)); err != nil {
+		return err
+	}
+
+	return h.updateToken(ctx, kh.slot, path, token, tokens)
+}
+
+// updateToken stores the token of the new key at the slot, or removes the stale token of the previous key.
+func (h *Handler) updateToken(ctx context.Context, slot int, path string, token []byte, tokens map[int][]byte) error {
+	switch _, exists := tokens[slot]; {
+	case token != nil:
+		return setToken(ctx, path, slot, token)
+	case exists:
+		// stale token of the previous key
+		return removeToken(ctx, path, slot)
+	}
+
+	return nil
+}
+
+// AddKey adds the key to the free key slot of the opened partition.
+func (h *Handler) AddKey(ctx context.Context, cfg config.EncryptionKey) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	path, err := h.partition.Path()
+	if err != nil {
+		return err
+	}
+
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(cfg.Slot())]; ok {
+		return fmt.Errorf("key slot %d is already in use", cfg.Slot())
+	}
+
+	kh, err := newKeyHandler(cfg)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := readTokens(path)
+	if err != nil {
+		return err
+	}
+
+	k, err := h.existingKey(ctx, path, tokens, -1)
+	if err != nil {
+		return err
+	}
+
+	if err = h.addKey(ctx, k, kh, path, tokens); err != nil {
+		return fmt.Errorf("failed to add the key to slot %d: %w", kh.slot, err)
+	}
+
+	h.keys = append(h.keys, kh)
+
+	sortKeyHandlers(h.keys)
+
+	log.Printf("added encryption key to slot %d", kh.slot)
+
+	return nil
+}
+
+// RemoveKey removes the key from the key slot of the opened partition.
+//
+// The last key slot can't be removed.
+func (h *Handler) RemoveKey(ctx context.Context, slot int) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	path, err := h.partition.Path()
+	if err != nil {
+		return err
+	}
+
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(slot)]; !ok {
+		return fmt.Errorf("key slot %d is not in use", slot)
+	}
+
+	if len(keyslots.Keyslots) == 1 {
+		return fmt.Errorf("key slot %d is the last one, it can't be removed", slot)
+	}
+
+	tokens, err := readTokens(path)
+	if err != nil {
+		return err
+	}
+
+	k, err := h.existingKey(ctx, path, tokens, slot)
+	if err != nil {
+		return err
+	}
+
+	if err = h.encryptionProvider.RemoveKey(path, slot, k); err != nil {
+		return fmt.Errorf("failed to remove the key from slot %d: %w", slot, err)
+	}
+
+	if _, ok := tokens[slot]; ok {
+		if err = removeToken(ctx, path, slot); err != nil {
+			return err
 		}
+	}
 
-		err = h.encryptionProvider.AddKey(path, existingKey, newKey)
+	handlers := h.keys[:0]
+
+	for _, kh := range h.keys {
+		if kh.slot != slot {
+			handlers = append(handlers, kh)
+		}
+	}
+
+	h.keys = handlers
+
+	log.Printf("removed key at slot %d", slot)
+
+	return nil
+}
+
+// RotateKey replaces the key in the key slot of the opened partition.
+//
+// The new key is staged in a free key slot first, so the partition with a single key can be rotated as well.
+func (h *Handler) RotateKey(ctx context.Context, cfg config.EncryptionKey) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	path, err := h.partition.Path()
+	if err != nil {
+		return err
+	}
+
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(cfg.Slot())]; !ok {
+		return fmt.Errorf("key slot %d is not in use", cfg.Slot())
+	}
+
+	// slots which are either in use or reserved by the configuration
+	usedSlots := map[int]struct{}{}
+
+	for slot := range keyslots.Keyslots {
+		s, err := strconv.Atoi(slot)
 		if err != nil {
-			return fmt.Errorf("failed to add new key during key update %w", err)
+			return err
 		}
 
+		usedSlots[s] = struct{}{}
+	}
+
+	for _, existing := range h.keys {
+		usedSlots[existing.slot] = struct{}{}
+	}
+
+	kh, err := newKeyHandler(cfg)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := readTokens(path)
+	if err != nil {
 		return err
 	}
 
+	// any working key authorizes the rotation, including the key being rotated
+	k, err := h.existingKey(ctx, path, tokens, -1)
+	if err != nil {
+		return err
+	}
+
+	if err = h.replaceKey(ctx, k, kh, path, tokens, usedSlots); err != nil {
+		return fmt.Errorf("failed to replace the key during key rotation: %w", err)
+	}
+
+	handlers := []*keyHandler{kh}
+
+	for _, existing := range h.keys {
+		if existing.slot != kh.slot {
+			handlers = append(handlers, existing)
+		}
+	}
+
+	sortKeyHandlers(handlers)
+
+	h.keys = handlers
+
+	log.Printf("rotated encryption key at slot %d", kh.slot)
+
 	return nil
 }
 
-func getKeys(encryptionConfig config.Encryption, partition *gpt.Partition) ([]*encryption.Key, error) {
-	encryptionKeys := make([]*encryption.Key, len(encryptionConfig.Keys()))
+// Status returns the encryption status of the partition.
+func (h *Handler) Status() (*runtimeres.EncryptionStatusSpec, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
-	for i, cfg := range encryptionConfig.Keys() {
-		handler, err := keys.NewHandler(cfg)
+	path, err := h.partition.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := readMetadata(path)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &runtimeres.EncryptionStatusSpec{
+		Provider: h.encryptionConfig.Kind(),
+	}
+
+	if segment, ok := metadata.Segments["0"]; ok {
+		status.Cipher = segment.Encryption
+		status.SectorSize = segment.SectorSize
+	}
+
+	kinds := map[int]string{}
+
+	for _, kh := range h.keys {
+		kinds[kh.slot] = kh.kind
+	}
+
+	for id, keyslot := range metadata.Keyslots {
+		slot, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse LUKS2 key slot: %w", err)
+		}
+
+		// key size is in bytes in the LUKS2 metadata
+		status.KeySize = keyslot.KeySize * 8
+
+		kind, ok := kinds[slot]
+		if !ok {
+			kind = keyKindUnknown
+		}
+
+		status.KeySlots = append(status.KeySlots, runtimeres.EncryptionKeySlotStatus{
+			Slot: slot,
+			Type: kind,
+		})
+	}
+
+	sort.Slice(status.KeySlots, func(i, j int) bool { return status.KeySlots[i].Slot < status.KeySlots[j].Slot })
+
+	return status, nil
+}
+
+// existingKey finds the working key to authorize the key slot changes, excluding the key slot which is being changed (if any).
+func (h *Handler) existingKey(ctx context.Context, path string, tokens map[int][]byte, excludeSlot int) (*encryption.Key, error) {
+	var keyErrs *multierror.Error
+
+	for _, kh := range h.keys {
+		if kh.slot == excludeSlot {
+			continue
+		}
+
+		key, err := kh.handler.GetKey(ctx, tokens[kh.slot], keys.WithPartitionLabel(h.partition.Name))
+		if err != nil {
+			keyErrs = multierror.Append(keyErrs, fmt.Errorf("failed to get the key for slot %d: %w", kh.slot, err))
+
+			continue
+		}
+
+		k := encryption.NewKey(kh.slot, key)
+
+		valid, err := h.encryptionProvider.CheckKey(path, k)
 		if err != nil {
 			return nil, err
 		}
 
-		k, err := handler.GetKey(keys.WithPartitionLabel(partition.Name))
+		if valid {
+			return k, nil
+		}
+	}
+
+	if keyErrs != nil {
+		return nil, fmt.Errorf("no working key found: %w", keyErrs)
+	}
+
+	return nil, fmt.Errorf("no working key found")
+}
+
+const (
+	keyKindStatic  = "static"
+	keyKindNodeID  = "nodeID"
+	keyKindKMS     = "kms"
+	keyKindUnknown = "unknown"
+)
+
+func keyKind(cfg config.EncryptionKey) string {
+	switch {
+	case cfg.Static() != nil:
+		return keyKindStatic
+	case cfg.NodeID() != nil:
+		return keyKindNodeID
+	case cfg.KMS() != nil:
+		return keyKindKMS
+	}
+
+	return keyKindUnknown
+}
+
+func newKeyHandler(cfg config.EncryptionKey) (*keyHandler, error) {
+	handler, err := keys.NewHandler(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyHandler{
+		slot:    cfg.Slot(),
+		kind:    keyKind(cfg),
+		handler: handler,
+	}, nil
+}
+
+func sortKeyHandlers(handlers []*keyHandler) {
+	sort.Slice(handlers, func(i, j int) bool { return handlers[i].slot < handlers[j].slot })
+}
+
+func getKeyHandlers(encryptionConfig config.Encryption) ([]*keyHandler, error) {
+	handlers := make([]*keyHandler, len(encryptionConfig.Keys()))
+
+	for i, cfg := range encryptionConfig.Keys() {
+		handler, err := newKeyHandler(cfg)
 		if err != nil {
 			return nil, err
 		}
 
-		encryptionKeys[i] = encryption.NewKey(cfg.Slot(), k)
+		handlers[i] = handler
 	}
 
-	//nolint:scopelint
-	sort.Slice(encryptionKeys, func(i, j int) bool { return encryptionKeys[i].Slot < encryptionKeys[j].Slot })
+	sortKeyHandlers(handlers)
 
-	return encryptionKeys, nil
+	return handlers, nil
 }
@@ -6,11 +6,17 @@
 package keys
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 )
 
+// ErrKeyInvalid is returned by GetKey when the key of the existing key slot definitely can't be recovered,
+// e.g. the key slot was created by a handler of a different kind.
+var ErrKeyInvalid = errors.New("key is invalid")
+
 // NewHandler creates a new key handler depending on key handler kind.
 func NewHandler(key config.EncryptionKey) (Handler, error) {
 	switch {
@@ -23,6 +29,8 @@ func NewHandler(key config.EncryptionKey) (Handler, error) {
 		return NewStaticKeyHandler(k)
 	case key.NodeID() != nil:
 		return NewNodeIDKeyHandler()
+	case key.KMS() != nil:
+		return NewKMSKeyHandler(key.KMS().Endpoint(), GetSystemUUID)
 	}
 
 	return nil, fmt.Errorf("failed to create key handler: malformed config")
@@ -30,5 +38,10 @@ func NewHandler(key config.EncryptionKey) (Handler, error) {
 
 // Handler represents an interface for fetching encryption keys.
 type Handler interface {
-	GetKey(options ...KeyOption) ([]byte, error)
+	// NewKey generates the key for the new key slot.
+	//
+	// The token (if not nil) should be stored along with the key slot, and passed back to GetKey.
+	NewKey(ctx context.Context, options ...KeyOption) (key, token []byte, err error)
+	// GetKey returns the key for the existing key slot using the token stored along with the key slot.
+	GetKey(ctx context.Context, token []byte, options ...KeyOption) ([]byte, error)
 }
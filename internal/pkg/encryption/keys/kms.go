@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+// ErrKMSUnavailable is returned when the KMS endpoint can't be reached.
+//
+// The error is transient, so the operation might be retried.
+var ErrKMSUnavailable = errors.New("KMS is unavailable")
+
+const (
+	// kmsKeySize is the size of the random key generated by the node.
+	kmsKeySize = 32
+
+	// kmsRequestTimeout is the timeout of a single KMS request.
+	kmsRequestTimeout = 30 * time.Second
+)
+
+// KMSKeyHandler generates the random key and seals it using the remote KMS.
+//
+// The sealed key is returned as the token, the KMS is required to unseal it back.
+type KMSKeyHandler struct {
+	endpoint    string
+	tls         bool
+	getNodeUUID func() (string, error)
+}
+
+// NewKMSKeyHandler creates new KMSKeyHandler.
+//
+// The endpoint scheme selects the transport: http:// (insecure) or https:// (TLS).
+func NewKMSKeyHandler(endpoint string, getNodeUUID func() (string, error)) (*KMSKeyHandler, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse KMS endpoint: %w", err)
+	}
+
+	h := &KMSKeyHandler{
+		endpoint:    u.Host,
+		getNodeUUID: getNodeUUID,
+	}
+
+	switch u.Scheme {
+	case "http":
+		if u.Port() == "" {
+			h.endpoint = net.JoinHostPort(u.Hostname(), "80")
+		}
+	case "https":
+		h.tls = true
+
+		if u.Port() == "" {
+			h.endpoint = net.JoinHostPort(u.Hostname(), "443")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported KMS endpoint scheme %q", u.Scheme)
+	}
+
+	return h, nil
+}
+
+// NewKey implements KeyHandler interface.
+func (h *KMSKeyHandler) NewKey(ctx context.Context, options ...KeyOption) ([]byte, []byte, error) {
+	key := make([]byte, kmsKeySize)
+
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+
+	// cryptsetup reads the passphrase as a text
+	passphrase := []byte(base64.StdEncoding.EncodeToString(key))
+
+	sealed, err := h.call(ctx, func(ctx context.Context, client kms.KMSServiceClient, req *kms.Request) (*kms.Response, error) {
+		req.Data = passphrase
+
+		return client.Seal(ctx, req)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to seal the key: %w", err)
+	}
+
+	return passphrase, sealed, nil
+}
+
+// GetKey implements KeyHandler interface.
+func (h *KMSKeyHandler) GetKey(ctx context.Context, token []byte, options ...KeyOption) ([]byte, error) {
+	if len(token) == 0 {
+		return nil, fmt.Errorf("%w: sealed key is missing", ErrKeyInvalid)
+	}
+
+	key, err := h.call(ctx, func(ctx context.Context, client kms.KMSServiceClient, req *kms.Request) (*kms.Response, error) {
+		req.Data = token
+
+		return client.Unseal(ctx, req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unseal the key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (h *KMSKeyHandler) call(ctx context.Context, f func(context.Context, kms.KMSServiceClient, *kms.Request) (*kms.Response, error)) ([]byte, error) {
+	nodeUUID, err := h.getNodeUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if h.tls {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+
+	conn, err := grpc.DialContext(ctx, h.endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrKMSUnavailable, err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	ctx, cancel := context.WithTimeout(ctx, kmsRequestTimeout)
+	defer cancel()
+
+	resp, err := f(ctx, kms.NewKMSServiceClient(conn), &kms.Request{
+		NodeUuid: nodeUUID,
+	})
+	if err != nil {
+		switch status.Code(err) { //nolint:exhaustive
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return nil, fmt.Errorf("%w: %s", ErrKMSUnavailable, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/internal/pkg/encryption/keys"
+	"github.com/talos-systems/talos/internal/pkg/encryption/kms"
+	kmsapi "github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+func startKMS(t *testing.T) string {
+	server, err := kms.NewServer([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	kmsapi.RegisterKMSServiceServer(s, server)
+
+	go s.Serve(lis) //nolint:errcheck
+
+	t.Cleanup(s.Stop)
+
+	return "http://" + lis.Addr().String()
+}
+
+func nodeUUID(uuid string) func() (string, error) {
+	return func() (string, error) {
+		return uuid, nil
+	}
+}
+
+func TestKMSKeyHandler(t *testing.T) {
+	ctx := context.Background()
+	endpoint := startKMS(t)
+
+	handler, err := keys.NewKMSKeyHandler(endpoint, nodeUUID("a5f4e4a1-7a9b-4b8c-9a8e-0d4c1d2f3b4c"))
+	require.NoError(t, err)
+
+	key, token, err := handler.NewKey(ctx, keys.WithPartitionLabel("STATE"))
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, key)
+	assert.NotEmpty(t, token)
+	assert.NotContains(t, string(token), string(key))
+
+	unsealed, err := handler.GetKey(ctx, token, keys.WithPartitionLabel("STATE"))
+	require.NoError(t, err)
+
+	assert.Equal(t, key, unsealed)
+
+	// every new key is random
+	anotherKey, _, err := handler.NewKey(ctx)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, key, anotherKey)
+
+	_, err = handler.GetKey(ctx, nil)
+	assert.ErrorIs(t, err, keys.ErrKeyInvalid)
+
+	// the key sealed for one node can't be unsealed by another one
+	otherHandler, err := keys.NewKMSKeyHandler(endpoint, nodeUUID("0e2d6c4b-3f1a-4e5d-8c7b-6a9f8e7d6c5b"))
+	require.NoError(t, err)
+
+	_, err = otherHandler.GetKey(ctx, token)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, keys.ErrKMSUnavailable)
+	assert.NotErrorIs(t, err, keys.ErrKeyInvalid)
+}
+
+func TestKMSKeyHandlerUnavailable(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	endpoint := "http://" + lis.Addr().String()
+
+	require.NoError(t, lis.Close())
+
+	handler, err := keys.NewKMSKeyHandler(endpoint, nodeUUID("a5f4e4a1-7a9b-4b8c-9a8e-0d4c1d2f3b4c"))
+	require.NoError(t, err)
+
+	_, _, err = handler.NewKey(context.Background())
+	assert.ErrorIs(t, err, keys.ErrKMSUnavailable)
+
+	_, err = handler.GetKey(context.Background(), []byte("sealed"))
+	assert.ErrorIs(t, err, keys.ErrKMSUnavailable)
+}
+
+func TestNewKMSKeyHandler(t *testing.T) {
+	for _, endpoint := range []string{"https://kms.example.com", "http://192.168.88.21:4443"} {
+		_, err := keys.NewKMSKeyHandler(endpoint, keys.GetSystemUUID)
+		assert.NoError(t, err, endpoint)
+	}
+
+	for _, endpoint := range []string{"kms.example.com:443", "grpc://kms.example.com", ":::"} {
+		_, err := keys.NewKMSKeyHandler(endpoint, keys.GetSystemUUID)
+		assert.Error(t, err, endpoint)
+	}
+}
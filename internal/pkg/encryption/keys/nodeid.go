@@ -5,6 +5,7 @@
 package keys
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/talos-systems/go-smbios/smbios"
@@ -19,22 +20,39 @@ func NewNodeIDKeyHandler() (*NodeIDKeyHandler, error) {
 	return &NodeIDKeyHandler{}, nil
 }
 
+// NewKey implements KeyHandler interface.
+func (h *NodeIDKeyHandler) NewKey(ctx context.Context, options ...KeyOption) ([]byte, []byte, error) {
+	k, err := h.GetKey(ctx, nil, options...)
+
+	return k, nil, err
+}
+
 // GetKey implements KeyHandler interface.
-func (h *NodeIDKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
+func (h *NodeIDKeyHandler) GetKey(ctx context.Context, token []byte, options ...KeyOption) ([]byte, error) {
 	opts, err := NewDefaultOptions(options)
 	if err != nil {
 		return nil, err
 	}
 
-	s, err := smbios.New()
+	machineUUID, err := GetSystemUUID()
 	if err != nil {
 		return nil, err
 	}
 
+	return []byte(machineUUID + opts.PartitionLabel), nil
+}
+
+// GetSystemUUID returns the node UUID read from the SMBIOS.
+func GetSystemUUID() (string, error) {
+	s, err := smbios.New()
+	if err != nil {
+		return "", err
+	}
+
 	machineUUID := s.SystemInformation.UUID
 
 	if machineUUID == "" {
-		return nil, fmt.Errorf("machine UUID is not populated %s", machineUUID)
+		return "", fmt.Errorf("machine UUID is not populated %s", machineUUID)
 	}
 
 	// primitive entropy check
@@ -42,9 +60,9 @@ func (h *NodeIDKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
 	for _, s := range machineUUID {
 		counts[s]++
 		if counts[s] > len(machineUUID)/2 {
-			return nil, fmt.Errorf("machine UUID %s entropy check failed", machineUUID)
+			return "", fmt.Errorf("machine UUID %s entropy check failed", machineUUID)
 		}
 	}
 
-	return []byte(machineUUID + opts.PartitionLabel), nil
+	return machineUUID, nil
 }
@@ -4,15 +4,15 @@
 
 package keys
 
-// KeyOption represents key option callback used in KeyHandler.GetKey func.
+// KeyOption represents key option callback used in KeyHandler.NewKey and KeyHandler.GetKey funcs.
 type KeyOption func(o *KeyOptions) error
 
-// KeyOptions set of options to be used in KeyHandler.GetKey func.
+// KeyOptions set of options to be used in KeyHandler.NewKey and KeyHandler.GetKey funcs.
 type KeyOptions struct {
 	PartitionLabel string
 }
 
-// WithPartitionLabel passes the partition label in to GetKey function.
+// WithPartitionLabel passes the partition label in to NewKey and GetKey functions.
 func WithPartitionLabel(label string) KeyOption {
 	return func(o *KeyOptions) error {
 		o.PartitionLabel = label
@@ -4,6 +4,8 @@
 
 package keys
 
+import "context"
+
 // StaticKeyHandler just handles the static key value all the time.
 type StaticKeyHandler struct {
 	key []byte
@@ -16,7 +18,14 @@ func NewStaticKeyHandler(key []byte) (*StaticKeyHandler, error) {
 	}, nil
 }
 
+// NewKey implements KeyHandler interface.
+func (h *StaticKeyHandler) NewKey(ctx context.Context, options ...KeyOption) ([]byte, []byte, error) {
+	k, err := h.GetKey(ctx, nil, options...)
+
+	return k, nil, err
+}
+
 // GetKey implements KeyHandler interface.
-func (h *StaticKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
+func (h *StaticKeyHandler) GetKey(ctx context.Context, token []byte, options ...KeyOption) ([]byte, error) {
 	return h.key, nil
 }
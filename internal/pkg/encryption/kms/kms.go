@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kms implements the reference KMS server for the disk encryption keys.
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+// Server seals the data with AES-GCM using the master key.
+//
+// The node UUID is used as the additional data, so the sealed data
+// can only be unsealed on behalf of the same node.
+type Server struct {
+	kms.UnimplementedKMSServiceServer
+
+	aead cipher.AEAD
+}
+
+// NewServer creates new Server, the master key should be 16, 24 or 32 bytes long.
+func NewServer(masterKey []byte) (*Server, error) {
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		aead: aead,
+	}, nil
+}
+
+// Seal implements the kms.KMSServiceServer interface.
+func (s *Server) Seal(ctx context.Context, req *kms.Request) (*kms.Response, error) {
+	if req.NodeUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "node UUID is required")
+	}
+
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(req.Data)+s.aead.Overhead())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &kms.Response{
+		Data: s.aead.Seal(nonce, nonce, req.Data, []byte(req.NodeUuid)),
+	}, nil
+}
+
+// Unseal implements the kms.KMSServiceServer interface.
+func (s *Server) Unseal(ctx context.Context, req *kms.Request) (*kms.Response, error) {
+	if req.NodeUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "node UUID is required")
+	}
+
+	if len(req.Data) < s.aead.NonceSize() {
+		return nil, status.Error(codes.InvalidArgument, "sealed data is too short")
+	}
+
+	nonce, sealed := req.Data[:s.aead.NonceSize()], req.Data[s.aead.NonceSize():]
+
+	data, err := s.aead.Open(nil, nonce, sealed, []byte(req.NodeUuid))
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("failed to unseal the data: %s", err))
+	}
+
+	return &kms.Response{
+		Data: data,
+	}, nil
+}
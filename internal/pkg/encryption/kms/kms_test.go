@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kms_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/encryption/kms"
+	kmsapi "github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := kms.NewServer([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	sealed, err := server.Seal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: []byte("secret")})
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(sealed.Data), "secret")
+
+	unsealed, err := server.Unseal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: sealed.Data})
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("secret"), unsealed.Data)
+
+	_, err = server.Unseal(ctx, &kmsapi.Request{NodeUuid: "node-2", Data: sealed.Data})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.Unseal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: []byte("short")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.Seal(ctx, &kmsapi.Request{Data: []byte("secret")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = kms.NewServer([]byte("short"))
+	assert.Error(t, err)
+}
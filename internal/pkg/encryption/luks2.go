@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// luks2Magic is the magic of the LUKS2 binary header.
+var luks2Magic = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe}
+
+// luks2BinaryHeaderSize is the size of the LUKS2 binary header, JSON area starts right after it.
+const luks2BinaryHeaderSize = 4096
+
+// luks2MaxKeyslots is the maximum number of LUKS2 key slots.
+const luks2MaxKeyslots = 32
+
+// luks2Metadata is the subset of the LUKS2 header JSON area.
+type luks2Metadata struct {
+	Keyslots map[string]struct {
+		Type    string `json:"type"`
+		KeySize uint   `json:"key_size"`
+	} `json:"keyslots"`
+	Tokens   map[string]json.RawMessage `json:"tokens"`
+	Segments map[string]struct {
+		Encryption string `json:"encryption"`
+		SectorSize uint64 `json:"sector_size"`
+	} `json:"segments"`
+}
+
+// readMetadata reads the LUKS2 header JSON area.
+func readMetadata(path string) (*luks2Metadata, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var hdr struct {
+		Magic      [6]byte
+		Version    uint16
+		HeaderSize uint64
+	}
+
+	if err = binary.Read(f, binary.BigEndian, &hdr); err != nil {
+		return nil, fmt.Errorf("failed to read LUKS2 header: %w", err)
+	}
+
+	if !bytes.Equal(hdr.Magic[:], luks2Magic) || hdr.Version != 2 {
+		return nil, fmt.Errorf("%s is not a LUKS2 device", path)
+	}
+
+	if hdr.HeaderSize <= luks2BinaryHeaderSize {
+		return nil, fmt.Errorf("invalid LUKS2 header size %d", hdr.HeaderSize)
+	}
+
+	area := make([]byte, hdr.HeaderSize-luks2BinaryHeaderSize)
+
+	if _, err = f.ReadAt(area, luks2BinaryHeaderSize); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read LUKS2 JSON area: %w", err)
+	}
+
+	// JSON area is padded with zeroes
+	if i := bytes.IndexByte(area, 0); i >= 0 {
+		area = area[:i]
+	}
+
+	var metadata luks2Metadata
+
+	if err = json.Unmarshal(area, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse LUKS2 JSON area: %w", err)
+	}
+
+	return &metadata, nil
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLUKS2Header(t *testing.T, metadata string) string {
+	const headerSize = 16384
+
+	header := make([]byte, headerSize)
+
+	copy(header, luks2Magic)
+	binary.BigEndian.PutUint16(header[6:], 2)
+	binary.BigEndian.PutUint64(header[8:], headerSize)
+	copy(header[luks2BinaryHeaderSize:], metadata)
+
+	path := filepath.Join(t.TempDir(), "device")
+
+	require.NoError(t, os.WriteFile(path, header, 0o600))
+
+	return path
+}
+
+func TestReadMetadata(t *testing.T) {
+	path := writeLUKS2Header(t, `{
+  "keyslots": {
+    "0": {"type": "luks2", "key_size": 64},
+    "3": {"type": "luks2", "key_size": 64}
+  },
+  "tokens": {},
+  "segments": {
+    "0": {"type": "crypt", "offset": "16777216", "size": "dynamic", "encryption": "aes-xts-plain64", "sector_size": 4096}
+  }
+}`)
+
+	metadata, err := readMetadata(path)
+	require.NoError(t, err)
+
+	assert.Len(t, metadata.Keyslots, 2)
+	assert.Equal(t, uint(64), metadata.Keyslots["3"].KeySize)
+	assert.Equal(t, "aes-xts-plain64", metadata.Segments["0"].Encryption)
+	assert.Equal(t, uint64(4096), metadata.Segments["0"].SectorSize)
+	assert.Empty(t, metadata.Tokens)
+}
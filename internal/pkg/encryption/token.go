@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+)
+
+// tokenType is the type of the LUKS2 tokens which store the key handler data.
+const tokenType = "talos-key"
+
+// token is the LUKS2 token JSON.
+type token struct {
+	Type     string   `json:"type"`
+	Keyslots []string `json:"keyslots"`
+	Data     []byte   `json:"talosData"`
+}
+
+// readTokens reads the key handler data stored in the LUKS2 tokens.
+//
+// Result maps the key slot to the data.
+func readTokens(path string) (map[int][]byte, error) {
+	metadata, err := readMetadata(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := map[int][]byte{}
+
+	for _, raw := range metadata.Tokens {
+		var t token
+
+		if err = json.Unmarshal(raw, &t); err != nil {
+			return nil, fmt.Errorf("failed to parse LUKS2 token: %w", err)
+		}
+
+		// skip tokens created by other tools
+		if t.Type != tokenType || len(t.Keyslots) != 1 {
+			continue
+		}
+
+		slot, err := strconv.Atoi(t.Keyslots[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse LUKS2 token key slot: %w", err)
+		}
+
+		tokens[slot] = t.Data
+	}
+
+	return tokens, nil
+}
+
+// setToken stores the key handler data in the LUKS2 token assigned to the key slot.
+//
+// Token ID matches the key slot, the existing token is replaced.
+func setToken(ctx context.Context, path string, slot int, data []byte) error {
+	t, err := json.Marshal(&token{
+		Type:     tokenType,
+		Keyslots: []string{strconv.Itoa(slot)},
+		Data:     data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return cryptsetup(ctx, bytes.NewReader(t), "token", "import", "--token-id", strconv.Itoa(slot), "--token-replace", path)
+}
+
+// removeToken removes the LUKS2 token assigned to the key slot.
+func removeToken(ctx context.Context, path string, slot int) error {
+	return cryptsetup(ctx, nil, "token", "remove", "--token-id", strconv.Itoa(slot), path)
+}
+
+func cryptsetup(ctx context.Context, stdin io.Reader, args ...string) error {
+	cmd := exec.CommandContext(ctx, "cryptsetup", args...)
+	cmd.Stdin = stdin
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("cryptsetup failed: %w: %s", err, bytes.TrimSpace(output))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTokens(t *testing.T) {
+	path := writeLUKS2Header(t, `{
+  "keyslots": {"0": {"type": "luks2"}, "1": {"type": "luks2"}, "2": {"type": "luks2"}},
+  "tokens": {
+    "1": {"type": "talos-key", "keyslots": ["1"], "talosData": "c2VhbGVk"},
+    "2": {"type": "systemd-tpm2", "keyslots": ["2"], "tpm2-blob": "AAAA"}
+  }
+}`)
+
+	tokens, err := readTokens(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[int][]byte{1: []byte("sealed")}, tokens)
+
+	path = writeLUKS2Header(t, `{"keyslots": {"0": {"type": "luks2"}}, "tokens": {}}`)
+
+	tokens, err = readTokens(path)
+	require.NoError(t, err)
+
+	assert.Empty(t, tokens)
+
+	path = filepath.Join(t.TempDir(), "plain")
+	require.NoError(t, os.WriteFile(path, make([]byte, 8192), 0o600))
+
+	_, err = readTokens(path)
+	assert.ErrorContains(t, err, "is not a LUKS2 device")
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package backup implements storage for the scheduled etcd backups.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+const (
+	namePrefix = "etcd-"
+	nameSuffix = ".snapshot"
+
+	timestampFormat = "20060102T150405Z"
+)
+
+// Snapshot describes a snapshot stored at the destination.
+type Snapshot struct {
+	Name      string
+	Timestamp time.Time
+	Revision  int64
+	Size      int64
+}
+
+// Destination stores the etcd snapshots.
+type Destination interface {
+	// Shared returns true if the destination is shared by all control plane nodes.
+	Shared() bool
+	// Location returns the location of the named snapshot.
+	Location(name string) string
+	// Save stores the snapshot file at path with the name and the checksum.
+	Save(ctx context.Context, name, path, checksum string) error
+	// List returns the snapshots stored at the destination sorted by the timestamp, oldest first.
+	List(ctx context.Context) ([]Snapshot, error)
+	// Checksum returns the hex-encoded SHA256 checksum of the named snapshot.
+	Checksum(ctx context.Context, name string) (string, error)
+	// Delete removes the named snapshot.
+	Delete(ctx context.Context, name string) error
+}
+
+// NewDestination creates the Destination from the backup configuration.
+func NewDestination(cfg config.EtcdBackup) (Destination, error) {
+	if !strings.HasPrefix(cfg.Destination(), "s3://") {
+		return NewLocalDestination(cfg.Destination()), nil
+	}
+
+	u, err := url.Parse(cfg.Destination())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing backup destination: %w", err)
+	}
+
+	return NewS3Destination(u.Host, u.Path, cfg.S3())
+}
+
+// Name builds the snapshot name from the timestamp and the revision.
+func Name(timestamp time.Time, revision int64) string {
+	return namePrefix + timestamp.UTC().Format(timestampFormat) + "-" + strconv.FormatInt(revision, 10) + nameSuffix
+}
+
+// ParseName parses the snapshot name built with Name.
+func ParseName(name string) (timestamp time.Time, revision int64, ok bool) {
+	if !strings.HasPrefix(name, namePrefix) || !strings.HasSuffix(name, nameSuffix) {
+		return time.Time{}, 0, false
+	}
+
+	ts, rev, found := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(name, namePrefix), nameSuffix), "-")
+	if !found {
+		return time.Time{}, 0, false
+	}
+
+	timestamp, err := time.Parse(timestampFormat, ts)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	revision, err = strconv.ParseInt(rev, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	return timestamp, revision, true
+}
+
+// Expired returns the snapshots which should be removed to keep only the retention count of the most recent ones.
+//
+// The snapshots should be sorted oldest first.
+func Expired(snapshots []Snapshot, retention int) []Snapshot {
+	if retention <= 0 || len(snapshots) <= retention {
+		return nil
+	}
+
+	return snapshots[:len(snapshots)-retention]
+}
+
+func sortSnapshots(snapshots []Snapshot) {
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Timestamp.Before(snapshots[j].Timestamp)
+	})
+}
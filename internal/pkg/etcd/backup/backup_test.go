@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backup_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup"
+)
+
+func TestName(t *testing.T) {
+	timestamp := time.Date(2022, 6, 20, 10, 11, 12, 0, time.UTC)
+
+	name := backup.Name(timestamp, 12345)
+	assert.Equal(t, "etcd-20220620T101112Z-12345.snapshot", name)
+
+	parsedTimestamp, revision, ok := backup.ParseName(name)
+	require.True(t, ok)
+	assert.Equal(t, timestamp, parsedTimestamp)
+	assert.EqualValues(t, 12345, revision)
+
+	for _, name := range []string{
+		"etcd.snapshot",
+		"etcd-20220620T101112Z-12345.snapshot.sha256",
+		"etcd-20220620T101112Z.snapshot",
+		"etcd-20220620-12345.snapshot",
+		"etcd-20220620T101112Z-abc.snapshot",
+	} {
+		_, _, ok = backup.ParseName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	snapshots := []backup.Snapshot{
+		{Name: "a"},
+		{Name: "b"},
+		{Name: "c"},
+	}
+
+	assert.Nil(t, backup.Expired(snapshots, 0))
+	assert.Nil(t, backup.Expired(snapshots, 3))
+	assert.Nil(t, backup.Expired(snapshots, 5))
+	assert.Equal(t, []backup.Snapshot{{Name: "a"}}, backup.Expired(snapshots, 2))
+	assert.Equal(t, []backup.Snapshot{{Name: "a"}, {Name: "b"}}, backup.Expired(snapshots, 1))
+}
+
+func TestLocalDestination(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	dest := backup.NewLocalDestination(filepath.Join(dir, "backups"))
+	assert.False(t, dest.Shared())
+
+	snapshots, err := dest.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+
+	src := filepath.Join(dir, "staging")
+	require.NoError(t, os.WriteFile(src, []byte("snapshot"), 0o600))
+
+	newer := backup.Name(time.Date(2022, 6, 20, 12, 0, 0, 0, time.UTC), 200)
+	older := backup.Name(time.Date(2022, 6, 20, 6, 0, 0, 0, time.UTC), 100)
+
+	require.NoError(t, dest.Save(ctx, newer, src, "abcd"))
+	require.NoError(t, dest.Save(ctx, older, src, "ef01"))
+
+	snapshots, err = dest.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+
+	assert.Equal(t, older, snapshots[0].Name)
+	assert.EqualValues(t, 100, snapshots[0].Revision)
+	assert.EqualValues(t, 8, snapshots[0].Size)
+	assert.Equal(t, newer, snapshots[1].Name)
+
+	assert.Equal(t, filepath.Join(dir, "backups", newer), dest.Location(newer))
+
+	checksum, err := dest.Checksum(ctx, older)
+	require.NoError(t, err)
+	assert.Equal(t, "ef01", checksum)
+
+	require.NoError(t, dest.Delete(ctx, older))
+
+	snapshots, err = dest.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, newer, snapshots[0].Name)
+
+	_, err = os.Stat(filepath.Join(dir, "backups", older+".sha256"))
+	assert.True(t, os.IsNotExist(err))
+}
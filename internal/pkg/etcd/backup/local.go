@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const checksumSuffix = ".sha256"
+
+// LocalDestination stores the snapshots in a local directory.
+//
+// The directory is local to the node, so each control plane node keeps its own set of snapshots,
+// which is not a replacement for the cluster-wide backup.
+// Checksums are stored next to the snapshots in the sha256sum format.
+type LocalDestination struct {
+	dir string
+}
+
+// NewLocalDestination creates a LocalDestination.
+func NewLocalDestination(dir string) *LocalDestination {
+	return &LocalDestination{
+		dir: dir,
+	}
+}
+
+// Shared implements Destination.
+func (d *LocalDestination) Shared() bool {
+	return false
+}
+
+// Location implements Destination.
+func (d *LocalDestination) Location(name string) string {
+	return filepath.Join(d.dir, name)
+}
+
+// Save implements Destination.
+func (d *LocalDestination) Save(ctx context.Context, name, path, checksum string) error {
+	if err := os.MkdirAll(d.dir, 0o700); err != nil {
+		return err
+	}
+
+	if err := copyFile(path, d.Location(name)); err != nil {
+		return fmt.Errorf("error saving snapshot: %w", err)
+	}
+
+	return os.WriteFile(d.Location(name)+checksumSuffix, []byte(checksum+"  "+name+"\n"), 0o600)
+}
+
+// List implements Destination.
+func (d *LocalDestination) List(ctx context.Context) ([]Snapshot, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		timestamp, revision, ok := ParseName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		snapshots = append(snapshots, Snapshot{
+			Name:      entry.Name(),
+			Timestamp: timestamp,
+			Revision:  revision,
+			Size:      info.Size(),
+		})
+	}
+
+	sortSnapshots(snapshots)
+
+	return snapshots, nil
+}
+
+// Checksum implements Destination.
+func (d *LocalDestination) Checksum(ctx context.Context, name string) (string, error) {
+	contents, err := os.ReadFile(d.Location(name) + checksumSuffix)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, _, _ := strings.Cut(string(contents), " ")
+
+	return checksum, nil
+}
+
+// Delete implements Destination.
+func (d *LocalDestination) Delete(ctx context.Context, name string) error {
+	for _, path := range []string{d.Location(name), d.Location(name) + checksumSuffix} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyFile copies src to dst via a temporary file, so that dst never contains a partial snapshot.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	tmp := dst + ".part"
+
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp) //nolint:errcheck
+
+	defer out.Close() //nolint:errcheck
+
+	if _, err = io.Copy(out, in); err != nil {
+		return err
+	}
+
+	if err = out.Sync(); err != nil {
+		return err
+	}
+
+	if err = out.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, dst)
+}
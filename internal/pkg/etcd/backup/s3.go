@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// checksumMetadataKey is the object metadata key holding the snapshot checksum.
+//
+// S3 canonicalizes the metadata keys, so the key is stored capitalized.
+const checksumMetadataKey = "Sha256"
+
+// S3Destination stores the snapshots in an S3-compatible bucket.
+//
+// Checksums are stored in the object metadata.
+type S3Destination struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+
+	bucket string
+	prefix string
+}
+
+// NewS3Destination creates a S3Destination.
+func NewS3Destination(bucket, prefix string, cfg config.EtcdBackupS3) (*S3Destination, error) {
+	awsConfig := &aws.Config{
+		Region:      aws.String(cfg.Region()),
+		Credentials: credentials.NewStaticCredentials(cfg.AccessKeyID(), cfg.SecretAccessKey(), ""),
+	}
+
+	if cfg.Endpoint() != "" {
+		// S3-compatible storage usually doesn't support virtual-hosted style requests
+		awsConfig.Endpoint = aws.String(cfg.Endpoint())
+		awsConfig.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating AWS session: %w", err)
+	}
+
+	client := s3.New(sess)
+
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	return &S3Destination{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   bucket,
+		prefix:   prefix,
+	}, nil
+}
+
+// Shared implements Destination.
+func (d *S3Destination) Shared() bool {
+	return true
+}
+
+// Location implements Destination.
+func (d *S3Destination) Location(name string) string {
+	return "s3://" + path.Join(d.bucket, d.prefix+name)
+}
+
+// Save implements Destination.
+func (d *S3Destination) Save(ctx context.Context, name, src, checksum string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	_, err = d.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.prefix + name),
+		Body:   f,
+		Metadata: map[string]*string{
+			checksumMetadataKey: aws.String(checksum),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// List implements Destination.
+func (d *S3Destination) List(ctx context.Context) ([]Snapshot, error) {
+	var snapshots []Snapshot
+
+	err := d.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(d.bucket),
+		Prefix: aws.String(d.prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			name := strings.TrimPrefix(aws.StringValue(object.Key), d.prefix)
+
+			timestamp, revision, ok := ParseName(name)
+			if !ok {
+				continue
+			}
+
+			snapshots = append(snapshots, Snapshot{
+				Name:      name,
+				Timestamp: timestamp,
+				Revision:  revision,
+				Size:      aws.Int64Value(object.Size),
+			})
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing snapshots: %w", err)
+	}
+
+	sortSnapshots(snapshots)
+
+	return snapshots, nil
+}
+
+// Checksum implements Destination.
+func (d *S3Destination) Checksum(ctx context.Context, name string) (string, error) {
+	out, err := d.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.prefix + name),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(out.Metadata[checksumMetadataKey]), nil
+}
+
+// Delete implements Destination.
+func (d *S3Destination) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(d.prefix + name),
+	})
+
+	return err
+}
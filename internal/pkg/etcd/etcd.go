@@ -6,10 +6,14 @@ package etcd
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -289,3 +293,118 @@ func (c *Client) ForfeitLeadership(ctx context.Context) (string, error) {
 
 	return "", nil
 }
+
+// LocalStatus returns the status of the etcd member the client is connected to.
+//
+// The client should be created with NewLocalClient.
+func (c *Client) LocalStatus(ctx context.Context) (*clientv3.StatusResponse, error) {
+	return c.Status(ctx, c.Endpoints()[0])
+}
+
+// DefragmentLocal defragments the backend database of the etcd member the client is connected to.
+//
+// Defragmentation blocks the member from serving requests, so it should be run on one member at a time.
+// The client should be created with NewLocalClient.
+func (c *Client) DefragmentLocal(ctx context.Context) error {
+	_, err := c.Defragment(ctx, c.Endpoints()[0])
+
+	return err
+}
+
+// IsLocalLeader returns true if the etcd member the client is connected to is the cluster leader.
+//
+// The client should be created with NewLocalClient.
+func (c *Client) IsLocalLeader(ctx context.Context) (bool, error) {
+	status, err := c.LocalStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return status.Leader == status.Header.MemberId, nil
+}
+
+// SnapshotInfo describes the snapshot written with WriteSnapshot.
+type SnapshotInfo struct {
+	// Revision is the revision of the etcd member when the snapshot was started.
+	Revision int64
+	// Size is the size of the snapshot in bytes.
+	Size int64
+	// SHA256 is the hex-encoded SHA256 checksum of the snapshot.
+	SHA256 string
+}
+
+// WriteSnapshot streams the snapshot of the etcd member the client is connected to into w.
+//
+// The client should be created with NewLocalClient.
+func (c *Client) WriteSnapshot(ctx context.Context, w io.Writer) (SnapshotInfo, error) {
+	status, err := c.LocalStatus(ctx)
+	if err != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed to get etcd status: %w", err)
+	}
+
+	rd, err := c.Snapshot(ctx)
+	if err != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed reading etcd snapshot: %w", err)
+	}
+
+	defer rd.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(w, hash), rd)
+	if err != nil {
+		return SnapshotInfo{}, fmt.Errorf("failed writing etcd snapshot: %w", err)
+	}
+
+	return SnapshotInfo{
+		Revision: status.Header.Revision,
+		Size:     size,
+		SHA256:   hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// SaveSnapshot saves the snapshot of the etcd member the client is connected to into the file at path.
+//
+// The client should be created with NewLocalClient.
+func (c *Client) SaveSnapshot(ctx context.Context, path string) (SnapshotInfo, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	info, err := c.WriteSnapshot(ctx, f)
+	if err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	if err = f.Sync(); err != nil {
+		return SnapshotInfo{}, err
+	}
+
+	return info, f.Close()
+}
+
+// DefaultQuotaBackendBytes is the backend database size quota etcd uses when it is not configured explicitly.
+const DefaultQuotaBackendBytes = 2 * 1024 * 1024 * 1024
+
+// QuotaBackendBytes returns the backend database size quota as configured via etcd extra args.
+func QuotaBackendBytes(extraArgs map[string]string) (int64, error) {
+	value, ok := extraArgs["quota-backend-bytes"]
+	if !ok {
+		return DefaultQuotaBackendBytes, nil
+	}
+
+	quota, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing quota-backend-bytes %q: %w", value, err)
+	}
+
+	// etcd uses the default quota if the value is not positive
+	if quota <= 0 {
+		return DefaultQuotaBackendBytes, nil
+	}
+
+	return quota, nil
+}
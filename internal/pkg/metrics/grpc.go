@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RequestDuration tracks the latencies of the gRPC requests.
+type RequestDuration struct {
+	histogram *prometheus.HistogramVec
+}
+
+// NewRequestDuration creates and registers the gRPC request latency histogram for the component.
+func NewRequestDuration(component string) *RequestDuration {
+	rd := &RequestDuration{
+		histogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Subsystem: component,
+				Name:      "grpc_request_duration_seconds",
+				Help:      "Latency of the gRPC requests.",
+				Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+			},
+			[]string{"method", "code"},
+		),
+	}
+
+	prometheus.MustRegister(rd.histogram)
+
+	return rd
+}
+
+func (rd *RequestDuration) observe(method string, start time.Time, err error) {
+	rd.histogram.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (rd *RequestDuration) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		rd.observe(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (rd *RequestDuration) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		rd.observe(info.FullMethod, start, err)
+
+		return err
+	}
+}
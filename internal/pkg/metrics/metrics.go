@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package metrics implements the Prometheus metrics endpoints of Talos components.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Namespace is the common prefix of the Talos metrics.
+const Namespace = "talos"
+
+// Path is the HTTP path the metrics are served on.
+const Path = "/metrics"
+
+// client doesn't use the proxy settings from the environment, metrics endpoints are always local.
+var client = &http.Client{
+	Transport: &http.Transport{},
+}
+
+// Address returns the address of the metrics endpoint for the bind address and the port.
+func Address(bindAddress string, port int) string {
+	return net.JoinHostPort(bindAddress, strconv.Itoa(port))
+}
+
+// LocalAddress returns the address to reach the metrics endpoint from the node itself.
+//
+// Unspecified bind address is replaced with the loopback one.
+func LocalAddress(bindAddress string, port int) string {
+	if ip := net.ParseIP(bindAddress); ip != nil && ip.IsUnspecified() {
+		if ip.To4() != nil {
+			bindAddress = "127.0.0.1"
+		} else {
+			bindAddress = "::1"
+		}
+	}
+
+	return Address(bindAddress, port)
+}
+
+// ListenRetryInterval is the interval between the attempts to listen on the metrics endpoint address.
+const ListenRetryInterval = 5 * time.Second
+
+// ListenAndServe serves the metrics of the default registry on the address until the context is canceled.
+//
+// The bind address might not be assigned to the node yet, so listening is retried until it succeeds.
+func ListenAndServe(ctx context.Context, address string, logf func(format string, v ...interface{})) error {
+	var (
+		listener net.Listener
+		err      error
+	)
+
+	for {
+		listener, err = net.Listen("tcp", address)
+		if err == nil {
+			break
+		}
+
+		logf("failed to listen on metrics address %q, retrying: %s", address, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(ListenRetryInterval):
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, promhttp.Handler())
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+
+	if err = srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err = <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Gather returns the metrics of the default registry in the Prometheus text format.
+func Gather() (string, error) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	for _, family := range families {
+		if _, err = expfmt.MetricFamilyToText(&buf, family); err != nil {
+			return "", err
+		}
+	}
+
+	return buf.String(), nil
+}
+
+// Fetch fetches the metrics in the Prometheus text format from the endpoint at the address.
+func Fetch(ctx context.Context, address string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+Path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Accept", string(expfmt.FmtText))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+)
+
+func TestLocalAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		bindAddress string
+		expected    string
+	}{
+		{"127.0.0.1", "127.0.0.1:50010"},
+		{"10.5.0.2", "10.5.0.2:50010"},
+		{"0.0.0.0", "127.0.0.1:50010"},
+		{"::", "[::1]:50010"},
+		{"fd00::1", "[fd00::1]:50010"},
+	} {
+		assert.Equal(t, tt.expected, metrics.LocalAddress(tt.bindAddress, 50010), tt.bindAddress)
+	}
+}
+
+func TestServe(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rd := metrics.NewRequestDuration("test")
+
+	_, err := rd.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.TestService/Get"},
+		func(context.Context, interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+	)
+	require.Error(t, err)
+
+	text, err := metrics.Gather()
+	require.NoError(t, err)
+
+	assert.Contains(t, text, `talos_test_grpc_request_duration_seconds_count{code="NotFound",method="/test.TestService/Get"} 1`)
+
+	// pick a free port
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := listener.Addr().String()
+
+	require.NoError(t, listener.Close())
+
+	serveCtx, serveCancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- metrics.ListenAndServe(serveCtx, address, t.Logf)
+	}()
+
+	require.Eventually(t, func() bool {
+		text, err = metrics.Fetch(ctx, address)
+
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.Contains(t, text, "talos_test_grpc_request_duration_seconds_count")
+
+	serveCancel()
+
+	require.NoError(t, <-errCh)
+}
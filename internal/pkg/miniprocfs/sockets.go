@@ -0,0 +1,366 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package miniprocfs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// Socket states as reported by the kernel in /proc/net/tcp.
+var tcpStates = map[uint64]string{
+	0x01: "ESTABLISHED",
+	0x02: "SYN_SENT",
+	0x03: "SYN_RECV",
+	0x04: "FIN_WAIT1",
+	0x05: "FIN_WAIT2",
+	0x06: "TIME_WAIT",
+	0x07: "CLOSE",
+	0x08: "CLOSE_WAIT",
+	0x09: "LAST_ACK",
+	0x0a: "LISTEN",
+	0x0b: "CLOSING",
+	0x0c: "NEW_SYN_RECV",
+}
+
+// Socket states.
+const (
+	SocketStateListen      = "LISTEN"
+	SocketStateEstablished = "ESTABLISHED"
+	SocketStateUnconnected = "UNCONN"
+)
+
+const (
+	unixStateConnected = 0x03
+	unixFlagAcceptCon  = 0x10000
+)
+
+var socketFiles = map[machine.SocketInfo_Protocol]string{
+	machine.SocketInfo_TCP:  "tcp",
+	machine.SocketInfo_TCP6: "tcp6",
+	machine.SocketInfo_UDP:  "udp",
+	machine.SocketInfo_UDP6: "udp6",
+	machine.SocketInfo_RAW:  "raw",
+	machine.SocketInfo_RAW6: "raw6",
+	machine.SocketInfo_UNIX: "unix",
+}
+
+// Sockets reads the sockets of the protocol from the net directory of the procfs, e.g. /proc/net or /proc/<pid>/net.
+func Sockets(netPath string, protocol machine.SocketInfo_Protocol) ([]*machine.SocketInfo, error) {
+	name, ok := socketFiles[protocol]
+	if !ok {
+		return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	}
+
+	f, err := os.Open(filepath.Join(netPath, name))
+	if err != nil {
+		// protocol might be disabled in the kernel (e.g. IPv6)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var sockets []*machine.SocketInfo
+
+	scanner := bufio.NewScanner(f)
+
+	// skip the header
+	scanner.Scan()
+
+	for scanner.Scan() {
+		var info *machine.SocketInfo
+
+		if protocol == machine.SocketInfo_UNIX {
+			info, err = parseUnixSocket(scanner.Text())
+		} else {
+			info, err = parseInetSocket(scanner.Text(), protocol)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", f.Name(), err)
+		}
+
+		info.Protocol = protocol
+
+		sockets = append(sockets, info)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sockets, f.Close()
+}
+
+// IsListening returns true if the socket is listening for the connections (or bound, but not connected for datagram sockets).
+func IsListening(info *machine.SocketInfo) bool {
+	return info.State == SocketStateListen || info.State == SocketStateUnconnected
+}
+
+// parseInetSocket parses the line of /proc/net/{tcp,udp,raw}[6]:
+//
+//	sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+//	0: 0100007F:0277 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 12345 ...
+func parseInetSocket(line string, protocol machine.SocketInfo_Protocol) (*machine.SocketInfo, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 10 {
+		return nil, fmt.Errorf("unexpected format %q", line)
+	}
+
+	localAddress, localPort, err := parseInetAddress(fields[1])
+	if err != nil {
+		return nil, err
+	}
+
+	remoteAddress, remotePort, err := parseInetAddress(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := strconv.ParseUint(fields[3], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	state := tcpStates[st]
+
+	if protocol != machine.SocketInfo_TCP && protocol != machine.SocketInfo_TCP6 && state == "CLOSE" {
+		state = SocketStateUnconnected
+	}
+
+	txQueue, rxQueue, ok := strings.Cut(fields[4], ":")
+	if !ok {
+		return nil, fmt.Errorf("unexpected queue format %q", fields[4])
+	}
+
+	info := &machine.SocketInfo{
+		LocalAddress:  localAddress,
+		LocalPort:     localPort,
+		RemoteAddress: remoteAddress,
+		RemotePort:    remotePort,
+		State:         state,
+	}
+
+	if info.TxQueue, err = strconv.ParseUint(txQueue, 16, 64); err != nil {
+		return nil, err
+	}
+
+	if info.RxQueue, err = strconv.ParseUint(rxQueue, 16, 64); err != nil {
+		return nil, err
+	}
+
+	uid, err := strconv.ParseUint(fields[7], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	info.Uid = uint32(uid)
+
+	if info.Inode, err = strconv.ParseUint(fields[9], 10, 64); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// parseInetAddress parses the address in the hex format, the address is stored as 32-bit words in the host byte order.
+func parseInetAddress(s string) (string, uint32, error) {
+	addr, port, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", 0, fmt.Errorf("unexpected address format %q", s)
+	}
+
+	b, err := hex.DecodeString(addr)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if len(b) != net.IPv4len && len(b) != net.IPv6len {
+		return "", 0, fmt.Errorf("unexpected address length %q", s)
+	}
+
+	ip := make(net.IP, len(b))
+
+	for i := 0; i < len(b); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.LittleEndian.Uint32(b[i:]))
+	}
+
+	p, err := strconv.ParseUint(port, 16, 16)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return ip.String(), uint32(p), nil
+}
+
+// parseUnixSocket parses the line of /proc/net/unix:
+//
+//	Num       RefCount Protocol Flags    Type St Inode Path
+//	0000000000000000: 00000002 00000000 00010000 0001 01 12345 /run/containerd/containerd.sock
+func parseUnixSocket(line string) (*machine.SocketInfo, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 7 {
+		return nil, fmt.Errorf("unexpected format %q", line)
+	}
+
+	flags, err := strconv.ParseUint(fields[3], 16, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := strconv.ParseUint(fields[5], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &machine.SocketInfo{
+		State: SocketStateUnconnected,
+	}
+
+	switch {
+	case flags&unixFlagAcceptCon != 0:
+		info.State = SocketStateListen
+	case st == unixStateConnected:
+		info.State = SocketStateEstablished
+	}
+
+	if info.Inode, err = strconv.ParseUint(fields[6], 10, 64); err != nil {
+		return nil, err
+	}
+
+	if len(fields) > 7 {
+		info.Path = fields[7]
+	}
+
+	return info, nil
+}
+
+// SocketOwner is the process owning the socket.
+type SocketOwner struct {
+	Pid     int32
+	Command string
+}
+
+// SocketOwners maps the socket inodes to the processes which have the sockets open.
+//
+// If the socket is shared by several processes, the process with the lowest PID is returned.
+func SocketOwners(rootPath string) (map[uint64]SocketOwner, error) {
+	owners := map[uint64]SocketOwner{}
+
+	err := walkPids(rootPath, func(pid int32, path string) {
+		fds, err := os.ReadDir(filepath.Join(path, "fd"))
+		if err != nil {
+			// process exited or is not accessible
+			return
+		}
+
+		var command string
+
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(path, "fd", fd.Name()))
+			if err != nil {
+				continue
+			}
+
+			inode, ok := parseNsLink(link, "socket")
+			if !ok {
+				continue
+			}
+
+			if owner, exists := owners[inode]; exists && owner.Pid < pid {
+				continue
+			}
+
+			if command == "" {
+				comm, err := os.ReadFile(filepath.Join(path, "comm"))
+				if err != nil {
+					return
+				}
+
+				command = string(bytes.TrimSpace(comm))
+			}
+
+			owners[inode] = SocketOwner{
+				Pid:     pid,
+				Command: command,
+			}
+		}
+	})
+
+	return owners, err
+}
+
+// NetNamespaces returns the network namespaces of the processes other than the network namespace of PID 1.
+//
+// The result maps the network namespace (e.g. "net:[4026532288]") to the lowest PID of the processes in it.
+func NetNamespaces(rootPath string) (map[string]int32, error) {
+	hostNetNS, err := os.Readlink(filepath.Join(rootPath, "1", "ns", "net"))
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := map[string]int32{}
+
+	err = walkPids(rootPath, func(pid int32, path string) {
+		netNS, err := os.Readlink(filepath.Join(path, "ns", "net"))
+		if err != nil || netNS == hostNetNS {
+			return
+		}
+
+		if existing, ok := namespaces[netNS]; ok && existing < pid {
+			return
+		}
+
+		namespaces[netNS] = pid
+	})
+
+	return namespaces, err
+}
+
+func walkPids(rootPath string, f func(pid int32, path string)) error {
+	entries, err := os.ReadDir(rootPath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		pid, err := strconv.ParseInt(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		f(int32(pid), filepath.Join(rootPath, entry.Name()))
+	}
+
+	return nil
+}
+
+// parseNsLink parses the links like "socket:[12345]".
+func parseNsLink(link, kind string) (uint64, bool) {
+	if !strings.HasPrefix(link, kind+":[") || !strings.HasSuffix(link, "]") {
+		return 0, false
+	}
+
+	inode, err := strconv.ParseUint(link[len(kind)+2:len(link)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return inode, true
+}
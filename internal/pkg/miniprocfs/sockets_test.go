@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package miniprocfs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/miniprocfs"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestSockets(t *testing.T) {
+	for _, tt := range []struct {
+		protocol machine.SocketInfo_Protocol
+		expected []*machine.SocketInfo
+	}{
+		{
+			protocol: machine.SocketInfo_TCP,
+			expected: []*machine.SocketInfo{
+				{
+					Protocol:      machine.SocketInfo_TCP,
+					LocalAddress:  "0.0.0.0",
+					LocalPort:     50051,
+					RemoteAddress: "0.0.0.0",
+					State:         "LISTEN",
+					Inode:         21010,
+				},
+				{
+					Protocol:      machine.SocketInfo_TCP,
+					LocalAddress:  "10.5.0.2",
+					LocalPort:     50051,
+					RemoteAddress: "10.5.0.3",
+					RemotePort:    54000,
+					State:         "ESTABLISHED",
+					RxQueue:       16,
+					Inode:         21344,
+				},
+			},
+		},
+		{
+			protocol: machine.SocketInfo_TCP6,
+			expected: []*machine.SocketInfo{
+				{
+					Protocol:      machine.SocketInfo_TCP6,
+					LocalAddress:  "::",
+					LocalPort:     6443,
+					RemoteAddress: "::",
+					State:         "LISTEN",
+					Inode:         21011,
+				},
+				{
+					Protocol:      machine.SocketInfo_TCP6,
+					LocalAddress:  "fd00::2",
+					LocalPort:     6443,
+					RemoteAddress: "fd00::3",
+					RemotePort:    50000,
+					State:         "TIME_WAIT",
+				},
+			},
+		},
+		{
+			protocol: machine.SocketInfo_UDP,
+			expected: []*machine.SocketInfo{
+				{
+					Protocol:      machine.SocketInfo_UDP,
+					LocalAddress:  "0.0.0.0",
+					LocalPort:     51820,
+					RemoteAddress: "0.0.0.0",
+					State:         "UNCONN",
+					Inode:         22011,
+				},
+			},
+		},
+		{
+			protocol: machine.SocketInfo_UNIX,
+			expected: []*machine.SocketInfo{
+				{
+					Protocol: machine.SocketInfo_UNIX,
+					State:    "LISTEN",
+					Inode:    23011,
+					Path:     "/system/run/machined/machine.sock",
+				},
+				{
+					Protocol: machine.SocketInfo_UNIX,
+					State:    "ESTABLISHED",
+					Inode:    23012,
+				},
+				{
+					Protocol: machine.SocketInfo_UNIX,
+					State:    "UNCONN",
+					Inode:    23013,
+					Path:     "@/containerd-shim/abcdef.sock",
+				},
+			},
+		},
+		{
+			protocol: machine.SocketInfo_RAW,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.protocol.String(), func(t *testing.T) {
+			sockets, err := miniprocfs.Sockets("testdata/sockets/net", tt.protocol)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, sockets)
+		})
+	}
+}
+
+func TestSocketOwners(t *testing.T) {
+	owners, err := miniprocfs.SocketOwners("testdata/sockets")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[uint64]miniprocfs.SocketOwner{
+		21010: {Pid: 1, Command: "machined"},
+		23011: {Pid: 1, Command: "machined"},
+		21344: {Pid: 4242, Command: "coredns"},
+	}, owners)
+}
+
+func TestNetNamespaces(t *testing.T) {
+	namespaces, err := miniprocfs.NetNamespaces("testdata/sockets")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int32{
+		"net:[4026532288]": 4242,
+	}, namespaces)
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+const (
+	// ext4SuperblockOffset is the offset of the ext2/3/4 superblock on the device.
+	ext4SuperblockOffset = 1024
+	// ext4MagicOffset is the offset of the magic in the superblock.
+	ext4MagicOffset = 0x38
+	ext4Magic       = 0xef53
+)
+
+// probeExt4 checks whether the device has the ext2/3/4 superblock.
+//
+// The filesystem probe doesn't detect ext4, so it is checked separately to never reformat an existing filesystem.
+func probeExt4(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var magic [2]byte
+
+	if _, err = f.ReadAt(magic[:], ext4SuperblockOffset+ext4MagicOffset); err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return binary.LittleEndian.Uint16(magic[:]) == ext4Magic, nil
+}
@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeExt4(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disk")
+
+	// too small to hold the superblock
+	require.NoError(t, os.WriteFile(path, make([]byte, 512), 0o600))
+
+	isExt4, err := probeExt4(path)
+	require.NoError(t, err)
+	assert.False(t, isExt4)
+
+	image := make([]byte, 4096)
+
+	require.NoError(t, os.WriteFile(path, image, 0o600))
+
+	isExt4, err = probeExt4(path)
+	require.NoError(t, err)
+	assert.False(t, isExt4)
+
+	image[ext4SuperblockOffset+ext4MagicOffset] = 0x53
+	image[ext4SuperblockOffset+ext4MagicOffset+1] = 0xef
+
+	require.NoError(t, os.WriteFile(path, image, 0o600))
+
+	isExt4, err = probeExt4(path)
+	require.NoError(t, err)
+	assert.True(t, isExt4)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/go-retry/retry"
 	"golang.org/x/sys/unix"
 
+	"github.com/talos-systems/talos/internal/pkg/encryption"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/makefs"
 )
@@ -176,6 +177,8 @@ type Point struct {
 	flags  uintptr
 	data   string
 	*Options
+
+	encryptionHandler *encryption.Handler
 }
 
 // PointMap represents a unique set of mount points.
@@ -387,10 +390,17 @@ func (p *Point) ResizePartition() (resized bool, err error) {
 }
 
 // GrowFilesystem grows a partition's filesystem to the maximum size allowed.
-// NB: An XFS partition MUST be mounted, or this will fail.
+// NB: An XFS partition MUST be mounted, or this will fail, ext4 is grown both online and offline.
 func (p *Point) GrowFilesystem() (err error) {
-	if err = makefs.XFSGrow(p.Target()); err != nil {
-		return fmt.Errorf("xfs_growfs: %w", err)
+	switch p.Fstype() {
+	case makefs.FilesystemTypeExt4:
+		if err = makefs.Ext4Resize(p.Source()); err != nil {
+			return fmt.Errorf("resize2fs: %w", err)
+		}
+	default:
+		if err = makefs.XFSGrow(p.Target()); err != nil {
+			return fmt.Errorf("xfs_growfs: %w", err)
+		}
 	}
 
 	return nil
@@ -402,8 +412,15 @@ func (p *Point) Repair() error {
 		p.Logger.Printf("filesystem on %s needs cleaning, running repair", p.Source())
 	}
 
-	if err := makefs.XFSRepair(p.Source(), p.Fstype()); err != nil {
-		return fmt.Errorf("xfs_repair: %w", err)
+	switch p.Fstype() {
+	case makefs.FilesystemTypeExt4:
+		if err := makefs.Ext4Repair(p.Source(), p.Fstype()); err != nil {
+			return fmt.Errorf("e2fsck: %w", err)
+		}
+	default:
+		if err := makefs.XFSRepair(p.Source(), p.Fstype()); err != nil {
+			return fmt.Errorf("xfs_repair: %w", err)
+		}
 	}
 
 	if p.Logger != nil {
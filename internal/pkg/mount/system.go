@@ -12,9 +12,11 @@ import (
 	"os"
 	"sync"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/talos-systems/go-blockdevice/blockdevice"
 	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem"
+	"github.com/talos-systems/go-blockdevice/blockdevice/partition/gpt"
 	"golang.org/x/sys/unix"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
@@ -60,7 +62,6 @@ func SystemMountPointsForDevice(devpath string, opts ...Option) (mountpoints *Po
 }
 
 // SystemMountPointForLabel returns a mount point for the specified device and label.
-//nolint:gocyclo
 func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opts ...Option) (mountpoint *Point, err error) {
 	var target string
 
@@ -91,6 +92,14 @@ func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opt
 		return nil, fmt.Errorf("failed to find device with label %s: %w", label, err)
 	}
 
+	return partitionMountPoint(device, part, target, partition.NewFormatOptions(part.Name), opts...)
+}
+
+// partitionMountPoint returns a mount point for the partition which opens the encryption and formats the partition
+// using the format options before mounting.
+//
+//nolint:gocyclo
+func partitionMountPoint(device *blockdevice.BlockDevice, part *gpt.Partition, target string, formatOptions *partition.FormatOptions, opts ...Option) (mountpoint *Point, err error) {
 	fsType, err := part.Filesystem()
 	if err != nil {
 		return nil, err
@@ -122,11 +131,12 @@ func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opt
 					path string
 				)
 
-				if path, err = encryptionHandler.Open(); err != nil {
+				if path, err = encryptionHandler.Open(context.Background()); err != nil {
 					return err
 				}
 
 				p.source = path
+				p.encryptionHandler = encryptionHandler
 
 				return nil
 			},
@@ -158,15 +168,25 @@ func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opt
 			return nil
 		}
 
-		opts := partition.NewFormatOptions(part.Name)
-		if opts == nil {
+		isExt4, err := probeExt4(p.source)
+		if err != nil {
+			return err
+		}
+
+		if isExt4 {
+			p.fstype = partition.FilesystemTypeExt4
+
+			return nil
+		}
+
+		if formatOptions == nil {
 			return fmt.Errorf("failed to determine format options for partition label %s", part.Name)
 		}
 
 		if !o.MountFlags.Check(SkipIfNoFilesystem) {
-			p.fstype = opts.FileSystemType
+			p.fstype = formatOptions.FileSystemType
 
-			return partition.Format(p.source, opts)
+			return partition.Format(p.source, formatOptions)
 		}
 
 		return nil
@@ -234,6 +254,18 @@ func SystemPartitionMount(r runtime.Runtime, logger *log.Logger, label string, o
 		return fmt.Errorf("error creating mount status resource: %w", err)
 	}
 
+	if mountpoint.encryptionHandler != nil {
+		var encryptionStatus *runtimeres.EncryptionStatus
+
+		if encryptionStatus, err = newEncryptionStatus(mountpoint.encryptionHandler, label); err != nil {
+			return err
+		}
+
+		if err = r.State().V1Alpha2().Resources().Create(context.Background(), encryptionStatus); err != nil && !state.IsConflictError(err) {
+			return fmt.Errorf("error creating encryption status resource: %w", err)
+		}
+	}
+
 	mountpointsMutex.Lock()
 	defer mountpointsMutex.Unlock()
 
@@ -265,9 +297,67 @@ func SystemPartitionUnmount(r runtime.Runtime, logger *log.Logger, label string)
 		return fmt.Errorf("error destroying mount status resource: %w", err)
 	}
 
+	if mountpoint.encryptionHandler != nil {
+		err = r.State().V1Alpha2().Resources().Destroy(context.Background(), runtimeres.NewEncryptionStatus(v1alpha1.NamespaceName, label).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying encryption status resource: %w", err)
+		}
+	}
+
 	mountpointsMutex.Lock()
 	delete(mountpoints, label)
 	mountpointsMutex.Unlock()
 
 	return nil
 }
+
+// SystemPartitionEncryption returns the encryption handler of the mounted system partition.
+//
+// If the partition is not mounted or not encrypted, nil is returned.
+func SystemPartitionEncryption(label string) *encryption.Handler {
+	mountpointsMutex.RLock()
+	defer mountpointsMutex.RUnlock()
+
+	mountpoint, ok := mountpoints[label]
+	if !ok {
+		return nil
+	}
+
+	return mountpoint.encryptionHandler
+}
+
+// UpdateEncryptionStatus refreshes the encryption status resource of the mounted system partition.
+func UpdateEncryptionStatus(ctx context.Context, r runtime.Runtime, label string) error {
+	handler := SystemPartitionEncryption(label)
+	if handler == nil {
+		return fmt.Errorf("partition %s is not mounted or not encrypted", label)
+	}
+
+	encryptionStatus, err := newEncryptionStatus(handler, label)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.State().V1Alpha2().Resources().UpdateWithConflicts(ctx, encryptionStatus.Metadata(), func(res resource.Resource) error {
+		*res.(*runtimeres.EncryptionStatus).TypedSpec() = *encryptionStatus.TypedSpec()
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating encryption status resource: %w", err)
+	}
+
+	return nil
+}
+
+func newEncryptionStatus(handler *encryption.Handler, label string) (*runtimeres.EncryptionStatus, error) {
+	spec, err := handler.Status()
+	if err != nil {
+		return nil, fmt.Errorf("error reading encryption status: %w", err)
+	}
+
+	encryptionStatus := runtimeres.NewEncryptionStatus(v1alpha1.NamespaceName, label)
+	*encryptionStatus.TypedSpec() = *spec
+
+	return encryptionStatus, nil
+}
@@ -0,0 +1,372 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/talos-systems/go-blockdevice/blockdevice"
+	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem"
+	"github.com/talos-systems/go-blockdevice/blockdevice/partition/gpt"
+
+	"github.com/talos-systems/talos/internal/pkg/partition"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// UserVolumeStatus describes the mounted user volume.
+type UserVolumeStatus struct {
+	Partition  string
+	Size       uint64
+	Filesystem string
+	MountPoint string
+	Encrypted  bool
+}
+
+type userVolume struct {
+	disk     string
+	diskSize uint64
+	point    *Point
+	status   UserVolumeStatus
+}
+
+var (
+	userVolumes       = map[string]*userVolume{}
+	userVolumesMutex  sync.Mutex
+	userVolumesClosed bool
+)
+
+// UserVolumePartitionLabel returns the partition label of the user volume.
+func UserVolumePartitionLabel(name string) string {
+	return constants.UserVolumePartitionLabelPrefix + name
+}
+
+// UserVolumeMount creates the partition of the user volume on the disk if it doesn't exist, opens the encryption,
+// formats the partition if it has no filesystem and mounts it.
+//
+// If the volume is already mounted, the partition and the filesystem are grown when the disk was resized,
+// and the volume is remounted if the mountpoint was changed.
+func UserVolumeMount(logger *log.Logger, devpath string, volume config.UserVolume) (*UserVolumeStatus, error) {
+	userVolumesMutex.Lock()
+	defer userVolumesMutex.Unlock()
+
+	if userVolumesClosed {
+		return nil, fmt.Errorf("user volumes are unmounted for shutdown")
+	}
+
+	label := UserVolumePartitionLabel(volume.Name())
+
+	if v, ok := userVolumes[label]; ok {
+		if v.disk == devpath && v.status.MountPoint == volume.MountPoint() && v.status.Filesystem == volume.Filesystem() {
+			if err := v.grow(logger, volume); err != nil {
+				return nil, err
+			}
+
+			status := v.status
+
+			return &status, nil
+		}
+
+		if err := unmountUserVolume(label); err != nil {
+			return nil, err
+		}
+	}
+
+	v, err := mountUserVolume(logger, devpath, label, volume)
+	if err != nil {
+		return nil, err
+	}
+
+	userVolumes[label] = v
+
+	status := v.status
+
+	return &status, nil
+}
+
+// UserVolumeUnmount unmounts the user volume by the name.
+//
+// The partition and the data on it are kept.
+func UserVolumeUnmount(name string) error {
+	userVolumesMutex.Lock()
+	defer userVolumesMutex.Unlock()
+
+	return unmountUserVolume(UserVolumePartitionLabel(name))
+}
+
+// UserVolumeUnmountAll unmounts all user volumes and prevents them from being mounted again.
+func UserVolumeUnmountAll() error {
+	userVolumesMutex.Lock()
+	defer userVolumesMutex.Unlock()
+
+	userVolumesClosed = true
+
+	var result *multierror.Error
+
+	for label := range userVolumes {
+		if err := unmountUserVolume(label); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func unmountUserVolume(label string) error {
+	v, ok := userVolumes[label]
+	if !ok {
+		return nil
+	}
+
+	if err := v.point.Unmount(); err != nil {
+		return fmt.Errorf("error unmounting volume %s: %w", label, err)
+	}
+
+	delete(userVolumes, label)
+
+	return nil
+}
+
+//nolint:gocyclo
+func mountUserVolume(logger *log.Logger, devpath, label string, volume config.UserVolume) (*userVolume, error) {
+	bd, err := blockdevice.Open(devpath, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return nil, fmt.Errorf("error opening block device %q: %w", devpath, err)
+	}
+
+	//nolint:errcheck
+	defer bd.Close()
+
+	part, err := userVolumePartition(logger, bd, devpath, label, volume.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	partPath, err := part.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []Option{WithLogger(logger)}
+
+	if volume.Encryption() != nil {
+		opts = append(opts, WithEncryptionConfig(volume.Encryption()))
+	}
+
+	formatOptions := &partition.FormatOptions{
+		PartitionType:  partition.LinuxFilesystemData,
+		FileSystemType: volume.Filesystem(),
+		Force:          true,
+	}
+
+	mountpoint, err := partitionMountPoint(bd, part, volume.MountPoint(), formatOptions, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = mountMountpoint(mountpoint); err != nil {
+		// close the encryption if it was opened
+		return nil, multierror.Append(err, mountpoint.Unmount()).ErrorOrNil()
+	}
+
+	if mountpoint.Fstype() != volume.Filesystem() {
+		return nil, multierror.Append(
+			fmt.Errorf("partition %s has filesystem %q, but the volume filesystem is %q", partPath, mountpoint.Fstype(), volume.Filesystem()),
+			mountpoint.Unmount(),
+		).ErrorOrNil()
+	}
+
+	v := &userVolume{
+		disk:  devpath,
+		point: mountpoint,
+		status: UserVolumeStatus{
+			Partition:  partPath,
+			Filesystem: mountpoint.Fstype(),
+			MountPoint: mountpoint.Target(),
+			Encrypted:  mountpoint.encryptionHandler != nil,
+		},
+	}
+
+	// the partition might have been grown above, so make sure the filesystem matches it
+	if volume.Size() == 0 {
+		if err = mountpoint.GrowFilesystem(); err != nil {
+			return nil, err
+		}
+	}
+
+	if v.status.Size, err = blockDeviceSize(partPath); err != nil {
+		return nil, err
+	}
+
+	if v.diskSize, err = blockDeviceSize(devpath); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// userVolumePartition finds or creates the partition of the user volume.
+//
+// The disk should be either empty or have only the user volume partitions, so that the system disks
+// and the disks used for anything else are never repartitioned.
+// If the volume size is not set, the partition is grown to the end of the free space on the disk.
+//
+//nolint:gocyclo,cyclop
+func userVolumePartition(logger *log.Logger, bd *blockdevice.BlockDevice, devpath, label string, size uint64) (*gpt.Partition, error) {
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		if !errors.Is(err, blockdevice.ErrMissingPartitionTable) {
+			return nil, err
+		}
+
+		// don't destroy the data on the disk which is used without a partition table
+		sb, probeErr := filesystem.Probe(devpath)
+		if probeErr != nil {
+			return nil, probeErr
+		}
+
+		if sb != nil && sb.Type() != filesystem.Unknown {
+			return nil, fmt.Errorf("disk %s has no partition table, but has %q filesystem", devpath, sb.Type())
+		}
+
+		logger.Printf("creating new partition table on %s", devpath)
+
+		if pt, err = gpt.New(bd.Device()); err != nil {
+			return nil, err
+		}
+
+		if err = pt.Write(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range pt.Partitions().Items() {
+		if !strings.HasPrefix(p.Name, constants.UserVolumePartitionLabelPrefix) {
+			return nil, fmt.Errorf("disk %s has partition %q which is not a user volume", devpath, p.Name)
+		}
+	}
+
+	part := pt.Partitions().FindByName(label)
+
+	if part == nil {
+		opts := []gpt.PartitionOption{
+			gpt.WithPartitionType(partition.LinuxFilesystemData),
+			gpt.WithPartitionName(label),
+		}
+
+		if size == 0 {
+			opts = append(opts, gpt.WithMaximumSize(true))
+		}
+
+		if part, err = pt.InsertAt(len(pt.Partitions().Items()), size, opts...); err != nil {
+			return nil, fmt.Errorf("error creating partition %s on %s: %w", label, devpath, err)
+		}
+
+		if err = pt.Write(); err != nil {
+			return nil, err
+		}
+
+		logger.Printf("created partition %s on %s, size %d blocks", label, devpath, part.Length())
+
+		return part, nil
+	}
+
+	if size != 0 {
+		return part, nil
+	}
+
+	if err = pt.Repair(); err != nil {
+		return nil, err
+	}
+
+	resized, err := pt.Resize(part)
+	if err != nil {
+		return nil, fmt.Errorf("error resizing partition %s on %s: %w", label, devpath, err)
+	}
+
+	if resized {
+		if err = pt.Write(); err != nil {
+			return nil, err
+		}
+
+		logger.Printf("resized partition %s on %s to %d blocks", label, devpath, part.Length())
+	}
+
+	return part, nil
+}
+
+// grow resizes the partition and the filesystem of the mounted volume if the disk was resized.
+//
+// Encrypted volumes are grown on the next mount, as the size of the opened encrypted mapping is fixed.
+func (v *userVolume) grow(logger *log.Logger, volume config.UserVolume) error {
+	if volume.Size() != 0 || v.status.Encrypted {
+		return nil
+	}
+
+	size, err := blockDeviceSize(v.disk)
+	if err != nil {
+		return err
+	}
+
+	if size == v.diskSize {
+		return nil
+	}
+
+	logger.Printf("disk %s was resized, growing volume %s", v.disk, volume.Name())
+
+	bd, err := blockdevice.Open(v.disk, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return fmt.Errorf("error opening block device %q: %w", v.disk, err)
+	}
+
+	//nolint:errcheck
+	defer bd.Close()
+
+	part, err := userVolumePartition(logger, bd, v.disk, UserVolumePartitionLabel(volume.Name()), 0)
+	if err != nil {
+		return err
+	}
+
+	if err = v.point.GrowFilesystem(); err != nil {
+		return err
+	}
+
+	partPath, err := part.Path()
+	if err != nil {
+		return err
+	}
+
+	if v.status.Size, err = blockDeviceSize(partPath); err != nil {
+		return err
+	}
+
+	v.diskSize = size
+
+	return nil
+}
+
+// blockDeviceSize returns the size of the disk or the partition in bytes as reported by the kernel.
+func blockDeviceSize(devpath string) (uint64, error) {
+	contents, err := os.ReadFile(filepath.Join("/sys/class/block", filepath.Base(devpath), "size"))
+	if err != nil {
+		return 0, err
+	}
+
+	sectors, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	// the size is always reported in 512-byte sectors
+	return sectors * 512, nil
+}
@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/talos-systems/talos/internal/pkg/metrics"
+)
+
+var (
+	clockOffsetGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "ntp",
+		Name:      "clock_offset_seconds",
+		Help:      "Clock offset reported by the last NTP response.",
+	})
+
+	roundTripTimeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "ntp",
+		Name:      "round_trip_time_seconds",
+		Help:      "Round trip time of the last NTP query.",
+	})
+
+	pollIntervalGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "ntp",
+		Name:      "poll_interval_seconds",
+		Help:      "Current interval between the NTP queries.",
+	})
+
+	spikesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "ntp",
+		Name:      "spikes_total",
+		Help:      "Number of the NTP responses ignored as spikes.",
+	})
+)
@@ -231,6 +231,13 @@ func (syncer *Syncer) Run(ctx context.Context) {
 
 		if resp != nil && resp.Validate() == nil {
 			spike = syncer.spikeDetector(resp)
+
+			clockOffsetGauge.Set(resp.ClockOffset.Seconds())
+			roundTripTimeGauge.Set(resp.RTT.Seconds())
+
+			if spike {
+				spikesCounter.Inc()
+			}
 		}
 
 		switch {
@@ -262,6 +269,8 @@ func (syncer *Syncer) Run(ctx context.Context) {
 			pollInterval = syncer.MinPoll
 		}
 
+		pollIntervalGauge.Set(pollInterval.Seconds())
+
 		syncer.logger.Debug("sample stats",
 			zap.Duration("jitter", time.Duration(syncer.samplesJitter*float64(time.Second))),
 			zap.Duration("poll_interval", pollInterval),
@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package pcap implements packet capture in the pcap format and compilation of the capture filters.
+package pcap
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mdlayher/packet"
+	"golang.org/x/net/bpf"
+)
+
+// DefaultSnapLen is the default snap length of the capture.
+const DefaultSnapLen = 65536
+
+// maxPacketLen is the size of the read buffer, larger packets are truncated.
+const maxPacketLen = 262144
+
+// LinkType is the pcap link-layer header type.
+type LinkType uint32
+
+// LinkType constants.
+const (
+	LinkTypeEthernet LinkType = 1
+	LinkTypeRaw      LinkType = 101
+)
+
+// ARPHRD_* constants of the interface types and ETH_P_ALL protocol from the Linux headers.
+const (
+	arphrdEther    = 1
+	arphrdLoopback = 772
+	arphrdNone     = 65534
+
+	ethPAll = 0x0003
+)
+
+// LinkTypeOf returns the link-layer header type of the packets captured on the interface with the ARPHRD_* type.
+func LinkTypeOf(arphrd uint16) (LinkType, error) {
+	switch arphrd {
+	case arphrdEther, arphrdLoopback:
+		return LinkTypeEthernet, nil
+	case arphrdNone:
+		return LinkTypeRaw, nil
+	default:
+		return 0, fmt.Errorf("unsupported link type %d", arphrd)
+	}
+}
+
+// Options of the packet capture.
+type Options struct {
+	Interface   string
+	Promiscuous bool
+	SnapLen     int
+	Filter      []bpf.RawInstruction
+}
+
+// Capture captures the packets on the interface and writes them to w in the pcap format.
+//
+// Capture returns when the context is canceled.
+func Capture(ctx context.Context, w io.Writer, opts Options) error {
+	iface, err := net.InterfaceByName(opts.Interface)
+	if err != nil {
+		return fmt.Errorf("error looking up interface: %w", err)
+	}
+
+	linkType, err := interfaceLinkType(iface.Name)
+	if err != nil {
+		return err
+	}
+
+	snapLen := opts.SnapLen
+	if snapLen <= 0 || snapLen > maxPacketLen {
+		snapLen = DefaultSnapLen
+	}
+
+	conn, err := packet.Listen(iface, packet.Raw, ethPAll, &packet.Config{
+		Filter: opts.Filter,
+	})
+	if err != nil {
+		return fmt.Errorf("error opening packet socket: %w", err)
+	}
+
+	//nolint:errcheck
+	defer conn.Close()
+
+	if opts.Promiscuous {
+		if err = conn.SetPromiscuous(true); err != nil {
+			return fmt.Errorf("error enabling promiscuous mode: %w", err)
+		}
+	}
+
+	if err = writeFileHeader(w, snapLen, linkType); err != nil {
+		return err
+	}
+
+	// unblock the read below on cancel
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetReadDeadline(time.Now()) //nolint:errcheck
+		case <-stopCh:
+		}
+	}()
+
+	buf := make([]byte, maxPacketLen)
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil && errors.Is(err, os.ErrDeadlineExceeded) {
+				return nil
+			}
+
+			return fmt.Errorf("error reading packet: %w", err)
+		}
+
+		if err = writePacket(w, time.Now(), buf[:n], snapLen); err != nil {
+			return err
+		}
+	}
+}
+
+func interfaceLinkType(name string) (LinkType, error) {
+	contents, err := os.ReadFile(filepath.Join("/sys/class/net", name, "type"))
+	if err != nil {
+		return 0, fmt.Errorf("error reading interface type: %w", err)
+	}
+
+	arphrd, err := strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing interface type: %w", err)
+	}
+
+	return LinkTypeOf(uint16(arphrd))
+}
+
+// writeFileHeader writes the pcap file header with microsecond timestamps.
+func writeFileHeader(w io.Writer, snapLen int, linkType LinkType) error {
+	var hdr [24]byte
+
+	binary.LittleEndian.PutUint32(hdr[0:4], 0xa1b2c3d4)
+	binary.LittleEndian.PutUint16(hdr[4:6], 2)
+	binary.LittleEndian.PutUint16(hdr[6:8], 4)
+	binary.LittleEndian.PutUint32(hdr[16:20], uint32(snapLen))
+	binary.LittleEndian.PutUint32(hdr[20:24], uint32(linkType))
+
+	_, err := w.Write(hdr[:])
+
+	return err
+}
+
+// writePacket writes the packet record truncated to the snap length.
+func writePacket(w io.Writer, timestamp time.Time, data []byte, snapLen int) error {
+	captured := data
+	if len(captured) > snapLen {
+		captured = captured[:snapLen]
+	}
+
+	record := make([]byte, 16+len(captured))
+
+	binary.LittleEndian.PutUint32(record[0:4], uint32(timestamp.Unix()))
+	binary.LittleEndian.PutUint32(record[4:8], uint32(timestamp.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(record[8:12], uint32(len(captured)))
+	binary.LittleEndian.PutUint32(record[12:16], uint32(len(data)))
+	copy(record[16:], captured)
+
+	_, err := w.Write(record)
+
+	return err
+}
@@ -0,0 +1,665 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/bpf"
+	"inet.af/netaddr"
+)
+
+// IP protocol numbers.
+const (
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+)
+
+// EtherType values.
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeIPv6 = 0x86dd
+)
+
+// Compile compiles the filter expression into the BPF program for the packets with the link-layer header type.
+//
+// Compile supports a subset of the pcap-filter(7) syntax:
+//
+//	ip | ip6 | arp | tcp | udp | icmp | icmp6
+//	[ip | ip6] [src | dst] host <address>
+//	[ip | ip6] [src | dst] net <address>/<bits>
+//	[tcp | udp] [src | dst] port <port>
+//
+// Primitives are combined with `and` (`&&`), `or` (`||`), `not` (`!`) and parentheses.
+// Empty expression compiles to an empty program, which accepts all packets.
+func Compile(expr string, linkType LinkType) ([]bpf.RawInstruction, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	var nl uint32
+
+	switch linkType {
+	case LinkTypeEthernet:
+		nl = 14
+	case LinkTypeRaw:
+		nl = 0
+	default:
+		return nil, fmt.Errorf("unsupported link type %d", linkType)
+	}
+
+	p := &parser{
+		tokens:   tokens,
+		linkType: linkType,
+		nl:       nl,
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+
+	c := &compiler{}
+
+	accept, reject := c.newLabel(), c.newLabel()
+
+	c.compile(root, accept, reject)
+
+	c.place(accept)
+	c.emit(instruction{ins: bpf.RetConstant{Val: maxPacketLen}})
+
+	c.place(reject)
+	c.emit(instruction{ins: bpf.RetConstant{Val: 0}})
+
+	program, err := c.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	return bpf.Assemble(program)
+}
+
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(expr); {
+		ch := rune(expr[i])
+
+		switch {
+		case unicode.IsSpace(ch):
+			i++
+		case ch == '(' || ch == ')':
+			tokens = append(tokens, string(ch))
+			i++
+		case strings.HasPrefix(expr[i:], "&&") || strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, expr[i:i+2])
+			i += 2
+		case ch == '!':
+			tokens = append(tokens, "!")
+			i++
+		case unicode.IsLetter(ch) || unicode.IsDigit(ch) || strings.ContainsRune(".:/-_", ch):
+			j := i
+
+			for j < len(expr) && (unicode.IsLetter(rune(expr[j])) || unicode.IsDigit(rune(expr[j])) || strings.ContainsRune(".:/-_", rune(expr[j]))) {
+				j++
+			}
+
+			tokens = append(tokens, expr[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q", ch)
+		}
+	}
+
+	return tokens, nil
+}
+
+// node is a node of the filter expression tree.
+type node interface{}
+
+type andNode struct {
+	left, right node
+}
+
+type orNode struct {
+	left, right node
+}
+
+type notNode struct {
+	node node
+}
+
+// constNode always matches or never matches.
+type constNode bool
+
+// testNode loads a value into the accumulator and compares it.
+type testNode struct {
+	loads []bpf.Instruction
+	cond  bpf.JumpTest
+	val   uint32
+}
+
+func and(nodes ...node) node {
+	result := nodes[0]
+
+	for _, n := range nodes[1:] {
+		result = andNode{result, n}
+	}
+
+	return result
+}
+
+func or(nodes ...node) node {
+	result := nodes[0]
+
+	for _, n := range nodes[1:] {
+		result = orNode{result, n}
+	}
+
+	return result
+}
+
+func load(off, size uint32, cond bpf.JumpTest, val uint32) testNode {
+	return testNode{
+		loads: []bpf.Instruction{bpf.LoadAbsolute{Off: off, Size: int(size)}},
+		cond:  cond,
+		val:   val,
+	}
+}
+
+func loadMasked(off, mask, val uint32) node {
+	switch mask {
+	case 0:
+		return constNode(true)
+	case 0xffffffff:
+		return load(off, 4, bpf.JumpEqual, val)
+	default:
+		return testNode{
+			loads: []bpf.Instruction{
+				bpf.LoadAbsolute{Off: off, Size: 4},
+				bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: mask},
+			},
+			cond: bpf.JumpEqual,
+			val:  val & mask,
+		}
+	}
+}
+
+type direction int
+
+const (
+	dirAny direction = iota
+	dirSrc
+	dirDst
+)
+
+func (d direction) match(src, dst node) node {
+	switch d {
+	case dirSrc:
+		return src
+	case dirDst:
+		return dst
+	default:
+		return or(src, dst)
+	}
+}
+
+type parser struct {
+	tokens   []string
+	pos      int
+	linkType LinkType
+	nl       uint32 // offset of the network layer header
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *parser) next() string {
+	token := p.peek()
+
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+
+	return token
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "or" || p.peek() == "||" {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "and" || p.peek() == "&&" {
+		p.next()
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	switch p.peek() {
+	case "not", "!":
+		p.next()
+
+		n, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{n}, nil
+	case "(":
+		p.next()
+
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if token := p.next(); token != ")" {
+			return nil, fmt.Errorf("expected \")\", got %q", token)
+		}
+
+		return n, nil
+	default:
+		return p.parsePrimitive()
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (p *parser) parsePrimitive() (node, error) {
+	var proto string
+
+	switch p.peek() {
+	case "ip", "ip6", "arp", "tcp", "udp", "icmp", "icmp6":
+		proto = p.next()
+	}
+
+	dir := dirAny
+
+	switch p.peek() {
+	case "src":
+		dir = dirSrc
+
+		p.next()
+	case "dst":
+		dir = dirDst
+
+		p.next()
+	}
+
+	switch keyword := p.peek(); keyword {
+	case "host", "net":
+		p.next()
+
+		if proto != "" && proto != "ip" && proto != "ip6" {
+			return nil, fmt.Errorf("%q can't be used with %q", proto, keyword)
+		}
+
+		return p.parseAddress(keyword, proto, dir, p.next())
+	case "port":
+		p.next()
+
+		if proto != "" && proto != "tcp" && proto != "udp" {
+			return nil, fmt.Errorf("%q can't be used with %q", proto, keyword)
+		}
+
+		return p.parsePort(proto, dir, p.next())
+	}
+
+	if dir != dirAny {
+		return nil, fmt.Errorf("expected \"host\", \"net\" or \"port\", got %q", p.peek())
+	}
+
+	switch proto {
+	case "ip":
+		return p.isIPv4(), nil
+	case "ip6":
+		return p.isIPv6(), nil
+	case "arp":
+		return p.isARP(), nil
+	case "tcp":
+		return or(p.ipv4Proto(protoTCP), p.ipv6Proto(protoTCP)), nil
+	case "udp":
+		return or(p.ipv4Proto(protoUDP), p.ipv6Proto(protoUDP)), nil
+	case "icmp":
+		return p.ipv4Proto(protoICMP), nil
+	case "icmp6":
+		return p.ipv6Proto(protoICMPv6), nil
+	case "":
+		if p.peek() == "" {
+			return nil, fmt.Errorf("unexpected end of the expression")
+		}
+
+		return nil, fmt.Errorf("unexpected %q", p.peek())
+	}
+
+	panic("unreachable")
+}
+
+func (p *parser) parseAddress(keyword, proto string, dir direction, value string) (node, error) {
+	var (
+		prefix netaddr.IPPrefix
+		err    error
+	)
+
+	if keyword == "host" {
+		var ip netaddr.IP
+
+		ip, err = netaddr.ParseIP(value)
+		if err == nil {
+			prefix = netaddr.IPPrefixFrom(ip, ip.BitLen())
+		}
+	} else {
+		prefix, err = netaddr.ParseIPPrefix(value)
+		prefix = prefix.Masked()
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", keyword, value, err)
+	}
+
+	if prefix.IP().Is4() {
+		if proto == "ip6" {
+			return nil, fmt.Errorf("%q is not an IPv6 address", value)
+		}
+
+		addr := prefix.IP().As4()
+		mask := ^uint32(0) << (32 - prefix.Bits())
+		val := binary.BigEndian.Uint32(addr[:])
+
+		return and(
+			p.isIPv4(),
+			dir.match(
+				loadMasked(p.nl+12, mask, val),
+				loadMasked(p.nl+16, mask, val),
+			),
+		), nil
+	}
+
+	if proto == "ip" {
+		return nil, fmt.Errorf("%q is not an IPv4 address", value)
+	}
+
+	addr := prefix.IP().As16()
+
+	match := func(off uint32) node {
+		var words []node
+
+		for i := 0; i < 4; i++ {
+			bits := int(prefix.Bits()) - i*32
+
+			var mask uint32
+
+			switch {
+			case bits >= 32:
+				mask = 0xffffffff
+			case bits > 0:
+				mask = ^uint32(0) << (32 - bits)
+			}
+
+			if mask == 0 {
+				break
+			}
+
+			words = append(words, loadMasked(off+uint32(i)*4, mask, binary.BigEndian.Uint32(addr[i*4:])))
+		}
+
+		if len(words) == 0 {
+			return constNode(true)
+		}
+
+		return and(words...)
+	}
+
+	return and(
+		p.isIPv6(),
+		dir.match(match(p.nl+8), match(p.nl+24)),
+	), nil
+}
+
+func (p *parser) parsePort(proto string, dir direction, value string) (node, error) {
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q", value)
+	}
+
+	protos := []uint32{protoTCP, protoUDP}
+
+	switch proto {
+	case "tcp":
+		protos = []uint32{protoTCP}
+	case "udp":
+		protos = []uint32{protoUDP}
+	}
+
+	var ipv4Protos, ipv6Protos []node
+
+	for _, ipProto := range protos {
+		ipv4Protos = append(ipv4Protos, load(p.nl+9, 1, bpf.JumpEqual, ipProto))
+		ipv6Protos = append(ipv6Protos, load(p.nl+6, 1, bpf.JumpEqual, ipProto))
+	}
+
+	// IPv4 header length is variable, X is loaded with the header length
+	ipv4Port := func(off uint32) node {
+		return testNode{
+			loads: []bpf.Instruction{
+				bpf.LoadMemShift{Off: p.nl},
+				bpf.LoadIndirect{Off: p.nl + off, Size: 2},
+			},
+			cond: bpf.JumpEqual,
+			val:  uint32(port),
+		}
+	}
+
+	// IPv6 extension headers are not supported
+	ipv6Port := func(off uint32) node {
+		return load(p.nl+40+off, 2, bpf.JumpEqual, uint32(port))
+	}
+
+	return or(
+		and(
+			p.isIPv4(),
+			or(ipv4Protos...),
+			load(p.nl+6, 2, bpf.JumpBitsNotSet, 0x1fff), // not a fragment
+			dir.match(ipv4Port(0), ipv4Port(2)),
+		),
+		and(
+			p.isIPv6(),
+			or(ipv6Protos...),
+			dir.match(ipv6Port(0), ipv6Port(2)),
+		),
+	), nil
+}
+
+func (p *parser) etherType(etherType uint32) node {
+	return load(12, 2, bpf.JumpEqual, etherType)
+}
+
+// ipVersion matches the version of the IP header for the links without the link-layer header.
+func (p *parser) ipVersion(version uint32) node {
+	return testNode{
+		loads: []bpf.Instruction{
+			bpf.LoadAbsolute{Off: 0, Size: 1},
+			bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: 0xf0},
+		},
+		cond: bpf.JumpEqual,
+		val:  version << 4,
+	}
+}
+
+func (p *parser) isIPv4() node {
+	if p.linkType == LinkTypeRaw {
+		return p.ipVersion(4)
+	}
+
+	return p.etherType(etherTypeIPv4)
+}
+
+func (p *parser) isIPv6() node {
+	if p.linkType == LinkTypeRaw {
+		return p.ipVersion(6)
+	}
+
+	return p.etherType(etherTypeIPv6)
+}
+
+func (p *parser) isARP() node {
+	if p.linkType == LinkTypeRaw {
+		return constNode(false)
+	}
+
+	return p.etherType(etherTypeARP)
+}
+
+func (p *parser) ipv4Proto(proto uint32) node {
+	return and(p.isIPv4(), load(p.nl+9, 1, bpf.JumpEqual, proto))
+}
+
+func (p *parser) ipv6Proto(proto uint32) node {
+	return and(p.isIPv6(), load(p.nl+6, 1, bpf.JumpEqual, proto))
+}
+
+type label int
+
+// instruction is either a regular instruction, or a jump to the labels.
+type instruction struct {
+	ins bpf.Instruction
+
+	// conditional jump
+	jump   bool
+	cond   bpf.JumpTest
+	val    uint32
+	jt, jf label
+
+	// unconditional jump to jt
+	ja bool
+}
+
+type compiler struct {
+	program []instruction
+	labels  []int
+}
+
+func (c *compiler) newLabel() label {
+	c.labels = append(c.labels, -1)
+
+	return label(len(c.labels) - 1)
+}
+
+func (c *compiler) place(l label) {
+	c.labels[l] = len(c.program)
+}
+
+func (c *compiler) emit(ins instruction) {
+	c.program = append(c.program, ins)
+}
+
+// compile emits the code which jumps to t if the node matches, and to f otherwise.
+func (c *compiler) compile(n node, t, f label) {
+	switch n := n.(type) {
+	case andNode:
+		next := c.newLabel()
+
+		c.compile(n.left, next, f)
+		c.place(next)
+		c.compile(n.right, t, f)
+	case orNode:
+		next := c.newLabel()
+
+		c.compile(n.left, t, next)
+		c.place(next)
+		c.compile(n.right, t, f)
+	case notNode:
+		c.compile(n.node, f, t)
+	case constNode:
+		target := f
+		if n {
+			target = t
+		}
+
+		c.emit(instruction{ja: true, jt: target})
+	case testNode:
+		for _, ins := range n.loads {
+			c.emit(instruction{ins: ins})
+		}
+
+		c.emit(instruction{jump: true, cond: n.cond, val: n.val, jt: t, jf: f})
+	default:
+		panic(fmt.Sprintf("unexpected node %T", n))
+	}
+}
+
+// resolve converts the labels into the jump offsets.
+func (c *compiler) resolve() ([]bpf.Instruction, error) {
+	program := make([]bpf.Instruction, len(c.program))
+
+	for i, ins := range c.program {
+		switch {
+		case ins.jump:
+			skipTrue, skipFalse := c.labels[ins.jt]-i-1, c.labels[ins.jf]-i-1
+
+			if skipTrue > 255 || skipFalse > 255 {
+				return nil, fmt.Errorf("filter expression is too complex")
+			}
+
+			program[i] = bpf.JumpIf{Cond: ins.cond, Val: ins.val, SkipTrue: uint8(skipTrue), SkipFalse: uint8(skipFalse)}
+		case ins.ja:
+			program[i] = bpf.Jump{Skip: uint32(c.labels[ins.jt] - i - 1)}
+		default:
+			program[i] = ins.ins
+		}
+	}
+
+	return program, nil
+}
@@ -0,0 +1,273 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pcap_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/bpf"
+
+	"github.com/talos-systems/talos/internal/pkg/pcap"
+)
+
+func serialize(t *testing.T, linkType pcap.LinkType, l ...gopacket.SerializableLayer) []byte {
+	t.Helper()
+
+	if linkType == pcap.LinkTypeEthernet {
+		etherType := layers.EthernetTypeIPv4
+
+		switch l[0].(type) {
+		case *layers.IPv6:
+			etherType = layers.EthernetTypeIPv6
+		case *layers.ARP:
+			etherType = layers.EthernetTypeARP
+		}
+
+		l = append([]gopacket.SerializableLayer{
+			&layers.Ethernet{
+				SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+				DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+				EthernetType: etherType,
+			},
+		}, l...)
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true}, l...))
+
+	return buf.Bytes()
+}
+
+func ipv4(src, dst string, proto layers.IPProtocol) *layers.IPv4 {
+	return &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: proto,
+		SrcIP:    net.ParseIP(src).To4(),
+		DstIP:    net.ParseIP(dst).To4(),
+	}
+}
+
+func ipv6(src, dst string, proto layers.IPProtocol) *layers.IPv6 {
+	return &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: proto,
+		SrcIP:      net.ParseIP(src),
+		DstIP:      net.ParseIP(dst),
+	}
+}
+
+//nolint:maintidx
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	type packet struct {
+		name    string
+		layers  []gopacket.SerializableLayer
+		matches bool
+	}
+
+	tcp4 := func(src, dst string, srcPort, dstPort layers.TCPPort) []gopacket.SerializableLayer {
+		return []gopacket.SerializableLayer{ipv4(src, dst, layers.IPProtocolTCP), &layers.TCP{SrcPort: srcPort, DstPort: dstPort, SYN: true}}
+	}
+
+	udp4 := func(src, dst string, srcPort, dstPort layers.UDPPort) []gopacket.SerializableLayer {
+		return []gopacket.SerializableLayer{ipv4(src, dst, layers.IPProtocolUDP), &layers.UDP{SrcPort: srcPort, DstPort: dstPort}}
+	}
+
+	tcp6 := func(src, dst string, srcPort, dstPort layers.TCPPort) []gopacket.SerializableLayer {
+		return []gopacket.SerializableLayer{ipv6(src, dst, layers.IPProtocolTCP), &layers.TCP{SrcPort: srcPort, DstPort: dstPort, SYN: true}}
+	}
+
+	icmp4 := func(src, dst string) []gopacket.SerializableLayer {
+		return []gopacket.SerializableLayer{ipv4(src, dst, layers.IPProtocolICMPv4), &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0)}}
+	}
+
+	arp := []gopacket.SerializableLayer{
+		&layers.ARP{
+			AddrType:          layers.LinkTypeEthernet,
+			Protocol:          layers.EthernetTypeIPv4,
+			HwAddressSize:     6,
+			ProtAddressSize:   4,
+			Operation:         layers.ARPRequest,
+			SourceHwAddress:   []byte{0, 1, 2, 3, 4, 5},
+			SourceProtAddress: []byte{10, 5, 0, 2},
+			DstHwAddress:      []byte{0, 0, 0, 0, 0, 0},
+			DstProtAddress:    []byte{10, 5, 0, 3},
+		},
+	}
+
+	for _, tt := range []struct {
+		expr    string
+		packets []packet
+	}{
+		{
+			expr: "tcp",
+			packets: []packet{
+				{"tcp4", tcp4("10.5.0.2", "10.5.0.3", 50000, 6443), true},
+				{"tcp6", tcp6("fd00::2", "fd00::3", 50000, 6443), true},
+				{"udp4", udp4("10.5.0.2", "10.5.0.3", 50000, 53), false},
+				{"arp", arp, false},
+			},
+		},
+		{
+			expr: "icmp or arp",
+			packets: []packet{
+				{"icmp4", icmp4("10.5.0.2", "10.5.0.3"), true},
+				{"arp", arp, true},
+				{"tcp4", tcp4("10.5.0.2", "10.5.0.3", 50000, 6443), false},
+			},
+		},
+		{
+			expr: "host 10.5.0.3",
+			packets: []packet{
+				{"dst", tcp4("10.5.0.2", "10.5.0.3", 50000, 6443), true},
+				{"src", tcp4("10.5.0.3", "10.5.0.2", 6443, 50000), true},
+				{"other", tcp4("10.5.0.2", "10.5.0.4", 50000, 6443), false},
+				{"ipv6", tcp6("fd00::2", "fd00::3", 50000, 6443), false},
+			},
+		},
+		{
+			expr: "src host fd00::2",
+			packets: []packet{
+				{"src", tcp6("fd00::2", "fd00::3", 50000, 6443), true},
+				{"dst", tcp6("fd00::3", "fd00::2", 6443, 50000), false},
+				{"ipv4", tcp4("10.5.0.2", "10.5.0.3", 50000, 6443), false},
+			},
+		},
+		{
+			expr: "dst net 10.5.0.0/24",
+			packets: []packet{
+				{"dst", tcp4("192.168.0.1", "10.5.0.3", 50000, 6443), true},
+				{"src", tcp4("10.5.0.3", "192.168.0.1", 50000, 6443), false},
+				{"other", tcp4("192.168.0.1", "10.6.0.3", 50000, 6443), false},
+			},
+		},
+		{
+			expr: "net fd00:1::/32",
+			packets: []packet{
+				{"src", tcp6("fd00:1:2::1", "fd00::3", 50000, 6443), true},
+				{"other", tcp6("fd00:2::1", "fd00::3", 50000, 6443), false},
+			},
+		},
+		{
+			expr: "port 6443",
+			packets: []packet{
+				{"tcp4 dst", tcp4("10.5.0.2", "10.5.0.3", 50000, 6443), true},
+				{"tcp4 src", tcp4("10.5.0.3", "10.5.0.2", 6443, 50000), true},
+				{"tcp6", tcp6("fd00::2", "fd00::3", 50000, 6443), true},
+				{"udp4", udp4("10.5.0.2", "10.5.0.3", 50000, 6443), true},
+				{"other", tcp4("10.5.0.2", "10.5.0.3", 50000, 50001), false},
+				{"ipv4 options", []gopacket.SerializableLayer{
+					&layers.IPv4{
+						Version:  4,
+						TTL:      64,
+						Protocol: layers.IPProtocolTCP,
+						SrcIP:    net.ParseIP("10.5.0.2").To4(),
+						DstIP:    net.ParseIP("10.5.0.3").To4(),
+						Options: []layers.IPv4Option{
+							{OptionType: 1}, {OptionType: 1}, {OptionType: 1}, {OptionType: 0},
+						},
+					},
+					&layers.TCP{SrcPort: 50000, DstPort: 6443},
+				}, true},
+			},
+		},
+		{
+			expr: "udp dst port 51820 and not host 10.5.0.4",
+			packets: []packet{
+				{"match", udp4("10.5.0.2", "10.5.0.3", 51820, 51820), true},
+				{"excluded host", udp4("10.5.0.2", "10.5.0.4", 51820, 51820), false},
+				{"src port", udp4("10.5.0.2", "10.5.0.3", 51820, 50000), false},
+				{"tcp", tcp4("10.5.0.2", "10.5.0.3", 50000, 51820), false},
+			},
+		},
+		{
+			expr: "(tcp port 6443 || tcp port 50000) && !src host 10.5.0.3",
+			packets: []packet{
+				{"match 6443", tcp4("10.5.0.2", "10.5.0.3", 40000, 6443), true},
+				{"match 50000", tcp4("10.5.0.2", "10.5.0.3", 40000, 50000), true},
+				{"excluded host", tcp4("10.5.0.3", "10.5.0.2", 6443, 40000), false},
+				{"other port", tcp4("10.5.0.2", "10.5.0.3", 40000, 40001), false},
+			},
+		},
+	} {
+		tt := tt
+
+		for _, linkType := range []pcap.LinkType{pcap.LinkTypeEthernet, pcap.LinkTypeRaw} {
+			linkType := linkType
+
+			t.Run(tt.expr, func(t *testing.T) {
+				t.Parallel()
+
+				raw, err := pcap.Compile(tt.expr, linkType)
+				require.NoError(t, err)
+
+				program, ok := bpf.Disassemble(raw)
+				require.True(t, ok)
+
+				vm, err := bpf.NewVM(program)
+				require.NoError(t, err)
+
+				for _, p := range tt.packets {
+					if _, isARP := p.layers[0].(*layers.ARP); isARP && linkType == pcap.LinkTypeRaw {
+						continue
+					}
+
+					data := serialize(t, linkType, p.layers...)
+
+					n, err := vm.Run(data)
+					require.NoError(t, err)
+
+					assert.Equal(t, p.matches, n > 0, "packet %q, link type %d", p.name, linkType)
+				}
+			})
+		}
+	}
+}
+
+func TestCompileEmpty(t *testing.T) {
+	t.Parallel()
+
+	raw, err := pcap.Compile("  ", pcap.LinkTypeEthernet)
+	require.NoError(t, err)
+	assert.Empty(t, raw)
+}
+
+func TestCompileErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		expr     string
+		expected string
+	}{
+		{"host", `invalid host "": ParseIP(""): unable to parse IP`},
+		{"port http", `invalid port "http"`},
+		{"ip6 host 10.5.0.2", `"10.5.0.2" is not an IPv6 address`},
+		{"tcp host 10.5.0.2", `"tcp" can't be used with "host"`},
+		{"icmp port 53", `"icmp" can't be used with "port"`},
+		{"src 10.5.0.2", `expected "host", "net" or "port", got "10.5.0.2"`},
+		{"(tcp", `expected ")", got ""`},
+		{"tcp udp", `unexpected "udp"`},
+		{"tcp and", `unexpected end of the expression`},
+		{"tcp[13] = 2", `unexpected character '['`},
+	} {
+		tt := tt
+
+		t.Run(tt.expr, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pcap.Compile(tt.expr, pcap.LinkTypeEthernet)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
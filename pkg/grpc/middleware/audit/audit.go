@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package audit provides gRPC middleware which records the API calls to the audit log.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+)
+
+// MaxRequestSize is the maximum size of the JSON-encoded request body recorded in the audit log.
+const MaxRequestSize = 4096
+
+// redactedMethods carry secrets in the requests, so the request bodies are never recorded.
+var redactedMethods = map[string]struct{}{
+	"/machine.MachineService/ApplyConfiguration":    {},
+	"/machine.MachineService/EtcdRecover":           {},
+	"/machine.MachineService/GenerateConfiguration": {},
+	"/machine.MachineService/UpdateEncryptionKeys":  {},
+	"/securityapi.LoginService/OIDCLogin":           {},
+}
+
+// Record is the audit log record of a single API call.
+//
+// Records are written as JSON lines, so that they are parsed as structured log events.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Level     string    `json:"level"`
+	Msg       string    `json:"msg"`
+	Component string    `json:"component"`
+	Method    string    `json:"method"`
+	Peer      string    `json:"peer,omitempty"`
+	Subject   string    `json:"subject,omitempty"`
+	Roles     []string  `json:"roles"`
+	Nodes     []string  `json:"nodes,omitempty"`
+	ProxyFrom []string  `json:"proxy_from,omitempty"`
+	Code      string    `json:"code"`
+	Error     string    `json:"error,omitempty"`
+	Duration  float64   `json:"duration_seconds"`
+
+	Request   json.RawMessage `json:"request,omitempty"`
+	Redacted  bool            `json:"redacted,omitempty"`
+	Truncated bool            `json:"truncated,omitempty"`
+}
+
+// Auditor records the API calls.
+//
+// Auditor should be installed after the authz.Injector, as it records the roles of the client.
+type Auditor struct {
+	// Component handling the API calls, e.g. "apid" or "machined".
+	Component string
+
+	// Writer receives the audit records.
+	Writer io.Writer
+
+	// RecordRequests enables recording of the request bodies.
+	//
+	// Requests should be decoded protobuf messages, so it can't be used with the proxy codec.
+	RecordRequests bool
+
+	// Logger.
+	Logger func(format string, v ...interface{})
+
+	mu sync.Mutex
+}
+
+func (a *Auditor) logf(format string, v ...interface{}) {
+	if a.Logger != nil {
+		a.Logger(format, v...)
+	}
+}
+
+func (a *Auditor) encodeRequest(method string, req interface{}, record *Record) {
+	if !a.RecordRequests || req == nil {
+		return
+	}
+
+	if _, redacted := redactedMethods[method]; redacted {
+		record.Redacted = true
+
+		return
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+
+	body, err := protojson.Marshal(msg)
+	if err != nil {
+		a.logf("failed to encode %s request: %s", method, err)
+
+		return
+	}
+
+	if len(body) > MaxRequestSize {
+		record.Truncated = true
+
+		return
+	}
+
+	record.Request = body
+}
+
+func (a *Auditor) record(ctx context.Context, method string, start time.Time, record *Record, err error) {
+	code := status.Code(err)
+
+	record.Time = start
+	record.Level = "info"
+	record.Msg = fmt.Sprintf("%s %s", method, code)
+	record.Component = a.Component
+	record.Method = method
+	record.Subject = GetSubject(ctx)
+	record.Roles = authz.GetRoles(ctx).Strings()
+	record.Code = code.String()
+	record.Duration = time.Since(start).Seconds()
+
+	if code != codes.OK {
+		record.Level = "warn"
+		record.Error = status.Convert(err).Message()
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Peer = p.Addr.String()
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		record.Nodes = md.Get("nodes")
+		record.ProxyFrom = md.Get("proxyfrom")
+	}
+
+	line, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		a.logf("failed to encode audit record: %s", marshalErr)
+
+		return
+	}
+
+	a.write(line)
+}
+
+// write writes a single audit record line.
+func (a *Auditor) write(line []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, err := a.Writer.Write(append(line, '\n')); err != nil {
+		a.logf("failed to write audit record: %s", err)
+	}
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (a *Auditor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx = ContextWithSubject(ctx, extractSubject(ctx))
+
+		var record Record
+
+		a.encodeRequest(info.FullMethod, req, &record)
+
+		resp, err := handler(ctx, req)
+
+		a.record(ctx, info.FullMethod, start, &record, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+//
+// For the client-streaming methods, only the first request message is recorded.
+func (a *Auditor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := ContextWithSubject(stream.Context(), extractSubject(stream.Context()))
+
+		wrapped := &recordingStream{
+			WrappedServerStream: grpc_middleware.WrapServerStream(stream),
+			auditor:             a,
+			method:              info.FullMethod,
+		}
+		wrapped.WrappedContext = ctx
+
+		err := handler(srv, wrapped)
+
+		a.record(ctx, info.FullMethod, start, &wrapped.record, err)
+
+		return err
+	}
+}
+
+// recordingStream records the first request message received from the client.
+type recordingStream struct {
+	*grpc_middleware.WrappedServerStream
+
+	auditor  *Auditor
+	method   string
+	received bool
+	record   Record
+}
+
+// RecvMsg implements grpc.ServerStream interface.
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.WrappedServerStream.RecvMsg(m)
+
+	if err == nil && !s.received {
+		s.received = true
+
+		s.auditor.encodeRequest(s.method, m, &s.record)
+	}
+
+	return err
+}
@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func call(t *testing.T, auditor *audit.Auditor, method string, req interface{}, handlerErr error) audit.Record {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	auditor.Writer = &buf
+
+	ctx := authz.ContextWithRoles(context.Background(), role.MakeSet(role.Reader))
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("nodes", "10.5.0.2", "nodes", "10.5.0.3", "talos-audit-subject", "O=os:reader"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("172.20.0.1"), Port: 40000}})
+
+	_, err := auditor.UnaryInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, "O=os:reader", audit.GetSubject(ctx))
+
+		return nil, handlerErr
+	})
+	require.Equal(t, handlerErr, err)
+
+	require.True(t, strings.HasSuffix(buf.String(), "\n"))
+
+	var record audit.Record
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	return record
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	auditor := &audit.Auditor{
+		Component:      "machined",
+		RecordRequests: true,
+	}
+
+	record := call(t, auditor, "/machine.MachineService/ServiceRestart", wrapperspb.String("kubelet"), nil)
+
+	assert.Equal(t, "machined", record.Component)
+	assert.Equal(t, "/machine.MachineService/ServiceRestart", record.Method)
+	assert.Equal(t, "info", record.Level)
+	assert.Equal(t, "OK", record.Code)
+	assert.Equal(t, "O=os:reader", record.Subject)
+	assert.Equal(t, []string{"os:reader"}, record.Roles)
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3"}, record.Nodes)
+	assert.Equal(t, "172.20.0.1:40000", record.Peer)
+	assert.JSONEq(t, `"kubelet"`, string(record.Request))
+	assert.False(t, record.Redacted)
+	assert.WithinDuration(t, time.Now(), record.Time, time.Minute)
+
+	record = call(t, auditor, "/machine.MachineService/ApplyConfiguration", wrapperspb.String("secret"), status.Error(codes.PermissionDenied, "not authorized"))
+
+	assert.Equal(t, "warn", record.Level)
+	assert.Equal(t, "PermissionDenied", record.Code)
+	assert.Equal(t, "not authorized", record.Error)
+	assert.True(t, record.Redacted)
+	assert.Empty(t, record.Request)
+
+	record = call(t, auditor, "/machine.MachineService/ServiceRestart", wrapperspb.String(strings.Repeat("a", audit.MaxRequestSize)), nil)
+
+	assert.True(t, record.Truncated)
+	assert.Empty(t, record.Request)
+
+	auditor.RecordRequests = false
+
+	record = call(t, auditor, "/machine.MachineService/ServiceRestart", wrapperspb.String("kubelet"), nil)
+
+	assert.Empty(t, record.Request)
+}
+
+func TestUnaryInterceptorRedactsEncryptionKeys(t *testing.T) {
+	t.Parallel()
+
+	auditor := &audit.Auditor{
+		Component:      "machined",
+		RecordRequests: true,
+	}
+
+	record := call(t, auditor, "/machine.MachineService/UpdateEncryptionKeys", &machine.UpdateEncryptionKeysRequest{
+		Partition: "STATE",
+		Action:    machine.UpdateEncryptionKeysRequest_ADD,
+		Key: &machine.EncryptionKeySpec{
+			Slot:             1,
+			StaticPassphrase: "topsecretpassphrase",
+		},
+	}, nil)
+
+	assert.True(t, record.Redacted)
+	assert.Empty(t, record.Request)
+}
+
+type syncBuffer struct {
+	lines chan string
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lines <- string(p)
+
+	return len(p), nil
+}
+
+func TestSocket(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.sock")
+
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	buf := &syncBuffer{lines: make(chan string, 2)}
+
+	auditor := &audit.Auditor{
+		Writer: buf,
+	}
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		serveErr <- auditor.Serve(l)
+	}()
+
+	w := &audit.SocketWriter{
+		Path: path,
+	}
+
+	_, err = w.Write([]byte("{\"msg\":\"first\"}\n{\"msg\":"))
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("\"second\"}\n"))
+	require.NoError(t, err)
+
+	for _, expected := range []string{"{\"msg\":\"first\"}\n", "{\"msg\":\"second\"}\n"} {
+		select {
+		case line := <-buf.lines:
+			assert.Equal(t, expected, line)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for the audit record")
+		}
+	}
+
+	require.NoError(t, w.Close())
+	require.NoError(t, l.Close())
+	require.NoError(t, <-serveErr)
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// maxRecordSize is the maximum size of the audit record accepted by Serve.
+const maxRecordSize = 64 * 1024
+
+// socketWriteTimeout limits the time spent writing a single record to the socket.
+const socketWriteTimeout = 5 * time.Second
+
+// Serve accepts the audit records written by the SocketWriter and writes them to the Auditor.Writer.
+//
+// Serve is used to collect the audit records of the components which don't have direct access to the logs (apid).
+func (a *Auditor) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		go a.forward(conn)
+	}
+}
+
+func (a *Auditor) forward(conn net.Conn) {
+	//nolint:errcheck
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 4096), maxRecordSize)
+
+	for scanner.Scan() {
+		a.write(scanner.Bytes())
+	}
+
+	if err := scanner.Err(); err != nil {
+		a.logf("failed to read audit records: %s", err)
+	}
+}
+
+// SocketWriter writes the audit records to the unix socket served by the Auditor.Serve.
+//
+// The socket is dialed on the first write and after each write failure, so the records
+// written while the socket is not available are dropped.
+type SocketWriter struct {
+	Path string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// Write implements io.Writer.
+func (w *SocketWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		conn, err := net.DialTimeout("unix", w.Path, socketWriteTimeout)
+		if err != nil {
+			return 0, err
+		}
+
+		w.conn = conn
+	}
+
+	if err := w.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout)); err != nil {
+		return 0, w.reset(err)
+	}
+
+	n, err := w.conn.Write(p)
+	if err != nil {
+		return n, w.reset(err)
+	}
+
+	return n, nil
+}
+
+// Close implements io.Closer.
+func (w *SocketWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		return nil
+	}
+
+	return w.reset(nil)
+}
+
+func (w *SocketWriter) reset(err error) error {
+	closeErr := w.conn.Close()
+	w.conn = nil
+
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+// mdKey is used to forward the client subject in gRPC metadata.
+const mdKey = "talos-audit-subject"
+
+// ctxKey is used to store the client subject in the context.
+type ctxKey struct{}
+
+// GetSubject returns the client subject stored in the context by the Auditor interceptor.
+func GetSubject(ctx context.Context) string {
+	subject, _ := ctx.Value(ctxKey{}).(string) //nolint:errcheck
+
+	return subject
+}
+
+// ContextWithSubject returns derived context with the client subject set.
+func ContextWithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, subject)
+}
+
+// SetMetadata sets the client subject in gRPC metadata for the requests proxied to the backends.
+func SetMetadata(md metadata.MD, subject string) {
+	if subject == "" {
+		delete(md, mdKey)
+
+		return
+	}
+
+	md.Set(mdKey, subject)
+}
+
+// extractSubject returns the subject of the client certificate.
+//
+// The subject is taken from gRPC metadata (set by the upstream apid instance) if the request
+// has no client certificate (local sockets), or if the certificate has the impersonator role (requests proxied from other apid instances).
+func extractSubject(ctx context.Context) string {
+	var subject string
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			cert := tlsInfo.State.PeerCertificates[0]
+			subject = cert.Subject.String()
+
+			if roles, _ := role.Parse(cert.Subject.Organization); !roles.Includes(role.Impersonator) {
+				return subject
+			}
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(mdKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return subject
+}
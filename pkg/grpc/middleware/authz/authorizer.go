@@ -6,6 +6,7 @@ package authz
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -26,6 +27,12 @@ type Authorizer struct {
 	// Defines roles for gRPC methods not present in Rules.
 	FallbackRoles role.Set
 
+	// Returns allowed gRPC methods for custom (not built-in) roles, optional.
+	//
+	// Each allowed method is either a full gRPC method name, all methods of a service ("/machine.MachineService/*"),
+	// or a built-in role name which allows all methods allowed for that role.
+	CustomRoles func() map[role.Role][]string
+
 	// Logger.
 	Logger func(format string, v ...interface{})
 }
@@ -52,11 +59,55 @@ func (a *Authorizer) authorize(ctx context.Context, method string) error {
 		return nil
 	}
 
+	if customRole, ok := a.authorizeCustom(method, allowedRoles, clientRoles); ok {
+		a.logf("authorized (custom role %q allows %q)", customRole, method)
+
+		return nil
+	}
+
 	a.logf("not authorized (%v doesn't include %v)", allowedRoles.Strings(), clientRoles.Strings())
 
 	return ErrNotAuthorized
 }
 
+// authorizeCustom returns the custom role of the user which allows calling the given gRPC method.
+func (a *Authorizer) authorizeCustom(method string, allowedRoles, clientRoles role.Set) (role.Role, bool) {
+	if a.CustomRoles == nil {
+		return "", false
+	}
+
+	customRoles := a.CustomRoles()
+
+	for _, r := range clientRoles.Strings() {
+		clientRole := role.Role(r)
+
+		// built-in roles can't be redefined
+		if role.All.Includes(clientRole) {
+			continue
+		}
+
+		for _, allowed := range customRoles[clientRole] {
+			if methodAllowed(allowed, method, allowedRoles) {
+				return clientRole, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// methodAllowed checks if the allowed method of the custom role matches the gRPC method.
+func methodAllowed(allowed, method string, allowedRoles role.Set) bool {
+	switch {
+	case strings.HasPrefix(allowed, role.Prefix):
+		return allowedRoles.Includes(role.Role(allowed))
+	case strings.HasSuffix(allowed, "/*"):
+		return strings.HasPrefix(method, strings.TrimSuffix(allowed, "*"))
+	default:
+		return allowed == method
+	}
+}
+
 // UnaryInterceptor returns grpc UnaryServerInterceptor.
 func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -4,11 +4,70 @@
 
 package authz_test
 
-import "testing"
+import (
+	"context"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func TestAuthorizerCustomRoles(t *testing.T) {
+	t.Parallel()
+
+	authorizer := &authz.Authorizer{
+		Rules: map[string]role.Set{
+			"/machine.MachineService/ApplyConfiguration": role.MakeSet(role.Admin),
+			"/machine.MachineService/Logs":               role.MakeSet(role.Admin, role.Reader),
+			"/machine.MachineService/Reboot":             role.MakeSet(role.Admin),
+			"/machine.MachineService/Reset":              role.MakeSet(role.Admin),
+		},
+		FallbackRoles: role.MakeSet(role.Admin),
+		CustomRoles: func() map[role.Role][]string {
+			return map[role.Role][]string{
+				"sre":           {"os:reader", "/machine.MachineService/Reboot"},
+				"time":          {"/time.TimeService/*"},
+				role.EtcdBackup: {"/machine.MachineService/Logs"},
+				"restricted":    {},
+			}
+		},
+	}
+
+	interceptor := authorizer.UnaryInterceptor()
+
+	for _, tt := range []struct {
+		roles      role.Set
+		method     string
+		authorized bool
+	}{
+		{role.MakeSet(role.Admin), "/machine.MachineService/Reset", true},
+		{role.MakeSet(role.Reader), "/machine.MachineService/Reboot", false},
+		{role.MakeSet("sre"), "/machine.MachineService/Reboot", true},
+		{role.MakeSet("sre"), "/machine.MachineService/Logs", true},
+		{role.MakeSet("sre"), "/machine.MachineService/Reset", false},
+		{role.MakeSet("sre"), "/machine.MachineService/ApplyConfiguration", false},
+		{role.MakeSet("sre"), "/machine.MachineService/Unknown", false},
+		{role.MakeSet("time"), "/time.TimeService/Time", true},
+		{role.MakeSet("time"), "/time.TimeServiceX/Time", false},
+		{role.MakeSet("time"), "/machine.MachineService/Logs", false},
+		{role.MakeSet("restricted", "sre"), "/machine.MachineService/Reboot", true},
+		{role.MakeSet("restricted"), "/machine.MachineService/Logs", false},
+		{role.MakeSet("undefined"), "/machine.MachineService/Logs", false},
+		{role.MakeSet(role.EtcdBackup), "/machine.MachineService/Logs", false},
+	} {
+		ctx := authz.ContextWithRoles(context.Background(), tt.roles)
+
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+
+		if tt.authorized {
+			assert.NoError(t, err, "%v %s", tt.roles.Strings(), tt.method)
+		} else {
+			assert.Equal(t, authz.ErrNotAuthorized, err, "%v %s", tt.roles.Strings(), tt.method)
+		}
+	}
 }
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/talos-systems/talos/pkg/grpc/middleware/audit"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
 )
 
@@ -43,6 +44,7 @@ func (l *Local) GetConnection(ctx context.Context) (context.Context, *grpc.Clien
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	audit.SetMetadata(md, audit.GetSubject(ctx))
 
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
@@ -386,6 +386,55 @@ func (ListRequest_Type) EnumDescriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{45, 0}
 }
 
+type EtcdMemberAlarm_AlarmType int32
+
+const (
+	EtcdMemberAlarm_NONE    EtcdMemberAlarm_AlarmType = 0
+	EtcdMemberAlarm_NOSPACE EtcdMemberAlarm_AlarmType = 1
+	EtcdMemberAlarm_CORRUPT EtcdMemberAlarm_AlarmType = 2
+)
+
+// Enum value maps for EtcdMemberAlarm_AlarmType.
+var (
+	EtcdMemberAlarm_AlarmType_name = map[int32]string{
+		0: "NONE",
+		1: "NOSPACE",
+		2: "CORRUPT",
+	}
+	EtcdMemberAlarm_AlarmType_value = map[string]int32{
+		"NONE":    0,
+		"NOSPACE": 1,
+		"CORRUPT": 2,
+	}
+)
+
+func (x EtcdMemberAlarm_AlarmType) Enum() *EtcdMemberAlarm_AlarmType {
+	p := new(EtcdMemberAlarm_AlarmType)
+	*p = x
+	return p
+}
+
+func (x EtcdMemberAlarm_AlarmType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EtcdMemberAlarm_AlarmType) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[7].Descriptor()
+}
+
+func (EtcdMemberAlarm_AlarmType) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[7]
+}
+
+func (x EtcdMemberAlarm_AlarmType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EtcdMemberAlarm_AlarmType.Descriptor instead.
+func (EtcdMemberAlarm_AlarmType) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{115, 0}
+}
+
 type MachineConfig_MachineType int32
 
 const (
@@ -422,11 +471,11 @@ func (x MachineConfig_MachineType) String() string {
 }
 
 func (MachineConfig_MachineType) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_machine_proto_enumTypes[7].Descriptor()
+	return file_machine_machine_proto_enumTypes[8].Descriptor()
 }
 
 func (MachineConfig_MachineType) Type() protoreflect.EnumType {
-	return &file_machine_machine_proto_enumTypes[7]
+	return &file_machine_machine_proto_enumTypes[8]
 }
 
 func (x MachineConfig_MachineType) Number() protoreflect.EnumNumber {
@@ -435,7 +484,166 @@ func (x MachineConfig_MachineType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineConfig_MachineType.Descriptor instead.
 func (MachineConfig_MachineType) EnumDescriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{118, 0}
+	return file_machine_machine_proto_rawDescGZIP(), []int{131, 0}
+}
+
+type NetstatRequest_Filter int32
+
+const (
+	NetstatRequest_ALL       NetstatRequest_Filter = 0
+	NetstatRequest_CONNECTED NetstatRequest_Filter = 1
+	NetstatRequest_LISTENING NetstatRequest_Filter = 2
+)
+
+// Enum value maps for NetstatRequest_Filter.
+var (
+	NetstatRequest_Filter_name = map[int32]string{
+		0: "ALL",
+		1: "CONNECTED",
+		2: "LISTENING",
+	}
+	NetstatRequest_Filter_value = map[string]int32{
+		"ALL":       0,
+		"CONNECTED": 1,
+		"LISTENING": 2,
+	}
+)
+
+func (x NetstatRequest_Filter) Enum() *NetstatRequest_Filter {
+	p := new(NetstatRequest_Filter)
+	*p = x
+	return p
+}
+
+func (x NetstatRequest_Filter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetstatRequest_Filter) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[9].Descriptor()
+}
+
+func (NetstatRequest_Filter) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[9]
+}
+
+func (x NetstatRequest_Filter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetstatRequest_Filter.Descriptor instead.
+func (NetstatRequest_Filter) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{144, 0}
+}
+
+type SocketInfo_Protocol int32
+
+const (
+	SocketInfo_TCP  SocketInfo_Protocol = 0
+	SocketInfo_TCP6 SocketInfo_Protocol = 1
+	SocketInfo_UDP  SocketInfo_Protocol = 2
+	SocketInfo_UDP6 SocketInfo_Protocol = 3
+	SocketInfo_RAW  SocketInfo_Protocol = 4
+	SocketInfo_RAW6 SocketInfo_Protocol = 5
+	SocketInfo_UNIX SocketInfo_Protocol = 6
+)
+
+// Enum value maps for SocketInfo_Protocol.
+var (
+	SocketInfo_Protocol_name = map[int32]string{
+		0: "TCP",
+		1: "TCP6",
+		2: "UDP",
+		3: "UDP6",
+		4: "RAW",
+		5: "RAW6",
+		6: "UNIX",
+	}
+	SocketInfo_Protocol_value = map[string]int32{
+		"TCP":  0,
+		"TCP6": 1,
+		"UDP":  2,
+		"UDP6": 3,
+		"RAW":  4,
+		"RAW6": 5,
+		"UNIX": 6,
+	}
+)
+
+func (x SocketInfo_Protocol) Enum() *SocketInfo_Protocol {
+	p := new(SocketInfo_Protocol)
+	*p = x
+	return p
+}
+
+func (x SocketInfo_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SocketInfo_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[10].Descriptor()
+}
+
+func (SocketInfo_Protocol) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[10]
+}
+
+func (x SocketInfo_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SocketInfo_Protocol.Descriptor instead.
+func (SocketInfo_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{145, 0}
+}
+
+type UpdateEncryptionKeysRequest_Action int32
+
+const (
+	UpdateEncryptionKeysRequest_ADD    UpdateEncryptionKeysRequest_Action = 0
+	UpdateEncryptionKeysRequest_REMOVE UpdateEncryptionKeysRequest_Action = 1
+	UpdateEncryptionKeysRequest_ROTATE UpdateEncryptionKeysRequest_Action = 2
+)
+
+// Enum value maps for UpdateEncryptionKeysRequest_Action.
+var (
+	UpdateEncryptionKeysRequest_Action_name = map[int32]string{
+		0: "ADD",
+		1: "REMOVE",
+		2: "ROTATE",
+	}
+	UpdateEncryptionKeysRequest_Action_value = map[string]int32{
+		"ADD":    0,
+		"REMOVE": 1,
+		"ROTATE": 2,
+	}
+)
+
+func (x UpdateEncryptionKeysRequest_Action) Enum() *UpdateEncryptionKeysRequest_Action {
+	p := new(UpdateEncryptionKeysRequest_Action)
+	*p = x
+	return p
+}
+
+func (x UpdateEncryptionKeysRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateEncryptionKeysRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[11].Descriptor()
+}
+
+func (UpdateEncryptionKeysRequest_Action) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[11]
+}
+
+func (x UpdateEncryptionKeysRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateEncryptionKeysRequest_Action.Descriptor instead.
+func (UpdateEncryptionKeysRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{159, 0}
 }
 
 // rpc applyConfiguration
@@ -7855,18 +8063,16 @@ func (x *EtcdRecoverResponse) GetMessages() []*EtcdRecover {
 	return nil
 }
 
-type RouteConfig struct {
+type EtcdAlarmListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Gateway string `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Metric  uint32 `protobuf:"varint,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	Messages []*EtcdAlarm `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *RouteConfig) Reset() {
-	*x = RouteConfig{}
+func (x *EtcdAlarmListResponse) Reset() {
+	*x = EtcdAlarmListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7874,13 +8080,13 @@ func (x *RouteConfig) Reset() {
 	}
 }
 
-func (x *RouteConfig) String() string {
+func (x *EtcdAlarmListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteConfig) ProtoMessage() {}
+func (*EtcdAlarmListResponse) ProtoMessage() {}
 
-func (x *RouteConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdAlarmListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7892,42 +8098,29 @@ func (x *RouteConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteConfig.ProtoReflect.Descriptor instead.
-func (*RouteConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdAlarmListResponse.ProtoReflect.Descriptor instead.
+func (*EtcdAlarmListResponse) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *RouteConfig) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *RouteConfig) GetGateway() string {
-	if x != nil {
-		return x.Gateway
-	}
-	return ""
-}
-
-func (x *RouteConfig) GetMetric() uint32 {
+func (x *EtcdAlarmListResponse) GetMessages() []*EtcdAlarm {
 	if x != nil {
-		return x.Metric
+		return x.Messages
 	}
-	return 0
+	return nil
 }
 
-type DHCPOptionsConfig struct {
+type EtcdAlarm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RouteMetric uint32 `protobuf:"varint,1,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
+	Metadata     *common.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	MemberAlarms []*EtcdMemberAlarm `protobuf:"bytes,2,rep,name=member_alarms,json=memberAlarms,proto3" json:"member_alarms,omitempty"`
 }
 
-func (x *DHCPOptionsConfig) Reset() {
-	*x = DHCPOptionsConfig{}
+func (x *EtcdAlarm) Reset() {
+	*x = EtcdAlarm{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7935,13 +8128,13 @@ func (x *DHCPOptionsConfig) Reset() {
 	}
 }
 
-func (x *DHCPOptionsConfig) String() string {
+func (x *EtcdAlarm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DHCPOptionsConfig) ProtoMessage() {}
+func (*EtcdAlarm) ProtoMessage() {}
 
-func (x *DHCPOptionsConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdAlarm) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7953,34 +8146,36 @@ func (x *DHCPOptionsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DHCPOptionsConfig.ProtoReflect.Descriptor instead.
-func (*DHCPOptionsConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdAlarm.ProtoReflect.Descriptor instead.
+func (*EtcdAlarm) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *DHCPOptionsConfig) GetRouteMetric() uint32 {
+func (x *EtcdAlarm) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.RouteMetric
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type NetworkDeviceConfig struct {
+func (x *EtcdAlarm) GetMemberAlarms() []*EtcdMemberAlarm {
+	if x != nil {
+		return x.MemberAlarms
+	}
+	return nil
+}
+
+type EtcdMemberAlarm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interface   string             `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
-	Cidr        string             `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`
-	Mtu         int32              `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	Dhcp        bool               `protobuf:"varint,4,opt,name=dhcp,proto3" json:"dhcp,omitempty"`
-	Ignore      bool               `protobuf:"varint,5,opt,name=ignore,proto3" json:"ignore,omitempty"`
-	DhcpOptions *DHCPOptionsConfig `protobuf:"bytes,6,opt,name=dhcp_options,json=dhcpOptions,proto3" json:"dhcp_options,omitempty"`
-	Routes      []*RouteConfig     `protobuf:"bytes,7,rep,name=routes,proto3" json:"routes,omitempty"`
+	MemberId uint64                    `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Alarm    EtcdMemberAlarm_AlarmType `protobuf:"varint,2,opt,name=alarm,proto3,enum=machine.EtcdMemberAlarm_AlarmType" json:"alarm,omitempty"`
 }
 
-func (x *NetworkDeviceConfig) Reset() {
-	*x = NetworkDeviceConfig{}
+func (x *EtcdMemberAlarm) Reset() {
+	*x = EtcdMemberAlarm{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7988,13 +8183,13 @@ func (x *NetworkDeviceConfig) Reset() {
 	}
 }
 
-func (x *NetworkDeviceConfig) String() string {
+func (x *EtcdMemberAlarm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkDeviceConfig) ProtoMessage() {}
+func (*EtcdMemberAlarm) ProtoMessage() {}
 
-func (x *NetworkDeviceConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdMemberAlarm) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8006,71 +8201,35 @@ func (x *NetworkDeviceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkDeviceConfig.ProtoReflect.Descriptor instead.
-func (*NetworkDeviceConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdMemberAlarm.ProtoReflect.Descriptor instead.
+func (*EtcdMemberAlarm) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *NetworkDeviceConfig) GetInterface() string {
-	if x != nil {
-		return x.Interface
-	}
-	return ""
-}
-
-func (x *NetworkDeviceConfig) GetCidr() string {
-	if x != nil {
-		return x.Cidr
-	}
-	return ""
-}
-
-func (x *NetworkDeviceConfig) GetMtu() int32 {
+func (x *EtcdMemberAlarm) GetMemberId() uint64 {
 	if x != nil {
-		return x.Mtu
+		return x.MemberId
 	}
 	return 0
 }
 
-func (x *NetworkDeviceConfig) GetDhcp() bool {
-	if x != nil {
-		return x.Dhcp
-	}
-	return false
-}
-
-func (x *NetworkDeviceConfig) GetIgnore() bool {
-	if x != nil {
-		return x.Ignore
-	}
-	return false
-}
-
-func (x *NetworkDeviceConfig) GetDhcpOptions() *DHCPOptionsConfig {
-	if x != nil {
-		return x.DhcpOptions
-	}
-	return nil
-}
-
-func (x *NetworkDeviceConfig) GetRoutes() []*RouteConfig {
+func (x *EtcdMemberAlarm) GetAlarm() EtcdMemberAlarm_AlarmType {
 	if x != nil {
-		return x.Routes
+		return x.Alarm
 	}
-	return nil
+	return EtcdMemberAlarm_NONE
 }
 
-type NetworkConfig struct {
+type EtcdAlarmDisarmResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hostname   string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Interfaces []*NetworkDeviceConfig `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Messages []*EtcdAlarmDisarm `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *NetworkConfig) Reset() {
-	*x = NetworkConfig{}
+func (x *EtcdAlarmDisarmResponse) Reset() {
+	*x = EtcdAlarmDisarmResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8078,13 +8237,13 @@ func (x *NetworkConfig) Reset() {
 	}
 }
 
-func (x *NetworkConfig) String() string {
+func (x *EtcdAlarmDisarmResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkConfig) ProtoMessage() {}
+func (*EtcdAlarmDisarmResponse) ProtoMessage() {}
 
-func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdAlarmDisarmResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8096,36 +8255,29 @@ func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
-func (*NetworkConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdAlarmDisarmResponse.ProtoReflect.Descriptor instead.
+func (*EtcdAlarmDisarmResponse) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *NetworkConfig) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *NetworkConfig) GetInterfaces() []*NetworkDeviceConfig {
+func (x *EtcdAlarmDisarmResponse) GetMessages() []*EtcdAlarmDisarm {
 	if x != nil {
-		return x.Interfaces
+		return x.Messages
 	}
 	return nil
 }
 
-type InstallConfig struct {
+type EtcdAlarmDisarm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstallDisk  string `protobuf:"bytes,1,opt,name=install_disk,json=installDisk,proto3" json:"install_disk,omitempty"`
-	InstallImage string `protobuf:"bytes,2,opt,name=install_image,json=installImage,proto3" json:"install_image,omitempty"`
+	Metadata     *common.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	MemberAlarms []*EtcdMemberAlarm `protobuf:"bytes,2,rep,name=member_alarms,json=memberAlarms,proto3" json:"member_alarms,omitempty"`
 }
 
-func (x *InstallConfig) Reset() {
-	*x = InstallConfig{}
+func (x *EtcdAlarmDisarm) Reset() {
+	*x = EtcdAlarmDisarm{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8133,13 +8285,13 @@ func (x *InstallConfig) Reset() {
 	}
 }
 
-func (x *InstallConfig) String() string {
+func (x *EtcdAlarmDisarm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallConfig) ProtoMessage() {}
+func (*EtcdAlarmDisarm) ProtoMessage() {}
 
-func (x *InstallConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdAlarmDisarm) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8151,38 +8303,35 @@ func (x *InstallConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallConfig.ProtoReflect.Descriptor instead.
-func (*InstallConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdAlarmDisarm.ProtoReflect.Descriptor instead.
+func (*EtcdAlarmDisarm) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *InstallConfig) GetInstallDisk() string {
+func (x *EtcdAlarmDisarm) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.InstallDisk
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *InstallConfig) GetInstallImage() string {
+func (x *EtcdAlarmDisarm) GetMemberAlarms() []*EtcdMemberAlarm {
 	if x != nil {
-		return x.InstallImage
+		return x.MemberAlarms
 	}
-	return ""
+	return nil
 }
 
-type MachineConfig struct {
+type EtcdDefragmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type              MachineConfig_MachineType `protobuf:"varint,1,opt,name=type,proto3,enum=machine.MachineConfig_MachineType" json:"type,omitempty"`
-	InstallConfig     *InstallConfig            `protobuf:"bytes,2,opt,name=install_config,json=installConfig,proto3" json:"install_config,omitempty"`
-	NetworkConfig     *NetworkConfig            `protobuf:"bytes,3,opt,name=network_config,json=networkConfig,proto3" json:"network_config,omitempty"`
-	KubernetesVersion string                    `protobuf:"bytes,4,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
+	Messages []*EtcdDefragment `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *MachineConfig) Reset() {
-	*x = MachineConfig{}
+func (x *EtcdDefragmentResponse) Reset() {
+	*x = EtcdDefragmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8190,13 +8339,13 @@ func (x *MachineConfig) Reset() {
 	}
 }
 
-func (x *MachineConfig) String() string {
+func (x *EtcdDefragmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MachineConfig) ProtoMessage() {}
+func (*EtcdDefragmentResponse) ProtoMessage() {}
 
-func (x *MachineConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdDefragmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8208,49 +8357,28 @@ func (x *MachineConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MachineConfig.ProtoReflect.Descriptor instead.
-func (*MachineConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdDefragmentResponse.ProtoReflect.Descriptor instead.
+func (*EtcdDefragmentResponse) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *MachineConfig) GetType() MachineConfig_MachineType {
-	if x != nil {
-		return x.Type
-	}
-	return MachineConfig_TYPE_UNKNOWN
-}
-
-func (x *MachineConfig) GetInstallConfig() *InstallConfig {
-	if x != nil {
-		return x.InstallConfig
-	}
-	return nil
-}
-
-func (x *MachineConfig) GetNetworkConfig() *NetworkConfig {
+func (x *EtcdDefragmentResponse) GetMessages() []*EtcdDefragment {
 	if x != nil {
-		return x.NetworkConfig
+		return x.Messages
 	}
 	return nil
 }
 
-func (x *MachineConfig) GetKubernetesVersion() string {
-	if x != nil {
-		return x.KubernetesVersion
-	}
-	return ""
-}
-
-type ControlPlaneConfig struct {
+type EtcdDefragment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *ControlPlaneConfig) Reset() {
-	*x = ControlPlaneConfig{}
+func (x *EtcdDefragment) Reset() {
+	*x = EtcdDefragment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8258,13 +8386,13 @@ func (x *ControlPlaneConfig) Reset() {
 	}
 }
 
-func (x *ControlPlaneConfig) String() string {
+func (x *EtcdDefragment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlPlaneConfig) ProtoMessage() {}
+func (*EtcdDefragment) ProtoMessage() {}
 
-func (x *ControlPlaneConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdDefragment) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8276,29 +8404,28 @@ func (x *ControlPlaneConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlPlaneConfig.ProtoReflect.Descriptor instead.
-func (*ControlPlaneConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdDefragment.ProtoReflect.Descriptor instead.
+func (*EtcdDefragment) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *ControlPlaneConfig) GetEndpoint() string {
+func (x *EtcdDefragment) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.Endpoint
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-type CNIConfig struct {
+type EtcdStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Urls []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Messages []*EtcdStatus `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *CNIConfig) Reset() {
-	*x = CNIConfig{}
+func (x *EtcdStatusResponse) Reset() {
+	*x = EtcdStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8306,13 +8433,13 @@ func (x *CNIConfig) Reset() {
 	}
 }
 
-func (x *CNIConfig) String() string {
+func (x *EtcdStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CNIConfig) ProtoMessage() {}
+func (*EtcdStatusResponse) ProtoMessage() {}
 
-func (x *CNIConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8324,36 +8451,29 @@ func (x *CNIConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CNIConfig.ProtoReflect.Descriptor instead.
-func (*CNIConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdStatusResponse.ProtoReflect.Descriptor instead.
+func (*EtcdStatusResponse) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *CNIConfig) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CNIConfig) GetUrls() []string {
+func (x *EtcdStatusResponse) GetMessages() []*EtcdStatus {
 	if x != nil {
-		return x.Urls
+		return x.Messages
 	}
 	return nil
 }
 
-type ClusterNetworkConfig struct {
+type EtcdStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DnsDomain string     `protobuf:"bytes,1,opt,name=dns_domain,json=dnsDomain,proto3" json:"dns_domain,omitempty"`
-	CniConfig *CNIConfig `protobuf:"bytes,2,opt,name=cni_config,json=cniConfig,proto3" json:"cni_config,omitempty"`
+	Metadata     *common.Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	MemberStatus *EtcdMemberStatus `protobuf:"bytes,2,opt,name=member_status,json=memberStatus,proto3" json:"member_status,omitempty"`
 }
 
-func (x *ClusterNetworkConfig) Reset() {
-	*x = ClusterNetworkConfig{}
+func (x *EtcdStatus) Reset() {
+	*x = EtcdStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8361,13 +8481,13 @@ func (x *ClusterNetworkConfig) Reset() {
 	}
 }
 
-func (x *ClusterNetworkConfig) String() string {
+func (x *EtcdStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterNetworkConfig) ProtoMessage() {}
+func (*EtcdStatus) ProtoMessage() {}
 
-func (x *ClusterNetworkConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8379,38 +8499,46 @@ func (x *ClusterNetworkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterNetworkConfig.ProtoReflect.Descriptor instead.
-func (*ClusterNetworkConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdStatus.ProtoReflect.Descriptor instead.
+func (*EtcdStatus) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *ClusterNetworkConfig) GetDnsDomain() string {
+func (x *EtcdStatus) GetMetadata() *common.Metadata {
 	if x != nil {
-		return x.DnsDomain
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *ClusterNetworkConfig) GetCniConfig() *CNIConfig {
+func (x *EtcdStatus) GetMemberStatus() *EtcdMemberStatus {
 	if x != nil {
-		return x.CniConfig
+		return x.MemberStatus
 	}
 	return nil
 }
 
-type ClusterConfig struct {
+type EtcdMemberStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                     string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ControlPlane             *ControlPlaneConfig   `protobuf:"bytes,2,opt,name=control_plane,json=controlPlane,proto3" json:"control_plane,omitempty"`
-	ClusterNetwork           *ClusterNetworkConfig `protobuf:"bytes,3,opt,name=cluster_network,json=clusterNetwork,proto3" json:"cluster_network,omitempty"`
-	AllowSchedulingOnMasters bool                  `protobuf:"varint,4,opt,name=allow_scheduling_on_masters,json=allowSchedulingOnMasters,proto3" json:"allow_scheduling_on_masters,omitempty"`
+	MemberId         uint64   `protobuf:"varint,10,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ProtocolVersion  string   `protobuf:"bytes,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	DbSize           int64    `protobuf:"varint,2,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
+	DbSizeInUse      int64    `protobuf:"varint,3,opt,name=db_size_in_use,json=dbSizeInUse,proto3" json:"db_size_in_use,omitempty"`
+	Leader           uint64   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	RaftIndex        uint64   `protobuf:"varint,5,opt,name=raft_index,json=raftIndex,proto3" json:"raft_index,omitempty"`
+	RaftTerm         uint64   `protobuf:"varint,6,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`
+	RaftAppliedIndex uint64   `protobuf:"varint,7,opt,name=raft_applied_index,json=raftAppliedIndex,proto3" json:"raft_applied_index,omitempty"`
+	Errors           []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	IsLearner        bool     `protobuf:"varint,9,opt,name=is_learner,json=isLearner,proto3" json:"is_learner,omitempty"`
+	// Backend database size quota (`quota-backend-bytes`) of the member.
+	DbSizeQuota int64 `protobuf:"varint,11,opt,name=db_size_quota,json=dbSizeQuota,proto3" json:"db_size_quota,omitempty"`
 }
 
-func (x *ClusterConfig) Reset() {
-	*x = ClusterConfig{}
+func (x *EtcdMemberStatus) Reset() {
+	*x = EtcdMemberStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8418,13 +8546,13 @@ func (x *ClusterConfig) Reset() {
 	}
 }
 
-func (x *ClusterConfig) String() string {
+func (x *EtcdMemberStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterConfig) ProtoMessage() {}
+func (*EtcdMemberStatus) ProtoMessage() {}
 
-func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
+func (x *EtcdMemberStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8436,54 +8564,102 @@ func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterConfig.ProtoReflect.Descriptor instead.
-func (*ClusterConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdMemberStatus.ProtoReflect.Descriptor instead.
+func (*EtcdMemberStatus) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ClusterConfig) GetName() string {
+func (x *EtcdMemberStatus) GetMemberId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *EtcdMemberStatus) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
 	}
 	return ""
 }
 
-func (x *ClusterConfig) GetControlPlane() *ControlPlaneConfig {
+func (x *EtcdMemberStatus) GetDbSize() int64 {
 	if x != nil {
-		return x.ControlPlane
+		return x.DbSize
 	}
-	return nil
+	return 0
 }
 
-func (x *ClusterConfig) GetClusterNetwork() *ClusterNetworkConfig {
+func (x *EtcdMemberStatus) GetDbSizeInUse() int64 {
 	if x != nil {
-		return x.ClusterNetwork
+		return x.DbSizeInUse
+	}
+	return 0
+}
+
+func (x *EtcdMemberStatus) GetLeader() uint64 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *EtcdMemberStatus) GetRaftIndex() uint64 {
+	if x != nil {
+		return x.RaftIndex
+	}
+	return 0
+}
+
+func (x *EtcdMemberStatus) GetRaftTerm() uint64 {
+	if x != nil {
+		return x.RaftTerm
+	}
+	return 0
+}
+
+func (x *EtcdMemberStatus) GetRaftAppliedIndex() uint64 {
+	if x != nil {
+		return x.RaftAppliedIndex
+	}
+	return 0
+}
+
+func (x *EtcdMemberStatus) GetErrors() []string {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *ClusterConfig) GetAllowSchedulingOnMasters() bool {
+func (x *EtcdMemberStatus) GetIsLearner() bool {
 	if x != nil {
-		return x.AllowSchedulingOnMasters
+		return x.IsLearner
 	}
 	return false
 }
 
-// GenerateConfigurationRequest describes a request to generate a new configuration
-// on a node.
-type GenerateConfigurationRequest struct {
+func (x *EtcdMemberStatus) GetDbSizeQuota() int64 {
+	if x != nil {
+		return x.DbSizeQuota
+	}
+	return 0
+}
+
+type ServiceMetrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConfigVersion string                 `protobuf:"bytes,1,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	ClusterConfig *ClusterConfig         `protobuf:"bytes,2,opt,name=cluster_config,json=clusterConfig,proto3" json:"cluster_config,omitempty"`
-	MachineConfig *MachineConfig         `protobuf:"bytes,3,opt,name=machine_config,json=machineConfig,proto3" json:"machine_config,omitempty"`
-	OverrideTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=override_time,json=overrideTime,proto3" json:"override_time,omitempty"`
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Metrics in the Prometheus text exposition format.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Error encountered while fetching the metrics of the service.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GenerateConfigurationRequest) Reset() {
-	*x = GenerateConfigurationRequest{}
+func (x *ServiceMetrics) Reset() {
+	*x = ServiceMetrics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8491,13 +8667,13 @@ func (x *GenerateConfigurationRequest) Reset() {
 	}
 }
 
-func (x *GenerateConfigurationRequest) String() string {
+func (x *ServiceMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateConfigurationRequest) ProtoMessage() {}
+func (*ServiceMetrics) ProtoMessage() {}
 
-func (x *GenerateConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *ServiceMetrics) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8509,52 +8685,43 @@ func (x *GenerateConfigurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*GenerateConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceMetrics.ProtoReflect.Descriptor instead.
+func (*ServiceMetrics) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GenerateConfigurationRequest) GetConfigVersion() string {
+func (x *ServiceMetrics) GetService() string {
 	if x != nil {
-		return x.ConfigVersion
+		return x.Service
 	}
 	return ""
 }
 
-func (x *GenerateConfigurationRequest) GetClusterConfig() *ClusterConfig {
-	if x != nil {
-		return x.ClusterConfig
-	}
-	return nil
-}
-
-func (x *GenerateConfigurationRequest) GetMachineConfig() *MachineConfig {
+func (x *ServiceMetrics) GetText() string {
 	if x != nil {
-		return x.MachineConfig
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-func (x *GenerateConfigurationRequest) GetOverrideTime() *timestamppb.Timestamp {
+func (x *ServiceMetrics) GetError() string {
 	if x != nil {
-		return x.OverrideTime
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-// GenerateConfiguration describes the response to a generate configuration request.
-type GenerateConfiguration struct {
+type Metrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata    *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Data        [][]byte         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
-	Talosconfig []byte           `protobuf:"bytes,3,opt,name=talosconfig,proto3" json:"talosconfig,omitempty"`
+	Metadata *common.Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Services []*ServiceMetrics `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
 }
 
-func (x *GenerateConfiguration) Reset() {
-	*x = GenerateConfiguration{}
+func (x *Metrics) Reset() {
+	*x = Metrics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8562,13 +8729,13 @@ func (x *GenerateConfiguration) Reset() {
 	}
 }
 
-func (x *GenerateConfiguration) String() string {
+func (x *Metrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateConfiguration) ProtoMessage() {}
+func (*Metrics) ProtoMessage() {}
 
-func (x *GenerateConfiguration) ProtoReflect() protoreflect.Message {
+func (x *Metrics) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8580,42 +8747,35 @@ func (x *GenerateConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateConfiguration.ProtoReflect.Descriptor instead.
-func (*GenerateConfiguration) Descriptor() ([]byte, []int) {
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GenerateConfiguration) GetMetadata() *common.Metadata {
+func (x *Metrics) GetMetadata() *common.Metadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *GenerateConfiguration) GetData() [][]byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *GenerateConfiguration) GetTalosconfig() []byte {
+func (x *Metrics) GetServices() []*ServiceMetrics {
 	if x != nil {
-		return x.Talosconfig
+		return x.Services
 	}
 	return nil
 }
 
-type GenerateConfigurationResponse struct {
+type MetricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*GenerateConfiguration `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages []*Metrics `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *GenerateConfigurationResponse) Reset() {
-	*x = GenerateConfigurationResponse{}
+func (x *MetricsResponse) Reset() {
+	*x = MetricsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8623,13 +8783,13 @@ func (x *GenerateConfigurationResponse) Reset() {
 	}
 }
 
-func (x *GenerateConfigurationResponse) String() string {
+func (x *MetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateConfigurationResponse) ProtoMessage() {}
+func (*MetricsResponse) ProtoMessage() {}
 
-func (x *GenerateConfigurationResponse) ProtoReflect() protoreflect.Message {
+func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8641,31 +8801,30 @@ func (x *GenerateConfigurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*GenerateConfigurationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsResponse.ProtoReflect.Descriptor instead.
+func (*MetricsResponse) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GenerateConfigurationResponse) GetMessages() []*GenerateConfiguration {
+func (x *MetricsResponse) GetMessages() []*Metrics {
 	if x != nil {
 		return x.Messages
 	}
 	return nil
 }
 
-type GenerateClientConfigurationRequest struct {
+type RouteConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Roles in the generated client certificate.
-	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	// Client certificate TTL.
-	CrtTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=crt_ttl,json=crtTtl,proto3" json:"crt_ttl,omitempty"`
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Gateway string `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Metric  uint32 `protobuf:"varint,3,opt,name=metric,proto3" json:"metric,omitempty"`
 }
 
-func (x *GenerateClientConfigurationRequest) Reset() {
-	*x = GenerateClientConfigurationRequest{}
+func (x *RouteConfig) Reset() {
+	*x = RouteConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8673,13 +8832,13 @@ func (x *GenerateClientConfigurationRequest) Reset() {
 	}
 }
 
-func (x *GenerateClientConfigurationRequest) String() string {
+func (x *RouteConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateClientConfigurationRequest) ProtoMessage() {}
+func (*RouteConfig) ProtoMessage() {}
 
-func (x *GenerateClientConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *RouteConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8691,43 +8850,42 @@ func (x *GenerateClientConfigurationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateClientConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*GenerateClientConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteConfig.ProtoReflect.Descriptor instead.
+func (*RouteConfig) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GenerateClientConfigurationRequest) GetRoles() []string {
+func (x *RouteConfig) GetNetwork() string {
 	if x != nil {
-		return x.Roles
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *GenerateClientConfigurationRequest) GetCrtTtl() *durationpb.Duration {
+func (x *RouteConfig) GetGateway() string {
 	if x != nil {
-		return x.CrtTtl
+		return x.Gateway
 	}
-	return nil
+	return ""
 }
 
-type GenerateClientConfiguration struct {
+func (x *RouteConfig) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+type DHCPOptionsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// PEM-encoded CA certificate.
-	Ca []byte `protobuf:"bytes,2,opt,name=ca,proto3" json:"ca,omitempty"`
-	// PEM-encoded generated client certificate.
-	Crt []byte `protobuf:"bytes,3,opt,name=crt,proto3" json:"crt,omitempty"`
-	// PEM-encoded generated client key.
-	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	// Client configuration (talosconfig) file content.
-	Talosconfig []byte `protobuf:"bytes,5,opt,name=talosconfig,proto3" json:"talosconfig,omitempty"`
+	RouteMetric uint32 `protobuf:"varint,1,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
 }
 
-func (x *GenerateClientConfiguration) Reset() {
-	*x = GenerateClientConfiguration{}
+func (x *DHCPOptionsConfig) Reset() {
+	*x = DHCPOptionsConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_machine_machine_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8735,13 +8893,13 @@ func (x *GenerateClientConfiguration) Reset() {
 	}
 }
 
-func (x *GenerateClientConfiguration) String() string {
+func (x *DHCPOptionsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateClientConfiguration) ProtoMessage() {}
+func (*DHCPOptionsConfig) ProtoMessage() {}
 
-func (x *GenerateClientConfiguration) ProtoReflect() protoreflect.Message {
+func (x *DHCPOptionsConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_machine_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8753,71 +8911,194 @@ func (x *GenerateClientConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateClientConfiguration.ProtoReflect.Descriptor instead.
-func (*GenerateClientConfiguration) Descriptor() ([]byte, []int) {
+// Deprecated: Use DHCPOptionsConfig.ProtoReflect.Descriptor instead.
+func (*DHCPOptionsConfig) Descriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GenerateClientConfiguration) GetMetadata() *common.Metadata {
+func (x *DHCPOptionsConfig) GetRouteMetric() uint32 {
 	if x != nil {
-		return x.Metadata
+		return x.RouteMetric
 	}
-	return nil
+	return 0
 }
 
-func (x *GenerateClientConfiguration) GetCa() []byte {
+type NetworkDeviceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface   string             `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Cidr        string             `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Mtu         int32              `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Dhcp        bool               `protobuf:"varint,4,opt,name=dhcp,proto3" json:"dhcp,omitempty"`
+	Ignore      bool               `protobuf:"varint,5,opt,name=ignore,proto3" json:"ignore,omitempty"`
+	DhcpOptions *DHCPOptionsConfig `protobuf:"bytes,6,opt,name=dhcp_options,json=dhcpOptions,proto3" json:"dhcp_options,omitempty"`
+	Routes      []*RouteConfig     `protobuf:"bytes,7,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *NetworkDeviceConfig) Reset() {
+	*x = NetworkDeviceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkDeviceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDeviceConfig) ProtoMessage() {}
+
+func (x *NetworkDeviceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDeviceConfig.ProtoReflect.Descriptor instead.
+func (*NetworkDeviceConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *NetworkDeviceConfig) GetInterface() string {
 	if x != nil {
-		return x.Ca
+		return x.Interface
 	}
-	return nil
+	return ""
 }
 
-func (x *GenerateClientConfiguration) GetCrt() []byte {
+func (x *NetworkDeviceConfig) GetCidr() string {
 	if x != nil {
-		return x.Crt
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *NetworkDeviceConfig) GetMtu() int32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *NetworkDeviceConfig) GetDhcp() bool {
+	if x != nil {
+		return x.Dhcp
+	}
+	return false
+}
+
+func (x *NetworkDeviceConfig) GetIgnore() bool {
+	if x != nil {
+		return x.Ignore
+	}
+	return false
+}
+
+func (x *NetworkDeviceConfig) GetDhcpOptions() *DHCPOptionsConfig {
+	if x != nil {
+		return x.DhcpOptions
 	}
 	return nil
 }
 
-func (x *GenerateClientConfiguration) GetKey() []byte {
+func (x *NetworkDeviceConfig) GetRoutes() []*RouteConfig {
 	if x != nil {
-		return x.Key
+		return x.Routes
 	}
 	return nil
 }
 
-func (x *GenerateClientConfiguration) GetTalosconfig() []byte {
+type NetworkConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname   string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Interfaces []*NetworkDeviceConfig `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *NetworkConfig) Reset() {
+	*x = NetworkConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConfig) ProtoMessage() {}
+
+func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *NetworkConfig) GetHostname() string {
 	if x != nil {
-		return x.Talosconfig
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetInterfaces() []*NetworkDeviceConfig {
+	if x != nil {
+		return x.Interfaces
 	}
 	return nil
 }
 
-type GenerateClientConfigurationResponse struct {
+type InstallConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*GenerateClientConfiguration `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	InstallDisk  string `protobuf:"bytes,1,opt,name=install_disk,json=installDisk,proto3" json:"install_disk,omitempty"`
+	InstallImage string `protobuf:"bytes,2,opt,name=install_image,json=installImage,proto3" json:"install_image,omitempty"`
 }
 
-func (x *GenerateClientConfigurationResponse) Reset() {
-	*x = GenerateClientConfigurationResponse{}
+func (x *InstallConfig) Reset() {
+	*x = InstallConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_machine_machine_proto_msgTypes[128]
+		mi := &file_machine_machine_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateClientConfigurationResponse) String() string {
+func (x *InstallConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateClientConfigurationResponse) ProtoMessage() {}
+func (*InstallConfig) ProtoMessage() {}
 
-func (x *GenerateClientConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[128]
+func (x *InstallConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8828,25 +9109,2181 @@ func (x *GenerateClientConfigurationResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateClientConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*GenerateClientConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_machine_machine_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use InstallConfig.ProtoReflect.Descriptor instead.
+func (*InstallConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *GenerateClientConfigurationResponse) GetMessages() []*GenerateClientConfiguration {
+func (x *InstallConfig) GetInstallDisk() string {
 	if x != nil {
-		return x.Messages
+		return x.InstallDisk
+	}
+	return ""
+}
+
+func (x *InstallConfig) GetInstallImage() string {
+	if x != nil {
+		return x.InstallImage
+	}
+	return ""
+}
+
+type MachineConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              MachineConfig_MachineType `protobuf:"varint,1,opt,name=type,proto3,enum=machine.MachineConfig_MachineType" json:"type,omitempty"`
+	InstallConfig     *InstallConfig            `protobuf:"bytes,2,opt,name=install_config,json=installConfig,proto3" json:"install_config,omitempty"`
+	NetworkConfig     *NetworkConfig            `protobuf:"bytes,3,opt,name=network_config,json=networkConfig,proto3" json:"network_config,omitempty"`
+	KubernetesVersion string                    `protobuf:"bytes,4,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
+}
+
+func (x *MachineConfig) Reset() {
+	*x = MachineConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MachineConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineConfig) ProtoMessage() {}
+
+func (x *MachineConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineConfig.ProtoReflect.Descriptor instead.
+func (*MachineConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *MachineConfig) GetType() MachineConfig_MachineType {
+	if x != nil {
+		return x.Type
+	}
+	return MachineConfig_TYPE_UNKNOWN
+}
+
+func (x *MachineConfig) GetInstallConfig() *InstallConfig {
+	if x != nil {
+		return x.InstallConfig
 	}
 	return nil
 }
 
-var File_machine_machine_proto protoreflect.FileDescriptor
+func (x *MachineConfig) GetNetworkConfig() *NetworkConfig {
+	if x != nil {
+		return x.NetworkConfig
+	}
+	return nil
+}
 
-var file_machine_machine_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+func (x *MachineConfig) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
+}
+
+type ControlPlaneConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+}
+
+func (x *ControlPlaneConfig) Reset() {
+	*x = ControlPlaneConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlPlaneConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlPlaneConfig) ProtoMessage() {}
+
+func (x *ControlPlaneConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlPlaneConfig.ProtoReflect.Descriptor instead.
+func (*ControlPlaneConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ControlPlaneConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type CNIConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Urls []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *CNIConfig) Reset() {
+	*x = CNIConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CNIConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CNIConfig) ProtoMessage() {}
+
+func (x *CNIConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CNIConfig.ProtoReflect.Descriptor instead.
+func (*CNIConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *CNIConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CNIConfig) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type ClusterNetworkConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsDomain string     `protobuf:"bytes,1,opt,name=dns_domain,json=dnsDomain,proto3" json:"dns_domain,omitempty"`
+	CniConfig *CNIConfig `protobuf:"bytes,2,opt,name=cni_config,json=cniConfig,proto3" json:"cni_config,omitempty"`
+}
+
+func (x *ClusterNetworkConfig) Reset() {
+	*x = ClusterNetworkConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterNetworkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterNetworkConfig) ProtoMessage() {}
+
+func (x *ClusterNetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterNetworkConfig.ProtoReflect.Descriptor instead.
+func (*ClusterNetworkConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ClusterNetworkConfig) GetDnsDomain() string {
+	if x != nil {
+		return x.DnsDomain
+	}
+	return ""
+}
+
+func (x *ClusterNetworkConfig) GetCniConfig() *CNIConfig {
+	if x != nil {
+		return x.CniConfig
+	}
+	return nil
+}
+
+type ClusterConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                     string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ControlPlane             *ControlPlaneConfig   `protobuf:"bytes,2,opt,name=control_plane,json=controlPlane,proto3" json:"control_plane,omitempty"`
+	ClusterNetwork           *ClusterNetworkConfig `protobuf:"bytes,3,opt,name=cluster_network,json=clusterNetwork,proto3" json:"cluster_network,omitempty"`
+	AllowSchedulingOnMasters bool                  `protobuf:"varint,4,opt,name=allow_scheduling_on_masters,json=allowSchedulingOnMasters,proto3" json:"allow_scheduling_on_masters,omitempty"`
+}
+
+func (x *ClusterConfig) Reset() {
+	*x = ClusterConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterConfig) ProtoMessage() {}
+
+func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterConfig.ProtoReflect.Descriptor instead.
+func (*ClusterConfig) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ClusterConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClusterConfig) GetControlPlane() *ControlPlaneConfig {
+	if x != nil {
+		return x.ControlPlane
+	}
+	return nil
+}
+
+func (x *ClusterConfig) GetClusterNetwork() *ClusterNetworkConfig {
+	if x != nil {
+		return x.ClusterNetwork
+	}
+	return nil
+}
+
+func (x *ClusterConfig) GetAllowSchedulingOnMasters() bool {
+	if x != nil {
+		return x.AllowSchedulingOnMasters
+	}
+	return false
+}
+
+// GenerateConfigurationRequest describes a request to generate a new configuration
+// on a node.
+type GenerateConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigVersion string                 `protobuf:"bytes,1,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	ClusterConfig *ClusterConfig         `protobuf:"bytes,2,opt,name=cluster_config,json=clusterConfig,proto3" json:"cluster_config,omitempty"`
+	MachineConfig *MachineConfig         `protobuf:"bytes,3,opt,name=machine_config,json=machineConfig,proto3" json:"machine_config,omitempty"`
+	OverrideTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=override_time,json=overrideTime,proto3" json:"override_time,omitempty"`
+}
+
+func (x *GenerateConfigurationRequest) Reset() {
+	*x = GenerateConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConfigurationRequest) ProtoMessage() {}
+
+func (x *GenerateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GenerateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GenerateConfigurationRequest) GetConfigVersion() string {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return ""
+}
+
+func (x *GenerateConfigurationRequest) GetClusterConfig() *ClusterConfig {
+	if x != nil {
+		return x.ClusterConfig
+	}
+	return nil
+}
+
+func (x *GenerateConfigurationRequest) GetMachineConfig() *MachineConfig {
+	if x != nil {
+		return x.MachineConfig
+	}
+	return nil
+}
+
+func (x *GenerateConfigurationRequest) GetOverrideTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OverrideTime
+	}
+	return nil
+}
+
+// GenerateConfiguration describes the response to a generate configuration request.
+type GenerateConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata    *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data        [][]byte         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Talosconfig []byte           `protobuf:"bytes,3,opt,name=talosconfig,proto3" json:"talosconfig,omitempty"`
+}
+
+func (x *GenerateConfiguration) Reset() {
+	*x = GenerateConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConfiguration) ProtoMessage() {}
+
+func (x *GenerateConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConfiguration.ProtoReflect.Descriptor instead.
+func (*GenerateConfiguration) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GenerateConfiguration) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GenerateConfiguration) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GenerateConfiguration) GetTalosconfig() []byte {
+	if x != nil {
+		return x.Talosconfig
+	}
+	return nil
+}
+
+type GenerateConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*GenerateConfiguration `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GenerateConfigurationResponse) Reset() {
+	*x = GenerateConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConfigurationResponse) ProtoMessage() {}
+
+func (x *GenerateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*GenerateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GenerateConfigurationResponse) GetMessages() []*GenerateConfiguration {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type GenerateClientConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Roles in the generated client certificate.
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Client certificate TTL.
+	CrtTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=crt_ttl,json=crtTtl,proto3" json:"crt_ttl,omitempty"`
+	// Common name (CN) of the generated client certificate, e.g. the user identity.
+	CommonName string `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+}
+
+func (x *GenerateClientConfigurationRequest) Reset() {
+	*x = GenerateClientConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateClientConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClientConfigurationRequest) ProtoMessage() {}
+
+func (x *GenerateClientConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClientConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GenerateClientConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GenerateClientConfigurationRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GenerateClientConfigurationRequest) GetCrtTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CrtTtl
+	}
+	return nil
+}
+
+func (x *GenerateClientConfigurationRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+type GenerateClientConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// PEM-encoded CA certificate.
+	Ca []byte `protobuf:"bytes,2,opt,name=ca,proto3" json:"ca,omitempty"`
+	// PEM-encoded generated client certificate.
+	Crt []byte `protobuf:"bytes,3,opt,name=crt,proto3" json:"crt,omitempty"`
+	// PEM-encoded generated client key.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Client configuration (talosconfig) file content.
+	Talosconfig []byte `protobuf:"bytes,5,opt,name=talosconfig,proto3" json:"talosconfig,omitempty"`
+}
+
+func (x *GenerateClientConfiguration) Reset() {
+	*x = GenerateClientConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateClientConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClientConfiguration) ProtoMessage() {}
+
+func (x *GenerateClientConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClientConfiguration.ProtoReflect.Descriptor instead.
+func (*GenerateClientConfiguration) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GenerateClientConfiguration) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GenerateClientConfiguration) GetCa() []byte {
+	if x != nil {
+		return x.Ca
+	}
+	return nil
+}
+
+func (x *GenerateClientConfiguration) GetCrt() []byte {
+	if x != nil {
+		return x.Crt
+	}
+	return nil
+}
+
+func (x *GenerateClientConfiguration) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GenerateClientConfiguration) GetTalosconfig() []byte {
+	if x != nil {
+		return x.Talosconfig
+	}
+	return nil
+}
+
+type GenerateClientConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*GenerateClientConfiguration `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GenerateClientConfigurationResponse) Reset() {
+	*x = GenerateClientConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateClientConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClientConfigurationResponse) ProtoMessage() {}
+
+func (x *GenerateClientConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClientConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*GenerateClientConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GenerateClientConfigurationResponse) GetMessages() []*GenerateClientConfiguration {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type PacketCaptureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name to perform packet capture on.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Enable promiscuous mode.
+	Promiscuous bool `protobuf:"varint,2,opt,name=promiscuous,proto3" json:"promiscuous,omitempty"`
+	// Snap length in bytes, packets are truncated to the snap length.
+	SnapLen uint32 `protobuf:"varint,3,opt,name=snap_len,json=snapLen,proto3" json:"snap_len,omitempty"`
+	// BPF filter compiled by the client, all packets are captured if empty.
+	BpfFilter []*BPFInstruction `protobuf:"bytes,4,rep,name=bpf_filter,json=bpfFilter,proto3" json:"bpf_filter,omitempty"`
+}
+
+func (x *PacketCaptureRequest) Reset() {
+	*x = PacketCaptureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCaptureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptureRequest) ProtoMessage() {}
+
+func (x *PacketCaptureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptureRequest.ProtoReflect.Descriptor instead.
+func (*PacketCaptureRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *PacketCaptureRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *PacketCaptureRequest) GetPromiscuous() bool {
+	if x != nil {
+		return x.Promiscuous
+	}
+	return false
+}
+
+func (x *PacketCaptureRequest) GetSnapLen() uint32 {
+	if x != nil {
+		return x.SnapLen
+	}
+	return 0
+}
+
+func (x *PacketCaptureRequest) GetBpfFilter() []*BPFInstruction {
+	if x != nil {
+		return x.BpfFilter
+	}
+	return nil
+}
+
+type BPFInstruction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op uint32 `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	Jt uint32 `protobuf:"varint,2,opt,name=jt,proto3" json:"jt,omitempty"`
+	Jf uint32 `protobuf:"varint,3,opt,name=jf,proto3" json:"jf,omitempty"`
+	K  uint32 `protobuf:"varint,4,opt,name=k,proto3" json:"k,omitempty"`
+}
+
+func (x *BPFInstruction) Reset() {
+	*x = BPFInstruction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPFInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPFInstruction) ProtoMessage() {}
+
+func (x *BPFInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPFInstruction.ProtoReflect.Descriptor instead.
+func (*BPFInstruction) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *BPFInstruction) GetOp() uint32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *BPFInstruction) GetJt() uint32 {
+	if x != nil {
+		return x.Jt
+	}
+	return 0
+}
+
+func (x *BPFInstruction) GetJf() uint32 {
+	if x != nil {
+		return x.Jf
+	}
+	return 0
+}
+
+func (x *BPFInstruction) GetK() uint32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+type NetstatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter sockets by the state.
+	Filter NetstatRequest_Filter `protobuf:"varint,1,opt,name=filter,proto3,enum=machine.NetstatRequest_Filter" json:"filter,omitempty"`
+	// Protocols to list the sockets of, all protocols if empty.
+	Protocols []SocketInfo_Protocol `protobuf:"varint,2,rep,packed,name=protocols,proto3,enum=machine.SocketInfo_Protocol" json:"protocols,omitempty"`
+	// Resolve the processes owning the sockets.
+	Pid bool `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	// List the sockets in the network namespaces of the pods as well.
+	Pods bool `protobuf:"varint,4,opt,name=pods,proto3" json:"pods,omitempty"`
+}
+
+func (x *NetstatRequest) Reset() {
+	*x = NetstatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetstatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetstatRequest) ProtoMessage() {}
+
+func (x *NetstatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetstatRequest.ProtoReflect.Descriptor instead.
+func (*NetstatRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *NetstatRequest) GetFilter() NetstatRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return NetstatRequest_ALL
+}
+
+func (x *NetstatRequest) GetProtocols() []SocketInfo_Protocol {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *NetstatRequest) GetPid() bool {
+	if x != nil {
+		return x.Pid
+	}
+	return false
+}
+
+func (x *NetstatRequest) GetPods() bool {
+	if x != nil {
+		return x.Pods
+	}
+	return false
+}
+
+type SocketInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol      SocketInfo_Protocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=machine.SocketInfo_Protocol" json:"protocol,omitempty"`
+	LocalAddress  string              `protobuf:"bytes,2,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	LocalPort     uint32              `protobuf:"varint,3,opt,name=local_port,json=localPort,proto3" json:"local_port,omitempty"`
+	RemoteAddress string              `protobuf:"bytes,4,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	RemotePort    uint32              `protobuf:"varint,5,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	// Socket state, e.g. LISTEN or ESTABLISHED.
+	State   string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	TxQueue uint64 `protobuf:"varint,7,opt,name=tx_queue,json=txQueue,proto3" json:"tx_queue,omitempty"`
+	RxQueue uint64 `protobuf:"varint,8,opt,name=rx_queue,json=rxQueue,proto3" json:"rx_queue,omitempty"`
+	Uid     uint32 `protobuf:"varint,9,opt,name=uid,proto3" json:"uid,omitempty"`
+	Inode   uint64 `protobuf:"varint,10,opt,name=inode,proto3" json:"inode,omitempty"`
+	// Path of the unix socket.
+	Path string `protobuf:"bytes,11,opt,name=path,proto3" json:"path,omitempty"`
+	// Process owning the socket, set if requested.
+	Pid     int32  `protobuf:"varint,12,opt,name=pid,proto3" json:"pid,omitempty"`
+	Command string `protobuf:"bytes,13,opt,name=command,proto3" json:"command,omitempty"`
+	// Network namespace of the socket, empty for the host network namespace.
+	Netns string `protobuf:"bytes,14,opt,name=netns,proto3" json:"netns,omitempty"`
+}
+
+func (x *SocketInfo) Reset() {
+	*x = SocketInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocketInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocketInfo) ProtoMessage() {}
+
+func (x *SocketInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocketInfo.ProtoReflect.Descriptor instead.
+func (*SocketInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SocketInfo) GetProtocol() SocketInfo_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return SocketInfo_TCP
+}
+
+func (x *SocketInfo) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *SocketInfo) GetLocalPort() uint32 {
+	if x != nil {
+		return x.LocalPort
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *SocketInfo) GetRemotePort() uint32 {
+	if x != nil {
+		return x.RemotePort
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SocketInfo) GetTxQueue() uint64 {
+	if x != nil {
+		return x.TxQueue
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetRxQueue() uint64 {
+	if x != nil {
+		return x.RxQueue
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetInode() uint64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SocketInfo) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *SocketInfo) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SocketInfo) GetNetns() string {
+	if x != nil {
+		return x.Netns
+	}
+	return ""
+}
+
+type Netstat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Sockets  []*SocketInfo    `protobuf:"bytes,2,rep,name=sockets,proto3" json:"sockets,omitempty"`
+}
+
+func (x *Netstat) Reset() {
+	*x = Netstat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Netstat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Netstat) ProtoMessage() {}
+
+func (x *Netstat) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Netstat.ProtoReflect.Descriptor instead.
+func (*Netstat) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *Netstat) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Netstat) GetSockets() []*SocketInfo {
+	if x != nil {
+		return x.Sockets
+	}
+	return nil
+}
+
+type NetstatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Netstat `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *NetstatResponse) Reset() {
+	*x = NetstatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetstatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetstatResponse) ProtoMessage() {}
+
+func (x *NetstatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetstatResponse.ProtoReflect.Descriptor instead.
+func (*NetstatResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *NetstatResponse) GetMessages() []*Netstat {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type CgroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the cgroup relative to the cgroup root, the whole hierarchy if empty.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *CgroupsRequest) Reset() {
+	*x = CgroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupsRequest) ProtoMessage() {}
+
+func (x *CgroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupsRequest.ProtoReflect.Descriptor instead.
+func (*CgroupsRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *CgroupsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CgroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the cgroup relative to the cgroup root, e.g. /system/runtime.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Processes which are members of the cgroup.
+	Processes      []*CgroupProcess `protobuf:"bytes,2,rep,name=processes,proto3" json:"processes,omitempty"`
+	Cpu            *CgroupCPU       `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory         *CgroupMemory    `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Io             []*CgroupIOStat  `protobuf:"bytes,5,rep,name=io,proto3" json:"io,omitempty"`
+	CpuPressure    *CgroupPressure  `protobuf:"bytes,6,opt,name=cpu_pressure,json=cpuPressure,proto3" json:"cpu_pressure,omitempty"`
+	MemoryPressure *CgroupPressure  `protobuf:"bytes,7,opt,name=memory_pressure,json=memoryPressure,proto3" json:"memory_pressure,omitempty"`
+	IoPressure     *CgroupPressure  `protobuf:"bytes,8,opt,name=io_pressure,json=ioPressure,proto3" json:"io_pressure,omitempty"`
+}
+
+func (x *CgroupInfo) Reset() {
+	*x = CgroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupInfo) ProtoMessage() {}
+
+func (x *CgroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupInfo.ProtoReflect.Descriptor instead.
+func (*CgroupInfo) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *CgroupInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CgroupInfo) GetProcesses() []*CgroupProcess {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *CgroupInfo) GetCpu() *CgroupCPU {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *CgroupInfo) GetMemory() *CgroupMemory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *CgroupInfo) GetIo() []*CgroupIOStat {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+func (x *CgroupInfo) GetCpuPressure() *CgroupPressure {
+	if x != nil {
+		return x.CpuPressure
+	}
+	return nil
+}
+
+func (x *CgroupInfo) GetMemoryPressure() *CgroupPressure {
+	if x != nil {
+		return x.MemoryPressure
+	}
+	return nil
+}
+
+func (x *CgroupInfo) GetIoPressure() *CgroupPressure {
+	if x != nil {
+		return x.IoPressure
+	}
+	return nil
+}
+
+type CgroupProcess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid     int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *CgroupProcess) Reset() {
+	*x = CgroupProcess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupProcess) ProtoMessage() {}
+
+func (x *CgroupProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupProcess.ProtoReflect.Descriptor instead.
+func (*CgroupProcess) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CgroupProcess) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *CgroupProcess) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+// CgroupCPU describes the CPU usage and limits, the limits are set to the maximum uint64 value if not limited.
+type CgroupCPU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight uint64 `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Quota in microseconds per period.
+	MaxQuota      uint64 `protobuf:"varint,2,opt,name=max_quota,json=maxQuota,proto3" json:"max_quota,omitempty"`
+	MaxPeriod     uint64 `protobuf:"varint,3,opt,name=max_period,json=maxPeriod,proto3" json:"max_period,omitempty"`
+	UsageUsec     uint64 `protobuf:"varint,4,opt,name=usage_usec,json=usageUsec,proto3" json:"usage_usec,omitempty"`
+	UserUsec      uint64 `protobuf:"varint,5,opt,name=user_usec,json=userUsec,proto3" json:"user_usec,omitempty"`
+	SystemUsec    uint64 `protobuf:"varint,6,opt,name=system_usec,json=systemUsec,proto3" json:"system_usec,omitempty"`
+	NrPeriods     uint64 `protobuf:"varint,7,opt,name=nr_periods,json=nrPeriods,proto3" json:"nr_periods,omitempty"`
+	NrThrottled   uint64 `protobuf:"varint,8,opt,name=nr_throttled,json=nrThrottled,proto3" json:"nr_throttled,omitempty"`
+	ThrottledUsec uint64 `protobuf:"varint,9,opt,name=throttled_usec,json=throttledUsec,proto3" json:"throttled_usec,omitempty"`
+}
+
+func (x *CgroupCPU) Reset() {
+	*x = CgroupCPU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupCPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupCPU) ProtoMessage() {}
+
+func (x *CgroupCPU) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupCPU.ProtoReflect.Descriptor instead.
+func (*CgroupCPU) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CgroupCPU) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetMaxQuota() uint64 {
+	if x != nil {
+		return x.MaxQuota
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetMaxPeriod() uint64 {
+	if x != nil {
+		return x.MaxPeriod
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetUsageUsec() uint64 {
+	if x != nil {
+		return x.UsageUsec
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetUserUsec() uint64 {
+	if x != nil {
+		return x.UserUsec
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetSystemUsec() uint64 {
+	if x != nil {
+		return x.SystemUsec
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetNrPeriods() uint64 {
+	if x != nil {
+		return x.NrPeriods
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetNrThrottled() uint64 {
+	if x != nil {
+		return x.NrThrottled
+	}
+	return 0
+}
+
+func (x *CgroupCPU) GetThrottledUsec() uint64 {
+	if x != nil {
+		return x.ThrottledUsec
+	}
+	return 0
+}
+
+// CgroupMemory describes the memory usage and limits in bytes, the limits are set to the maximum uint64 value if not limited.
+type CgroupMemory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current uint64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Min     uint64 `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
+	Low     uint64 `protobuf:"varint,3,opt,name=low,proto3" json:"low,omitempty"`
+	High    uint64 `protobuf:"varint,4,opt,name=high,proto3" json:"high,omitempty"`
+	Max     uint64 `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Anon    uint64 `protobuf:"varint,6,opt,name=anon,proto3" json:"anon,omitempty"`
+	File    uint64 `protobuf:"varint,7,opt,name=file,proto3" json:"file,omitempty"`
+	OomKill uint64 `protobuf:"varint,8,opt,name=oom_kill,json=oomKill,proto3" json:"oom_kill,omitempty"`
+}
+
+func (x *CgroupMemory) Reset() {
+	*x = CgroupMemory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupMemory) ProtoMessage() {}
+
+func (x *CgroupMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupMemory.ProtoReflect.Descriptor instead.
+func (*CgroupMemory) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CgroupMemory) GetCurrent() uint64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetMin() uint64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetLow() uint64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetHigh() uint64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetMax() uint64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetAnon() uint64 {
+	if x != nil {
+		return x.Anon
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetFile() uint64 {
+	if x != nil {
+		return x.File
+	}
+	return 0
+}
+
+func (x *CgroupMemory) GetOomKill() uint64 {
+	if x != nil {
+		return x.OomKill
+	}
+	return 0
+}
+
+type CgroupIOStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Block device as major:minor.
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Block device name, e.g. sda.
+	DeviceName string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	Rbytes     uint64 `protobuf:"varint,3,opt,name=rbytes,proto3" json:"rbytes,omitempty"`
+	Wbytes     uint64 `protobuf:"varint,4,opt,name=wbytes,proto3" json:"wbytes,omitempty"`
+	Rios       uint64 `protobuf:"varint,5,opt,name=rios,proto3" json:"rios,omitempty"`
+	Wios       uint64 `protobuf:"varint,6,opt,name=wios,proto3" json:"wios,omitempty"`
+	Dbytes     uint64 `protobuf:"varint,7,opt,name=dbytes,proto3" json:"dbytes,omitempty"`
+	Dios       uint64 `protobuf:"varint,8,opt,name=dios,proto3" json:"dios,omitempty"`
+}
+
+func (x *CgroupIOStat) Reset() {
+	*x = CgroupIOStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupIOStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupIOStat) ProtoMessage() {}
+
+func (x *CgroupIOStat) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupIOStat.ProtoReflect.Descriptor instead.
+func (*CgroupIOStat) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CgroupIOStat) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CgroupIOStat) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *CgroupIOStat) GetRbytes() uint64 {
+	if x != nil {
+		return x.Rbytes
+	}
+	return 0
+}
+
+func (x *CgroupIOStat) GetWbytes() uint64 {
+	if x != nil {
+		return x.Wbytes
+	}
+	return 0
+}
+
+func (x *CgroupIOStat) GetRios() uint64 {
+	if x != nil {
+		return x.Rios
+	}
+	return 0
+}
+
+func (x *CgroupIOStat) GetWios() uint64 {
+	if x != nil {
+		return x.Wios
+	}
+	return 0
+}
+
+func (x *CgroupIOStat) GetDbytes() uint64 {
+	if x != nil {
+		return x.Dbytes
+	}
+	return 0
+}
+
+func (x *CgroupIOStat) GetDios() uint64 {
+	if x != nil {
+		return x.Dios
+	}
+	return 0
+}
+
+// CgroupPressure describes the pressure stall information (PSI).
+type CgroupPressure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Some *PressureStat `protobuf:"bytes,1,opt,name=some,proto3" json:"some,omitempty"`
+	Full *PressureStat `protobuf:"bytes,2,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (x *CgroupPressure) Reset() {
+	*x = CgroupPressure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupPressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupPressure) ProtoMessage() {}
+
+func (x *CgroupPressure) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupPressure.ProtoReflect.Descriptor instead.
+func (*CgroupPressure) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CgroupPressure) GetSome() *PressureStat {
+	if x != nil {
+		return x.Some
+	}
+	return nil
+}
+
+func (x *CgroupPressure) GetFull() *PressureStat {
+	if x != nil {
+		return x.Full
+	}
+	return nil
+}
+
+type PressureStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Share of the time stalled in percent over the last 10, 60 and 300 seconds.
+	Avg10  float64 `protobuf:"fixed64,1,opt,name=avg10,proto3" json:"avg10,omitempty"`
+	Avg60  float64 `protobuf:"fixed64,2,opt,name=avg60,proto3" json:"avg60,omitempty"`
+	Avg300 float64 `protobuf:"fixed64,3,opt,name=avg300,proto3" json:"avg300,omitempty"`
+	// Total stall time in microseconds.
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *PressureStat) Reset() {
+	*x = PressureStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PressureStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PressureStat) ProtoMessage() {}
+
+func (x *PressureStat) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PressureStat.ProtoReflect.Descriptor instead.
+func (*PressureStat) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *PressureStat) GetAvg10() float64 {
+	if x != nil {
+		return x.Avg10
+	}
+	return 0
+}
+
+func (x *PressureStat) GetAvg60() float64 {
+	if x != nil {
+		return x.Avg60
+	}
+	return 0
+}
+
+func (x *PressureStat) GetAvg300() float64 {
+	if x != nil {
+		return x.Avg300
+	}
+	return 0
+}
+
+func (x *PressureStat) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Cgroups struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Cgroups  []*CgroupInfo    `protobuf:"bytes,2,rep,name=cgroups,proto3" json:"cgroups,omitempty"`
+}
+
+func (x *Cgroups) Reset() {
+	*x = Cgroups{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cgroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cgroups) ProtoMessage() {}
+
+func (x *Cgroups) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cgroups.ProtoReflect.Descriptor instead.
+func (*Cgroups) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *Cgroups) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Cgroups) GetCgroups() []*CgroupInfo {
+	if x != nil {
+		return x.Cgroups
+	}
+	return nil
+}
+
+type CgroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Cgroups `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *CgroupsResponse) Reset() {
+	*x = CgroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupsResponse) ProtoMessage() {}
+
+func (x *CgroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupsResponse.ProtoReflect.Descriptor instead.
+func (*CgroupsResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *CgroupsResponse) GetMessages() []*Cgroups {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// EncryptionKeySpec describes the encryption key, exactly one of the key kinds should be set.
+type EncryptionKeySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot             int32  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	StaticPassphrase string `protobuf:"bytes,2,opt,name=static_passphrase,json=staticPassphrase,proto3" json:"static_passphrase,omitempty"`
+	NodeId           bool   `protobuf:"varint,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	KmsEndpoint      string `protobuf:"bytes,4,opt,name=kms_endpoint,json=kmsEndpoint,proto3" json:"kms_endpoint,omitempty"`
+}
+
+func (x *EncryptionKeySpec) Reset() {
+	*x = EncryptionKeySpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncryptionKeySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKeySpec) ProtoMessage() {}
+
+func (x *EncryptionKeySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKeySpec.ProtoReflect.Descriptor instead.
+func (*EncryptionKeySpec) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *EncryptionKeySpec) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EncryptionKeySpec) GetStaticPassphrase() string {
+	if x != nil {
+		return x.StaticPassphrase
+	}
+	return ""
+}
+
+func (x *EncryptionKeySpec) GetNodeId() bool {
+	if x != nil {
+		return x.NodeId
+	}
+	return false
+}
+
+func (x *EncryptionKeySpec) GetKmsEndpoint() string {
+	if x != nil {
+		return x.KmsEndpoint
+	}
+	return ""
+}
+
+type UpdateEncryptionKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partition label: STATE or EPHEMERAL.
+	Partition string                             `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Action    UpdateEncryptionKeysRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=machine.UpdateEncryptionKeysRequest_Action" json:"action,omitempty"`
+	// Key to add or rotate, only the slot is used to remove the key.
+	Key *EncryptionKeySpec `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *UpdateEncryptionKeysRequest) Reset() {
+	*x = UpdateEncryptionKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEncryptionKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEncryptionKeysRequest) ProtoMessage() {}
+
+func (x *UpdateEncryptionKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEncryptionKeysRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEncryptionKeysRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *UpdateEncryptionKeysRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *UpdateEncryptionKeysRequest) GetAction() UpdateEncryptionKeysRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return UpdateEncryptionKeysRequest_ADD
+}
+
+func (x *UpdateEncryptionKeysRequest) GetKey() *EncryptionKeySpec {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type UpdateEncryptionKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *UpdateEncryptionKeys) Reset() {
+	*x = UpdateEncryptionKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEncryptionKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEncryptionKeys) ProtoMessage() {}
+
+func (x *UpdateEncryptionKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEncryptionKeys.ProtoReflect.Descriptor instead.
+func (*UpdateEncryptionKeys) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *UpdateEncryptionKeys) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type UpdateEncryptionKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*UpdateEncryptionKeys `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *UpdateEncryptionKeysResponse) Reset() {
+	*x = UpdateEncryptionKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_machine_machine_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEncryptionKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEncryptionKeysResponse) ProtoMessage() {}
+
+func (x *UpdateEncryptionKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEncryptionKeysResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEncryptionKeysResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *UpdateEncryptionKeysResponse) GetMessages() []*UpdateEncryptionKeys {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+var File_machine_machine_proto protoreflect.FileDescriptor
+
+var file_machine_machine_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -9769,322 +12206,662 @@ var file_machine_machine_proto_rawDesc = []byte{
 	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65,
 	0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0x59, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x36, 0x0a, 0x11, 0x44, 0x48,
-	0x43, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x22, 0xf2, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x74, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x68, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x68,
-	0x63, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x68,
-	0x63, 0x70, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x64, 0x68,
-	0x63, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64,
-	0x69, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x0d,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x0b, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
-	0x4f, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x10, 0x03, 0x22, 0x30, 0x0a, 0x12, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x33, 0x0a,
-	0x09, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72,
-	0x6c, 0x73, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e,
-	0x73, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x6e, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6e, 0x69,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x09, 0x63, 0x6e, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xec, 0x01, 0x0a,
-	0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x6c,
-	0x61, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3d, 0x0a, 0x1b,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
-	0x6e, 0x67, 0x4f, 0x6e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x1c,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x3d, 0x0a, 0x0e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x63,
+	0x47, 0x0a, 0x15, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x09, 0x45, 0x74, 0x63, 0x64,
+	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61, 0x6c,
+	0x61, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41,
+	0x6c, 0x61, 0x72, 0x6d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x61, 0x72,
+	0x6d, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x2e, 0x41, 0x6c, 0x61,
+	0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x22, 0x2f, 0x0a,
+	0x09, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x52, 0x52, 0x55, 0x50, 0x54, 0x10, 0x02, 0x22, 0x4f,
+	0x0a, 0x17, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x44, 0x69, 0x73, 0x61, 0x72,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x44,
+	0x69, 0x73, 0x61, 0x72, 0x6d, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0x7e, 0x0a, 0x0f, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x44, 0x69, 0x73, 0x61,
+	0x72, 0x6d, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x61, 0x72, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x61, 0x72,
+	0x6d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x22,
+	0x4d, 0x0a, 0x16, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x3e,
+	0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x45,
+	0x0a, 0x12, 0x45, 0x74, 0x63, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x0a, 0x45, 0x74, 0x63, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x3e, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xf5, 0x02, 0x0a, 0x10, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x61, 0x66, 0x74, 0x54, 0x65, 0x72, 0x6d,
+	0x12, 0x2c, 0x0a, 0x12, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x61,
+	0x66, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6c, 0x65, 0x61,
+	0x72, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4c, 0x65,
+	0x61, 0x72, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x62,
+	0x53, 0x69, 0x7a, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x0e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x6c, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x3f, 0x0a,
+	0x0f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x59,
+	0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x36, 0x0a, 0x11, 0x44, 0x48, 0x43,
+	0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x22, 0xf2, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x74, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x68, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x68, 0x63,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x68, 0x63,
+	0x70, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x64, 0x68, 0x63,
+	0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x22, 0x57, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x69,
+	0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x0d, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x0d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x3f, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a,
-	0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x5b, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x22,
+	0x69, 0x67, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x57, 0x0a, 0x0b, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
+	0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x10, 0x03, 0x22, 0x30, 0x0a, 0x12, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x09,
+	0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e, 0x73,
+	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x6e, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6e, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x4e, 0x49, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x09, 0x63, 0x6e, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xec, 0x01, 0x0a, 0x0d,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3d, 0x0a, 0x1b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
+	0x67, 0x4f, 0x6e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x1c, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x3d, 0x0a, 0x0e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x3f, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x7b, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5b,
+	0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x22,
 	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x74, 0x5f,
 	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x72, 0x74, 0x54, 0x74, 0x6c, 0x22, 0xa1, 0x01, 0x0a,
-	0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x63, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x22, 0x67, 0x0a, 0x23, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x32, 0xb9, 0x15, 0x0a, 0x0e, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x14,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2e, 0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3f, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x12, 0x15, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x30, 0x01, 0x12, 0x32, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x45, 0x74, 0x63,
-	0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x45,
-	0x74, 0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45,
-	0x74, 0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69,
-	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x12, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
-	0x12, 0x3c, 0x0a, 0x0c, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x66,
-	0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x40,
-	0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01,
-	0x12, 0x3b, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x6f,
-	0x61, 0x64, 0x41, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x51, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x23, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
-	0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08,
-	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
-	0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
-	0x12, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64,
-	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-	0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3c, 0x0a, 0x07, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
-	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x72, 0x74, 0x54, 0x74, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa1, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x63,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x63, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x67, 0x0a, 0x23, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
 	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x63, 0x75, 0x6f, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x63, 0x75,
+	0x6f, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6e, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x12, 0x36,
+	0x0a, 0x0a, 0x62, 0x70, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x50, 0x46,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x62, 0x70, 0x66,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x0e, 0x42, 0x50, 0x46, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x6a, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6a, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6a, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6a, 0x66, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x01, 0x6b, 0x22, 0xdb, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x73, 0x74,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x3a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70,
+	0x6f, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x22, 0xeb, 0x03, 0x0a, 0x0a, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78,
+	0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65,
+	0x74, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x43, 0x50, 0x36,
+	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x55,
+	0x44, 0x50, 0x36, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x04, 0x12, 0x08,
+	0x0a, 0x04, 0x52, 0x41, 0x57, 0x36, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x4e, 0x49, 0x58,
+	0x10, 0x06, 0x22, 0x66, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x07, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x4e, 0x65,
+	0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61,
+	0x74, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0e, 0x43,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x8a, 0x03, 0x0a, 0x0a, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x63, 0x70,
+	0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75,
+	0x12, 0x2d, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x25, 0x0a, 0x02, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x4f, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x02, 0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x52, 0x0a, 0x69, 0x6f, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0x3b,
+	0x0a, 0x0d, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0xa5, 0x02, 0x0a, 0x09,
+	0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x73, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x72,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6e, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x72, 0x5f,
+	0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6e, 0x72, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x63, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x63, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c,
+	0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6e, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x61, 0x6e, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6f, 0x6d, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6f, 0x6f, 0x6d, 0x4b, 0x69, 0x6c, 0x6c, 0x22, 0xcb, 0x01, 0x0a, 0x0c,
+	0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6f,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x77, 0x69, 0x6f, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x6f, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x69, 0x6f, 0x73, 0x22, 0x66, 0x0a, 0x0e, 0x43, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x73,
+	0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x52, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x66, 0x75, 0x6c,
+	0x6c, 0x22, 0x68, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x76, 0x67, 0x31, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x61, 0x76, 0x67, 0x31, 0x30, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x76, 0x67, 0x36, 0x30,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x76, 0x67, 0x36, 0x30, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x76, 0x67, 0x33, 0x30, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x76, 0x67, 0x33, 0x30, 0x30, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x66, 0x0a, 0x07, 0x43,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x6d, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x6d, 0x73, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x29, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x4f, 0x54, 0x41, 0x54,
+	0x45, 0x10, 0x02, 0x22, 0x44, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x32, 0xbb, 0x1a, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12,
+	0x3b, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x50, 0x55,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x05, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x32, 0x0a,
+	0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x51, 0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74,
+	0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74,
+	0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x10, 0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74,
+	0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f,
+	0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12,
+	0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f,
+	0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x0c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x1c, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x45,
+	0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x45, 0x74, 0x63,
+	0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63,
+	0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x44,
+	0x69, 0x73, 0x61, 0x72, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72,
+	0x6d, 0x44, 0x69, 0x73, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x45, 0x74,
+	0x63, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x40, 0x0a,
+	0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12,
+	0x3b, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x41, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12,
+	0x39, 0x0a, 0x06, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x6f,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30,
+	0x01, 0x12, 0x3c, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x17, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x07, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x74,
+	0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10099,8 +12876,8 @@ func file_machine_machine_proto_rawDescGZIP() []byte {
 	return file_machine_machine_proto_rawDescData
 }
 
-var file_machine_machine_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_machine_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
+var file_machine_machine_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_machine_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 162)
 var file_machine_machine_proto_goTypes = []interface{}{
 	(ApplyConfigurationRequest_Mode)(0),         // 0: machine.ApplyConfigurationRequest.Mode
 	(RebootRequest_Mode)(0),                     // 1: machine.RebootRequest.Mode
@@ -10109,349 +12886,442 @@ var file_machine_machine_proto_goTypes = []interface{}{
 	(TaskEvent_Action)(0),                       // 4: machine.TaskEvent.Action
 	(ServiceStateEvent_Action)(0),               // 5: machine.ServiceStateEvent.Action
 	(ListRequest_Type)(0),                       // 6: machine.ListRequest.Type
-	(MachineConfig_MachineType)(0),              // 7: machine.MachineConfig.MachineType
-	(*ApplyConfigurationRequest)(nil),           // 8: machine.ApplyConfigurationRequest
-	(*ApplyConfiguration)(nil),                  // 9: machine.ApplyConfiguration
-	(*ApplyConfigurationResponse)(nil),          // 10: machine.ApplyConfigurationResponse
-	(*RebootRequest)(nil),                       // 11: machine.RebootRequest
-	(*Reboot)(nil),                              // 12: machine.Reboot
-	(*RebootResponse)(nil),                      // 13: machine.RebootResponse
-	(*BootstrapRequest)(nil),                    // 14: machine.BootstrapRequest
-	(*Bootstrap)(nil),                           // 15: machine.Bootstrap
-	(*BootstrapResponse)(nil),                   // 16: machine.BootstrapResponse
-	(*SequenceEvent)(nil),                       // 17: machine.SequenceEvent
-	(*PhaseEvent)(nil),                          // 18: machine.PhaseEvent
-	(*TaskEvent)(nil),                           // 19: machine.TaskEvent
-	(*ServiceStateEvent)(nil),                   // 20: machine.ServiceStateEvent
-	(*RestartEvent)(nil),                        // 21: machine.RestartEvent
-	(*ConfigLoadErrorEvent)(nil),                // 22: machine.ConfigLoadErrorEvent
-	(*ConfigValidationErrorEvent)(nil),          // 23: machine.ConfigValidationErrorEvent
-	(*AddressEvent)(nil),                        // 24: machine.AddressEvent
-	(*EventsRequest)(nil),                       // 25: machine.EventsRequest
-	(*Event)(nil),                               // 26: machine.Event
-	(*ResetPartitionSpec)(nil),                  // 27: machine.ResetPartitionSpec
-	(*ResetRequest)(nil),                        // 28: machine.ResetRequest
-	(*Reset)(nil),                               // 29: machine.Reset
-	(*ResetResponse)(nil),                       // 30: machine.ResetResponse
-	(*Shutdown)(nil),                            // 31: machine.Shutdown
-	(*ShutdownRequest)(nil),                     // 32: machine.ShutdownRequest
-	(*ShutdownResponse)(nil),                    // 33: machine.ShutdownResponse
-	(*UpgradeRequest)(nil),                      // 34: machine.UpgradeRequest
-	(*Upgrade)(nil),                             // 35: machine.Upgrade
-	(*UpgradeResponse)(nil),                     // 36: machine.UpgradeResponse
-	(*ServiceList)(nil),                         // 37: machine.ServiceList
-	(*ServiceListResponse)(nil),                 // 38: machine.ServiceListResponse
-	(*ServiceInfo)(nil),                         // 39: machine.ServiceInfo
-	(*ServiceEvents)(nil),                       // 40: machine.ServiceEvents
-	(*ServiceEvent)(nil),                        // 41: machine.ServiceEvent
-	(*ServiceHealth)(nil),                       // 42: machine.ServiceHealth
-	(*ServiceStartRequest)(nil),                 // 43: machine.ServiceStartRequest
-	(*ServiceStart)(nil),                        // 44: machine.ServiceStart
-	(*ServiceStartResponse)(nil),                // 45: machine.ServiceStartResponse
-	(*ServiceStopRequest)(nil),                  // 46: machine.ServiceStopRequest
-	(*ServiceStop)(nil),                         // 47: machine.ServiceStop
-	(*ServiceStopResponse)(nil),                 // 48: machine.ServiceStopResponse
-	(*ServiceRestartRequest)(nil),               // 49: machine.ServiceRestartRequest
-	(*ServiceRestart)(nil),                      // 50: machine.ServiceRestart
-	(*ServiceRestartResponse)(nil),              // 51: machine.ServiceRestartResponse
-	(*CopyRequest)(nil),                         // 52: machine.CopyRequest
-	(*ListRequest)(nil),                         // 53: machine.ListRequest
-	(*DiskUsageRequest)(nil),                    // 54: machine.DiskUsageRequest
-	(*FileInfo)(nil),                            // 55: machine.FileInfo
-	(*DiskUsageInfo)(nil),                       // 56: machine.DiskUsageInfo
-	(*Mounts)(nil),                              // 57: machine.Mounts
-	(*MountsResponse)(nil),                      // 58: machine.MountsResponse
-	(*MountStat)(nil),                           // 59: machine.MountStat
-	(*Version)(nil),                             // 60: machine.Version
-	(*VersionResponse)(nil),                     // 61: machine.VersionResponse
-	(*VersionInfo)(nil),                         // 62: machine.VersionInfo
-	(*PlatformInfo)(nil),                        // 63: machine.PlatformInfo
-	(*FeaturesInfo)(nil),                        // 64: machine.FeaturesInfo
-	(*LogsRequest)(nil),                         // 65: machine.LogsRequest
-	(*ReadRequest)(nil),                         // 66: machine.ReadRequest
-	(*RollbackRequest)(nil),                     // 67: machine.RollbackRequest
-	(*Rollback)(nil),                            // 68: machine.Rollback
-	(*RollbackResponse)(nil),                    // 69: machine.RollbackResponse
-	(*ContainersRequest)(nil),                   // 70: machine.ContainersRequest
-	(*ContainerInfo)(nil),                       // 71: machine.ContainerInfo
-	(*Container)(nil),                           // 72: machine.Container
-	(*ContainersResponse)(nil),                  // 73: machine.ContainersResponse
-	(*DmesgRequest)(nil),                        // 74: machine.DmesgRequest
-	(*ProcessesResponse)(nil),                   // 75: machine.ProcessesResponse
-	(*Process)(nil),                             // 76: machine.Process
-	(*ProcessInfo)(nil),                         // 77: machine.ProcessInfo
-	(*RestartRequest)(nil),                      // 78: machine.RestartRequest
-	(*Restart)(nil),                             // 79: machine.Restart
-	(*RestartResponse)(nil),                     // 80: machine.RestartResponse
-	(*StatsRequest)(nil),                        // 81: machine.StatsRequest
-	(*Stats)(nil),                               // 82: machine.Stats
-	(*StatsResponse)(nil),                       // 83: machine.StatsResponse
-	(*Stat)(nil),                                // 84: machine.Stat
-	(*Memory)(nil),                              // 85: machine.Memory
-	(*MemoryResponse)(nil),                      // 86: machine.MemoryResponse
-	(*MemInfo)(nil),                             // 87: machine.MemInfo
-	(*HostnameResponse)(nil),                    // 88: machine.HostnameResponse
-	(*Hostname)(nil),                            // 89: machine.Hostname
-	(*LoadAvgResponse)(nil),                     // 90: machine.LoadAvgResponse
-	(*LoadAvg)(nil),                             // 91: machine.LoadAvg
-	(*SystemStatResponse)(nil),                  // 92: machine.SystemStatResponse
-	(*SystemStat)(nil),                          // 93: machine.SystemStat
-	(*CPUStat)(nil),                             // 94: machine.CPUStat
-	(*SoftIRQStat)(nil),                         // 95: machine.SoftIRQStat
-	(*CPUInfoResponse)(nil),                     // 96: machine.CPUInfoResponse
-	(*CPUsInfo)(nil),                            // 97: machine.CPUsInfo
-	(*CPUInfo)(nil),                             // 98: machine.CPUInfo
-	(*NetworkDeviceStatsResponse)(nil),          // 99: machine.NetworkDeviceStatsResponse
-	(*NetworkDeviceStats)(nil),                  // 100: machine.NetworkDeviceStats
-	(*NetDev)(nil),                              // 101: machine.NetDev
-	(*DiskStatsResponse)(nil),                   // 102: machine.DiskStatsResponse
-	(*DiskStats)(nil),                           // 103: machine.DiskStats
-	(*DiskStat)(nil),                            // 104: machine.DiskStat
-	(*EtcdLeaveClusterRequest)(nil),             // 105: machine.EtcdLeaveClusterRequest
-	(*EtcdLeaveCluster)(nil),                    // 106: machine.EtcdLeaveCluster
-	(*EtcdLeaveClusterResponse)(nil),            // 107: machine.EtcdLeaveClusterResponse
-	(*EtcdRemoveMemberRequest)(nil),             // 108: machine.EtcdRemoveMemberRequest
-	(*EtcdRemoveMember)(nil),                    // 109: machine.EtcdRemoveMember
-	(*EtcdRemoveMemberResponse)(nil),            // 110: machine.EtcdRemoveMemberResponse
-	(*EtcdForfeitLeadershipRequest)(nil),        // 111: machine.EtcdForfeitLeadershipRequest
-	(*EtcdForfeitLeadership)(nil),               // 112: machine.EtcdForfeitLeadership
-	(*EtcdForfeitLeadershipResponse)(nil),       // 113: machine.EtcdForfeitLeadershipResponse
-	(*EtcdMemberListRequest)(nil),               // 114: machine.EtcdMemberListRequest
-	(*EtcdMember)(nil),                          // 115: machine.EtcdMember
-	(*EtcdMembers)(nil),                         // 116: machine.EtcdMembers
-	(*EtcdMemberListResponse)(nil),              // 117: machine.EtcdMemberListResponse
-	(*EtcdSnapshotRequest)(nil),                 // 118: machine.EtcdSnapshotRequest
-	(*EtcdRecover)(nil),                         // 119: machine.EtcdRecover
-	(*EtcdRecoverResponse)(nil),                 // 120: machine.EtcdRecoverResponse
-	(*RouteConfig)(nil),                         // 121: machine.RouteConfig
-	(*DHCPOptionsConfig)(nil),                   // 122: machine.DHCPOptionsConfig
-	(*NetworkDeviceConfig)(nil),                 // 123: machine.NetworkDeviceConfig
-	(*NetworkConfig)(nil),                       // 124: machine.NetworkConfig
-	(*InstallConfig)(nil),                       // 125: machine.InstallConfig
-	(*MachineConfig)(nil),                       // 126: machine.MachineConfig
-	(*ControlPlaneConfig)(nil),                  // 127: machine.ControlPlaneConfig
-	(*CNIConfig)(nil),                           // 128: machine.CNIConfig
-	(*ClusterNetworkConfig)(nil),                // 129: machine.ClusterNetworkConfig
-	(*ClusterConfig)(nil),                       // 130: machine.ClusterConfig
-	(*GenerateConfigurationRequest)(nil),        // 131: machine.GenerateConfigurationRequest
-	(*GenerateConfiguration)(nil),               // 132: machine.GenerateConfiguration
-	(*GenerateConfigurationResponse)(nil),       // 133: machine.GenerateConfigurationResponse
-	(*GenerateClientConfigurationRequest)(nil),  // 134: machine.GenerateClientConfigurationRequest
-	(*GenerateClientConfiguration)(nil),         // 135: machine.GenerateClientConfiguration
-	(*GenerateClientConfigurationResponse)(nil), // 136: machine.GenerateClientConfigurationResponse
-	(*durationpb.Duration)(nil),                 // 137: google.protobuf.Duration
-	(*common.Metadata)(nil),                     // 138: common.Metadata
-	(*common.Error)(nil),                        // 139: common.Error
-	(*anypb.Any)(nil),                           // 140: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),               // 141: google.protobuf.Timestamp
-	(common.ContainerDriver)(0),                 // 142: common.ContainerDriver
-	(*emptypb.Empty)(nil),                       // 143: google.protobuf.Empty
-	(*common.Data)(nil),                         // 144: common.Data
+	(EtcdMemberAlarm_AlarmType)(0),              // 7: machine.EtcdMemberAlarm.AlarmType
+	(MachineConfig_MachineType)(0),              // 8: machine.MachineConfig.MachineType
+	(NetstatRequest_Filter)(0),                  // 9: machine.NetstatRequest.Filter
+	(SocketInfo_Protocol)(0),                    // 10: machine.SocketInfo.Protocol
+	(UpdateEncryptionKeysRequest_Action)(0),     // 11: machine.UpdateEncryptionKeysRequest.Action
+	(*ApplyConfigurationRequest)(nil),           // 12: machine.ApplyConfigurationRequest
+	(*ApplyConfiguration)(nil),                  // 13: machine.ApplyConfiguration
+	(*ApplyConfigurationResponse)(nil),          // 14: machine.ApplyConfigurationResponse
+	(*RebootRequest)(nil),                       // 15: machine.RebootRequest
+	(*Reboot)(nil),                              // 16: machine.Reboot
+	(*RebootResponse)(nil),                      // 17: machine.RebootResponse
+	(*BootstrapRequest)(nil),                    // 18: machine.BootstrapRequest
+	(*Bootstrap)(nil),                           // 19: machine.Bootstrap
+	(*BootstrapResponse)(nil),                   // 20: machine.BootstrapResponse
+	(*SequenceEvent)(nil),                       // 21: machine.SequenceEvent
+	(*PhaseEvent)(nil),                          // 22: machine.PhaseEvent
+	(*TaskEvent)(nil),                           // 23: machine.TaskEvent
+	(*ServiceStateEvent)(nil),                   // 24: machine.ServiceStateEvent
+	(*RestartEvent)(nil),                        // 25: machine.RestartEvent
+	(*ConfigLoadErrorEvent)(nil),                // 26: machine.ConfigLoadErrorEvent
+	(*ConfigValidationErrorEvent)(nil),          // 27: machine.ConfigValidationErrorEvent
+	(*AddressEvent)(nil),                        // 28: machine.AddressEvent
+	(*EventsRequest)(nil),                       // 29: machine.EventsRequest
+	(*Event)(nil),                               // 30: machine.Event
+	(*ResetPartitionSpec)(nil),                  // 31: machine.ResetPartitionSpec
+	(*ResetRequest)(nil),                        // 32: machine.ResetRequest
+	(*Reset)(nil),                               // 33: machine.Reset
+	(*ResetResponse)(nil),                       // 34: machine.ResetResponse
+	(*Shutdown)(nil),                            // 35: machine.Shutdown
+	(*ShutdownRequest)(nil),                     // 36: machine.ShutdownRequest
+	(*ShutdownResponse)(nil),                    // 37: machine.ShutdownResponse
+	(*UpgradeRequest)(nil),                      // 38: machine.UpgradeRequest
+	(*Upgrade)(nil),                             // 39: machine.Upgrade
+	(*UpgradeResponse)(nil),                     // 40: machine.UpgradeResponse
+	(*ServiceList)(nil),                         // 41: machine.ServiceList
+	(*ServiceListResponse)(nil),                 // 42: machine.ServiceListResponse
+	(*ServiceInfo)(nil),                         // 43: machine.ServiceInfo
+	(*ServiceEvents)(nil),                       // 44: machine.ServiceEvents
+	(*ServiceEvent)(nil),                        // 45: machine.ServiceEvent
+	(*ServiceHealth)(nil),                       // 46: machine.ServiceHealth
+	(*ServiceStartRequest)(nil),                 // 47: machine.ServiceStartRequest
+	(*ServiceStart)(nil),                        // 48: machine.ServiceStart
+	(*ServiceStartResponse)(nil),                // 49: machine.ServiceStartResponse
+	(*ServiceStopRequest)(nil),                  // 50: machine.ServiceStopRequest
+	(*ServiceStop)(nil),                         // 51: machine.ServiceStop
+	(*ServiceStopResponse)(nil),                 // 52: machine.ServiceStopResponse
+	(*ServiceRestartRequest)(nil),               // 53: machine.ServiceRestartRequest
+	(*ServiceRestart)(nil),                      // 54: machine.ServiceRestart
+	(*ServiceRestartResponse)(nil),              // 55: machine.ServiceRestartResponse
+	(*CopyRequest)(nil),                         // 56: machine.CopyRequest
+	(*ListRequest)(nil),                         // 57: machine.ListRequest
+	(*DiskUsageRequest)(nil),                    // 58: machine.DiskUsageRequest
+	(*FileInfo)(nil),                            // 59: machine.FileInfo
+	(*DiskUsageInfo)(nil),                       // 60: machine.DiskUsageInfo
+	(*Mounts)(nil),                              // 61: machine.Mounts
+	(*MountsResponse)(nil),                      // 62: machine.MountsResponse
+	(*MountStat)(nil),                           // 63: machine.MountStat
+	(*Version)(nil),                             // 64: machine.Version
+	(*VersionResponse)(nil),                     // 65: machine.VersionResponse
+	(*VersionInfo)(nil),                         // 66: machine.VersionInfo
+	(*PlatformInfo)(nil),                        // 67: machine.PlatformInfo
+	(*FeaturesInfo)(nil),                        // 68: machine.FeaturesInfo
+	(*LogsRequest)(nil),                         // 69: machine.LogsRequest
+	(*ReadRequest)(nil),                         // 70: machine.ReadRequest
+	(*RollbackRequest)(nil),                     // 71: machine.RollbackRequest
+	(*Rollback)(nil),                            // 72: machine.Rollback
+	(*RollbackResponse)(nil),                    // 73: machine.RollbackResponse
+	(*ContainersRequest)(nil),                   // 74: machine.ContainersRequest
+	(*ContainerInfo)(nil),                       // 75: machine.ContainerInfo
+	(*Container)(nil),                           // 76: machine.Container
+	(*ContainersResponse)(nil),                  // 77: machine.ContainersResponse
+	(*DmesgRequest)(nil),                        // 78: machine.DmesgRequest
+	(*ProcessesResponse)(nil),                   // 79: machine.ProcessesResponse
+	(*Process)(nil),                             // 80: machine.Process
+	(*ProcessInfo)(nil),                         // 81: machine.ProcessInfo
+	(*RestartRequest)(nil),                      // 82: machine.RestartRequest
+	(*Restart)(nil),                             // 83: machine.Restart
+	(*RestartResponse)(nil),                     // 84: machine.RestartResponse
+	(*StatsRequest)(nil),                        // 85: machine.StatsRequest
+	(*Stats)(nil),                               // 86: machine.Stats
+	(*StatsResponse)(nil),                       // 87: machine.StatsResponse
+	(*Stat)(nil),                                // 88: machine.Stat
+	(*Memory)(nil),                              // 89: machine.Memory
+	(*MemoryResponse)(nil),                      // 90: machine.MemoryResponse
+	(*MemInfo)(nil),                             // 91: machine.MemInfo
+	(*HostnameResponse)(nil),                    // 92: machine.HostnameResponse
+	(*Hostname)(nil),                            // 93: machine.Hostname
+	(*LoadAvgResponse)(nil),                     // 94: machine.LoadAvgResponse
+	(*LoadAvg)(nil),                             // 95: machine.LoadAvg
+	(*SystemStatResponse)(nil),                  // 96: machine.SystemStatResponse
+	(*SystemStat)(nil),                          // 97: machine.SystemStat
+	(*CPUStat)(nil),                             // 98: machine.CPUStat
+	(*SoftIRQStat)(nil),                         // 99: machine.SoftIRQStat
+	(*CPUInfoResponse)(nil),                     // 100: machine.CPUInfoResponse
+	(*CPUsInfo)(nil),                            // 101: machine.CPUsInfo
+	(*CPUInfo)(nil),                             // 102: machine.CPUInfo
+	(*NetworkDeviceStatsResponse)(nil),          // 103: machine.NetworkDeviceStatsResponse
+	(*NetworkDeviceStats)(nil),                  // 104: machine.NetworkDeviceStats
+	(*NetDev)(nil),                              // 105: machine.NetDev
+	(*DiskStatsResponse)(nil),                   // 106: machine.DiskStatsResponse
+	(*DiskStats)(nil),                           // 107: machine.DiskStats
+	(*DiskStat)(nil),                            // 108: machine.DiskStat
+	(*EtcdLeaveClusterRequest)(nil),             // 109: machine.EtcdLeaveClusterRequest
+	(*EtcdLeaveCluster)(nil),                    // 110: machine.EtcdLeaveCluster
+	(*EtcdLeaveClusterResponse)(nil),            // 111: machine.EtcdLeaveClusterResponse
+	(*EtcdRemoveMemberRequest)(nil),             // 112: machine.EtcdRemoveMemberRequest
+	(*EtcdRemoveMember)(nil),                    // 113: machine.EtcdRemoveMember
+	(*EtcdRemoveMemberResponse)(nil),            // 114: machine.EtcdRemoveMemberResponse
+	(*EtcdForfeitLeadershipRequest)(nil),        // 115: machine.EtcdForfeitLeadershipRequest
+	(*EtcdForfeitLeadership)(nil),               // 116: machine.EtcdForfeitLeadership
+	(*EtcdForfeitLeadershipResponse)(nil),       // 117: machine.EtcdForfeitLeadershipResponse
+	(*EtcdMemberListRequest)(nil),               // 118: machine.EtcdMemberListRequest
+	(*EtcdMember)(nil),                          // 119: machine.EtcdMember
+	(*EtcdMembers)(nil),                         // 120: machine.EtcdMembers
+	(*EtcdMemberListResponse)(nil),              // 121: machine.EtcdMemberListResponse
+	(*EtcdSnapshotRequest)(nil),                 // 122: machine.EtcdSnapshotRequest
+	(*EtcdRecover)(nil),                         // 123: machine.EtcdRecover
+	(*EtcdRecoverResponse)(nil),                 // 124: machine.EtcdRecoverResponse
+	(*EtcdAlarmListResponse)(nil),               // 125: machine.EtcdAlarmListResponse
+	(*EtcdAlarm)(nil),                           // 126: machine.EtcdAlarm
+	(*EtcdMemberAlarm)(nil),                     // 127: machine.EtcdMemberAlarm
+	(*EtcdAlarmDisarmResponse)(nil),             // 128: machine.EtcdAlarmDisarmResponse
+	(*EtcdAlarmDisarm)(nil),                     // 129: machine.EtcdAlarmDisarm
+	(*EtcdDefragmentResponse)(nil),              // 130: machine.EtcdDefragmentResponse
+	(*EtcdDefragment)(nil),                      // 131: machine.EtcdDefragment
+	(*EtcdStatusResponse)(nil),                  // 132: machine.EtcdStatusResponse
+	(*EtcdStatus)(nil),                          // 133: machine.EtcdStatus
+	(*EtcdMemberStatus)(nil),                    // 134: machine.EtcdMemberStatus
+	(*ServiceMetrics)(nil),                      // 135: machine.ServiceMetrics
+	(*Metrics)(nil),                             // 136: machine.Metrics
+	(*MetricsResponse)(nil),                     // 137: machine.MetricsResponse
+	(*RouteConfig)(nil),                         // 138: machine.RouteConfig
+	(*DHCPOptionsConfig)(nil),                   // 139: machine.DHCPOptionsConfig
+	(*NetworkDeviceConfig)(nil),                 // 140: machine.NetworkDeviceConfig
+	(*NetworkConfig)(nil),                       // 141: machine.NetworkConfig
+	(*InstallConfig)(nil),                       // 142: machine.InstallConfig
+	(*MachineConfig)(nil),                       // 143: machine.MachineConfig
+	(*ControlPlaneConfig)(nil),                  // 144: machine.ControlPlaneConfig
+	(*CNIConfig)(nil),                           // 145: machine.CNIConfig
+	(*ClusterNetworkConfig)(nil),                // 146: machine.ClusterNetworkConfig
+	(*ClusterConfig)(nil),                       // 147: machine.ClusterConfig
+	(*GenerateConfigurationRequest)(nil),        // 148: machine.GenerateConfigurationRequest
+	(*GenerateConfiguration)(nil),               // 149: machine.GenerateConfiguration
+	(*GenerateConfigurationResponse)(nil),       // 150: machine.GenerateConfigurationResponse
+	(*GenerateClientConfigurationRequest)(nil),  // 151: machine.GenerateClientConfigurationRequest
+	(*GenerateClientConfiguration)(nil),         // 152: machine.GenerateClientConfiguration
+	(*GenerateClientConfigurationResponse)(nil), // 153: machine.GenerateClientConfigurationResponse
+	(*PacketCaptureRequest)(nil),                // 154: machine.PacketCaptureRequest
+	(*BPFInstruction)(nil),                      // 155: machine.BPFInstruction
+	(*NetstatRequest)(nil),                      // 156: machine.NetstatRequest
+	(*SocketInfo)(nil),                          // 157: machine.SocketInfo
+	(*Netstat)(nil),                             // 158: machine.Netstat
+	(*NetstatResponse)(nil),                     // 159: machine.NetstatResponse
+	(*CgroupsRequest)(nil),                      // 160: machine.CgroupsRequest
+	(*CgroupInfo)(nil),                          // 161: machine.CgroupInfo
+	(*CgroupProcess)(nil),                       // 162: machine.CgroupProcess
+	(*CgroupCPU)(nil),                           // 163: machine.CgroupCPU
+	(*CgroupMemory)(nil),                        // 164: machine.CgroupMemory
+	(*CgroupIOStat)(nil),                        // 165: machine.CgroupIOStat
+	(*CgroupPressure)(nil),                      // 166: machine.CgroupPressure
+	(*PressureStat)(nil),                        // 167: machine.PressureStat
+	(*Cgroups)(nil),                             // 168: machine.Cgroups
+	(*CgroupsResponse)(nil),                     // 169: machine.CgroupsResponse
+	(*EncryptionKeySpec)(nil),                   // 170: machine.EncryptionKeySpec
+	(*UpdateEncryptionKeysRequest)(nil),         // 171: machine.UpdateEncryptionKeysRequest
+	(*UpdateEncryptionKeys)(nil),                // 172: machine.UpdateEncryptionKeys
+	(*UpdateEncryptionKeysResponse)(nil),        // 173: machine.UpdateEncryptionKeysResponse
+	(*durationpb.Duration)(nil),                 // 174: google.protobuf.Duration
+	(*common.Metadata)(nil),                     // 175: common.Metadata
+	(*common.Error)(nil),                        // 176: common.Error
+	(*anypb.Any)(nil),                           // 177: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),               // 178: google.protobuf.Timestamp
+	(common.ContainerDriver)(0),                 // 179: common.ContainerDriver
+	(*emptypb.Empty)(nil),                       // 180: google.protobuf.Empty
+	(*common.Data)(nil),                         // 181: common.Data
 }
 var file_machine_machine_proto_depIdxs = []int32{
 	0,   // 0: machine.ApplyConfigurationRequest.mode:type_name -> machine.ApplyConfigurationRequest.Mode
-	137, // 1: machine.ApplyConfigurationRequest.try_mode_timeout:type_name -> google.protobuf.Duration
-	138, // 2: machine.ApplyConfiguration.metadata:type_name -> common.Metadata
+	174, // 1: machine.ApplyConfigurationRequest.try_mode_timeout:type_name -> google.protobuf.Duration
+	175, // 2: machine.ApplyConfiguration.metadata:type_name -> common.Metadata
 	0,   // 3: machine.ApplyConfiguration.mode:type_name -> machine.ApplyConfigurationRequest.Mode
-	9,   // 4: machine.ApplyConfigurationResponse.messages:type_name -> machine.ApplyConfiguration
+	13,  // 4: machine.ApplyConfigurationResponse.messages:type_name -> machine.ApplyConfiguration
 	1,   // 5: machine.RebootRequest.mode:type_name -> machine.RebootRequest.Mode
-	138, // 6: machine.Reboot.metadata:type_name -> common.Metadata
-	12,  // 7: machine.RebootResponse.messages:type_name -> machine.Reboot
-	138, // 8: machine.Bootstrap.metadata:type_name -> common.Metadata
-	15,  // 9: machine.BootstrapResponse.messages:type_name -> machine.Bootstrap
+	175, // 6: machine.Reboot.metadata:type_name -> common.Metadata
+	16,  // 7: machine.RebootResponse.messages:type_name -> machine.Reboot
+	175, // 8: machine.Bootstrap.metadata:type_name -> common.Metadata
+	19,  // 9: machine.BootstrapResponse.messages:type_name -> machine.Bootstrap
 	2,   // 10: machine.SequenceEvent.action:type_name -> machine.SequenceEvent.Action
-	139, // 11: machine.SequenceEvent.error:type_name -> common.Error
+	176, // 11: machine.SequenceEvent.error:type_name -> common.Error
 	3,   // 12: machine.PhaseEvent.action:type_name -> machine.PhaseEvent.Action
 	4,   // 13: machine.TaskEvent.action:type_name -> machine.TaskEvent.Action
 	5,   // 14: machine.ServiceStateEvent.action:type_name -> machine.ServiceStateEvent.Action
-	42,  // 15: machine.ServiceStateEvent.health:type_name -> machine.ServiceHealth
-	138, // 16: machine.Event.metadata:type_name -> common.Metadata
-	140, // 17: machine.Event.data:type_name -> google.protobuf.Any
-	27,  // 18: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
-	138, // 19: machine.Reset.metadata:type_name -> common.Metadata
-	29,  // 20: machine.ResetResponse.messages:type_name -> machine.Reset
-	138, // 21: machine.Shutdown.metadata:type_name -> common.Metadata
-	31,  // 22: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
-	138, // 23: machine.Upgrade.metadata:type_name -> common.Metadata
-	35,  // 24: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
-	138, // 25: machine.ServiceList.metadata:type_name -> common.Metadata
-	39,  // 26: machine.ServiceList.services:type_name -> machine.ServiceInfo
-	37,  // 27: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
-	40,  // 28: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
-	42,  // 29: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
-	41,  // 30: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
-	141, // 31: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
-	141, // 32: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
-	138, // 33: machine.ServiceStart.metadata:type_name -> common.Metadata
-	44,  // 34: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
-	138, // 35: machine.ServiceStop.metadata:type_name -> common.Metadata
-	47,  // 36: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
-	138, // 37: machine.ServiceRestart.metadata:type_name -> common.Metadata
-	50,  // 38: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
+	46,  // 15: machine.ServiceStateEvent.health:type_name -> machine.ServiceHealth
+	175, // 16: machine.Event.metadata:type_name -> common.Metadata
+	177, // 17: machine.Event.data:type_name -> google.protobuf.Any
+	31,  // 18: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
+	175, // 19: machine.Reset.metadata:type_name -> common.Metadata
+	33,  // 20: machine.ResetResponse.messages:type_name -> machine.Reset
+	175, // 21: machine.Shutdown.metadata:type_name -> common.Metadata
+	35,  // 22: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
+	175, // 23: machine.Upgrade.metadata:type_name -> common.Metadata
+	39,  // 24: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
+	175, // 25: machine.ServiceList.metadata:type_name -> common.Metadata
+	43,  // 26: machine.ServiceList.services:type_name -> machine.ServiceInfo
+	41,  // 27: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
+	44,  // 28: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
+	46,  // 29: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
+	45,  // 30: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
+	178, // 31: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
+	178, // 32: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
+	175, // 33: machine.ServiceStart.metadata:type_name -> common.Metadata
+	48,  // 34: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
+	175, // 35: machine.ServiceStop.metadata:type_name -> common.Metadata
+	51,  // 36: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
+	175, // 37: machine.ServiceRestart.metadata:type_name -> common.Metadata
+	54,  // 38: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
 	6,   // 39: machine.ListRequest.types:type_name -> machine.ListRequest.Type
-	138, // 40: machine.FileInfo.metadata:type_name -> common.Metadata
-	138, // 41: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
-	138, // 42: machine.Mounts.metadata:type_name -> common.Metadata
-	59,  // 43: machine.Mounts.stats:type_name -> machine.MountStat
-	57,  // 44: machine.MountsResponse.messages:type_name -> machine.Mounts
-	138, // 45: machine.Version.metadata:type_name -> common.Metadata
-	62,  // 46: machine.Version.version:type_name -> machine.VersionInfo
-	63,  // 47: machine.Version.platform:type_name -> machine.PlatformInfo
-	64,  // 48: machine.Version.features:type_name -> machine.FeaturesInfo
-	60,  // 49: machine.VersionResponse.messages:type_name -> machine.Version
-	142, // 50: machine.LogsRequest.driver:type_name -> common.ContainerDriver
-	138, // 51: machine.Rollback.metadata:type_name -> common.Metadata
-	68,  // 52: machine.RollbackResponse.messages:type_name -> machine.Rollback
-	142, // 53: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
-	138, // 54: machine.Container.metadata:type_name -> common.Metadata
-	71,  // 55: machine.Container.containers:type_name -> machine.ContainerInfo
-	72,  // 56: machine.ContainersResponse.messages:type_name -> machine.Container
-	76,  // 57: machine.ProcessesResponse.messages:type_name -> machine.Process
-	138, // 58: machine.Process.metadata:type_name -> common.Metadata
-	77,  // 59: machine.Process.processes:type_name -> machine.ProcessInfo
-	142, // 60: machine.RestartRequest.driver:type_name -> common.ContainerDriver
-	138, // 61: machine.Restart.metadata:type_name -> common.Metadata
-	79,  // 62: machine.RestartResponse.messages:type_name -> machine.Restart
-	142, // 63: machine.StatsRequest.driver:type_name -> common.ContainerDriver
-	138, // 64: machine.Stats.metadata:type_name -> common.Metadata
-	84,  // 65: machine.Stats.stats:type_name -> machine.Stat
-	82,  // 66: machine.StatsResponse.messages:type_name -> machine.Stats
-	138, // 67: machine.Memory.metadata:type_name -> common.Metadata
-	87,  // 68: machine.Memory.meminfo:type_name -> machine.MemInfo
-	85,  // 69: machine.MemoryResponse.messages:type_name -> machine.Memory
-	89,  // 70: machine.HostnameResponse.messages:type_name -> machine.Hostname
-	138, // 71: machine.Hostname.metadata:type_name -> common.Metadata
-	91,  // 72: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
-	138, // 73: machine.LoadAvg.metadata:type_name -> common.Metadata
-	93,  // 74: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
-	138, // 75: machine.SystemStat.metadata:type_name -> common.Metadata
-	94,  // 76: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
-	94,  // 77: machine.SystemStat.cpu:type_name -> machine.CPUStat
-	95,  // 78: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
-	97,  // 79: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
-	138, // 80: machine.CPUsInfo.metadata:type_name -> common.Metadata
-	98,  // 81: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
-	100, // 82: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
-	138, // 83: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
-	101, // 84: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
-	101, // 85: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
-	103, // 86: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
-	138, // 87: machine.DiskStats.metadata:type_name -> common.Metadata
-	104, // 88: machine.DiskStats.total:type_name -> machine.DiskStat
-	104, // 89: machine.DiskStats.devices:type_name -> machine.DiskStat
-	138, // 90: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
-	106, // 91: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
-	138, // 92: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
-	109, // 93: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
-	138, // 94: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
-	112, // 95: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
-	138, // 96: machine.EtcdMembers.metadata:type_name -> common.Metadata
-	115, // 97: machine.EtcdMembers.members:type_name -> machine.EtcdMember
-	116, // 98: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
-	138, // 99: machine.EtcdRecover.metadata:type_name -> common.Metadata
-	119, // 100: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
-	122, // 101: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
-	121, // 102: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
-	123, // 103: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
-	7,   // 104: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
-	125, // 105: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
-	124, // 106: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
-	128, // 107: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
-	127, // 108: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
-	129, // 109: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
-	130, // 110: machine.GenerateConfigurationRequest.cluster_config:type_name -> machine.ClusterConfig
-	126, // 111: machine.GenerateConfigurationRequest.machine_config:type_name -> machine.MachineConfig
-	141, // 112: machine.GenerateConfigurationRequest.override_time:type_name -> google.protobuf.Timestamp
-	138, // 113: machine.GenerateConfiguration.metadata:type_name -> common.Metadata
-	132, // 114: machine.GenerateConfigurationResponse.messages:type_name -> machine.GenerateConfiguration
-	137, // 115: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
-	138, // 116: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
-	135, // 117: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
-	8,   // 118: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
-	14,  // 119: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
-	70,  // 120: machine.MachineService.Containers:input_type -> machine.ContainersRequest
-	52,  // 121: machine.MachineService.Copy:input_type -> machine.CopyRequest
-	143, // 122: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
-	143, // 123: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
-	74,  // 124: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
-	25,  // 125: machine.MachineService.Events:input_type -> machine.EventsRequest
-	114, // 126: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
-	108, // 127: machine.MachineService.EtcdRemoveMember:input_type -> machine.EtcdRemoveMemberRequest
-	105, // 128: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
-	111, // 129: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
-	144, // 130: machine.MachineService.EtcdRecover:input_type -> common.Data
-	118, // 131: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
-	131, // 132: machine.MachineService.GenerateConfiguration:input_type -> machine.GenerateConfigurationRequest
-	143, // 133: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
-	143, // 134: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
-	53,  // 135: machine.MachineService.List:input_type -> machine.ListRequest
-	54,  // 136: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
-	143, // 137: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
-	65,  // 138: machine.MachineService.Logs:input_type -> machine.LogsRequest
-	143, // 139: machine.MachineService.Memory:input_type -> google.protobuf.Empty
-	143, // 140: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
-	143, // 141: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
-	143, // 142: machine.MachineService.Processes:input_type -> google.protobuf.Empty
-	66,  // 143: machine.MachineService.Read:input_type -> machine.ReadRequest
-	11,  // 144: machine.MachineService.Reboot:input_type -> machine.RebootRequest
-	78,  // 145: machine.MachineService.Restart:input_type -> machine.RestartRequest
-	67,  // 146: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
-	28,  // 147: machine.MachineService.Reset:input_type -> machine.ResetRequest
-	143, // 148: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
-	49,  // 149: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
-	43,  // 150: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
-	46,  // 151: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
-	32,  // 152: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
-	81,  // 153: machine.MachineService.Stats:input_type -> machine.StatsRequest
-	143, // 154: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
-	34,  // 155: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
-	143, // 156: machine.MachineService.Version:input_type -> google.protobuf.Empty
-	134, // 157: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
-	10,  // 158: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
-	16,  // 159: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
-	73,  // 160: machine.MachineService.Containers:output_type -> machine.ContainersResponse
-	144, // 161: machine.MachineService.Copy:output_type -> common.Data
-	96,  // 162: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
-	102, // 163: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
-	144, // 164: machine.MachineService.Dmesg:output_type -> common.Data
-	26,  // 165: machine.MachineService.Events:output_type -> machine.Event
-	117, // 166: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
-	110, // 167: machine.MachineService.EtcdRemoveMember:output_type -> machine.EtcdRemoveMemberResponse
-	107, // 168: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
-	113, // 169: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
-	120, // 170: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
-	144, // 171: machine.MachineService.EtcdSnapshot:output_type -> common.Data
-	133, // 172: machine.MachineService.GenerateConfiguration:output_type -> machine.GenerateConfigurationResponse
-	88,  // 173: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
-	144, // 174: machine.MachineService.Kubeconfig:output_type -> common.Data
-	55,  // 175: machine.MachineService.List:output_type -> machine.FileInfo
-	56,  // 176: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
-	90,  // 177: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
-	144, // 178: machine.MachineService.Logs:output_type -> common.Data
-	86,  // 179: machine.MachineService.Memory:output_type -> machine.MemoryResponse
-	58,  // 180: machine.MachineService.Mounts:output_type -> machine.MountsResponse
-	99,  // 181: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
-	75,  // 182: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
-	144, // 183: machine.MachineService.Read:output_type -> common.Data
-	13,  // 184: machine.MachineService.Reboot:output_type -> machine.RebootResponse
-	80,  // 185: machine.MachineService.Restart:output_type -> machine.RestartResponse
-	69,  // 186: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
-	30,  // 187: machine.MachineService.Reset:output_type -> machine.ResetResponse
-	38,  // 188: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
-	51,  // 189: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
-	45,  // 190: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
-	48,  // 191: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
-	33,  // 192: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
-	83,  // 193: machine.MachineService.Stats:output_type -> machine.StatsResponse
-	92,  // 194: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
-	36,  // 195: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
-	61,  // 196: machine.MachineService.Version:output_type -> machine.VersionResponse
-	136, // 197: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
-	158, // [158:198] is the sub-list for method output_type
-	118, // [118:158] is the sub-list for method input_type
-	118, // [118:118] is the sub-list for extension type_name
-	118, // [118:118] is the sub-list for extension extendee
-	0,   // [0:118] is the sub-list for field type_name
+	175, // 40: machine.FileInfo.metadata:type_name -> common.Metadata
+	175, // 41: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
+	175, // 42: machine.Mounts.metadata:type_name -> common.Metadata
+	63,  // 43: machine.Mounts.stats:type_name -> machine.MountStat
+	61,  // 44: machine.MountsResponse.messages:type_name -> machine.Mounts
+	175, // 45: machine.Version.metadata:type_name -> common.Metadata
+	66,  // 46: machine.Version.version:type_name -> machine.VersionInfo
+	67,  // 47: machine.Version.platform:type_name -> machine.PlatformInfo
+	68,  // 48: machine.Version.features:type_name -> machine.FeaturesInfo
+	64,  // 49: machine.VersionResponse.messages:type_name -> machine.Version
+	179, // 50: machine.LogsRequest.driver:type_name -> common.ContainerDriver
+	175, // 51: machine.Rollback.metadata:type_name -> common.Metadata
+	72,  // 52: machine.RollbackResponse.messages:type_name -> machine.Rollback
+	179, // 53: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
+	175, // 54: machine.Container.metadata:type_name -> common.Metadata
+	75,  // 55: machine.Container.containers:type_name -> machine.ContainerInfo
+	76,  // 56: machine.ContainersResponse.messages:type_name -> machine.Container
+	80,  // 57: machine.ProcessesResponse.messages:type_name -> machine.Process
+	175, // 58: machine.Process.metadata:type_name -> common.Metadata
+	81,  // 59: machine.Process.processes:type_name -> machine.ProcessInfo
+	179, // 60: machine.RestartRequest.driver:type_name -> common.ContainerDriver
+	175, // 61: machine.Restart.metadata:type_name -> common.Metadata
+	83,  // 62: machine.RestartResponse.messages:type_name -> machine.Restart
+	179, // 63: machine.StatsRequest.driver:type_name -> common.ContainerDriver
+	175, // 64: machine.Stats.metadata:type_name -> common.Metadata
+	88,  // 65: machine.Stats.stats:type_name -> machine.Stat
+	86,  // 66: machine.StatsResponse.messages:type_name -> machine.Stats
+	175, // 67: machine.Memory.metadata:type_name -> common.Metadata
+	91,  // 68: machine.Memory.meminfo:type_name -> machine.MemInfo
+	89,  // 69: machine.MemoryResponse.messages:type_name -> machine.Memory
+	93,  // 70: machine.HostnameResponse.messages:type_name -> machine.Hostname
+	175, // 71: machine.Hostname.metadata:type_name -> common.Metadata
+	95,  // 72: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
+	175, // 73: machine.LoadAvg.metadata:type_name -> common.Metadata
+	97,  // 74: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
+	175, // 75: machine.SystemStat.metadata:type_name -> common.Metadata
+	98,  // 76: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
+	98,  // 77: machine.SystemStat.cpu:type_name -> machine.CPUStat
+	99,  // 78: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
+	101, // 79: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
+	175, // 80: machine.CPUsInfo.metadata:type_name -> common.Metadata
+	102, // 81: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
+	104, // 82: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
+	175, // 83: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
+	105, // 84: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
+	105, // 85: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
+	107, // 86: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
+	175, // 87: machine.DiskStats.metadata:type_name -> common.Metadata
+	108, // 88: machine.DiskStats.total:type_name -> machine.DiskStat
+	108, // 89: machine.DiskStats.devices:type_name -> machine.DiskStat
+	175, // 90: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
+	110, // 91: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
+	175, // 92: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
+	113, // 93: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
+	175, // 94: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
+	116, // 95: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
+	175, // 96: machine.EtcdMembers.metadata:type_name -> common.Metadata
+	119, // 97: machine.EtcdMembers.members:type_name -> machine.EtcdMember
+	120, // 98: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
+	175, // 99: machine.EtcdRecover.metadata:type_name -> common.Metadata
+	123, // 100: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
+	126, // 101: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
+	175, // 102: machine.EtcdAlarm.metadata:type_name -> common.Metadata
+	127, // 103: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	7,   // 104: machine.EtcdMemberAlarm.alarm:type_name -> machine.EtcdMemberAlarm.AlarmType
+	129, // 105: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
+	175, // 106: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
+	127, // 107: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	131, // 108: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
+	175, // 109: machine.EtcdDefragment.metadata:type_name -> common.Metadata
+	133, // 110: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
+	175, // 111: machine.EtcdStatus.metadata:type_name -> common.Metadata
+	134, // 112: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
+	175, // 113: machine.Metrics.metadata:type_name -> common.Metadata
+	135, // 114: machine.Metrics.services:type_name -> machine.ServiceMetrics
+	136, // 115: machine.MetricsResponse.messages:type_name -> machine.Metrics
+	139, // 116: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
+	138, // 117: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
+	140, // 118: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
+	8,   // 119: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
+	142, // 120: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
+	141, // 121: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
+	145, // 122: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
+	144, // 123: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
+	146, // 124: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
+	147, // 125: machine.GenerateConfigurationRequest.cluster_config:type_name -> machine.ClusterConfig
+	143, // 126: machine.GenerateConfigurationRequest.machine_config:type_name -> machine.MachineConfig
+	178, // 127: machine.GenerateConfigurationRequest.override_time:type_name -> google.protobuf.Timestamp
+	175, // 128: machine.GenerateConfiguration.metadata:type_name -> common.Metadata
+	149, // 129: machine.GenerateConfigurationResponse.messages:type_name -> machine.GenerateConfiguration
+	174, // 130: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
+	175, // 131: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
+	152, // 132: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
+	155, // 133: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
+	9,   // 134: machine.NetstatRequest.filter:type_name -> machine.NetstatRequest.Filter
+	10,  // 135: machine.NetstatRequest.protocols:type_name -> machine.SocketInfo.Protocol
+	10,  // 136: machine.SocketInfo.protocol:type_name -> machine.SocketInfo.Protocol
+	175, // 137: machine.Netstat.metadata:type_name -> common.Metadata
+	157, // 138: machine.Netstat.sockets:type_name -> machine.SocketInfo
+	158, // 139: machine.NetstatResponse.messages:type_name -> machine.Netstat
+	162, // 140: machine.CgroupInfo.processes:type_name -> machine.CgroupProcess
+	163, // 141: machine.CgroupInfo.cpu:type_name -> machine.CgroupCPU
+	164, // 142: machine.CgroupInfo.memory:type_name -> machine.CgroupMemory
+	165, // 143: machine.CgroupInfo.io:type_name -> machine.CgroupIOStat
+	166, // 144: machine.CgroupInfo.cpu_pressure:type_name -> machine.CgroupPressure
+	166, // 145: machine.CgroupInfo.memory_pressure:type_name -> machine.CgroupPressure
+	166, // 146: machine.CgroupInfo.io_pressure:type_name -> machine.CgroupPressure
+	167, // 147: machine.CgroupPressure.some:type_name -> machine.PressureStat
+	167, // 148: machine.CgroupPressure.full:type_name -> machine.PressureStat
+	175, // 149: machine.Cgroups.metadata:type_name -> common.Metadata
+	161, // 150: machine.Cgroups.cgroups:type_name -> machine.CgroupInfo
+	168, // 151: machine.CgroupsResponse.messages:type_name -> machine.Cgroups
+	11,  // 152: machine.UpdateEncryptionKeysRequest.action:type_name -> machine.UpdateEncryptionKeysRequest.Action
+	170, // 153: machine.UpdateEncryptionKeysRequest.key:type_name -> machine.EncryptionKeySpec
+	175, // 154: machine.UpdateEncryptionKeys.metadata:type_name -> common.Metadata
+	172, // 155: machine.UpdateEncryptionKeysResponse.messages:type_name -> machine.UpdateEncryptionKeys
+	12,  // 156: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
+	18,  // 157: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
+	74,  // 158: machine.MachineService.Containers:input_type -> machine.ContainersRequest
+	56,  // 159: machine.MachineService.Copy:input_type -> machine.CopyRequest
+	180, // 160: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
+	180, // 161: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
+	78,  // 162: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
+	29,  // 163: machine.MachineService.Events:input_type -> machine.EventsRequest
+	118, // 164: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
+	112, // 165: machine.MachineService.EtcdRemoveMember:input_type -> machine.EtcdRemoveMemberRequest
+	109, // 166: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
+	115, // 167: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
+	181, // 168: machine.MachineService.EtcdRecover:input_type -> common.Data
+	122, // 169: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
+	180, // 170: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
+	180, // 171: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
+	180, // 172: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
+	180, // 173: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
+	148, // 174: machine.MachineService.GenerateConfiguration:input_type -> machine.GenerateConfigurationRequest
+	180, // 175: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
+	180, // 176: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
+	57,  // 177: machine.MachineService.List:input_type -> machine.ListRequest
+	58,  // 178: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
+	180, // 179: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
+	69,  // 180: machine.MachineService.Logs:input_type -> machine.LogsRequest
+	180, // 181: machine.MachineService.Memory:input_type -> google.protobuf.Empty
+	180, // 182: machine.MachineService.Metrics:input_type -> google.protobuf.Empty
+	180, // 183: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
+	180, // 184: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
+	180, // 185: machine.MachineService.Processes:input_type -> google.protobuf.Empty
+	70,  // 186: machine.MachineService.Read:input_type -> machine.ReadRequest
+	15,  // 187: machine.MachineService.Reboot:input_type -> machine.RebootRequest
+	82,  // 188: machine.MachineService.Restart:input_type -> machine.RestartRequest
+	71,  // 189: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
+	32,  // 190: machine.MachineService.Reset:input_type -> machine.ResetRequest
+	180, // 191: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
+	53,  // 192: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
+	47,  // 193: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
+	50,  // 194: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
+	36,  // 195: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
+	85,  // 196: machine.MachineService.Stats:input_type -> machine.StatsRequest
+	180, // 197: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
+	38,  // 198: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
+	180, // 199: machine.MachineService.Version:input_type -> google.protobuf.Empty
+	151, // 200: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
+	154, // 201: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
+	156, // 202: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
+	160, // 203: machine.MachineService.Cgroups:input_type -> machine.CgroupsRequest
+	171, // 204: machine.MachineService.UpdateEncryptionKeys:input_type -> machine.UpdateEncryptionKeysRequest
+	14,  // 205: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
+	20,  // 206: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
+	77,  // 207: machine.MachineService.Containers:output_type -> machine.ContainersResponse
+	181, // 208: machine.MachineService.Copy:output_type -> common.Data
+	100, // 209: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
+	106, // 210: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
+	181, // 211: machine.MachineService.Dmesg:output_type -> common.Data
+	30,  // 212: machine.MachineService.Events:output_type -> machine.Event
+	121, // 213: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
+	114, // 214: machine.MachineService.EtcdRemoveMember:output_type -> machine.EtcdRemoveMemberResponse
+	111, // 215: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
+	117, // 216: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
+	124, // 217: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
+	181, // 218: machine.MachineService.EtcdSnapshot:output_type -> common.Data
+	125, // 219: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
+	128, // 220: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
+	130, // 221: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
+	132, // 222: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
+	150, // 223: machine.MachineService.GenerateConfiguration:output_type -> machine.GenerateConfigurationResponse
+	92,  // 224: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
+	181, // 225: machine.MachineService.Kubeconfig:output_type -> common.Data
+	59,  // 226: machine.MachineService.List:output_type -> machine.FileInfo
+	60,  // 227: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
+	94,  // 228: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
+	181, // 229: machine.MachineService.Logs:output_type -> common.Data
+	90,  // 230: machine.MachineService.Memory:output_type -> machine.MemoryResponse
+	137, // 231: machine.MachineService.Metrics:output_type -> machine.MetricsResponse
+	62,  // 232: machine.MachineService.Mounts:output_type -> machine.MountsResponse
+	103, // 233: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
+	79,  // 234: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
+	181, // 235: machine.MachineService.Read:output_type -> common.Data
+	17,  // 236: machine.MachineService.Reboot:output_type -> machine.RebootResponse
+	84,  // 237: machine.MachineService.Restart:output_type -> machine.RestartResponse
+	73,  // 238: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
+	34,  // 239: machine.MachineService.Reset:output_type -> machine.ResetResponse
+	42,  // 240: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
+	55,  // 241: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
+	49,  // 242: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
+	52,  // 243: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
+	37,  // 244: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
+	87,  // 245: machine.MachineService.Stats:output_type -> machine.StatsResponse
+	96,  // 246: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
+	40,  // 247: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
+	65,  // 248: machine.MachineService.Version:output_type -> machine.VersionResponse
+	153, // 249: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
+	181, // 250: machine.MachineService.PacketCapture:output_type -> common.Data
+	159, // 251: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
+	169, // 252: machine.MachineService.Cgroups:output_type -> machine.CgroupsResponse
+	173, // 253: machine.MachineService.UpdateEncryptionKeys:output_type -> machine.UpdateEncryptionKeysResponse
+	205, // [205:254] is the sub-list for method output_type
+	156, // [156:205] is the sub-list for method input_type
+	156, // [156:156] is the sub-list for extension type_name
+	156, // [156:156] is the sub-list for extension extendee
+	0,   // [0:156] is the sub-list for field type_name
 }
 
 func init() { file_machine_machine_proto_init() }
@@ -10472,8 +13342,344 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyConfiguration); i {
+		file_machine_machine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebootRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reboot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebootResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bootstrap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhaseEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigLoadErrorEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigValidationErrorEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPartitionSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shutdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upgrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10484,8 +13690,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyConfigurationResponse); i {
+		file_machine_machine_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10496,8 +13702,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RebootRequest); i {
+		file_machine_machine_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10508,8 +13714,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reboot); i {
+		file_machine_machine_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10520,8 +13726,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RebootResponse); i {
+		file_machine_machine_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10532,8 +13738,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapRequest); i {
+		file_machine_machine_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10544,8 +13750,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bootstrap); i {
+		file_machine_machine_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10556,8 +13762,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapResponse); i {
+		file_machine_machine_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10568,8 +13774,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SequenceEvent); i {
+		file_machine_machine_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10580,8 +13786,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PhaseEvent); i {
+		file_machine_machine_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10592,8 +13798,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskEvent); i {
+		file_machine_machine_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10604,8 +13810,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStateEvent); i {
+		file_machine_machine_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10616,8 +13822,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartEvent); i {
+		file_machine_machine_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRestartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10628,8 +13834,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigLoadErrorEvent); i {
+		file_machine_machine_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRestart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10640,8 +13846,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigValidationErrorEvent); i {
+		file_machine_machine_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRestartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10652,8 +13858,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressEvent); i {
+		file_machine_machine_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10664,8 +13870,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventsRequest); i {
+		file_machine_machine_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10676,8 +13882,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_machine_machine_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10688,8 +13894,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetPartitionSpec); i {
+		file_machine_machine_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10700,8 +13906,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetRequest); i {
+		file_machine_machine_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskUsageInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10712,8 +13918,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reset); i {
+		file_machine_machine_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mounts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10724,8 +13930,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetResponse); i {
+		file_machine_machine_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10736,8 +13942,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shutdown); i {
+		file_machine_machine_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MountStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10748,8 +13954,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownRequest); i {
+		file_machine_machine_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10760,8 +13966,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownResponse); i {
+		file_machine_machine_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10772,8 +13978,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeRequest); i {
+		file_machine_machine_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10784,8 +13990,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upgrade); i {
+		file_machine_machine_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10796,8 +14002,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeResponse); i {
+		file_machine_machine_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeaturesInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10808,8 +14014,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceList); i {
+		file_machine_machine_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10820,8 +14026,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceListResponse); i {
+		file_machine_machine_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10832,8 +14038,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceInfo); i {
+		file_machine_machine_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10844,8 +14050,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceEvents); i {
+		file_machine_machine_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rollback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10856,8 +14062,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceEvent); i {
+		file_machine_machine_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10868,8 +14074,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceHealth); i {
+		file_machine_machine_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10880,8 +14086,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStartRequest); i {
+		file_machine_machine_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10892,8 +14098,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStart); i {
+		file_machine_machine_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Container); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10904,8 +14110,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStartResponse); i {
+		file_machine_machine_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10916,8 +14122,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStopRequest); i {
+		file_machine_machine_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DmesgRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10928,8 +14134,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStop); i {
+		file_machine_machine_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10940,8 +14146,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStopResponse); i {
+		file_machine_machine_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Process); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10952,8 +14158,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceRestartRequest); i {
+		file_machine_machine_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10964,8 +14170,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceRestart); i {
+		file_machine_machine_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10976,8 +14182,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceRestartResponse); i {
+		file_machine_machine_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Restart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10988,8 +14194,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyRequest); i {
+		file_machine_machine_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11000,8 +14206,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRequest); i {
+		file_machine_machine_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11012,8 +14218,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskUsageRequest); i {
+		file_machine_machine_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11024,8 +14230,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
+		file_machine_machine_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11036,8 +14242,68 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskUsageInfo); i {
+		file_machine_machine_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Memory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostnameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_machine_machine_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hostname); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11048,8 +14314,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mounts); i {
+		file_machine_machine_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadAvgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11060,8 +14326,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MountsResponse); i {
+		file_machine_machine_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadAvg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11072,8 +14338,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MountStat); i {
+		file_machine_machine_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11084,8 +14350,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
+		file_machine_machine_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11096,8 +14362,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+		file_machine_machine_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11108,8 +14374,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionInfo); i {
+		file_machine_machine_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftIRQStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11120,8 +14386,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlatformInfo); i {
+		file_machine_machine_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11132,8 +14398,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeaturesInfo); i {
+		file_machine_machine_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUsInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11144,8 +14410,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogsRequest); i {
+		file_machine_machine_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11156,8 +14422,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadRequest); i {
+		file_machine_machine_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkDeviceStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11168,8 +14434,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RollbackRequest); i {
+		file_machine_machine_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkDeviceStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11180,8 +14446,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rollback); i {
+		file_machine_machine_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetDev); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11192,8 +14458,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RollbackResponse); i {
+		file_machine_machine_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11204,8 +14470,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainersRequest); i {
+		file_machine_machine_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11216,8 +14482,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerInfo); i {
+		file_machine_machine_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11228,8 +14494,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Container); i {
+		file_machine_machine_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdLeaveClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11240,8 +14506,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainersResponse); i {
+		file_machine_machine_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdLeaveCluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11252,8 +14518,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DmesgRequest); i {
+		file_machine_machine_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdLeaveClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11264,8 +14530,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessesResponse); i {
+		file_machine_machine_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdRemoveMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11276,8 +14542,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Process); i {
+		file_machine_machine_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdRemoveMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11288,8 +14554,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessInfo); i {
+		file_machine_machine_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdRemoveMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11300,8 +14566,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartRequest); i {
+		file_machine_machine_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdForfeitLeadershipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11312,8 +14578,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Restart); i {
+		file_machine_machine_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdForfeitLeadership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11324,8 +14590,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartResponse); i {
+		file_machine_machine_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdForfeitLeadershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11336,8 +14602,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsRequest); i {
+		file_machine_machine_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdMemberListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11348,8 +14614,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats); i {
+		file_machine_machine_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11360,8 +14626,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse); i {
+		file_machine_machine_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdMembers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11372,8 +14638,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stat); i {
+		file_machine_machine_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdMemberListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11384,8 +14650,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Memory); i {
+		file_machine_machine_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11396,8 +14662,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemoryResponse); i {
+		file_machine_machine_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdRecover); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11408,8 +14674,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemInfo); i {
+		file_machine_machine_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdRecoverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11420,8 +14686,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostnameResponse); i {
+		file_machine_machine_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdAlarmListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11432,8 +14698,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Hostname); i {
+		file_machine_machine_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdAlarm); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11444,8 +14710,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadAvgResponse); i {
+		file_machine_machine_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdMemberAlarm); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11456,8 +14722,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadAvg); i {
+		file_machine_machine_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdAlarmDisarmResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11468,8 +14734,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStatResponse); i {
+		file_machine_machine_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdAlarmDisarm); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11480,8 +14746,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStat); i {
+		file_machine_machine_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdDefragmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11492,8 +14758,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUStat); i {
+		file_machine_machine_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdDefragment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11504,8 +14770,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SoftIRQStat); i {
+		file_machine_machine_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11516,8 +14782,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUInfoResponse); i {
+		file_machine_machine_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11528,8 +14794,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUsInfo); i {
+		file_machine_machine_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EtcdMemberStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11540,8 +14806,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUInfo); i {
+		file_machine_machine_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceMetrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11552,8 +14818,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkDeviceStatsResponse); i {
+		file_machine_machine_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11564,8 +14830,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkDeviceStats); i {
+		file_machine_machine_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11576,8 +14842,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetDev); i {
+		file_machine_machine_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11588,8 +14854,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskStatsResponse); i {
+		file_machine_machine_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DHCPOptionsConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11600,8 +14866,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskStats); i {
+		file_machine_machine_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkDeviceConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11612,8 +14878,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskStat); i {
+		file_machine_machine_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11624,8 +14890,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdLeaveClusterRequest); i {
+		file_machine_machine_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11636,8 +14902,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdLeaveCluster); i {
+		file_machine_machine_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11648,8 +14914,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdLeaveClusterResponse); i {
+		file_machine_machine_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlPlaneConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11660,8 +14926,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdRemoveMemberRequest); i {
+		file_machine_machine_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CNIConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11672,8 +14938,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdRemoveMember); i {
+		file_machine_machine_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterNetworkConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11684,8 +14950,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdRemoveMemberResponse); i {
+		file_machine_machine_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11696,8 +14962,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdForfeitLeadershipRequest); i {
+		file_machine_machine_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11708,8 +14974,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdForfeitLeadership); i {
+		file_machine_machine_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11720,8 +14986,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdForfeitLeadershipResponse); i {
+		file_machine_machine_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11732,8 +14998,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdMemberListRequest); i {
+		file_machine_machine_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateClientConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11744,8 +15010,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdMember); i {
+		file_machine_machine_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateClientConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11756,8 +15022,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdMembers); i {
+		file_machine_machine_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateClientConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11768,8 +15034,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdMemberListResponse); i {
+		file_machine_machine_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCaptureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11780,8 +15046,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdSnapshotRequest); i {
+		file_machine_machine_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPFInstruction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11792,8 +15058,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdRecover); i {
+		file_machine_machine_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetstatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11804,8 +15070,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EtcdRecoverResponse); i {
+		file_machine_machine_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocketInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11816,8 +15082,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteConfig); i {
+		file_machine_machine_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Netstat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11828,8 +15094,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DHCPOptionsConfig); i {
+		file_machine_machine_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetstatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11840,8 +15106,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkDeviceConfig); i {
+		file_machine_machine_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11852,8 +15118,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkConfig); i {
+		file_machine_machine_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11864,8 +15130,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallConfig); i {
+		file_machine_machine_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupProcess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11876,8 +15142,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineConfig); i {
+		file_machine_machine_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupCPU); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11888,8 +15154,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlPlaneConfig); i {
+		file_machine_machine_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupMemory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11900,8 +15166,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CNIConfig); i {
+		file_machine_machine_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupIOStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11912,8 +15178,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterNetworkConfig); i {
+		file_machine_machine_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupPressure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11924,8 +15190,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterConfig); i {
+		file_machine_machine_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PressureStat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11936,8 +15202,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateConfigurationRequest); i {
+		file_machine_machine_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cgroups); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11948,8 +15214,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateConfiguration); i {
+		file_machine_machine_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11960,8 +15226,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateConfigurationResponse); i {
+		file_machine_machine_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptionKeySpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11972,8 +15238,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateClientConfigurationRequest); i {
+		file_machine_machine_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEncryptionKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11984,8 +15250,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateClientConfiguration); i {
+		file_machine_machine_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEncryptionKeys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11996,8 +15262,8 @@ func file_machine_machine_proto_init() {
 				return nil
 			}
 		}
-		file_machine_machine_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateClientConfigurationResponse); i {
+		file_machine_machine_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEncryptionKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12014,8 +15280,8 @@ func file_machine_machine_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_machine_machine_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   129,
+			NumEnums:      12,
+			NumMessages:   162,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -54,6 +54,25 @@ type MachineServiceClient interface {
 	//
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(ctx context.Context, in *EtcdSnapshotRequest, opts ...grpc.CallOption) (MachineService_EtcdSnapshotClient, error)
+	// EtcdAlarmList lists etcd alarms for the current node.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdAlarmListResponse, error)
+	// EtcdAlarmDisarm disarms etcd alarms for the current node.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmDisarm(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdAlarmDisarmResponse, error)
+	// EtcdDefragment defragments etcd data directory for the current node.
+	//
+	// Defragmentation is a resource-heavy operation, so it should only run on a specific
+	// node.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdDefragment(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdDefragmentResponse, error)
+	// EtcdStatus returns etcd status for the current member.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdStatusResponse, error)
 	GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error)
 	Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error)
@@ -62,6 +81,10 @@ type MachineServiceClient interface {
 	LoadAvg(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoadAvgResponse, error)
 	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (MachineService_LogsClient, error)
 	Memory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MemoryResponse, error)
+	// Metrics returns the Prometheus metrics of machined, apid and trustd.
+	//
+	// Metrics should be enabled in the machine configuration.
+	Metrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetricsResponse, error)
 	Mounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MountsResponse, error)
 	NetworkDeviceStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkDeviceStatsResponse, error)
 	Processes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessesResponse, error)
@@ -81,6 +104,14 @@ type MachineServiceClient interface {
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
 	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
 	GenerateClientConfiguration(ctx context.Context, in *GenerateClientConfigurationRequest, opts ...grpc.CallOption) (*GenerateClientConfigurationResponse, error)
+	// PacketCapture performs packet capture on the network interface and streams back the pcap file.
+	PacketCapture(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (MachineService_PacketCaptureClient, error)
+	// Netstat lists the sockets of the node.
+	Netstat(ctx context.Context, in *NetstatRequest, opts ...grpc.CallOption) (*NetstatResponse, error)
+	// Cgroups returns the cgroup hierarchy with the resource usage.
+	Cgroups(ctx context.Context, in *CgroupsRequest, opts ...grpc.CallOption) (*CgroupsResponse, error)
+	// UpdateEncryptionKeys adds, removes or rotates the encryption keys of the mounted system partition.
+	UpdateEncryptionKeys(ctx context.Context, in *UpdateEncryptionKeysRequest, opts ...grpc.CallOption) (*UpdateEncryptionKeysResponse, error)
 }
 
 type machineServiceClient struct {
@@ -334,6 +365,42 @@ func (x *machineServiceEtcdSnapshotClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) EtcdAlarmList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdAlarmListResponse, error) {
+	out := new(EtcdAlarmListResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdAlarmList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdAlarmDisarm(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdAlarmDisarmResponse, error) {
+	out := new(EtcdAlarmDisarmResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdAlarmDisarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdDefragment(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdDefragmentResponse, error) {
+	out := new(EtcdDefragmentResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdDefragment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdStatusResponse, error) {
+	out := new(EtcdStatusResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error) {
 	out := new(GenerateConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/GenerateConfiguration", in, out, opts...)
@@ -498,6 +565,15 @@ func (c *machineServiceClient) Memory(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *machineServiceClient) Metrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Metrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Mounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MountsResponse, error) {
 	out := new(MountsResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Mounts", in, out, opts...)
@@ -683,6 +759,65 @@ func (c *machineServiceClient) GenerateClientConfiguration(ctx context.Context,
 	return out, nil
 }
 
+func (c *machineServiceClient) PacketCapture(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (MachineService_PacketCaptureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[10], "/machine.MachineService/PacketCapture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServicePacketCaptureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_PacketCaptureClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type machineServicePacketCaptureClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServicePacketCaptureClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) Netstat(ctx context.Context, in *NetstatRequest, opts ...grpc.CallOption) (*NetstatResponse, error) {
+	out := new(NetstatResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Netstat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) Cgroups(ctx context.Context, in *CgroupsRequest, opts ...grpc.CallOption) (*CgroupsResponse, error) {
+	out := new(CgroupsResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Cgroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) UpdateEncryptionKeys(ctx context.Context, in *UpdateEncryptionKeysRequest, opts ...grpc.CallOption) (*UpdateEncryptionKeysResponse, error) {
+	out := new(UpdateEncryptionKeysResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/UpdateEncryptionKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility
@@ -715,6 +850,25 @@ type MachineServiceServer interface {
 	//
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
+	// EtcdAlarmList lists etcd alarms for the current node.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error)
+	// EtcdAlarmDisarm disarms etcd alarms for the current node.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error)
+	// EtcdDefragment defragments etcd data directory for the current node.
+	//
+	// Defragmentation is a resource-heavy operation, so it should only run on a specific
+	// node.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error)
+	// EtcdStatus returns etcd status for the current member.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error)
 	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
 	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
 	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
@@ -723,6 +877,10 @@ type MachineServiceServer interface {
 	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
 	Logs(*LogsRequest, MachineService_LogsServer) error
 	Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error)
+	// Metrics returns the Prometheus metrics of machined, apid and trustd.
+	//
+	// Metrics should be enabled in the machine configuration.
+	Metrics(context.Context, *emptypb.Empty) (*MetricsResponse, error)
 	Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error)
 	NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error)
 	Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error)
@@ -742,6 +900,14 @@ type MachineServiceServer interface {
 	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
 	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
 	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
+	// PacketCapture performs packet capture on the network interface and streams back the pcap file.
+	PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error
+	// Netstat lists the sockets of the node.
+	Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error)
+	// Cgroups returns the cgroup hierarchy with the resource usage.
+	Cgroups(context.Context, *CgroupsRequest) (*CgroupsResponse, error)
+	// UpdateEncryptionKeys adds, removes or rotates the encryption keys of the mounted system partition.
+	UpdateEncryptionKeys(context.Context, *UpdateEncryptionKeysRequest) (*UpdateEncryptionKeysResponse, error)
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -791,6 +957,18 @@ func (UnimplementedMachineServiceServer) EtcdRecover(MachineService_EtcdRecoverS
 func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error {
 	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
 }
+func (UnimplementedMachineServiceServer) EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmList not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmDisarm not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdStatus not implemented")
+}
 func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
 }
@@ -815,6 +993,9 @@ func (UnimplementedMachineServiceServer) Logs(*LogsRequest, MachineService_LogsS
 func (UnimplementedMachineServiceServer) Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Memory not implemented")
 }
+func (UnimplementedMachineServiceServer) Metrics(context.Context, *emptypb.Empty) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
+}
 func (UnimplementedMachineServiceServer) Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Mounts not implemented")
 }
@@ -869,6 +1050,18 @@ func (UnimplementedMachineServiceServer) Version(context.Context, *emptypb.Empty
 func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientConfiguration not implemented")
 }
+func (UnimplementedMachineServiceServer) PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method PacketCapture not implemented")
+}
+func (UnimplementedMachineServiceServer) Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
+}
+func (UnimplementedMachineServiceServer) Cgroups(context.Context, *CgroupsRequest) (*CgroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cgroups not implemented")
+}
+func (UnimplementedMachineServiceServer) UpdateEncryptionKeys(context.Context, *UpdateEncryptionKeysRequest) (*UpdateEncryptionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEncryptionKeys not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
 // UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1154,6 +1347,78 @@ func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_EtcdAlarmList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdAlarmList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdAlarmDisarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdAlarmDisarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdDefragment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -1310,6 +1575,24 @@ func _MachineService_Memory_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_Metrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Metrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Metrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Metrics(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Mounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1637,6 +1920,81 @@ func _MachineService_GenerateClientConfiguration_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_PacketCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PacketCaptureRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).PacketCapture(m, &machineServicePacketCaptureServer{stream})
+}
+
+type MachineService_PacketCaptureServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServicePacketCaptureServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServicePacketCaptureServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetstatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Netstat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Netstat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Netstat(ctx, req.(*NetstatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Cgroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CgroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Cgroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Cgroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Cgroups(ctx, req.(*CgroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_UpdateEncryptionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEncryptionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).UpdateEncryptionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/UpdateEncryptionKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).UpdateEncryptionKeys(ctx, req.(*UpdateEncryptionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1680,6 +2038,22 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EtcdForfeitLeadership",
 			Handler:    _MachineService_EtcdForfeitLeadership_Handler,
 		},
+		{
+			MethodName: "EtcdAlarmList",
+			Handler:    _MachineService_EtcdAlarmList_Handler,
+		},
+		{
+			MethodName: "EtcdAlarmDisarm",
+			Handler:    _MachineService_EtcdAlarmDisarm_Handler,
+		},
+		{
+			MethodName: "EtcdDefragment",
+			Handler:    _MachineService_EtcdDefragment_Handler,
+		},
+		{
+			MethodName: "EtcdStatus",
+			Handler:    _MachineService_EtcdStatus_Handler,
+		},
 		{
 			MethodName: "GenerateConfiguration",
 			Handler:    _MachineService_GenerateConfiguration_Handler,
@@ -1696,6 +2070,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Memory",
 			Handler:    _MachineService_Memory_Handler,
 		},
+		{
+			MethodName: "Metrics",
+			Handler:    _MachineService_Metrics_Handler,
+		},
 		{
 			MethodName: "Mounts",
 			Handler:    _MachineService_Mounts_Handler,
@@ -1764,6 +2142,18 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateClientConfiguration",
 			Handler:    _MachineService_GenerateClientConfiguration_Handler,
 		},
+		{
+			MethodName: "Netstat",
+			Handler:    _MachineService_Netstat_Handler,
+		},
+		{
+			MethodName: "Cgroups",
+			Handler:    _MachineService_Cgroups_Handler,
+		},
+		{
+			MethodName: "UpdateEncryptionKeys",
+			Handler:    _MachineService_UpdateEncryptionKeys_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1816,6 +2206,11 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_Read_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PacketCapture",
+			Handler:       _MachineService_PacketCapture_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
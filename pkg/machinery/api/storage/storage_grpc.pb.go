@@ -25,6 +25,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StorageServiceClient interface {
 	Disks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DisksResponse, error)
+	// BlockDeviceWipe performs a wipe of the blockdevice (disk).
+	//
+	// The disk should not be used by Talos (system disk or user volume).
+	BlockDeviceWipe(ctx context.Context, in *BlockDeviceWipeRequest, opts ...grpc.CallOption) (*BlockDeviceWipeResponse, error)
 }
 
 type storageServiceClient struct {
@@ -44,11 +48,24 @@ func (c *storageServiceClient) Disks(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *storageServiceClient) BlockDeviceWipe(ctx context.Context, in *BlockDeviceWipeRequest, opts ...grpc.CallOption) (*BlockDeviceWipeResponse, error) {
+	out := new(BlockDeviceWipeResponse)
+	err := c.cc.Invoke(ctx, "/storage.StorageService/BlockDeviceWipe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServiceServer is the server API for StorageService service.
 // All implementations must embed UnimplementedStorageServiceServer
 // for forward compatibility
 type StorageServiceServer interface {
 	Disks(context.Context, *emptypb.Empty) (*DisksResponse, error)
+	// BlockDeviceWipe performs a wipe of the blockdevice (disk).
+	//
+	// The disk should not be used by Talos (system disk or user volume).
+	BlockDeviceWipe(context.Context, *BlockDeviceWipeRequest) (*BlockDeviceWipeResponse, error)
 	mustEmbedUnimplementedStorageServiceServer()
 }
 
@@ -59,6 +76,9 @@ type UnimplementedStorageServiceServer struct {
 func (UnimplementedStorageServiceServer) Disks(context.Context, *emptypb.Empty) (*DisksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Disks not implemented")
 }
+func (UnimplementedStorageServiceServer) BlockDeviceWipe(context.Context, *BlockDeviceWipeRequest) (*BlockDeviceWipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDeviceWipe not implemented")
+}
 func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
 
 // UnsafeStorageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +110,24 @@ func _StorageService_Disks_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageService_BlockDeviceWipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDeviceWipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).BlockDeviceWipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.StorageService/BlockDeviceWipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).BlockDeviceWipe(ctx, req.(*BlockDeviceWipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageService_ServiceDesc is the grpc.ServiceDesc for StorageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +139,10 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Disks",
 			Handler:    _StorageService_Disks_Handler,
 		},
+		{
+			MethodName: "BlockDeviceWipe",
+			Handler:    _StorageService_BlockDeviceWipe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storage/storage.proto",
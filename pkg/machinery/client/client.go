@@ -415,6 +415,17 @@ func (c *Client) Disks(ctx context.Context, callOptions ...grpc.CallOption) (res
 	return
 }
 
+// BlockDeviceWipe wipes the block devices which are not used by Talos.
+func (c *Client) BlockDeviceWipe(ctx context.Context, req *storageapi.BlockDeviceWipeRequest, callOptions ...grpc.CallOption) (resp *storageapi.BlockDeviceWipeResponse, err error) {
+	resp, err = c.StorageClient.BlockDeviceWipe(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*storageapi.BlockDeviceWipeResponse) //nolint:errcheck
+
+	return
+}
+
 // Stats implements the proto.MachineServiceClient interface.
 func (c *Client) Stats(ctx context.Context, namespace string, driver common.ContainerDriver, callOptions ...grpc.CallOption) (resp *machineapi.StatsResponse, err error) {
 	resp, err = c.MachineClient.Stats(
@@ -626,6 +637,17 @@ func (c *Client) Memory(ctx context.Context, callOptions ...grpc.CallOption) (re
 	return
 }
 
+// Metrics returns the Prometheus metrics of machined, apid and trustd.
+func (c *Client) Metrics(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.MetricsResponse, error) {
+	resp, err := c.MachineClient.Metrics(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.MetricsResponse) //nolint:errcheck
+
+	return resp, err
+}
+
 // Mounts implements the proto.MachineServiceClient interface.
 func (c *Client) Mounts(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.MountsResponse, err error) {
 	resp, err = c.MachineClient.Mounts(
@@ -883,6 +905,53 @@ func (c *Client) EtcdMemberList(ctx context.Context, req *machineapi.EtcdMemberL
 	return resp, err
 }
 
+// EtcdAlarmList lists etcd alarms for the current node.
+func (c *Client) EtcdAlarmList(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.EtcdAlarmListResponse, error) {
+	resp, err := c.MachineClient.EtcdAlarmList(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdAlarmListResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdAlarmDisarm disarms etcd alarms for the current node.
+func (c *Client) EtcdAlarmDisarm(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.EtcdAlarmDisarmResponse, error) {
+	resp, err := c.MachineClient.EtcdAlarmDisarm(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdAlarmDisarmResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdDefragment defragments etcd data directory for the current node.
+//
+// Defragmentation is a resource-heavy operation, so it should only run on a specific
+// node.
+func (c *Client) EtcdDefragment(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.EtcdDefragmentResponse, error) {
+	resp, err := c.MachineClient.EtcdDefragment(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdDefragmentResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdStatus returns etcd status for the current member.
+func (c *Client) EtcdStatus(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.EtcdStatusResponse, error) {
+	resp, err := c.MachineClient.EtcdStatus(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdStatusResponse) //nolint:errcheck
+
+	return resp, err
+}
+
 // EtcdSnapshot receives a snapshot of the etcd from the node.
 func (c *Client) EtcdSnapshot(ctx context.Context, req *machineapi.EtcdSnapshotRequest, callOptions ...grpc.CallOption) (io.ReadCloser, <-chan error, error) {
 	stream, err := c.MachineClient.EtcdSnapshot(ctx, req, callOptions...)
@@ -951,6 +1020,49 @@ func (c *Client) GenerateClientConfiguration(ctx context.Context, req *machineap
 	return
 }
 
+// PacketCapture performs packet capture on the node and streams back the pcap file.
+func (c *Client) PacketCapture(ctx context.Context, req *machineapi.PacketCaptureRequest, callOptions ...grpc.CallOption) (io.ReadCloser, <-chan error, error) {
+	stream, err := c.MachineClient.PacketCapture(ctx, req, callOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// Netstat lists the sockets of the node.
+func (c *Client) Netstat(ctx context.Context, req *machineapi.NetstatRequest, callOptions ...grpc.CallOption) (resp *machineapi.NetstatResponse, err error) {
+	resp, err = c.MachineClient.Netstat(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.NetstatResponse) //nolint:errcheck
+
+	return
+}
+
+// Cgroups returns the cgroup hierarchy of the node with the resource usage.
+func (c *Client) Cgroups(ctx context.Context, req *machineapi.CgroupsRequest, callOptions ...grpc.CallOption) (resp *machineapi.CgroupsResponse, err error) {
+	resp, err = c.MachineClient.Cgroups(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.CgroupsResponse) //nolint:errcheck
+
+	return
+}
+
+// UpdateEncryptionKeys adds, removes or rotates the encryption keys of the system partition.
+func (c *Client) UpdateEncryptionKeys(ctx context.Context, req *machineapi.UpdateEncryptionKeysRequest, callOptions ...grpc.CallOption) (resp *machineapi.UpdateEncryptionKeysResponse, err error) {
+	resp, err = c.MachineClient.UpdateEncryptionKeys(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.UpdateEncryptionKeysResponse) //nolint:errcheck
+
+	return
+}
+
 // MachineStream is a common interface for streams returned by streaming APIs.
 type MachineStream interface {
 	Recv() (*common.Data, error)
@@ -52,6 +52,19 @@ type Context struct {
 	CA               string   `yaml:"ca"`
 	Crt              string   `yaml:"crt"`
 	Key              string   `yaml:"key"`
+
+	OIDC *OIDCContext `yaml:"oidc,omitempty"`
+}
+
+// OIDCContext holds the OIDC login settings of the context.
+//
+// If set, the client certificate of the context is short-lived, and it is renewed
+// with the refresh token once it expires.
+type OIDCContext struct {
+	IssuerURL    string   `yaml:"issuerURL"`
+	ClientID     string   `yaml:"clientID"`
+	Scopes       []string `yaml:"scopes,omitempty"`
+	RefreshToken string   `yaml:"refreshToken,omitempty"`
 }
 
 func (c *Context) upgrade() {
@@ -14,7 +14,9 @@ import (
 	"os"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
 	"github.com/talos-systems/talos/pkg/machinery/config/decoder"
+	_ "github.com/talos-systems/talos/pkg/machinery/config/types/network" // register network config documents
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 )
 
@@ -22,6 +24,8 @@ import (
 var ErrNoConfig = errors.New("config not found")
 
 // newConfig initializes and returns a Configurator.
+//
+// Source might contain the v1alpha1 document and any number of auxiliary documents.
 func newConfig(source []byte) (config config.Provider, err error) {
 	dec := decoder.NewDecoder(source)
 
@@ -30,15 +34,31 @@ func newConfig(source []byte) (config config.Provider, err error) {
 		return nil, err
 	}
 
-	// Look for the older flat v1alpha1 file first, since we have to handle it in
-	// a special way.
+	var (
+		talosconfig *v1alpha1.Config
+		documents   []config.Document
+	)
+
 	for _, manifest := range manifests {
-		if talosconfig, ok := manifest.(*v1alpha1.Config); ok {
-			return v1alpha1.WrapReadonly(talosconfig, source), nil
+		switch doc := manifest.(type) {
+		case *v1alpha1.Config:
+			if talosconfig != nil {
+				return nil, errors.New("duplicate v1alpha1 config document")
+			}
+
+			talosconfig = doc
+		case config.Document:
+			documents = append(documents, doc)
+		default:
+			return nil, fmt.Errorf("unsupported config document type %T", manifest)
 		}
 	}
 
-	return nil, ErrNoConfig
+	if talosconfig == nil {
+		return nil, ErrNoConfig
+	}
+
+	return container.NewReadonly(source, talosconfig, documents...)
 }
 
 // NewFromFile will take a filepath and attempt to parse a config file from it.
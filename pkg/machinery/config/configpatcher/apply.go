@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher
+
+import (
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+)
+
+// Input to the patch application process.
+type Input interface {
+	Config() (config.Provider, error)
+	Bytes() ([]byte, error)
+}
+
+// Output of the patch application process.
+type Output = Input
+
+// WithConfig returns a patch input for a parsed machine configuration.
+func WithConfig(cfg config.Provider) Input {
+	return configInput{cfg: cfg}
+}
+
+// WithBytes returns a patch input for a marshaled machine configuration.
+func WithBytes(cfg []byte) Input {
+	return bytesInput{bytes: cfg}
+}
+
+type configInput struct {
+	cfg config.Provider
+}
+
+func (in configInput) Config() (config.Provider, error) {
+	return in.cfg, nil
+}
+
+func (in configInput) Bytes() ([]byte, error) {
+	return in.cfg.Bytes()
+}
+
+type bytesInput struct {
+	bytes []byte
+}
+
+func (in bytesInput) Config() (config.Provider, error) {
+	return configloader.NewFromBytes(in.bytes)
+}
+
+func (in bytesInput) Bytes() ([]byte, error) {
+	return in.bytes, nil
+}
+
+// Apply config patches to Talos machine config.
+//
+// Each patch is either JSON6902 or StrategicMergePatch, patches are applied in order.
+// Conversion between the marshaled and parsed representation happens only when
+// the patch type changes.
+func Apply(in Input, patches []Patch) (Output, error) {
+	for _, patch := range patches {
+		switch p := patch.(type) {
+		case jsonpatch.Patch:
+			cfg, err := in.Bytes()
+			if err != nil {
+				return nil, err
+			}
+
+			patched, err := JSON6902(cfg, p)
+			if err != nil {
+				return nil, err
+			}
+
+			in = WithBytes(patched)
+		case StrategicMergePatch:
+			cfg, err := in.Config()
+			if err != nil {
+				return nil, err
+			}
+
+			patched, err := StrategicMerge(cfg, p)
+			if err != nil {
+				return nil, err
+			}
+
+			in = WithConfig(patched)
+		default:
+			return nil, fmt.Errorf("unknown patch type %T", patch)
+		}
+	}
+
+	return in, nil
+}
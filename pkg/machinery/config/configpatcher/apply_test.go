@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+//go:embed testdata/apply/config.yaml
+var applyConfig []byte
+
+//go:embed testdata/apply/strategic.yaml
+var applyStrategicPatch []byte
+
+func TestApply(t *testing.T) {
+	patches, err := configpatcher.LoadPatches([]string{
+		`[{"op":"add","path":"/machine/network/hostname","value":"foo"}]`,
+		string(applyStrategicPatch),
+		`[{"op":"add","path":"/machine/certSANs","value":["foo.com"]}]`,
+	})
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithBytes(applyConfig), patches)
+	require.NoError(t, err)
+
+	provider, err := out.Config()
+	require.NoError(t, err)
+
+	cfg, ok := provider.Raw().(*v1alpha1.Config)
+	require.True(t, ok)
+
+	assert.Equal(t, "foo", cfg.MachineConfig.MachineNetwork.NetworkHostname)
+	assert.Equal(t, []string{"foo.com"}, cfg.MachineConfig.MachineCertSANs)
+	assert.Equal(t, "https://1.2.3.4:6443", cfg.ClusterConfig.ControlPlane.Endpoint.String())
+
+	devices := cfg.MachineConfig.MachineNetwork.NetworkInterfaces
+	require.Len(t, devices, 3)
+
+	assert.Equal(t, "eth0", devices[0].DeviceInterface)
+	assert.Equal(t, []string{"10.5.0.2/24", "10.5.0.3/24"}, devices[0].DeviceAddresses)
+	require.Len(t, devices[0].DeviceRoutes, 1)
+	assert.Equal(t, "10.5.0.254", devices[0].DeviceRoutes[0].RouteGateway)
+	assert.EqualValues(t, 1024, devices[0].DeviceRoutes[0].RouteMetric)
+	require.Len(t, devices[0].DeviceVlans, 2)
+	assert.EqualValues(t, 100, devices[0].DeviceVlans[0].VlanID)
+	assert.True(t, devices[0].DeviceVlans[0].VlanDHCP)
+	assert.Equal(t, []string{"192.168.0.2/24"}, devices[0].DeviceVlans[0].VlanAddresses)
+	assert.EqualValues(t, 200, devices[0].DeviceVlans[1].VlanID)
+
+	require.NotNil(t, devices[1].DeviceSelector)
+	assert.True(t, devices[1].DeviceDHCP)
+	assert.Equal(t, 9000, devices[1].DeviceMTU)
+
+	assert.Equal(t, "eth1", devices[2].DeviceInterface)
+
+	mounts := cfg.MachineConfig.MachineKubelet.KubeletExtraMounts
+	require.Len(t, mounts, 2)
+	assert.Equal(t, "/var/lib/other", mounts[0].Source)
+	assert.Equal(t, []string{"bind"}, mounts[0].Options)
+	assert.Equal(t, "/var/mnt", mounts[1].Destination)
+
+	manifests := cfg.ClusterConfig.ClusterInlineManifests
+	require.Len(t, manifests, 1)
+	assert.Contains(t, manifests[0].InlineManifestContents, "name: ci")
+}
+
+func TestApplyConfigInput(t *testing.T) {
+	patches, err := configpatcher.LoadPatches([]string{string(applyStrategicPatch)})
+	require.NoError(t, err)
+
+	in, err := configpatcher.WithBytes(applyConfig).Config()
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithConfig(in), patches)
+	require.NoError(t, err)
+
+	// original config should not be modified
+	assert.Len(t, in.Machine().Network().Devices(), 2)
+
+	provider, err := out.Config()
+	require.NoError(t, err)
+
+	assert.Len(t, provider.Machine().Network().Devices(), 3)
+
+	_, err = out.Bytes()
+	require.NoError(t, err)
+}
+
+func TestApplyDocumentsOnly(t *testing.T) {
+	patches, err := configpatcher.LoadPatches([]string{"@testdata/documents.yaml"})
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithBytes(applyConfig), patches)
+	require.NoError(t, err)
+
+	provider, err := out.Config()
+	require.NoError(t, err)
+
+	// the v1alpha1 document is not changed by the patch
+	assert.Len(t, provider.Machine().Network().Devices(), 2)
+	require.Len(t, provider.Documents(), 1)
+	assert.Equal(t, "NetworkRuleConfig/ingress-apid", config.DocumentID(provider.Documents()[0]))
+}
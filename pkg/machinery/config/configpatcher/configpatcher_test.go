@@ -6,9 +6,12 @@ package configpatcher_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 )
@@ -67,3 +70,36 @@ func TestJSON6902(t *testing.T) {
 		})
 	}
 }
+
+const multiDocConfig = `machine:
+  kubelet: {}
+---
+apiVersion: v1alpha1
+kind: ExampleConfig
+name: example
+`
+
+const multiDocPatched = `machine:
+  kubelet:
+    extraArgs:
+      cloud-provider: external
+---
+apiVersion: v1alpha1
+kind: ExampleConfig
+name: example
+`
+
+func TestJSON6902MultiDoc(t *testing.T) {
+	patch, err := jsonpatch.DecodePatch([]byte(`[{"op": "add", "path": "/machine/kubelet/extraArgs", "value": {"cloud-provider": "external"}}]`))
+	require.NoError(t, err)
+
+	// the patch is applied to the v1alpha1 document, auxiliary documents are preserved
+	got, err := configpatcher.JSON6902([]byte(multiDocConfig), patch)
+	require.NoError(t, err)
+
+	assert.Equal(t, multiDocPatched, string(got))
+
+	// no v1alpha1 document to patch
+	_, err = configpatcher.JSON6902([]byte(multiDocConfig[strings.Index(multiDocConfig, "---"):]+"---\napiVersion: v1alpha1\nkind: OtherConfig\n"), patch)
+	assert.Error(t, err)
+}
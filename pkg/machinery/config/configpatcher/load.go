@@ -6,33 +6,85 @@ package configpatcher
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
+	"github.com/talos-systems/talos/pkg/machinery/config/decoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 )
 
-type patch []map[string]interface{}
+// Patch is either JSON patch or strategic merge patch.
+type Patch interface{}
 
-// LoadPatch loads the JSON patch either from JSON or YAML.
-func LoadPatch(in []byte) (p jsonpatch.Patch, err error) {
-	var jsonErr error
+type patch []map[string]interface{}
 
+// LoadPatch loads the strategic merge patch or JSON patch (JSON/YAML for JSON patch).
+func LoadPatch(in []byte) (Patch, error) {
 	// try JSON first
-	if p, jsonErr = jsonpatch.DecodePatch(in); jsonErr == nil {
+	p, jsonErr := jsonpatch.DecodePatch(in)
+	if jsonErr == nil {
 		return p, nil
 	}
 
-	// try YAML
+	// try YAML JSON patch
 	var yamlPatch patch
 
-	if err = yaml.Unmarshal(in, &yamlPatch); err != nil {
-		// not YAML either, return JSON error
-		return p, jsonErr
+	if err := yaml.Unmarshal(in, &yamlPatch); err == nil {
+		return convertYAMLPatch(yamlPatch)
+	}
+
+	// try strategic merge patch
+	cfg, err := configloader.NewFromBytes(in)
+	if errors.Is(err, configloader.ErrNoConfig) {
+		// the patch might contain only auxiliary documents
+		cfg, err = loadDocumentsPatch(in)
+	}
+
+	if err != nil {
+		// not a config either, report both errors
+		return nil, fmt.Errorf("failed to load patch as JSON patch (%s) or strategic merge patch: %w", jsonErr, err)
+	}
+
+	return NewStrategicMergePatch(cfg), nil
+}
+
+// loadDocumentsPatch loads the strategic merge patch which has no v1alpha1 document.
+//
+// The v1alpha1 document of the patch is empty, so that only the auxiliary documents are merged.
+func loadDocumentsPatch(in []byte) (config.Provider, error) {
+	manifests, err := decoder.NewDecoder(in).Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make([]config.Document, 0, len(manifests))
+
+	for _, manifest := range manifests {
+		doc, ok := manifest.(config.Document)
+		if !ok {
+			return nil, fmt.Errorf("unsupported config document type %T", manifest)
+		}
+
+		documents = append(documents, doc)
+	}
+
+	if len(documents) == 0 {
+		return nil, configloader.ErrNoConfig
 	}
 
-	p = make(jsonpatch.Patch, 0, len(yamlPatch))
+	return container.New(&v1alpha1.Config{}, documents...)
+}
+
+func convertYAMLPatch(yamlPatch patch) (jsonpatch.Patch, error) {
+	p := make(jsonpatch.Patch, 0, len(yamlPatch))
 
 	for _, yp := range yamlPatch {
 		op := make(jsonpatch.Operation, len(yp))
@@ -52,13 +104,15 @@ func LoadPatch(in []byte) (p jsonpatch.Patch, err error) {
 	return p, nil
 }
 
-// LoadPatches loads the JSON patch either from value literal or from a file if the patch starts with '@'.
-func LoadPatches(in []string) (jsonpatch.Patch, error) {
-	var result jsonpatch.Patch
+// LoadPatches loads the patches either from value literal or from a file if the patch starts with '@'.
+//
+// Consecutive JSON patches are merged into a single patch.
+func LoadPatches(in []string) ([]Patch, error) {
+	var result []Patch
 
 	for _, patchString := range in {
 		var (
-			p        jsonpatch.Patch
+			p        Patch
 			contents []byte
 			err      error
 		)
@@ -79,7 +133,15 @@ func LoadPatches(in []string) (jsonpatch.Patch, error) {
 			return result, err
 		}
 
-		result = append(result, p...)
+		if jsonPatch, ok := p.(jsonpatch.Patch); ok && len(result) > 0 {
+			if prevPatch, ok := result[len(result)-1].(jsonpatch.Patch); ok {
+				result[len(result)-1] = append(prevPatch, jsonPatch...)
+
+				continue
+			}
+		}
+
+		result = append(result, p)
 	}
 
 	return result, nil
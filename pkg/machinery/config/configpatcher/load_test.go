@@ -8,9 +8,11 @@ import (
 	_ "embed"
 	"testing"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 )
 
@@ -20,10 +22,19 @@ var jsonPatch []byte
 //go:embed testdata/patch.yaml
 var yamlPatch []byte
 
+//go:embed testdata/strategic.yaml
+var strategicPatch []byte
+
+//go:embed testdata/documents.yaml
+var documentsPatch []byte
+
 func TestLoadJSON(t *testing.T) {
-	p, err := configpatcher.LoadPatch(jsonPatch)
+	raw, err := configpatcher.LoadPatch(jsonPatch)
 	require.NoError(t, err)
 
+	p, ok := raw.(jsonpatch.Patch)
+	require.True(t, ok)
+
 	assert.Len(t, p, 1)
 	assert.Equal(t, p[0].Kind(), "add")
 
@@ -35,9 +46,12 @@ func TestLoadJSON(t *testing.T) {
 }
 
 func TestLoadYAML(t *testing.T) {
-	p, err := configpatcher.LoadPatch(yamlPatch)
+	raw, err := configpatcher.LoadPatch(yamlPatch)
 	require.NoError(t, err)
 
+	p, ok := raw.(jsonpatch.Patch)
+	require.True(t, ok)
+
 	assert.Len(t, p, 1)
 	assert.Equal(t, p[0].Kind(), "add")
 
@@ -53,16 +67,58 @@ func TestLoadYAML(t *testing.T) {
 	assert.Equal(t, v, []interface{}{"a", "b", "c"})
 }
 
+func TestLoadStrategic(t *testing.T) {
+	raw, err := configpatcher.LoadPatch(strategicPatch)
+	require.NoError(t, err)
+
+	p, ok := raw.(configpatcher.StrategicMergePatch)
+	require.True(t, ok)
+
+	assert.Equal(t, "foo.com", p.Provider().Machine().Network().Hostname())
+}
+
+func TestLoadStrategicDocumentsOnly(t *testing.T) {
+	raw, err := configpatcher.LoadPatch(documentsPatch)
+	require.NoError(t, err)
+
+	p, ok := raw.(configpatcher.StrategicMergePatch)
+	require.True(t, ok)
+
+	documents := p.Provider().Documents()
+	require.Len(t, documents, 1)
+	assert.Equal(t, "NetworkRuleConfig/ingress-apid", config.DocumentID(documents[0]))
+}
+
+func TestLoadInvalid(t *testing.T) {
+	_, err := configpatcher.LoadPatch([]byte(`foo: bar`))
+	require.Error(t, err)
+}
+
 func TestLoadPatches(t *testing.T) {
-	p, err := configpatcher.LoadPatches([]string{
+	patchList, err := configpatcher.LoadPatches([]string{
 		"@testdata/patch.json",
 		"@testdata/patch.yaml",
 		`[{"op":"replace","path":"/some","value": []}]`,
+		"@testdata/strategic.yaml",
+		`[{"op":"add","path":"/some","value": []}]`,
 	})
 	require.NoError(t, err)
 
+	require.Len(t, patchList, 3)
+
+	p, ok := patchList[0].(jsonpatch.Patch)
+	require.True(t, ok)
+
 	assert.Len(t, p, 3)
 	assert.Equal(t, p[0].Kind(), "add")
 	assert.Equal(t, p[1].Kind(), "add")
 	assert.Equal(t, p[2].Kind(), "replace")
+
+	assert.Implements(t, (*configpatcher.StrategicMergePatch)(nil), patchList[1])
+
+	p, ok = patchList[2].(jsonpatch.Patch)
+	require.True(t, ok)
+
+	assert.Len(t, p, 1)
+	assert.Equal(t, p[0].Kind(), "add")
 }
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher
+
+import (
+	"fmt"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
+	"github.com/talos-systems/talos/pkg/machinery/config/merge"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// StrategicMergePatch is a strategic merge config patch.
+type StrategicMergePatch interface {
+	Provider() config.Provider
+}
+
+// NewStrategicMergePatch creates a new strategic merge patch from the partial machine config.
+func NewStrategicMergePatch(cfg config.Provider) StrategicMergePatch {
+	return strategicMergePatch{provider: cfg}
+}
+
+type strategicMergePatch struct {
+	provider config.Provider
+}
+
+// Provider implements StrategicMergePatch interface.
+func (p strategicMergePatch) Provider() config.Provider {
+	return p.provider
+}
+
+// StrategicMerge performs strategic merge config patching.
+//
+// The patch is a partial v1alpha1 machine configuration which is merged into the base config.
+// Lists of network interfaces, VLANs, routes, kubelet extra mounts and inline manifests are merged
+// using merge keys (interface name or device selector, VLAN ID, route network, mount destination
+// and manifest name), other lists are appended to, other values are replaced if set in the patch.
+//
+// Auxiliary documents in the patch are merged into the documents of the same kind (and name),
+// documents which are not present in the base config are appended.
+func StrategicMerge(cfg config.Provider, patch StrategicMergePatch) (config.Provider, error) {
+	left, ok := cfg.Raw().(*v1alpha1.Config)
+	if !ok {
+		return nil, fmt.Errorf("unsupported config type for strategic merge %T", cfg.Raw())
+	}
+
+	right, ok := patch.Provider().Raw().(*v1alpha1.Config)
+	if !ok {
+		return nil, fmt.Errorf("unsupported patch type for strategic merge %T", patch.Provider().Raw())
+	}
+
+	left = left.DeepCopy()
+
+	if err := merge.Merge(left, right.DeepCopy()); err != nil {
+		return nil, fmt.Errorf("failed to apply strategic merge patch: %w", err)
+	}
+
+	documents, err := mergeDocuments(cfg.Documents(), patch.Provider().Documents())
+	if err != nil {
+		return nil, err
+	}
+
+	return container.New(left, documents...)
+}
+
+func mergeDocuments(left, right []config.Document) ([]config.Document, error) {
+	documents := make([]config.Document, 0, len(left)+len(right))
+	index := make(map[string]int, len(left))
+
+	for _, doc := range left {
+		index[config.DocumentID(doc)] = len(documents)
+		documents = append(documents, doc.Clone())
+	}
+
+	for _, doc := range right {
+		id := config.DocumentID(doc)
+
+		i, ok := index[id]
+		if !ok {
+			index[id] = len(documents)
+			documents = append(documents, doc.Clone())
+
+			continue
+		}
+
+		if err := merge.Merge(documents[i], doc.Clone()); err != nil {
+			return nil, fmt.Errorf("failed to apply strategic merge patch to %s: %w", id, err)
+		}
+	}
+
+	return documents, nil
+}
@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package container implements a wrapper which wraps all configuration documents into a single container.
+package container
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// Container wraps the v1alpha1 configuration document and auxiliary documents.
+//
+// Container implements config.Provider interface, the v1alpha1 document is used
+// to implement most of the methods.
+type Container struct {
+	v1alpha1Config *v1alpha1.Config
+	documents      []config.Document
+
+	bytes    []byte
+	readonly bool
+}
+
+var _ config.Provider = &Container{}
+
+// New creates a container out of the v1alpha1 config and the list of auxiliary documents.
+func New(cfg *v1alpha1.Config, documents ...config.Document) (*Container, error) {
+	if cfg == nil {
+		return nil, errors.New("v1alpha1 config is required")
+	}
+
+	seen := make(map[string]struct{}, len(documents))
+
+	for _, doc := range documents {
+		id := config.DocumentID(doc)
+
+		if _, ok := seen[id]; ok {
+			return nil, fmt.Errorf("duplicate document: %s", id)
+		}
+
+		seen[id] = struct{}{}
+	}
+
+	return &Container{
+		v1alpha1Config: cfg,
+		documents:      documents,
+	}, nil
+}
+
+// NewReadonly creates a read-only container which preserves byte representation of the contents.
+func NewReadonly(source []byte, cfg *v1alpha1.Config, documents ...config.Document) (*Container, error) {
+	c, err := New(cfg, documents...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.bytes = source
+	c.readonly = true
+
+	return c, nil
+}
+
+// Version implements config.Provider interface.
+func (c *Container) Version() string {
+	return c.v1alpha1Config.Version()
+}
+
+// Debug implements config.Provider interface.
+func (c *Container) Debug() bool {
+	return c.v1alpha1Config.Debug()
+}
+
+// Persist implements config.Provider interface.
+func (c *Container) Persist() bool {
+	return c.v1alpha1Config.Persist()
+}
+
+// Machine implements config.Provider interface.
+func (c *Container) Machine() config.MachineConfig {
+	return c.v1alpha1Config.Machine()
+}
+
+// Cluster implements config.Provider interface.
+func (c *Container) Cluster() config.ClusterConfig {
+	return c.v1alpha1Config.Cluster()
+}
+
+// Documents implements config.Provider interface.
+func (c *Container) Documents() []config.Document {
+	if !c.readonly {
+		return c.documents
+	}
+
+	documents := make([]config.Document, 0, len(c.documents))
+
+	for _, doc := range c.documents {
+		documents = append(documents, doc.Clone())
+	}
+
+	return documents
+}
+
+// Validate checks configuration and returns warnings and fatal errors (as multierror).
+//
+// The v1alpha1 document and all auxiliary documents which implement config.Validator are validated.
+func (c *Container) Validate(mode config.RuntimeMode, opts ...config.ValidationOption) ([]string, error) {
+	warnings, err := c.v1alpha1Config.Validate(mode, opts...)
+
+	var result *multierror.Error
+
+	if err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	for _, doc := range c.documents {
+		validator, ok := doc.(config.Validator)
+		if !ok {
+			continue
+		}
+
+		docWarnings, docErr := validator.Validate(mode, opts...)
+
+		warnings = append(warnings, docWarnings...)
+
+		if docErr != nil {
+			result = multierror.Append(result, fmt.Errorf("%s: %w", config.DocumentID(doc), docErr))
+		}
+	}
+
+	return warnings, result.ErrorOrNil()
+}
+
+// Bytes returns source YAML representation (if available) or does default encoding.
+func (c *Container) Bytes() ([]byte, error) {
+	if c.bytes != nil {
+		return c.bytes, nil
+	}
+
+	return c.EncodeBytes()
+}
+
+// EncodeString implements config.Provider interface.
+func (c *Container) EncodeString(encoderOptions ...encoder.Option) (string, error) {
+	b, err := c.EncodeBytes(encoderOptions...)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// EncodeBytes implements config.Provider interface.
+//
+// Documents are encoded as a multi-document YAML stream, the v1alpha1 document goes first.
+func (c *Container) EncodeBytes(encoderOptions ...encoder.Option) ([]byte, error) {
+	var buf bytes.Buffer
+
+	out, err := c.v1alpha1Config.EncodeBytes(encoderOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(out)
+
+	for _, doc := range c.documents {
+		out, err = encoder.NewEncoder(doc, encoderOptions...).Encode()
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", config.DocumentID(doc), err)
+		}
+
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+
+		buf.WriteString("---\n")
+		buf.Write(out)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Raw implements config.Provider interface.
+//
+// Raw returns the v1alpha1 document.
+func (c *Container) Raw() interface{} {
+	if c.readonly {
+		return c.v1alpha1Config.DeepCopy()
+	}
+
+	return c.v1alpha1Config
+}
+
+// Readonly returns true if the container is read-only.
+func (c *Container) Readonly() bool {
+	return c.readonly
+}
+
+// Clone returns a mutable deep copy of the container.
+func (c *Container) Clone() *Container {
+	documents := make([]config.Document, 0, len(c.documents))
+
+	for _, doc := range c.documents {
+		documents = append(documents, doc.Clone())
+	}
+
+	return &Container{
+		v1alpha1Config: c.v1alpha1Config.DeepCopy(),
+		documents:      documents,
+	}
+}
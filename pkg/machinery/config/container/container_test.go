@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/meta"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+type mockDocument struct {
+	meta.Meta `yaml:",inline"`
+	MockName  string `yaml:"name"`
+	Valid     bool   `yaml:"valid"`
+}
+
+func (d *mockDocument) Name() string {
+	return d.MockName
+}
+
+func (d *mockDocument) Clone() config.Document {
+	c := *d
+
+	return &c
+}
+
+func (d *mockDocument) Validate(config.RuntimeMode, ...config.ValidationOption) ([]string, error) {
+	if !d.Valid {
+		return []string{"mock warning"}, errors.New("mock is invalid")
+	}
+
+	return nil, nil
+}
+
+func newMockDocument(name string, valid bool) *mockDocument {
+	return &mockDocument{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       "MockConfig",
+		},
+		MockName: name,
+		Valid:    valid,
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := container.New(nil)
+	require.Error(t, err)
+
+	_, err = container.New(&v1alpha1.Config{}, newMockDocument("a", true), newMockDocument("a", true))
+	require.EqualError(t, err, "duplicate document: MockConfig/a")
+
+	c, err := container.New(&v1alpha1.Config{ConfigVersion: "v1alpha1"}, newMockDocument("a", true), newMockDocument("b", true))
+	require.NoError(t, err)
+
+	assert.Equal(t, "v1alpha1", c.Version())
+	assert.Len(t, c.Documents(), 2)
+	assert.False(t, c.Readonly())
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	c, err := container.New(&v1alpha1.Config{ConfigVersion: "v1alpha1"}, newMockDocument("a", true))
+	require.NoError(t, err)
+
+	out, err := c.EncodeString(encoder.WithComments(encoder.CommentsDisabled))
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(out, "version: v1alpha1\n"))
+	assert.True(t, strings.HasSuffix(out, `
+---
+apiVersion: v1alpha1
+kind: MockConfig
+name: a
+valid: true
+`))
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	c, err := container.New(&v1alpha1.Config{ConfigVersion: "v1alpha1"}, newMockDocument("a", true), newMockDocument("b", false))
+	require.NoError(t, err)
+
+	warnings, err := c.Validate(mockMode{})
+	require.Error(t, err)
+
+	assert.Contains(t, warnings, "mock warning")
+	assert.Contains(t, err.Error(), "MockConfig/b: mock is invalid")
+	assert.NotContains(t, err.Error(), "MockConfig/a")
+}
+
+func TestReadonly(t *testing.T) {
+	t.Parallel()
+
+	source := []byte("version: v1alpha1\n")
+
+	c, err := container.NewReadonly(source, &v1alpha1.Config{ConfigVersion: "v1alpha1"}, newMockDocument("a", true))
+	require.NoError(t, err)
+
+	assert.True(t, c.Readonly())
+
+	b, err := c.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, source, b)
+
+	// modifications of the returned documents don't affect the container
+	c.Documents()[0].(*mockDocument).MockName = "b"                 //nolint:forcetypeassert
+	c.Raw().(*v1alpha1.Config).ConfigVersion = "v1alpha2"           //nolint:forcetypeassert
+	assert.Equal(t, "a", c.Documents()[0].(*mockDocument).MockName) //nolint:forcetypeassert
+	assert.Equal(t, "v1alpha1", c.Version())
+
+	clone := c.Clone()
+	assert.False(t, clone.Readonly())
+
+	clone.Documents()[0].(*mockDocument).MockName = "c"                 //nolint:forcetypeassert
+	assert.Equal(t, "c", clone.Documents()[0].(*mockDocument).MockName) //nolint:forcetypeassert
+	assert.Equal(t, "a", c.Documents()[0].(*mockDocument).MockName)     //nolint:forcetypeassert
+}
+
+type mockMode struct{}
+
+func (mockMode) String() string {
+	return "mock"
+}
+
+func (mockMode) RequiresInstall() bool {
+	return false
+}
@@ -29,12 +29,18 @@ var (
 const (
 	// ManifestVersionKey is the string indicating a manifest's version.
 	ManifestVersionKey = "version"
+	// ManifestAPIVersionKey is the string indicating a document's API version.
+	//
+	// Documents with API version are decoded as a whole, without the spec key.
+	ManifestAPIVersionKey = "apiVersion"
 	// ManifestKindKey is the string indicating a manifest's kind.
 	ManifestKindKey = "kind"
 	// ManifestSpecKey is represents a manifest's spec.
 	ManifestSpecKey = "spec"
 	// ManifestDeprecatedKey is represents the deprected v1alpha1 manifest.
 	ManifestDeprecatedKey = "machine"
+	// ManifestDeprecatedClusterKey represents the v1alpha1 manifest without the machine section (e.g. a config patch).
+	ManifestDeprecatedClusterKey = "cluster"
 )
 
 // Decoder represents a multi-doc YAML decoder.
@@ -110,6 +116,10 @@ func decode(manifest *yaml.Node) (target interface{}, err error) {
 		spec    *yaml.Node
 	)
 
+	if isDocument(manifest) {
+		return decodeDocument(manifest)
+	}
+
 	for i, node := range manifest.Content {
 		switch node.Value {
 		case ManifestKindKey:
@@ -134,7 +144,7 @@ func decode(manifest *yaml.Node) (target interface{}, err error) {
 			}
 
 			spec = manifest.Content[i+1]
-		case ManifestDeprecatedKey:
+		case ManifestDeprecatedKey, ManifestDeprecatedClusterKey:
 			if target, err = config.New("v1alpha1", ""); err != nil {
 				return nil, fmt.Errorf("new deprecated config: %w", err)
 			}
@@ -181,3 +191,54 @@ func decode(manifest *yaml.Node) (target interface{}, err error) {
 
 	return target, nil
 }
+
+func isDocument(manifest *yaml.Node) bool {
+	if manifest.Kind != yaml.MappingNode {
+		return false
+	}
+
+	for i := 0; i < len(manifest.Content); i += 2 {
+		if manifest.Content[i].Value == ManifestAPIVersionKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeDocument(manifest *yaml.Node) (target interface{}, err error) {
+	var header struct {
+		APIVersion string `yaml:"apiVersion"`
+		Kind       string `yaml:"kind"`
+	}
+
+	if err = manifest.Decode(&header); err != nil {
+		return nil, fmt.Errorf("document header decode: %w", err)
+	}
+
+	if header.Kind == "" {
+		return nil, ErrMissingKind
+	}
+
+	if header.APIVersion == "" {
+		return nil, ErrMissingVersion
+	}
+
+	if target, err = config.New(header.Kind, header.APIVersion); err != nil {
+		return nil, fmt.Errorf("new document: %w", err)
+	}
+
+	if target == nil {
+		return nil, fmt.Errorf("new document: %q %q: %w", header.Kind, header.APIVersion, config.ErrNotRegistered)
+	}
+
+	if err = manifest.Decode(target); err != nil {
+		return nil, fmt.Errorf("document decode: %w", err)
+	}
+
+	if err = checkUnknownKeys(target, manifest); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/decoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/meta"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 )
 
@@ -34,6 +35,11 @@ type MockUnstructured struct {
 	Pods []v1alpha1.Unstructured `yaml:"pods,omitempty"`
 }
 
+type MockDocument struct {
+	meta.Meta `yaml:",inline"`
+	Test      bool `yaml:"test"`
+}
+
 func init() {
 	config.Register("mock", func(version string) interface{} {
 		switch version {
@@ -53,6 +59,10 @@ func init() {
 	config.Register("unstructured", func(string) interface{} {
 		return &MockUnstructured{}
 	})
+
+	config.Register("MockDocument", func(string) interface{} {
+		return &MockDocument{}
+	})
 }
 
 func TestDecoder(t *testing.T) {
@@ -297,6 +307,49 @@ spec:
   omit: false
 `),
 		},
+		{
+			name: "document",
+			source: []byte(`---
+apiVersion: v1alpha1
+kind: MockDocument
+test: true
+`),
+			expected: []interface{}{
+				&MockDocument{
+					Meta: meta.Meta{
+						MetaAPIVersion: "v1alpha1",
+						MetaKind:       "MockDocument",
+					},
+					Test: true,
+				},
+			},
+		},
+		{
+			name: "document missing kind",
+			source: []byte(`---
+apiVersion: v1alpha1
+test: true
+`),
+			expectedErr: "missing kind",
+		},
+		{
+			name: "document extra field",
+			source: []byte(`---
+apiVersion: v1alpha1
+kind: MockDocument
+test: true
+extra: fail
+`),
+			expectedErr: "unknown keys found during decoding:\nextra: fail\n",
+		},
+		{
+			name: "document unknown kind",
+			source: []byte(`---
+apiVersion: v1alpha1
+kind: UnknownDocument
+`),
+			expectedErr: "new document: \"UnknownDocument\" \"v1alpha1\": not registered",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// Document is an auxiliary machine configuration document.
+//
+// Machine configuration is a YAML stream which contains the v1alpha1 document
+// and any number of auxiliary documents identified by `apiVersion` and `kind`.
+// Document kinds are registered with Register.
+type Document interface {
+	Kind() string
+	APIVersion() string
+
+	// Clone returns a deep copy of the document.
+	Clone() Document
+}
+
+// NamedDocument is a document which might be specified multiple times in the machine configuration.
+//
+// Named documents are identified by the kind and name, other documents are identified by the kind.
+type NamedDocument interface {
+	Document
+
+	Name() string
+}
+
+// Validator is implemented by documents which support validation.
+type Validator interface {
+	Validate(RuntimeMode, ...ValidationOption) ([]string, error)
+}
+
+// RuntimeDocument is implemented by documents which are watched by the controllers,
+// so that the changes to them are applied without a reboot.
+type RuntimeDocument interface {
+	Document
+
+	// AppliedAtRuntime is a marker method, it should return true.
+	AppliedAtRuntime() bool
+}
+
+// DocumentID returns a unique identifier of the document within the machine configuration.
+func DocumentID(doc Document) string {
+	if named, ok := doc.(NamedDocument); ok {
+		return doc.Kind() + "/" + named.Name()
+	}
+
+	return doc.Kind()
+}
@@ -5,6 +5,7 @@
 package encoder
 
 import (
+	"encoding"
 	"reflect"
 	"sort"
 	"strings"
@@ -133,6 +134,15 @@ func toYamlNode(in interface{}, options *Options) (*yaml.Node, error) {
 		in = res
 	}
 
+	// types implementing encoding.TextMarshaler are encoded as scalars
+	if _, ok := in.(encoding.TextMarshaler); ok && !isNil(reflect.ValueOf(in)) {
+		if err := node.Encode(in); err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	}
+
 	v := reflect.ValueOf(in)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
@@ -78,6 +78,19 @@ func (cm *WithCustomMarshaller) MarshalYAML() (interface{}, error) {
 	return node, nil
 }
 
+type WithTextMarshaler struct {
+	Value TextValue `yaml:"value"`
+}
+
+type TextValue struct {
+	value string
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v TextValue) MarshalText() ([]byte, error) {
+	return []byte(v.value), nil
+}
+
 // This is manually defined documentation data for Config.
 // It is intended to be generated by `docgen` command.
 var (
@@ -470,6 +483,19 @@ mixed_in: a # was inlined
 				encoder.WithComments(encoder.CommentsExamples),
 			},
 		},
+		{
+			name: "text marshaler",
+			value: &WithTextMarshaler{
+				Value: TextValue{
+					value: "10.0.0.0/8",
+				},
+			},
+			expectedYAML: `value: 10.0.0.0/8
+`,
+			options: []encoder.Option{
+				encoder.WithComments(encoder.CommentsAll),
+			},
+		},
 		{
 			name: "with onlyifnotnil tag",
 			value: &Config{
@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package patcher implements JSON6902 patching of the marshaled machine configuration.
+//
+// It is shared by configpatcher and the deprecated v1alpha1 patching methods which can't import configpatcher.
+package patcher
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	ghodssyaml "github.com/ghodss/yaml"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// apiVersionKey is present only in the auxiliary (non-v1alpha1) documents.
+const apiVersionKey = "apiVersion"
+
+// JSON6902 is responsible for applying a JSON 6902 patch to the bootstrap data.
+//
+// If the machine config consists of multiple documents, the patch is applied to the v1alpha1 document,
+// and the auxiliary documents are kept as is.
+func JSON6902(talosMachineConfig []byte, patch jsonpatch.Patch) ([]byte, error) {
+	documents, err := splitDocuments(talosMachineConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(documents) <= 1 {
+		return json6902(talosMachineConfig, patch)
+	}
+
+	v1alpha1Idx := -1
+
+	for i, doc := range documents {
+		if isAuxiliaryDocument(doc) {
+			continue
+		}
+
+		if v1alpha1Idx != -1 {
+			return nil, errors.New("JSON6902 patch can't be applied: multiple v1alpha1 documents found")
+		}
+
+		v1alpha1Idx = i
+	}
+
+	if v1alpha1Idx == -1 {
+		return nil, errors.New("JSON6902 patch can't be applied: no v1alpha1 document found")
+	}
+
+	var buf bytes.Buffer
+
+	for i, doc := range documents {
+		out, err := encodeDocument(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == v1alpha1Idx {
+			if out, err = json6902(out, patch); err != nil {
+				return nil, err
+			}
+		}
+
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+
+		buf.Write(out)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func json6902(talosMachineConfig []byte, patch jsonpatch.Patch) ([]byte, error) {
+	jsonDecodedData, err := ghodssyaml.YAMLToJSON(talosMachineConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failure converting talos machine config to json: %s", err)
+	}
+
+	jsonDecodedData, err = patch.Apply(jsonDecodedData)
+	if err != nil {
+		return nil, fmt.Errorf("failure applying rfc6902 patches to talos machine config: %s", err)
+	}
+
+	talosMachineConfig, err = ghodssyaml.JSONToYAML(jsonDecodedData)
+	if err != nil {
+		return nil, fmt.Errorf("failure converting talos machine config from json to yaml: %s", err)
+	}
+
+	return talosMachineConfig, nil
+}
+
+func splitDocuments(source []byte) ([]*yaml.Node, error) {
+	var documents []*yaml.Node
+
+	dec := yaml.NewDecoder(bytes.NewReader(source))
+
+	for {
+		var doc yaml.Node
+
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return documents, nil
+			}
+
+			return nil, fmt.Errorf("failure decoding talos machine config: %w", err)
+		}
+
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		documents = append(documents, &doc)
+	}
+}
+
+func isAuxiliaryDocument(doc *yaml.Node) bool {
+	root := doc.Content[0]
+
+	if root.Kind != yaml.MappingNode {
+		return false
+	}
+
+	for i := 0; i < len(root.Content); i += 2 {
+		if root.Content[i].Value == apiVersionKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+func encodeDocument(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failure encoding talos machine config document: %w", err)
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
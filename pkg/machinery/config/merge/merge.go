@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package merge provides config merge operations.
+package merge
+
+import (
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Mergeable is implemented by types which need custom merge logic.
+//
+// Typically it is implemented by slices of structures which have a merge key,
+// e.g. network interfaces merged by the interface name.
+type Mergeable interface {
+	Merge(other interface{}) error
+}
+
+// obsoleteUnmarshaler is the legacy yaml.v2-style custom unmarshaler interface supported by yaml.v3.
+type obsoleteUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+var (
+	mergeableType           = reflect.TypeOf((*Mergeable)(nil)).Elem()
+	unmarshalerType         = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	obsoleteUnmarshalerType = reflect.TypeOf((*obsoleteUnmarshaler)(nil)).Elem()
+)
+
+// Merge two config trees together.
+//
+// Data in the left is replaced with data in the right unless it's zero value.
+//
+// This function is not supposed to be a generic merge function.
+// It is specifically designed to merge Talos machine configuration.
+//
+// Rules:
+//   - if the type implements Mergeable, its Merge method is called;
+//   - types with custom YAML unmarshaling and byte slices are replaced as a whole;
+//   - structs are merged field by field;
+//   - maps are merged by keys, values for the same key are replaced;
+//   - slices are merged by appending right to left, unless the struct field has `merge:"replace"` tag;
+//   - pointers are merged by merging the values they point to;
+//   - all other values are replaced if the right value is not zero.
+func Merge(left, right interface{}) error {
+	l := reflect.ValueOf(left)
+	r := reflect.ValueOf(right)
+
+	if l.Type() != r.Type() {
+		return fmt.Errorf("merge: type mismatch left %v, right %v", l.Type(), r.Type())
+	}
+
+	if l.Kind() != reflect.Ptr {
+		return fmt.Errorf("merge: left is not a pointer, %v", l.Type())
+	}
+
+	if l.IsNil() || r.IsNil() {
+		return nil
+	}
+
+	return merge(l.Elem(), r.Elem(), false)
+}
+
+//nolint:gocyclo,cyclop
+func merge(vl, vr reflect.Value, replace bool) error {
+	if vl.Type() != vr.Type() {
+		return fmt.Errorf("merge: type mismatch left %v, right %v", vl.Type(), vr.Type())
+	}
+
+	if vr.IsZero() {
+		return nil
+	}
+
+	if replace || isAtomic(vl.Type()) {
+		vl.Set(vr)
+
+		return nil
+	}
+
+	if vl.CanAddr() && vl.Kind() != reflect.Ptr && vl.Addr().Type().Implements(mergeableType) {
+		return vl.Addr().Interface().(Mergeable).Merge(vr.Interface()) //nolint:forcetypeassert
+	}
+
+	switch vl.Kind() { //nolint:exhaustive
+	case reflect.Ptr:
+		if vl.IsNil() {
+			vl.Set(vr)
+
+			return nil
+		}
+
+		return merge(vl.Elem(), vr.Elem(), false)
+	case reflect.Struct:
+		t := vl.Type()
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			if err := merge(vl.Field(i), vr.Field(i), field.Tag.Get("merge") == "replace"); err != nil {
+				return fmt.Errorf("merge field %q: %w", field.Name, err)
+			}
+		}
+	case reflect.Map:
+		if vl.IsNil() {
+			vl.Set(reflect.MakeMapWithSize(vl.Type(), vr.Len()))
+		}
+
+		iter := vr.MapRange()
+
+		for iter.Next() {
+			vl.SetMapIndex(iter.Key(), iter.Value())
+		}
+	case reflect.Slice:
+		if vl.IsNil() {
+			vl.Set(vr)
+
+			return nil
+		}
+
+		vl.Set(reflect.AppendSlice(vl, vr))
+	default:
+		vl.Set(vr)
+	}
+
+	return nil
+}
+
+// isAtomic returns true if the value of the type should be replaced as a whole.
+func isAtomic(t reflect.Type) bool {
+	if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+		return true
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	pt := reflect.PtrTo(t)
+
+	return pt.Implements(unmarshalerType) || pt.Implements(obsoleteUnmarshalerType)
+}
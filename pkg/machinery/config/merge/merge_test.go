@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package merge_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/merge"
+)
+
+type Config struct {
+	A string
+	B int
+	C *bool
+	D *Struct
+	E []string
+	F map[string]int
+	G []byte
+	H []string `merge:"replace"`
+	I KeyedList
+
+	unexported string
+}
+
+type Struct struct {
+	X string
+	Y []int
+}
+
+type KeyedList []Keyed
+
+type Keyed struct {
+	Key   string
+	Value string
+}
+
+func (l *KeyedList) Merge(other interface{}) error {
+	otherList, ok := other.(KeyedList)
+	if !ok {
+		return fmt.Errorf("unexpected type %T", other)
+	}
+
+outer:
+	for _, item := range otherList {
+		for i := range *l {
+			if (*l)[i].Key == item.Key {
+				(*l)[i] = item
+
+				continue outer
+			}
+		}
+
+		*l = append(*l, item)
+	}
+
+	return nil
+}
+
+func pointer(b bool) *bool {
+	return &b
+}
+
+func TestMerge(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		left     *Config
+		right    *Config
+		expected *Config
+	}{
+		{
+			name:     "zero",
+			left:     &Config{},
+			right:    &Config{},
+			expected: &Config{},
+		},
+		{
+			name: "scalars",
+			left: &Config{
+				A: "a",
+				B: 1,
+			},
+			right: &Config{
+				B: 2,
+				C: pointer(false),
+			},
+			expected: &Config{
+				A: "a",
+				B: 2,
+				C: pointer(false),
+			},
+		},
+		{
+			name: "nested",
+			left: &Config{
+				D: &Struct{
+					X: "x",
+					Y: []int{1},
+				},
+			},
+			right: &Config{
+				D: &Struct{
+					Y: []int{2},
+				},
+			},
+			expected: &Config{
+				D: &Struct{
+					X: "x",
+					Y: []int{1, 2},
+				},
+			},
+		},
+		{
+			name: "slices and maps",
+			left: &Config{
+				E: []string{"a"},
+				F: map[string]int{"a": 1, "b": 2},
+				G: []byte("foo"),
+				H: []string{"a"},
+			},
+			right: &Config{
+				E: []string{"b"},
+				F: map[string]int{"b": 3, "c": 4},
+				G: []byte("bar"),
+				H: []string{"b"},
+			},
+			expected: &Config{
+				E: []string{"a", "b"},
+				F: map[string]int{"a": 1, "b": 3, "c": 4},
+				G: []byte("bar"),
+				H: []string{"b"},
+			},
+		},
+		{
+			name: "mergeable",
+			left: &Config{
+				I: KeyedList{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+			},
+			right: &Config{
+				I: KeyedList{{Key: "b", Value: "3"}, {Key: "c", Value: "4"}},
+			},
+			expected: &Config{
+				I: KeyedList{{Key: "a", Value: "1"}, {Key: "b", Value: "3"}, {Key: "c", Value: "4"}},
+			},
+		},
+		{
+			name: "unexported",
+			left: &Config{
+				unexported: "a",
+			},
+			right: &Config{
+				unexported: "b",
+			},
+			expected: &Config{
+				unexported: "a",
+			},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, merge.Merge(tt.left, tt.right))
+
+			assert.Equal(t, tt.expected, tt.left)
+		})
+	}
+}
+
+func TestMergeTypeMismatch(t *testing.T) {
+	assert.Error(t, merge.Merge(&Config{}, &Struct{}))
+	assert.Error(t, merge.Merge(Config{}, Config{}))
+}
@@ -0,0 +1,29 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// NetworkDefaultActionConfig is implemented by documents which define the default action of the host firewall.
+type NetworkDefaultActionConfig interface {
+	DefaultActionIngress() nethelpers.DefaultAction
+}
+
+// NetworkRuleConfig is implemented by documents which define host firewall rules.
+type NetworkRuleConfig interface {
+	NetworkRules() []NetworkRule
+}
+
+// NetworkRule defines a host firewall rule which allows ingress traffic.
+type NetworkRule interface {
+	Protocol() nethelpers.Protocol
+	PortRanges() [][2]uint16
+	Subnets() []netaddr.IPPrefix
+	ExceptSubnets() []netaddr.IPPrefix
+}
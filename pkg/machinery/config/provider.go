@@ -13,6 +13,7 @@ import (
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/talos-systems/crypto/x509"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -27,6 +28,9 @@ type Provider interface {
 	Machine() MachineConfig
 	Cluster() ClusterConfig
 
+	// Documents returns auxiliary (non-v1alpha1) configuration documents.
+	Documents() []Document
+
 	// Validate checks configuration and returns warnings and fatal errors (as multierror).
 	Validate(RuntimeMode, ...ValidationOption) ([]string, error)
 
@@ -48,6 +52,7 @@ type MachineConfig interface {
 	Security() Security
 	Network() MachineNetwork
 	Disks() []Disk
+	Volumes() []UserVolume
 	Time() Time
 	Env() Env
 	Files() ([]File, error)
@@ -62,7 +67,10 @@ type MachineConfig interface {
 	Features() Features
 	Udev() UdevConfig
 	Logging() Logging
+	Metrics() Metrics
 	Kernel() Kernel
+	NodeLabels() map[string]string
+	NodeTaints() map[string]string
 }
 
 // Disk represents the options available for partitioning, formatting, and
@@ -72,6 +80,17 @@ type Disk interface {
 	Partitions() []Partition
 }
 
+// UserVolume represents the user volume managed by Talos.
+type UserVolume interface {
+	Name() string
+	// DiskMatchers returns the matchers built from the volume disk selector.
+	DiskMatchers() []disk.Matcher
+	Size() uint64
+	Filesystem() string
+	MountPoint() string
+	Encryption() Encryption
+}
+
 // Partition represents the options for a device partition.
 type Partition interface {
 	Size() uint64
@@ -99,6 +118,18 @@ type Install interface {
 	Zero() bool
 	LegacyBIOSSupport() bool
 	WithBootloader() bool
+	Ephemeral() InstallEphemeral
+}
+
+// InstallEphemeral defines the placement and the size of the EPHEMERAL partition.
+type InstallEphemeral interface {
+	// Disk returns the device name of the disk matching the EPHEMERAL disk selector.
+	//
+	// If the disk selector is not set, an empty string is returned, and the EPHEMERAL partition
+	// is placed on the installation disk.
+	Disk() (string, error)
+	MinSize() uint64
+	MaxSize() uint64
 }
 
 // Extension defines the system extension.
@@ -142,6 +173,8 @@ type MachineNetwork interface {
 	ExtraHosts() []ExtraHost
 	KubeSpan() KubeSpan
 	DisableSearchDomain() bool
+	RoutingRules() []RoutingRule
+	HostDNS() HostDNS
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -213,6 +246,7 @@ type WireguardPeer interface {
 // bonded interface.
 type Bond interface {
 	Interfaces() []string
+	DeviceSelectors() []NetworkDeviceSelector
 	ARPIPTarget() []string
 	Mode() string
 	HashPolicy() string
@@ -249,7 +283,23 @@ type STP interface {
 // Bridge contains the options for configuring a bridged interface.
 type Bridge interface {
 	Interfaces() []string
+	DeviceSelectors() []NetworkDeviceSelector
 	STP() STP
+	VLAN() BridgeVLAN
+}
+
+// BridgeVLAN contains the VLAN filtering settings for a bridge.
+type BridgeVLAN interface {
+	FilteringEnabled() bool
+	DefaultPVID() uint16
+	Ports() []BridgePortVLAN
+}
+
+// BridgePortVLAN contains the VLAN settings for a bridge port.
+type BridgePortVLAN interface {
+	Interface() string
+	PVID() uint16
+	TaggedVLANs() []uint16
 }
 
 // Vlan represents vlan settings for a device.
@@ -268,6 +318,32 @@ type Route interface {
 	Gateway() string
 	Source() string
 	Metric() uint32
+	Table() string
+}
+
+// RoutingRule represents a policy routing rule.
+type RoutingRule interface {
+	Priority() uint32
+	From() string
+	To() string
+	IIFName() string
+	OIFName() string
+	FwMark() uint32
+	FwMask() uint32
+	Table() string
+}
+
+// HostDNS configures the host DNS caching forwarder.
+type HostDNS interface {
+	Enabled() bool
+	CacheSize() int
+	DomainUpstreams() []HostDNSDomainUpstream
+}
+
+// HostDNSDomainUpstream configures upstream nameservers for a domain.
+type HostDNSDomainUpstream interface {
+	Domain() string
+	Nameservers() []string
 }
 
 // KubeSpan configures KubeSpan feature.
@@ -452,6 +528,25 @@ type Etcd interface {
 	CA() *x509.PEMEncodedCertificateAndKey
 	ExtraArgs() map[string]string
 	Subnet() string
+	Backup() EtcdBackup
+}
+
+// EtcdBackup defines the requirements for a config that pertains to scheduled
+// etcd backups.
+type EtcdBackup interface {
+	Enabled() bool
+	Interval() time.Duration
+	Retention() int
+	Destination() string
+	S3() EtcdBackupS3
+}
+
+// EtcdBackupS3 defines the settings of the S3-compatible etcd backup destination.
+type EtcdBackupS3 interface {
+	Endpoint() string
+	Region() string
+	AccessKeyID() string
+	SecretAccessKey() string
 }
 
 // Token defines the requirements for a config that pertains to Kubernetes
@@ -485,6 +580,7 @@ type AdminKubeconfig interface {
 type EncryptionKey interface {
 	Static() EncryptionKeyStatic
 	NodeID() EncryptionKeyNodeID
+	KMS() EncryptionKeyKMS
 	Slot() int
 }
 
@@ -496,6 +592,11 @@ type EncryptionKeyStatic interface {
 // EncryptionKeyNodeID deterministically generated encryption key.
 type EncryptionKeyNodeID interface{}
 
+// EncryptionKeyKMS encryption key sealed by the KMS.
+type EncryptionKeyKMS interface {
+	Endpoint() string
+}
+
 // Encryption defines settings for the partition encryption.
 type Encryption interface {
 	Kind() string
@@ -514,6 +615,24 @@ type SystemDiskEncryption interface {
 // Features describe individual Talos features that can be switched on or off.
 type Features interface {
 	RBACEnabled() bool
+	RBACRoles() []RBACRole
+	OIDC() OIDC
+}
+
+// RBACRole describes a custom RBAC role.
+type RBACRole interface {
+	Name() string
+	Allow() []string
+}
+
+// OIDC describes the OpenID Connect login for the Talos API.
+type OIDC interface {
+	Enabled() bool
+	IssuerURL() string
+	ClientID() string
+	GroupsClaim() string
+	GroupRoles() map[string][]string
+	CertLifetime() time.Duration
 }
 
 // VolumeMount describes extra volume mount for the static pods.
@@ -561,12 +680,44 @@ type UdevConfig interface {
 // Logging describes logging configuration.
 type Logging interface {
 	Destinations() []LoggingDestination
+	Spool() LoggingSpool
+}
+
+// LoggingSpool describes the on-disk spool of the log events.
+type LoggingSpool interface {
+	Enabled() bool
+	MaxSize() uint64
 }
 
 // LoggingDestination describes logging destination.
 type LoggingDestination interface {
 	Endpoint() *url.URL
 	Format() string
+	TLSCA() []byte
+	Filter() LoggingFilter
+	ExtraFields() map[string]string
+}
+
+// LoggingFilter describes the filter for the log events sent to the logging destination.
+type LoggingFilter interface {
+	MinLevel() string
+	IncludeServices() []string
+	ExcludeServices() []string
+	MessageRegex() string
+	Sampling() LoggingSampling
+}
+
+// LoggingSampling describes sampling of the log events sent to the logging destination.
+type LoggingSampling interface {
+	Enabled() bool
+	Initial() int
+	Thereafter() int
+}
+
+// Metrics describes the Prometheus metrics endpoints configuration.
+type Metrics interface {
+	Enabled() bool
+	BindAddress() string
 }
 
 // Kernel describes Talos Linux kernel configuration.
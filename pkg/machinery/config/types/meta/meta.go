@@ -0,0 +1,29 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package meta provides common metadata for the auxiliary machine configuration documents.
+package meta
+
+// Meta is the common header of the auxiliary machine configuration documents.
+//
+// Meta should be inlined into the document structure:
+//
+//	type ExampleConfig struct {
+//	  meta.Meta `yaml:",inline"`
+//	  ...
+//	}
+type Meta struct {
+	MetaAPIVersion string `yaml:"apiVersion"`
+	MetaKind       string `yaml:"kind"`
+}
+
+// APIVersion implements config.Document interface.
+func (m Meta) APIVersion() string {
+	return m.MetaAPIVersion
+}
+
+// Kind implements config.Document interface.
+func (m Meta) Kind() string {
+	return m.MetaKind
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/meta"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// DefaultActionConfigKind is a default action config document kind.
+const DefaultActionConfigKind = "NetworkDefaultActionConfig"
+
+// Check interfaces.
+var (
+	_ config.Document                   = &DefaultActionConfigV1Alpha1{}
+	_ config.NetworkDefaultActionConfig = &DefaultActionConfigV1Alpha1{}
+	_ config.RuntimeDocument            = &DefaultActionConfigV1Alpha1{}
+)
+
+// DefaultActionConfigV1Alpha1 is a ingress firewall default action configuration document.
+//
+// Default action is applied to the ingress traffic which doesn't match any of the
+// NetworkRuleConfig rules. If the document is not present, the host firewall is disabled.
+//
+// Example:
+//
+//	apiVersion: v1alpha1
+//	kind: NetworkDefaultActionConfig
+//	ingress: block
+type DefaultActionConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	// Default action for all not explicitly configured ingress traffic: accept or block.
+	Ingress nethelpers.DefaultAction `yaml:"ingress"`
+}
+
+// NewDefaultActionConfigV1Alpha1 creates a new DefaultActionConfig config document.
+func NewDefaultActionConfigV1Alpha1() *DefaultActionConfigV1Alpha1 {
+	return &DefaultActionConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DefaultActionConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Clone implements config.Document interface.
+func (a *DefaultActionConfigV1Alpha1) Clone() config.Document {
+	c := *a
+
+	return &c
+}
+
+// AppliedAtRuntime implements config.RuntimeDocument interface.
+func (a *DefaultActionConfigV1Alpha1) AppliedAtRuntime() bool {
+	return true
+}
+
+// DefaultActionIngress implements config.NetworkDefaultActionConfig interface.
+func (a *DefaultActionConfigV1Alpha1) DefaultActionIngress() nethelpers.DefaultAction {
+	return a.Ingress
+}
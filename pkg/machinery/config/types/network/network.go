@@ -0,0 +1,30 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package network provides network machine configuration documents.
+package network
+
+import (
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+func init() {
+	config.Register(DefaultActionConfigKind, func(version string) interface{} {
+		switch version {
+		case "v1alpha1":
+			return &DefaultActionConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+
+	config.Register(RuleConfigKind, func(version string) interface{} {
+		switch version {
+		case "v1alpha1":
+			return &RuleConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
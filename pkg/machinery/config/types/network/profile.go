@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"fmt"
+
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// DefaultClusterSubnets is the default list of subnets the cluster nodes are expected to be in.
+var DefaultClusterSubnets = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"fd00::/8",
+}
+
+// Well-known ports which are not defined in constants.
+const (
+	etcdClientPort   = 2379
+	etcdPeerPort     = 2380
+	flannelVXLANPort = 4789
+)
+
+// DefaultIngressProfile returns the set of documents which enables the host firewall with the safe defaults.
+//
+// Ingress traffic is blocked by default, all Talos and Kubernetes services (including the Talos API and the Kubernetes API)
+// are only accessible from the cluster subnets, so the subnets should include the management network as well.
+func DefaultIngressProfile(controlPlane bool, clusterSubnets []string) ([]config.Document, error) {
+	cluster, err := parseIngress(clusterSubnets)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultAction := NewDefaultActionConfigV1Alpha1()
+	defaultAction.Ingress = nethelpers.DefaultActionBlock
+
+	docs := []config.Document{
+		defaultAction,
+		newRule("apid-ingress", nethelpers.ProtocolTCP, PortRanges{singlePort(constants.ApidPort)}, cluster),
+		newRule("apid-login-ingress", nethelpers.ProtocolTCP, PortRanges{singlePort(constants.ApidLoginPort)}, cluster),
+		newRule("kubelet-ingress", nethelpers.ProtocolTCP, PortRanges{singlePort(constants.KubeletPort)}, cluster),
+		newRule("flannel-ingress", nethelpers.ProtocolUDP, PortRanges{singlePort(flannelVXLANPort)}, cluster),
+		newRule("kubespan-ingress", nethelpers.ProtocolUDP, PortRanges{singlePort(constants.KubeSpanDefaultPort)}, cluster),
+	}
+
+	if controlPlane {
+		docs = append(docs,
+			newRule("kube-apiserver-ingress", nethelpers.ProtocolTCP, PortRanges{singlePort(constants.DefaultControlPlanePort)}, cluster),
+			newRule("trustd-ingress", nethelpers.ProtocolTCP, PortRanges{singlePort(constants.TrustdPort)}, cluster),
+			newRule("etcd-ingress", nethelpers.ProtocolTCP, PortRanges{{Lo: etcdClientPort, Hi: etcdPeerPort}}, cluster),
+		)
+	}
+
+	return docs, nil
+}
+
+func parseIngress(subnets []string) (IngressConfig, error) {
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("at least one subnet is required")
+	}
+
+	ingress := make(IngressConfig, 0, len(subnets))
+
+	for _, subnet := range subnets {
+		prefix, err := netaddr.ParseIPPrefix(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing subnet %q: %w", subnet, err)
+		}
+
+		ingress = append(ingress, IngressRule{Subnet: prefix.Masked()})
+	}
+
+	return ingress, nil
+}
+
+func newRule(name string, protocol nethelpers.Protocol, ports PortRanges, ingress IngressConfig) *RuleConfigV1Alpha1 {
+	rule := NewRuleConfigV1Alpha1()
+	rule.MetaName = name
+	rule.PortSelector.Protocol = protocol
+	rule.PortSelector.Ports = ports
+	rule.Ingress = append(IngressConfig(nil), ingress...)
+
+	return rule
+}
+
+func singlePort(port uint16) PortRange {
+	return PortRange{Lo: port, Hi: port}
+}
@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/decoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+func TestDefaultIngressProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name         string
+		controlPlane bool
+
+		expectedRules map[string][][2]uint16
+	}{
+		{
+			name: "worker",
+
+			expectedRules: map[string][][2]uint16{
+				"apid-ingress":       {{50000, 50000}},
+				"apid-login-ingress": {{50002, 50002}},
+				"kubelet-ingress":    {{10250, 10250}},
+				"flannel-ingress":    {{4789, 4789}},
+				"kubespan-ingress":   {{51820, 51820}},
+			},
+		},
+		{
+			name:         "controlplane",
+			controlPlane: true,
+
+			expectedRules: map[string][][2]uint16{
+				"apid-ingress":           {{50000, 50000}},
+				"apid-login-ingress":     {{50002, 50002}},
+				"kubelet-ingress":        {{10250, 10250}},
+				"flannel-ingress":        {{4789, 4789}},
+				"kubespan-ingress":       {{51820, 51820}},
+				"kube-apiserver-ingress": {{6443, 6443}},
+				"trustd-ingress":         {{50001, 50001}},
+				"etcd-ingress":           {{2379, 2380}},
+			},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			docs, err := network.DefaultIngressProfile(test.controlPlane, network.DefaultClusterSubnets)
+			require.NoError(t, err)
+
+			require.Len(t, docs, len(test.expectedRules)+1)
+
+			defaultAction, ok := docs[0].(config.NetworkDefaultActionConfig)
+			require.True(t, ok)
+			assert.Equal(t, nethelpers.DefaultActionBlock, defaultAction.DefaultActionIngress())
+
+			rules := map[string][][2]uint16{}
+
+			for _, doc := range docs[1:] {
+				rule, ok := doc.(*network.RuleConfigV1Alpha1)
+				require.True(t, ok)
+
+				_, err = rule.Validate(nil)
+				require.NoError(t, err)
+
+				networkRules := rule.NetworkRules()
+				require.NotEmpty(t, networkRules)
+
+				rules[rule.Name()] = networkRules[0].PortRanges()
+			}
+
+			assert.Equal(t, test.expectedRules, rules)
+
+			// the profile should survive the encode/decode round-trip
+			for _, doc := range docs {
+				out, err := encoder.NewEncoder(doc).Encode()
+				require.NoError(t, err)
+
+				decoded, err := decoder.NewDecoder(out).Decode()
+				require.NoError(t, err)
+				require.Len(t, decoded, 1)
+
+				assert.Equal(t, doc, decoded[0])
+			}
+		})
+	}
+}
+
+func TestDefaultIngressProfileSubnets(t *testing.T) {
+	t.Parallel()
+
+	docs, err := network.DefaultIngressProfile(true, []string{"172.20.0.1/24"})
+	require.NoError(t, err)
+
+	// all rules (including Talos and Kubernetes APIs) are limited to the cluster subnets
+	for _, doc := range docs[1:] {
+		rule, ok := doc.(*network.RuleConfigV1Alpha1)
+		require.True(t, ok)
+
+		require.Len(t, rule.Ingress, 1, rule.Name())
+		assert.Equal(t, "172.20.0.0/24", rule.Ingress[0].Subnet.String(), rule.Name())
+	}
+
+	_, err = network.DefaultIngressProfile(false, nil)
+	assert.Error(t, err)
+
+	_, err = network.DefaultIngressProfile(false, []string{"not-a-subnet"})
+	assert.Error(t, err)
+}
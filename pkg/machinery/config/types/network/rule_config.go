@@ -0,0 +1,251 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/meta"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// RuleConfigKind is a network rule config document kind.
+const RuleConfigKind = "NetworkRuleConfig"
+
+// Check interfaces.
+var (
+	_ config.NamedDocument     = &RuleConfigV1Alpha1{}
+	_ config.Validator         = &RuleConfigV1Alpha1{}
+	_ config.NetworkRuleConfig = &RuleConfigV1Alpha1{}
+	_ config.RuntimeDocument   = &RuleConfigV1Alpha1{}
+)
+
+// RuleConfigV1Alpha1 is a network firewall rule config document.
+//
+// The rule allows ingress traffic to the specified ports from the specified subnets.
+//
+// Example:
+//
+//	apiVersion: v1alpha1
+//	kind: NetworkRuleConfig
+//	name: ingress-apid
+//	portSelector:
+//	  ports:
+//	    - 50000
+//	  protocol: tcp
+//	ingress:
+//	  - subnet: 192.168.0.0/16
+//	    except: 192.168.0.1/32
+type RuleConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	// Name of the rule.
+	MetaName string `yaml:"name"`
+	// Ports and protocol to match.
+	PortSelector RulePortSelector `yaml:"portSelector"`
+	// List of source subnets allowed to access the ports.
+	Ingress IngressConfig `yaml:"ingress"`
+}
+
+// RulePortSelector is a port selector for the network rule.
+type RulePortSelector struct {
+	// List of ports or port ranges (e.g. `80`, `1000-2000`).
+	Ports PortRanges `yaml:"ports"`
+	// Protocol: tcp or udp.
+	Protocol nethelpers.Protocol `yaml:"protocol"`
+}
+
+// IngressConfig is a list of ingress rules.
+type IngressConfig []IngressRule
+
+// IngressRule is a ingress source subnet rule.
+type IngressRule struct {
+	// Source subnet.
+	Subnet netaddr.IPPrefix `yaml:"subnet"`
+	// Excluded subnet (optional).
+	Except netaddr.IPPrefix `yaml:"except,omitempty"`
+}
+
+// PortRanges is a list of port ranges.
+type PortRanges []PortRange
+
+// PortRange is a port range, both ends inclusive.
+//
+// PortRange is represented in YAML either as a single port (`80`) or as a range (`1000-2000`).
+type PortRange struct {
+	Lo uint16
+	Hi uint16
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler interface.
+func (pr *PortRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	lo, hi, isRange := strings.Cut(s, "-")
+	if !isRange {
+		hi = lo
+	}
+
+	l, err := strconv.ParseUint(strings.TrimSpace(lo), 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port %q: %w", s, err)
+	}
+
+	h, err := strconv.ParseUint(strings.TrimSpace(hi), 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port %q: %w", s, err)
+	}
+
+	pr.Lo, pr.Hi = uint16(l), uint16(h)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler interface.
+func (pr PortRange) MarshalYAML() (interface{}, error) {
+	if pr.Lo == pr.Hi {
+		return pr.Lo, nil
+	}
+
+	return fmt.Sprintf("%d-%d", pr.Lo, pr.Hi), nil
+}
+
+// NewRuleConfigV1Alpha1 creates a new RuleConfig config document.
+func NewRuleConfigV1Alpha1() *RuleConfigV1Alpha1 {
+	return &RuleConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       RuleConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Name implements config.NamedDocument interface.
+func (r *RuleConfigV1Alpha1) Name() string {
+	return r.MetaName
+}
+
+// Clone implements config.Document interface.
+func (r *RuleConfigV1Alpha1) Clone() config.Document {
+	c := *r
+
+	if r.PortSelector.Ports != nil {
+		c.PortSelector.Ports = make(PortRanges, len(r.PortSelector.Ports))
+		copy(c.PortSelector.Ports, r.PortSelector.Ports)
+	}
+
+	if r.Ingress != nil {
+		c.Ingress = make(IngressConfig, len(r.Ingress))
+		copy(c.Ingress, r.Ingress)
+	}
+
+	return &c
+}
+
+// AppliedAtRuntime implements config.RuntimeDocument interface.
+func (r *RuleConfigV1Alpha1) AppliedAtRuntime() bool {
+	return true
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (r *RuleConfigV1Alpha1) Validate(config.RuntimeMode, ...config.ValidationOption) ([]string, error) {
+	var result *multierror.Error
+
+	if r.MetaName == "" {
+		result = multierror.Append(result, errors.New("name is required"))
+	}
+
+	if len(r.PortSelector.Ports) == 0 {
+		result = multierror.Append(result, errors.New("portSelector.ports is required"))
+	}
+
+	for _, pr := range r.PortSelector.Ports {
+		if pr.Lo == 0 || pr.Lo > pr.Hi {
+			result = multierror.Append(result, fmt.Errorf("invalid port range %d-%d", pr.Lo, pr.Hi))
+		}
+	}
+
+	switch r.PortSelector.Protocol { //nolint:exhaustive
+	case nethelpers.ProtocolTCP, nethelpers.ProtocolUDP:
+	default:
+		result = multierror.Append(result, fmt.Errorf("unsupported protocol %q", r.PortSelector.Protocol))
+	}
+
+	if len(r.Ingress) == 0 {
+		result = multierror.Append(result, errors.New("ingress is required"))
+	}
+
+	for _, rule := range r.Ingress {
+		if rule.Subnet.IsZero() || !rule.Subnet.IsValid() {
+			result = multierror.Append(result, errors.New("ingress subnet is required"))
+
+			continue
+		}
+
+		if !rule.Except.IsZero() && rule.Except.IP().BitLen() != rule.Subnet.IP().BitLen() {
+			result = multierror.Append(result, fmt.Errorf("except %s should be of the same address family as subnet %s", rule.Except, rule.Subnet))
+		}
+	}
+
+	return nil, result.ErrorOrNil()
+}
+
+// NetworkRules implements config.NetworkRuleConfig interface.
+func (r *RuleConfigV1Alpha1) NetworkRules() []config.NetworkRule {
+	rules := make([]config.NetworkRule, 0, len(r.Ingress))
+
+	for _, ingress := range r.Ingress {
+		rules = append(rules, &networkRule{
+			selector: r.PortSelector,
+			ingress:  ingress,
+		})
+	}
+
+	return rules
+}
+
+type networkRule struct {
+	selector RulePortSelector
+	ingress  IngressRule
+}
+
+func (rule *networkRule) Protocol() nethelpers.Protocol {
+	return rule.selector.Protocol
+}
+
+func (rule *networkRule) PortRanges() [][2]uint16 {
+	ranges := make([][2]uint16, 0, len(rule.selector.Ports))
+
+	for _, pr := range rule.selector.Ports {
+		ranges = append(ranges, [2]uint16{pr.Lo, pr.Hi})
+	}
+
+	return ranges
+}
+
+func (rule *networkRule) Subnets() []netaddr.IPPrefix {
+	return []netaddr.IPPrefix{rule.ingress.Subnet}
+}
+
+func (rule *networkRule) ExceptSubnets() []netaddr.IPPrefix {
+	if rule.ingress.Except.IsZero() {
+		return nil
+	}
+
+	return []netaddr.IPPrefix{rule.ingress.Except}
+}
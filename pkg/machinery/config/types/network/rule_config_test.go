@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/decoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+func TestRuleConfigDecode(t *testing.T) {
+	t.Parallel()
+
+	docs, err := decoder.NewDecoder([]byte(`apiVersion: v1alpha1
+kind: NetworkDefaultActionConfig
+ingress: block
+---
+apiVersion: v1alpha1
+kind: NetworkRuleConfig
+name: ingress-apid
+portSelector:
+  ports:
+    - 50000
+    - 10250-10255
+  protocol: tcp
+ingress:
+  - subnet: 10.0.0.0/8
+    except: 10.0.0.1/32
+  - subnet: fd00::/8
+`)).Decode()
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+
+	defaultAction, ok := docs[0].(*network.DefaultActionConfigV1Alpha1)
+	require.True(t, ok)
+	assert.Equal(t, nethelpers.DefaultActionBlock, defaultAction.DefaultActionIngress())
+
+	rule, ok := docs[1].(*network.RuleConfigV1Alpha1)
+	require.True(t, ok)
+
+	assert.Equal(t, "ingress-apid", rule.Name())
+	assert.Equal(t, network.PortRanges{{Lo: 50000, Hi: 50000}, {Lo: 10250, Hi: 10255}}, rule.PortSelector.Ports)
+
+	_, err = rule.Validate(nil)
+	require.NoError(t, err)
+
+	rules := rule.NetworkRules()
+	require.Len(t, rules, 2)
+
+	assert.Equal(t, nethelpers.ProtocolTCP, rules[0].Protocol())
+	assert.Equal(t, [][2]uint16{{50000, 50000}, {10250, 10255}}, rules[0].PortRanges())
+	assert.Equal(t, []netaddr.IPPrefix{netaddr.MustParseIPPrefix("10.0.0.0/8")}, rules[0].Subnets())
+	assert.Equal(t, []netaddr.IPPrefix{netaddr.MustParseIPPrefix("10.0.0.1/32")}, rules[0].ExceptSubnets())
+	assert.Equal(t, []netaddr.IPPrefix{netaddr.MustParseIPPrefix("fd00::/8")}, rules[1].Subnets())
+	assert.Empty(t, rules[1].ExceptSubnets())
+}
+
+func TestRuleConfigEncode(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRuleConfigV1Alpha1()
+	cfg.MetaName = "kubelet"
+	cfg.PortSelector.Ports = network.PortRanges{{Lo: 10250, Hi: 10250}, {Lo: 30000, Hi: 32767}}
+	cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	cfg.Ingress = network.IngressConfig{
+		{Subnet: netaddr.MustParseIPPrefix("192.168.0.0/16")},
+	}
+
+	out, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, `apiVersion: v1alpha1
+kind: NetworkRuleConfig
+name: kubelet
+portSelector:
+    ports:
+        - 10250
+        - 30000-32767
+    protocol: tcp
+ingress:
+    - subnet: 192.168.0.0/16
+`, string(out))
+}
+
+func TestRuleConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RuleConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewRuleConfigV1Alpha1,
+
+			expectedError: "4 errors occurred:\n\t* name is required\n\t* portSelector.ports is required\n\t* unsupported protocol \"Protocol(0)\"\n\t* ingress is required\n\n",
+		},
+		{
+			name: "invalid ports",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "test"
+				cfg.PortSelector.Ports = network.PortRanges{{Lo: 0, Hi: 0}, {Lo: 200, Hi: 100}}
+				cfg.PortSelector.Protocol = nethelpers.ProtocolICMP
+				cfg.Ingress = network.IngressConfig{
+					{
+						Subnet: netaddr.MustParseIPPrefix("10.0.0.0/8"),
+						Except: netaddr.MustParseIPPrefix("fd00::/64"),
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "4 errors occurred:\n\t* invalid port range 0-0\n\t* invalid port range 200-100\n\t* unsupported protocol \"icmp\"\n\t* except fd00::/64 should be of the same address family as subnet 10.0.0.0/8\n\n",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "test"
+				cfg.PortSelector.Ports = network.PortRanges{{Lo: 53, Hi: 53}}
+				cfg.PortSelector.Protocol = nethelpers.ProtocolUDP
+				cfg.Ingress = network.IngressConfig{
+					{
+						Subnet: netaddr.MustParseIPPrefix("0.0.0.0/0"),
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(nil)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -14,6 +14,10 @@ import (
 	yaml "gopkg.in/yaml.v3"
 
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/network"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -68,7 +72,7 @@ func NewConfigBundle(opts ...Option) (*v1alpha1.ConfigBundle, error) {
 			}
 		}
 
-		if err := applyJSONPatches(bundle, options); err != nil {
+		if err := applyPatches(bundle, options); err != nil {
 			return nil, err
 		}
 
@@ -136,7 +140,13 @@ func NewConfigBundle(opts ...Option) (*v1alpha1.ConfigBundle, error) {
 		}
 	}
 
-	if err = applyJSONPatches(bundle, options); err != nil {
+	if options.HostFirewall {
+		if err = addHostFirewall(bundle, options.HostFirewallSubnets); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = applyPatches(bundle, options); err != nil {
 		return nil, err
 	}
 
@@ -148,18 +158,97 @@ func NewConfigBundle(opts ...Option) (*v1alpha1.ConfigBundle, error) {
 	return bundle, nil
 }
 
-func applyJSONPatches(bundle *v1alpha1.ConfigBundle, options Options) error {
-	if err := bundle.ApplyJSONPatch(options.JSONPatch, true, true); err != nil {
+func addHostFirewall(bundle *v1alpha1.ConfigBundle, clusterSubnets []string) error {
+	if len(clusterSubnets) == 0 {
+		clusterSubnets = network.DefaultClusterSubnets
+	}
+
+	for _, target := range []struct {
+		docs         *[]config.Document
+		controlPlane bool
+	}{
+		{&bundle.InitDocuments, true},
+		{&bundle.ControlPlaneDocuments, true},
+		{&bundle.WorkerDocuments, false},
+	} {
+		docs, err := network.DefaultIngressProfile(target.controlPlane, clusterSubnets)
+		if err != nil {
+			return fmt.Errorf("error generating host firewall config: %w", err)
+		}
+
+		*target.docs = append(*target.docs, docs...)
+	}
+
+	return nil
+}
+
+func applyPatches(bundle *v1alpha1.ConfigBundle, options Options) error {
+	if err := applyPatch(bundle, options.Patches, true, true); err != nil {
 		return fmt.Errorf("error patching configs: %w", err)
 	}
 
-	if err := bundle.ApplyJSONPatch(options.JSONPatchControlPlane, true, false); err != nil {
+	if err := applyPatch(bundle, options.PatchesControlPlane, true, false); err != nil {
 		return fmt.Errorf("error patching control plane configs: %w", err)
 	}
 
-	if err := bundle.ApplyJSONPatch(options.JSONPatchWorker, false, true); err != nil {
+	if err := applyPatch(bundle, options.PatchesWorker, false, true); err != nil {
 		return fmt.Errorf("error patching worker config: %w", err)
 	}
 
 	return nil
 }
+
+func applyPatch(bundle *v1alpha1.ConfigBundle, patch []configpatcher.Patch, patchControlPlane, patchWorker bool) error {
+	if len(patch) == 0 {
+		return nil
+	}
+
+	apply := func(in **v1alpha1.Config, docs *[]config.Document) error {
+		if *in == nil {
+			return nil
+		}
+
+		ctr, err := container.New(*in, *docs...)
+		if err != nil {
+			return err
+		}
+
+		out, err := configpatcher.Apply(configpatcher.WithConfig(ctr), patch)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := out.Config()
+		if err != nil {
+			return err
+		}
+
+		patched, ok := cfg.Raw().(*v1alpha1.Config)
+		if !ok {
+			return fmt.Errorf("unexpected config type %T", cfg.Raw())
+		}
+
+		*in = patched
+		*docs = cfg.Documents()
+
+		return nil
+	}
+
+	if patchControlPlane {
+		if err := apply(&bundle.InitCfg, &bundle.InitDocuments); err != nil {
+			return err
+		}
+
+		if err := apply(&bundle.ControlPlaneCfg, &bundle.ControlPlaneDocuments); err != nil {
+			return err
+		}
+	}
+
+	if patchWorker {
+		if err := apply(&bundle.WorkerCfg, &bundle.WorkerDocuments); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
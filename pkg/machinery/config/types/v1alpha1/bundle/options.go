@@ -7,6 +7,7 @@ package bundle
 import (
 	jsonpatch "github.com/evanphx/json-patch"
 
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 )
 
@@ -27,9 +28,12 @@ type Options struct {
 	Verbose         bool   // wheither to write any logs during generate
 	InputOptions    *InputOptions
 
-	JSONPatch             jsonpatch.Patch
-	JSONPatchControlPlane jsonpatch.Patch
-	JSONPatchWorker       jsonpatch.Patch
+	Patches             []configpatcher.Patch
+	PatchesControlPlane []configpatcher.Patch
+	PatchesWorker       []configpatcher.Patch
+
+	HostFirewall        bool     // whether to generate the host firewall configuration
+	HostFirewallSubnets []string // cluster subnets allowed to access the cluster services
 }
 
 // DefaultOptions returns default options.
@@ -66,29 +70,71 @@ func WithVerbose(verbose bool) Option {
 	}
 }
 
-// WithJSONPatch allows patching every config in a bundle with a patch.
-func WithJSONPatch(patch jsonpatch.Patch) Option {
+// WithPatch allows patching every config in a bundle with a patch.
+func WithPatch(patch []configpatcher.Patch) Option {
 	return func(o *Options) error {
-		o.JSONPatch = append(o.JSONPatch, patch...)
+		o.Patches = append(o.Patches, patch...)
 
 		return nil
 	}
 }
 
-// WithJSONPatchControlPlane allows patching init and controlplane config in a bundle with a patch.
-func WithJSONPatchControlPlane(patch jsonpatch.Patch) Option {
+// WithPatchControlPlane allows patching init and controlplane config in a bundle with a patch.
+func WithPatchControlPlane(patch []configpatcher.Patch) Option {
+	return func(o *Options) error {
+		o.PatchesControlPlane = append(o.PatchesControlPlane, patch...)
+
+		return nil
+	}
+}
+
+// WithPatchWorker allows patching worker config in a bundle with a patch.
+func WithPatchWorker(patch []configpatcher.Patch) Option {
+	return func(o *Options) error {
+		o.PatchesWorker = append(o.PatchesWorker, patch...)
+
+		return nil
+	}
+}
+
+// WithHostFirewall enables the host firewall with the default ingress profile.
+//
+// Cluster services are allowed to be accessed from the specified subnets,
+// if no subnets are specified, network.DefaultClusterSubnets are used.
+func WithHostFirewall(clusterSubnets []string) Option {
 	return func(o *Options) error {
-		o.JSONPatchControlPlane = append(o.JSONPatchControlPlane, patch...)
+		o.HostFirewall = true
+		o.HostFirewallSubnets = clusterSubnets
 
 		return nil
 	}
 }
 
+// WithJSONPatch allows patching every config in a bundle with a patch.
+//
+// Deprecated: use WithPatch instead.
+func WithJSONPatch(patch jsonpatch.Patch) Option {
+	return WithPatch(jsonPatches(patch))
+}
+
+// WithJSONPatchControlPlane allows patching init and controlplane config in a bundle with a patch.
+//
+// Deprecated: use WithPatchControlPlane instead.
+func WithJSONPatchControlPlane(patch jsonpatch.Patch) Option {
+	return WithPatchControlPlane(jsonPatches(patch))
+}
+
 // WithJSONPatchWorker allows patching worker config in a bundle with a patch.
+//
+// Deprecated: use WithPatchWorker instead.
 func WithJSONPatchWorker(patch jsonpatch.Patch) Option {
-	return func(o *Options) error {
-		o.JSONPatchWorker = append(o.JSONPatchWorker, patch...)
+	return WithPatchWorker(jsonPatches(patch))
+}
 
+func jsonPatches(patch jsonpatch.Patch) []configpatcher.Patch {
+	if len(patch) == 0 {
 		return nil
 	}
+
+	return []configpatcher.Patch{patch}
 }
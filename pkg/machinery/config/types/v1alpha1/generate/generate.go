@@ -426,12 +426,21 @@ func NewTalosCA(currentTime time.Time) (ca *x509.CertificateAuthority, err error
 
 // NewAdminCertificateAndKey generates the admin Talos certificate and key.
 func NewAdminCertificateAndKey(currentTime time.Time, ca *x509.PEMEncodedCertificateAndKey, roles role.Set, ttl time.Duration) (p *x509.PEMEncodedCertificateAndKey, err error) {
+	return NewClientCertificateAndKey(currentTime, ca, roles, ttl, "")
+}
+
+// NewClientCertificateAndKey generates the Talos client certificate and key with the common name identifying the client.
+func NewClientCertificateAndKey(currentTime time.Time, ca *x509.PEMEncodedCertificateAndKey, roles role.Set, ttl time.Duration, commonName string) (p *x509.PEMEncodedCertificateAndKey, err error) {
 	opts := []x509.Option{
 		x509.Organization(roles.Strings()...),
 		x509.NotAfter(currentTime.Add(ttl)),
 		x509.NotBefore(currentTime),
 	}
 
+	if commonName != "" {
+		opts = append(opts, x509.CommonName(commonName))
+	}
+
 	talosCA, err := x509.NewCertificateAuthorityFromCertificateAndKey(ca)
 	if err != nil {
 		return nil, err
@@ -15,8 +15,8 @@ import (
 
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
 	"github.com/talos-systems/talos/pkg/machinery/config"
-	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/internal/patcher"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 )
 
@@ -28,6 +28,11 @@ type ConfigBundle struct {
 	ControlPlaneCfg *Config
 	WorkerCfg       *Config
 	TalosCfg        *clientconfig.Config
+
+	// Auxiliary documents appended to the machine configuration of each type.
+	InitDocuments         []config.Document
+	ControlPlaneDocuments []config.Document
+	WorkerDocuments       []config.Document
 }
 
 // Init implements the ProviderBundle interface.
@@ -58,6 +63,7 @@ func (c *ConfigBundle) Write(outputDir string, commentsFlags encoder.CommentsFla
 
 		var (
 			configString string
+			documents    []config.Document
 			err          error
 		)
 
@@ -67,22 +73,43 @@ func (c *ConfigBundle) Write(outputDir string, commentsFlags encoder.CommentsFla
 			if err != nil {
 				return err
 			}
+
+			documents = c.InitDocuments
 		case machine.TypeControlPlane:
 			configString, err = c.ControlPlane().EncodeString(encoder.WithComments(commentsFlags))
 			if err != nil {
 				return err
 			}
+
+			documents = c.ControlPlaneDocuments
 		case machine.TypeWorker:
 			configString, err = c.Worker().EncodeString(encoder.WithComments(commentsFlags))
 			if err != nil {
 				return err
 			}
+
+			documents = c.WorkerDocuments
 		case machine.TypeUnknown:
 			fallthrough
 		default:
 			return fmt.Errorf("unexpected machine type %v", t)
 		}
 
+		for _, doc := range documents {
+			var out []byte
+
+			out, err = encoder.NewEncoder(doc, encoder.WithComments(commentsFlags)).Encode()
+			if err != nil {
+				return fmt.Errorf("error encoding %s: %w", config.DocumentID(doc), err)
+			}
+
+			if !strings.HasSuffix(configString, "\n") {
+				configString += "\n"
+			}
+
+			configString += "---\n" + string(out)
+		}
+
 		if err = ioutil.WriteFile(fullFilePath, []byte(configString), 0o644); err != nil {
 			return err
 		}
@@ -94,6 +121,9 @@ func (c *ConfigBundle) Write(outputDir string, commentsFlags encoder.CommentsFla
 }
 
 // ApplyJSONPatch patches every config type with a patch.
+//
+// Deprecated: use bundle.WithPatch, bundle.WithPatchControlPlane and bundle.WithPatchWorker options
+// or configpatcher.Apply instead.
 func (c *ConfigBundle) ApplyJSONPatch(patch jsonpatch.Patch, patchControlPlane, patchWorker bool) error {
 	if len(patch) == 0 {
 		return nil
@@ -109,7 +139,7 @@ func (c *ConfigBundle) ApplyJSONPatch(patch jsonpatch.Patch, patchControlPlane,
 
 		var patched []byte
 
-		patched, err = configpatcher.JSON6902(marshaled, patch)
+		patched, err = patcher.JSON6902(marshaled, patch)
 		if err != nil {
 			return nil, err
 		}
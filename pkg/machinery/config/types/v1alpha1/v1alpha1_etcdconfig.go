@@ -7,9 +7,11 @@ package v1alpha1
 import (
 	"fmt"
 	goruntime "runtime"
+	"time"
 
 	"github.com/talos-systems/crypto/x509"
 
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -47,3 +49,69 @@ func (e *EtcdConfig) ExtraArgs() map[string]string {
 func (e *EtcdConfig) Subnet() string {
 	return e.EtcdSubnet
 }
+
+// Backup implements the config.Etcd interface.
+func (e *EtcdConfig) Backup() config.EtcdBackup {
+	if e.EtcdBackup == nil {
+		return &EtcdBackupConfig{}
+	}
+
+	return e.EtcdBackup
+}
+
+// Enabled implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Enabled() bool {
+	return b.BackupDestination != ""
+}
+
+// Interval implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Interval() time.Duration {
+	if b.BackupInterval == 0 {
+		return constants.DefaultEtcdBackupInterval
+	}
+
+	return b.BackupInterval
+}
+
+// Retention implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Retention() int {
+	return b.BackupRetention
+}
+
+// Destination implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Destination() string {
+	return b.BackupDestination
+}
+
+// S3 implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) S3() config.EtcdBackupS3 {
+	if b.BackupS3 == nil {
+		return &EtcdBackupS3Config{}
+	}
+
+	return b.BackupS3
+}
+
+// Endpoint implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Endpoint() string {
+	return s.S3Endpoint
+}
+
+// Region implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Region() string {
+	if s.S3Region == "" {
+		return constants.DefaultEtcdBackupS3Region
+	}
+
+	return s.S3Region
+}
+
+// AccessKeyID implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) AccessKeyID() string {
+	return s.S3AccessKeyID
+}
+
+// SecretAccessKey implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) SecretAccessKey() string {
+	return s.S3SecretAccessKey
+}
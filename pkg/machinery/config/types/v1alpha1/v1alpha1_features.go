@@ -4,6 +4,22 @@
 
 package v1alpha1
 
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
 // RBACEnabled implements config.Features interface.
 func (f *FeaturesConfig) RBACEnabled() bool {
 	if f.RBAC == nil {
@@ -12,3 +28,173 @@ func (f *FeaturesConfig) RBACEnabled() bool {
 
 	return *f.RBAC
 }
+
+// RBACRoles implements config.Features interface.
+func (f *FeaturesConfig) RBACRoles() []config.RBACRole {
+	return slices.Map(f.RBACRoles, func(r *RBACRole) config.RBACRole { return r })
+}
+
+// OIDC implements config.Features interface.
+func (f *FeaturesConfig) OIDC() config.OIDC {
+	if f.OIDCConfig == nil {
+		return &OIDCConfig{}
+	}
+
+	return f.OIDCConfig
+}
+
+// Validate checks features configuration for errors.
+func (f *FeaturesConfig) Validate() error {
+	var result *multierror.Error
+
+	names := map[string]struct{}{}
+
+	for idx, r := range f.RBACRoles {
+		path := "features.rbacRoles[" + strconv.Itoa(idx) + "]"
+
+		switch {
+		case r.RoleName == "":
+			result = multierror.Append(result, fmt.Errorf("[%s]: role name is required", path+".name"))
+		case strings.HasPrefix(r.RoleName, role.Prefix):
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: prefix %q is reserved for the built-in roles", path+".name", r.RoleName, role.Prefix))
+		case strings.TrimSpace(r.RoleName) != r.RoleName || strings.Contains(r.RoleName, ","):
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: role name should not contain commas or surrounding whitespace", path+".name", r.RoleName))
+		}
+
+		if _, duplicate := names[r.RoleName]; duplicate {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: duplicate role name", path+".name", r.RoleName))
+		}
+
+		names[r.RoleName] = struct{}{}
+
+		if len(r.RoleAllow) == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s]: at least one allowed method is required", path+".allow"))
+		}
+
+		for _, method := range r.RoleAllow {
+			if err := validateRBACMethod(method); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".allow", method, err))
+			}
+		}
+	}
+
+	if f.OIDCConfig != nil {
+		result = multierror.Append(result, f.OIDCConfig.validate(f.RBACEnabled(), names))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validate checks OIDC configuration for errors.
+//
+// OIDC login issues certificates with the roles mapped from the groups, so it requires RBAC to be enabled.
+// Roles should be either built-in, or defined as custom roles.
+func (o *OIDCConfig) validate(rbacEnabled bool, customRoles map[string]struct{}) error {
+	var result *multierror.Error
+
+	if !rbacEnabled {
+		result = multierror.Append(result, fmt.Errorf("[features.oidc]: OIDC login requires features.rbac to be enabled"))
+	}
+
+	if u, err := url.Parse(o.OIDCIssuerURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		result = multierror.Append(result, fmt.Errorf("[features.oidc.issuerURL] %q: expected HTTP(S) URL", o.OIDCIssuerURL))
+	}
+
+	if o.OIDCClientID == "" {
+		result = multierror.Append(result, fmt.Errorf("[features.oidc.clientID]: client ID is required"))
+	}
+
+	if len(o.OIDCGroupRoles) == 0 {
+		result = multierror.Append(result, fmt.Errorf("[features.oidc.groupRoles]: at least one group should be mapped to roles"))
+	}
+
+	groups := make([]string, 0, len(o.OIDCGroupRoles))
+
+	for group := range o.OIDCGroupRoles {
+		groups = append(groups, group)
+	}
+
+	sort.Strings(groups)
+
+	for _, group := range groups {
+		for _, r := range o.OIDCGroupRoles[group] {
+			if _, ok := customRoles[r]; ok || role.All.Includes(role.Role(r)) {
+				continue
+			}
+
+			result = multierror.Append(result, fmt.Errorf("[features.oidc.groupRoles.%s] %q: role is neither built-in nor defined in features.rbacRoles", group, r))
+		}
+	}
+
+	if o.OIDCCertLifetime < 0 {
+		result = multierror.Append(result, fmt.Errorf("[features.oidc.certLifetime] %s: should be positive", o.OIDCCertLifetime))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateRBACMethod checks that the method is a full gRPC method name, a service wildcard or a built-in role.
+func validateRBACMethod(method string) error {
+	if strings.HasPrefix(method, role.Prefix) {
+		if !role.All.Includes(role.Role(method)) {
+			return fmt.Errorf("unknown built-in role")
+		}
+
+		return nil
+	}
+
+	service, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !strings.HasPrefix(method, "/") || !ok || service == "" || name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("expected full gRPC method name (/<package>.<Service>/<Method>), service wildcard (/<package>.<Service>/*) or built-in role name")
+	}
+
+	return nil
+}
+
+// Name implements config.RBACRole interface.
+func (r *RBACRole) Name() string {
+	return r.RoleName
+}
+
+// Allow implements config.RBACRole interface.
+func (r *RBACRole) Allow() []string {
+	return r.RoleAllow
+}
+
+// Enabled implements config.OIDC interface.
+func (o *OIDCConfig) Enabled() bool {
+	return o.OIDCIssuerURL != ""
+}
+
+// IssuerURL implements config.OIDC interface.
+func (o *OIDCConfig) IssuerURL() string {
+	return o.OIDCIssuerURL
+}
+
+// ClientID implements config.OIDC interface.
+func (o *OIDCConfig) ClientID() string {
+	return o.OIDCClientID
+}
+
+// GroupsClaim implements config.OIDC interface.
+func (o *OIDCConfig) GroupsClaim() string {
+	if o.OIDCGroupsClaim == "" {
+		return constants.DefaultOIDCGroupsClaim
+	}
+
+	return o.OIDCGroupsClaim
+}
+
+// GroupRoles implements config.OIDC interface.
+func (o *OIDCConfig) GroupRoles() map[string][]string {
+	return o.OIDCGroupRoles
+}
+
+// CertLifetime implements config.OIDC interface.
+func (o *OIDCConfig) CertLifetime() time.Duration {
+	if o.OIDCCertLifetime == 0 {
+		return constants.DefaultOIDCCertLifetime
+	}
+
+	return o.OIDCCertLifetime
+}
@@ -5,8 +5,10 @@
 package v1alpha1
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -32,17 +34,42 @@ func (lc *LoggingConfig) Validate() error {
 				errs = multierror.Append(errs, fmt.Errorf("empty logging endpoint's host"))
 			}
 
-			if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" {
+			if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" && endpoint.Scheme != "tls" {
 				errs = multierror.Append(errs, fmt.Errorf("unexpected logging endpoint scheme %q", endpoint.Scheme))
 			}
+
+			if dest.LoggingTLS != nil && endpoint.Scheme != "tls" {
+				errs = multierror.Append(errs, fmt.Errorf("logging TLS settings require \"tls\" endpoint scheme, got %q", endpoint.Scheme))
+			}
 		}
 
 		switch f := dest.LoggingFormat; f {
-		case constants.LoggingFormatJSONLines:
+		case constants.LoggingFormatJSONLines, constants.LoggingFormatSyslog, constants.LoggingFormatGELF:
 			// nothing
 		default:
 			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
 		}
+
+		if dest.LoggingTLS != nil && len(dest.LoggingTLS.TLSCA) > 0 {
+			if !x509.NewCertPool().AppendCertsFromPEM(dest.LoggingTLS.TLSCA) {
+				errs = multierror.Append(errs, fmt.Errorf("failed to parse logging TLS CA certificate"))
+			}
+		}
+
+		if dest.LoggingFilter != nil {
+			errs = multierror.Append(errs, dest.LoggingFilter.Validate())
+		}
+
+		for k := range dest.LoggingExtraFields {
+			switch k {
+			case "", "msg", "talos-time", "talos-level", "talos-service":
+				errs = multierror.Append(errs, fmt.Errorf("logging extra field name %q is reserved", k))
+			}
+		}
+	}
+
+	if lc.LoggingSpool != nil && lc.LoggingSpool.SpoolMaxSize != 0 && lc.LoggingSpool.SpoolMaxSize < constants.MinLogSpoolMaxSize {
+		errs = multierror.Append(errs, fmt.Errorf("logging spool max size should be at least %d bytes, got %d", constants.MinLogSpoolMaxSize, lc.LoggingSpool.SpoolMaxSize))
 	}
 
 	return errs.ErrorOrNil()
@@ -53,6 +80,29 @@ func (lc *LoggingConfig) Destinations() []config.LoggingDestination {
 	return slices.Map(lc.LoggingDestinations, func(ld LoggingDestination) config.LoggingDestination { return ld })
 }
 
+// Spool implements config.Logging interface.
+func (lc *LoggingConfig) Spool() config.LoggingSpool {
+	if lc.LoggingSpool == nil {
+		return &LoggingSpoolConfig{}
+	}
+
+	return lc.LoggingSpool
+}
+
+// Enabled implements config.LoggingSpool interface.
+func (ls *LoggingSpoolConfig) Enabled() bool {
+	return ls.SpoolEnabled
+}
+
+// MaxSize implements config.LoggingSpool interface.
+func (ls *LoggingSpoolConfig) MaxSize() uint64 {
+	if ls.SpoolMaxSize == 0 {
+		return constants.DefaultLogSpoolMaxSize
+	}
+
+	return uint64(ls.SpoolMaxSize)
+}
+
 // Endpoint implements config.LoggingDestination interface.
 func (ld LoggingDestination) Endpoint() *url.URL {
 	return ld.LoggingEndpoint.URL
@@ -62,3 +112,100 @@ func (ld LoggingDestination) Endpoint() *url.URL {
 func (ld LoggingDestination) Format() string {
 	return ld.LoggingFormat
 }
+
+// TLSCA implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLSCA() []byte {
+	if ld.LoggingTLS == nil {
+		return nil
+	}
+
+	return ld.LoggingTLS.TLSCA
+}
+
+// Filter implements config.LoggingDestination interface.
+func (ld LoggingDestination) Filter() config.LoggingFilter {
+	if ld.LoggingFilter == nil {
+		return &LoggingFilterConfig{}
+	}
+
+	return ld.LoggingFilter
+}
+
+// ExtraFields implements config.LoggingDestination interface.
+func (ld LoggingDestination) ExtraFields() map[string]string {
+	return ld.LoggingExtraFields
+}
+
+// Validate checks logging filter configuration for errors.
+func (lf *LoggingFilterConfig) Validate() error {
+	var errs *multierror.Error
+
+	switch lf.FilterMinLevel {
+	case "", "debug", "info", "warn", "error":
+		// nothing
+	default:
+		errs = multierror.Append(errs, fmt.Errorf("unknown logging filter level %q", lf.FilterMinLevel))
+	}
+
+	if lf.FilterMessageRegex != "" {
+		if _, err := regexp.Compile(lf.FilterMessageRegex); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid logging filter message regex: %w", err))
+		}
+	}
+
+	if lf.FilterSampling != nil {
+		if lf.FilterSampling.SamplingInitial <= 0 {
+			errs = multierror.Append(errs, fmt.Errorf("logging sampling initial should be positive, got %d", lf.FilterSampling.SamplingInitial))
+		}
+
+		if lf.FilterSampling.SamplingThereafter < 0 {
+			errs = multierror.Append(errs, fmt.Errorf("logging sampling thereafter should not be negative, got %d", lf.FilterSampling.SamplingThereafter))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// MinLevel implements config.LoggingFilter interface.
+func (lf *LoggingFilterConfig) MinLevel() string {
+	return lf.FilterMinLevel
+}
+
+// IncludeServices implements config.LoggingFilter interface.
+func (lf *LoggingFilterConfig) IncludeServices() []string {
+	return lf.FilterIncludeServices
+}
+
+// ExcludeServices implements config.LoggingFilter interface.
+func (lf *LoggingFilterConfig) ExcludeServices() []string {
+	return lf.FilterExcludeServices
+}
+
+// MessageRegex implements config.LoggingFilter interface.
+func (lf *LoggingFilterConfig) MessageRegex() string {
+	return lf.FilterMessageRegex
+}
+
+// Sampling implements config.LoggingFilter interface.
+func (lf *LoggingFilterConfig) Sampling() config.LoggingSampling {
+	if lf.FilterSampling == nil {
+		return &LoggingSamplingConfig{}
+	}
+
+	return lf.FilterSampling
+}
+
+// Enabled implements config.LoggingSampling interface.
+func (ls *LoggingSamplingConfig) Enabled() bool {
+	return ls.SamplingInitial > 0
+}
+
+// Initial implements config.LoggingSampling interface.
+func (ls *LoggingSamplingConfig) Initial() int {
+	return ls.SamplingInitial
+}
+
+// Thereafter implements config.LoggingSampling interface.
+func (ls *LoggingSamplingConfig) Thereafter() int {
+	return ls.SamplingThereafter
+}
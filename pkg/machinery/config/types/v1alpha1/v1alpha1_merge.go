@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/merge"
+)
+
+// Merge the network interface configuration intelligently.
+//
+// Interfaces are matched by the interface name or by the device selector.
+func (devices *NetworkDeviceList) Merge(other interface{}) error {
+	otherDevices, ok := other.(NetworkDeviceList)
+	if !ok {
+		return fmt.Errorf("unexpected type for device merge %T", other)
+	}
+
+	for _, device := range otherDevices {
+		if device == nil {
+			continue
+		}
+
+		var existing *Device
+
+		for _, d := range *devices {
+			if d != nil && d.sameDevice(device) {
+				existing = d
+
+				break
+			}
+		}
+
+		if existing == nil {
+			*devices = append(*devices, device)
+
+			continue
+		}
+
+		if err := merge.Merge(existing, device); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Device) sameDevice(other *Device) bool {
+	switch {
+	case d.DeviceInterface != "" || other.DeviceInterface != "":
+		return d.DeviceInterface == other.DeviceInterface
+	case d.DeviceSelector != nil && other.DeviceSelector != nil:
+		return *d.DeviceSelector == *other.DeviceSelector
+	default:
+		return false
+	}
+}
+
+// Merge the VLAN configuration intelligently.
+//
+// VLANs are matched by the VLAN ID.
+func (vlans *VlanList) Merge(other interface{}) error {
+	otherVlans, ok := other.(VlanList)
+	if !ok {
+		return fmt.Errorf("unexpected type for vlan merge %T", other)
+	}
+
+	for _, vlan := range otherVlans {
+		if vlan == nil {
+			continue
+		}
+
+		var existing *Vlan
+
+		for _, v := range *vlans {
+			if v != nil && v.VlanID == vlan.VlanID {
+				existing = v
+
+				break
+			}
+		}
+
+		if existing == nil {
+			*vlans = append(*vlans, vlan)
+
+			continue
+		}
+
+		if err := merge.Merge(existing, vlan); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Merge the routes intelligently.
+//
+// Routes are matched by the route network (destination) and the routing table.
+func (routes *RouteList) Merge(other interface{}) error {
+	otherRoutes, ok := other.(RouteList)
+	if !ok {
+		return fmt.Errorf("unexpected type for route merge %T", other)
+	}
+
+	for _, route := range otherRoutes {
+		if route == nil {
+			continue
+		}
+
+		var existing *Route
+
+		for _, r := range *routes {
+			if r != nil && r.RouteNetwork == route.RouteNetwork && r.RouteTable == route.RouteTable {
+				existing = r
+
+				break
+			}
+		}
+
+		if existing == nil {
+			*routes = append(*routes, route)
+
+			continue
+		}
+
+		if err := merge.Merge(existing, route); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Merge the kubelet extra mounts intelligently.
+//
+// Mounts are matched by the destination, and the matching mount is replaced completely.
+func (mounts *ExtraMountList) Merge(other interface{}) error {
+	otherMounts, ok := other.(ExtraMountList)
+	if !ok {
+		return fmt.Errorf("unexpected type for extra mount merge %T", other)
+	}
+
+outer:
+	for _, mount := range otherMounts {
+		for i := range *mounts {
+			if (*mounts)[i].Destination == mount.Destination {
+				(*mounts)[i] = mount
+
+				continue outer
+			}
+		}
+
+		*mounts = append(*mounts, mount)
+	}
+
+	return nil
+}
+
+// Merge the inline manifests intelligently.
+//
+// Manifests are matched by the name, and the matching manifest is replaced completely.
+func (manifests *ClusterInlineManifests) Merge(other interface{}) error {
+	otherManifests, ok := other.(ClusterInlineManifests)
+	if !ok {
+		return fmt.Errorf("unexpected type for inline manifest merge %T", other)
+	}
+
+outer:
+	for _, manifest := range otherManifests {
+		for i := range *manifests {
+			if (*manifests)[i].InlineManifestName == manifest.InlineManifestName {
+				(*manifests)[i] = manifest
+
+				continue outer
+			}
+		}
+
+		*manifests = append(*manifests, manifest)
+	}
+
+	return nil
+}
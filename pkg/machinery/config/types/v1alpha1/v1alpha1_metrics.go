@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// Validate checks metrics configuration for errors.
+func (mc *MetricsConfig) Validate() error {
+	if mc.MetricsBindAddress != "" && net.ParseIP(mc.MetricsBindAddress) == nil {
+		return fmt.Errorf("metrics bind address %q is not a valid IP address", mc.MetricsBindAddress)
+	}
+
+	return nil
+}
+
+// Enabled implements config.Metrics interface.
+func (mc *MetricsConfig) Enabled() bool {
+	return mc.MetricsEnabled
+}
+
+// BindAddress implements config.Metrics interface.
+func (mc *MetricsConfig) BindAddress() string {
+	if mc.MetricsBindAddress == "" {
+		return constants.DefaultMetricsBindAddress
+	}
+
+	return mc.MetricsBindAddress
+}
@@ -9,6 +9,7 @@ import (
 	stdx509 "crypto/x509"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -86,6 +87,13 @@ func (c *Config) Raw() interface{} {
 	return c
 }
 
+// Documents implements the config.Provider interface.
+//
+// Plain v1alpha1 config has no auxiliary documents.
+func (c *Config) Documents() []config.Document {
+	return nil
+}
+
 // Install implements the config.Provider interface.
 func (m *MachineConfig) Install() config.Install {
 	if m.MachineInstall == nil {
@@ -105,6 +113,11 @@ func (m *MachineConfig) Disks() []config.Disk {
 	return slices.Map(m.MachineDisks, func(d *MachineDisk) config.Disk { return d })
 }
 
+// Volumes implements the config.Provider interface.
+func (m *MachineConfig) Volumes() []config.UserVolume {
+	return slices.Map(m.MachineVolumes, func(v *UserVolumeConfig) config.UserVolume { return v })
+}
+
 // Network implements the config.Provider interface.
 func (m *MachineConfig) Network() config.MachineNetwork {
 	if m.MachineNetwork == nil {
@@ -214,6 +227,24 @@ func (m *MachineConfig) Sysfs() map[string]string {
 	return m.MachineSysfs
 }
 
+// NodeLabels implements the config.Provider interface.
+func (m *MachineConfig) NodeLabels() map[string]string {
+	if m.MachineNodeLabels == nil {
+		return make(map[string]string)
+	}
+
+	return m.MachineNodeLabels
+}
+
+// NodeTaints implements the config.Provider interface.
+func (m *MachineConfig) NodeTaints() map[string]string {
+	if m.MachineNodeTaints == nil {
+		return make(map[string]string)
+	}
+
+	return m.MachineNodeTaints
+}
+
 // CA implements the config.Provider interface.
 func (m *MachineConfig) CA() *x509.PEMEncodedCertificateAndKey {
 	return m.MachineCA
@@ -270,6 +301,15 @@ func (m *MachineConfig) Logging() config.Logging {
 	return m.MachineLogging
 }
 
+// Metrics implements the config.MachineConfig interface.
+func (m *MachineConfig) Metrics() config.Metrics {
+	if m.MachineMetrics == nil {
+		return &MetricsConfig{}
+	}
+
+	return m.MachineMetrics
+}
+
 // Kernel implements the config.MachineConfig interface.
 func (m *MachineConfig) Kernel() config.Kernel {
 	if m.MachineKernel == nil {
@@ -482,6 +522,20 @@ func (n *NetworkConfig) KubeSpan() config.KubeSpan {
 	return n.NetworkKubeSpan
 }
 
+// RoutingRules implements the config.Provider interface.
+func (n *NetworkConfig) RoutingRules() []config.RoutingRule {
+	return slices.Map(n.NetworkRoutingRules, func(r *RoutingRule) config.RoutingRule { return r })
+}
+
+// HostDNS implements the config.Provider interface.
+func (n *NetworkConfig) HostDNS() config.HostDNS {
+	if n.NetworkHostDNS == nil {
+		return &HostDNS{}
+	}
+
+	return n.NetworkHostDNS
+}
+
 // IP implements the MachineNetwork interface.
 func (e *ExtraHost) IP() string {
 	return e.HostIP
@@ -737,6 +791,51 @@ func (r *Route) Metric() uint32 {
 	return r.RouteMetric
 }
 
+// Table implements the MachineNetwork interface.
+func (r *Route) Table() string {
+	return r.RouteTable
+}
+
+// Priority implements the MachineNetwork interface.
+func (r *RoutingRule) Priority() uint32 {
+	return r.RulePriority
+}
+
+// From implements the MachineNetwork interface.
+func (r *RoutingRule) From() string {
+	return r.RuleFrom
+}
+
+// To implements the MachineNetwork interface.
+func (r *RoutingRule) To() string {
+	return r.RuleTo
+}
+
+// IIFName implements the MachineNetwork interface.
+func (r *RoutingRule) IIFName() string {
+	return r.RuleIIFName
+}
+
+// OIFName implements the MachineNetwork interface.
+func (r *RoutingRule) OIFName() string {
+	return r.RuleOIFName
+}
+
+// FwMark implements the MachineNetwork interface.
+func (r *RoutingRule) FwMark() uint32 {
+	return r.RuleFwMark
+}
+
+// FwMask implements the MachineNetwork interface.
+func (r *RoutingRule) FwMask() uint32 {
+	return r.RuleFwMask
+}
+
+// Table implements the MachineNetwork interface.
+func (r *RoutingRule) Table() string {
+	return r.RuleTable
+}
+
 // Interfaces implements the MachineNetwork interface.
 func (b *Bond) Interfaces() []string {
 	if b == nil {
@@ -746,6 +845,15 @@ func (b *Bond) Interfaces() []string {
 	return b.BondInterfaces
 }
 
+// DeviceSelectors implements the config.Bond interface.
+func (b *Bond) DeviceSelectors() []config.NetworkDeviceSelector {
+	if b == nil {
+		return nil
+	}
+
+	return slices.Map(b.BondDeviceSelectors, func(s NetworkDeviceSelector) config.NetworkDeviceSelector { return &s })
+}
+
 // ARPIPTarget implements the MachineNetwork interface.
 func (b *Bond) ARPIPTarget() []string {
 	if b == nil {
@@ -898,6 +1006,11 @@ func (b *Bridge) Interfaces() []string {
 	return b.BridgedInterfaces
 }
 
+// DeviceSelectors implements the config.Bridge interface.
+func (b *Bridge) DeviceSelectors() []config.NetworkDeviceSelector {
+	return slices.Map(b.BridgeDeviceSelectors, func(s NetworkDeviceSelector) config.NetworkDeviceSelector { return &s })
+}
+
 // STP implements the config.Bridge interface.
 func (b *Bridge) STP() config.STP {
 	if b.BridgeSTP == nil {
@@ -907,6 +1020,49 @@ func (b *Bridge) STP() config.STP {
 	return b.BridgeSTP
 }
 
+// VLAN implements the config.Bridge interface.
+func (b *Bridge) VLAN() config.BridgeVLAN {
+	if b.BridgeVLAN == nil {
+		return nil
+	}
+
+	return b.BridgeVLAN
+}
+
+// FilteringEnabled implements the config.BridgeVLAN interface.
+func (v *BridgeVLAN) FilteringEnabled() bool {
+	if v.BridgeVLANFiltering == nil {
+		return false
+	}
+
+	return *v.BridgeVLANFiltering
+}
+
+// DefaultPVID implements the config.BridgeVLAN interface.
+func (v *BridgeVLAN) DefaultPVID() uint16 {
+	return v.BridgeVLANDefaultPVID
+}
+
+// Ports implements the config.BridgeVLAN interface.
+func (v *BridgeVLAN) Ports() []config.BridgePortVLAN {
+	return slices.Map(v.BridgeVLANPorts, func(p *BridgePortVLAN) config.BridgePortVLAN { return p })
+}
+
+// Interface implements the config.BridgePortVLAN interface.
+func (p *BridgePortVLAN) Interface() string {
+	return p.PortInterface
+}
+
+// PVID implements the config.BridgePortVLAN interface.
+func (p *BridgePortVLAN) PVID() uint16 {
+	return p.PortPVID
+}
+
+// TaggedVLANs implements the config.BridgePortVLAN interface.
+func (p *BridgePortVLAN) TaggedVLANs() []uint16 {
+	return p.PortTaggedVLANs
+}
+
 // Addresses implements the MachineNetwork interface.
 func (v *Vlan) Addresses() []string {
 	switch {
@@ -958,6 +1114,35 @@ func (k NetworkKubeSpan) ForceRouting() bool {
 	return !k.KubeSpanAllowDownPeerBypass
 }
 
+// Enabled implements the config.HostDNS interface.
+func (h *HostDNS) Enabled() bool {
+	return h.HostDNSEnabled
+}
+
+// CacheSize implements the config.HostDNS interface.
+func (h *HostDNS) CacheSize() int {
+	if h.HostDNSCacheSize == 0 {
+		return constants.DefaultHostDNSCacheSize
+	}
+
+	return h.HostDNSCacheSize
+}
+
+// DomainUpstreams implements the config.HostDNS interface.
+func (h *HostDNS) DomainUpstreams() []config.HostDNSDomainUpstream {
+	return slices.Map(h.HostDNSDomainUpstreams, func(u *HostDNSDomainUpstream) config.HostDNSDomainUpstream { return u })
+}
+
+// Domain implements the config.HostDNSDomainUpstream interface.
+func (u *HostDNSDomainUpstream) Domain() string {
+	return u.UpstreamDomain
+}
+
+// Nameservers implements the config.HostDNSDomainUpstream interface.
+func (u *HostDNSDomainUpstream) Nameservers() []string {
+	return u.UpstreamNameservers
+}
+
 // Disabled implements the config.Provider interface.
 func (t *TimeConfig) Disabled() bool {
 	return t.TimeDisabled
@@ -1003,52 +1188,57 @@ func (i *InstallConfig) Disk() (string, error) {
 }
 
 // DiskMatchers implements the config.Provider interface.
-//nolint:gocyclo
 func (i *InstallConfig) DiskMatchers() []disk.Matcher {
 	if i.InstallDiskSelector != nil {
-		selector := i.InstallDiskSelector
+		return i.InstallDiskSelector.DiskMatchers()
+	}
 
-		matchers := []disk.Matcher{}
-		if selector.Size != nil {
-			matchers = append(matchers, selector.Size.Matcher)
-		}
+	return nil
+}
 
-		if selector.UUID != "" {
-			matchers = append(matchers, disk.WithUUID(selector.UUID))
-		}
+// DiskMatchers converts the disk selector to the list of disk matchers.
+//
+//nolint:gocyclo
+func (selector *InstallDiskSelector) DiskMatchers() []disk.Matcher {
+	matchers := []disk.Matcher{}
 
-		if selector.WWID != "" {
-			matchers = append(matchers, disk.WithWWID(selector.WWID))
-		}
+	if selector.Size != nil {
+		matchers = append(matchers, selector.Size.Matcher)
+	}
 
-		if selector.Model != "" {
-			matchers = append(matchers, disk.WithModel(selector.Model))
-		}
+	if selector.UUID != "" {
+		matchers = append(matchers, disk.WithUUID(selector.UUID))
+	}
 
-		if selector.Name != "" {
-			matchers = append(matchers, disk.WithName(selector.Name))
-		}
+	if selector.WWID != "" {
+		matchers = append(matchers, disk.WithWWID(selector.WWID))
+	}
 
-		if selector.Serial != "" {
-			matchers = append(matchers, disk.WithSerial(selector.Serial))
-		}
+	if selector.Model != "" {
+		matchers = append(matchers, disk.WithModel(selector.Model))
+	}
 
-		if selector.Modalias != "" {
-			matchers = append(matchers, disk.WithModalias(selector.Modalias))
-		}
+	if selector.Name != "" {
+		matchers = append(matchers, disk.WithName(selector.Name))
+	}
 
-		if disk.Type(selector.Type) != disk.TypeUnknown {
-			matchers = append(matchers, disk.WithType(disk.Type(selector.Type)))
-		}
+	if selector.Serial != "" {
+		matchers = append(matchers, disk.WithSerial(selector.Serial))
+	}
 
-		if selector.BusPath != "" {
-			matchers = append(matchers, disk.WithBusPath(selector.BusPath))
-		}
+	if selector.Modalias != "" {
+		matchers = append(matchers, disk.WithModalias(selector.Modalias))
+	}
 
-		return matchers
+	if disk.Type(selector.Type) != disk.TypeUnknown {
+		matchers = append(matchers, disk.WithType(disk.Type(selector.Type)))
 	}
 
-	return nil
+	if selector.BusPath != "" {
+		matchers = append(matchers, disk.WithBusPath(selector.BusPath))
+	}
+
+	return matchers
 }
 
 // ExtraKernelArgs implements the config.Provider interface.
@@ -1071,6 +1261,43 @@ func (i *InstallConfig) WithBootloader() bool {
 	return i.InstallBootloader
 }
 
+// Ephemeral implements the config.Provider interface.
+func (i *InstallConfig) Ephemeral() config.InstallEphemeral {
+	if i.InstallEphemeral == nil {
+		return &InstallEphemeralConfig{}
+	}
+
+	return i.InstallEphemeral
+}
+
+// Disk implements the config.Provider interface.
+func (e *InstallEphemeralConfig) Disk() (string, error) {
+	if e.EphemeralDiskSelector == nil {
+		return "", nil
+	}
+
+	d, err := disk.Find(e.EphemeralDiskSelector.DiskMatchers()...)
+	if err != nil {
+		return "", err
+	}
+
+	if d == nil {
+		return "", fmt.Errorf("no disk found matching the EPHEMERAL disk selector")
+	}
+
+	return d.DeviceName, nil
+}
+
+// MinSize implements the config.Provider interface.
+func (e *InstallEphemeralConfig) MinSize() uint64 {
+	return uint64(e.EphemeralMinSize)
+}
+
+// MaxSize implements the config.Provider interface.
+func (e *InstallEphemeralConfig) MaxSize() uint64 {
+	return uint64(e.EphemeralMaxSize)
+}
+
 // Image implements the config.Provider interface.
 func (i InstallExtensionConfig) Image() string {
 	return i.ExtensionImage
@@ -1146,6 +1373,52 @@ func (p *DiskPartition) MountPoint() string {
 	return p.DiskMountPoint
 }
 
+// Name implements the config.Provider interface.
+func (v *UserVolumeConfig) Name() string {
+	return v.VolumeName
+}
+
+// DiskMatchers implements the config.Provider interface.
+func (v *UserVolumeConfig) DiskMatchers() []disk.Matcher {
+	if v.VolumeDiskSelector == nil {
+		return nil
+	}
+
+	return v.VolumeDiskSelector.DiskMatchers()
+}
+
+// Size implements the config.Provider interface.
+func (v *UserVolumeConfig) Size() uint64 {
+	return uint64(v.VolumeSize)
+}
+
+// Filesystem implements the config.Provider interface.
+func (v *UserVolumeConfig) Filesystem() string {
+	if v.VolumeFilesystem == "" {
+		return constants.UserVolumeDefaultFilesystem
+	}
+
+	return v.VolumeFilesystem
+}
+
+// MountPoint implements the config.Provider interface.
+func (v *UserVolumeConfig) MountPoint() string {
+	if v.VolumeMountPoint == "" {
+		return filepath.Join(constants.UserVolumeMountPoint, v.VolumeName)
+	}
+
+	return v.VolumeMountPoint
+}
+
+// Encryption implements the config.Provider interface.
+func (v *UserVolumeConfig) Encryption() config.Encryption {
+	if v.VolumeEncryption == nil {
+		return nil
+	}
+
+	return v.VolumeEncryption
+}
+
 // Kind implements the config.Provider interface.
 func (e *EncryptionConfig) Kind() string {
 	return e.EncryptionProvider
@@ -1194,6 +1467,15 @@ func (e *EncryptionKey) NodeID() config.EncryptionKeyNodeID {
 	return e.KeyNodeID
 }
 
+// KMS implements the config.Provider interface.
+func (e *EncryptionKey) KMS() config.EncryptionKeyKMS {
+	if e.KeyKMS == nil {
+		return nil
+	}
+
+	return e.KeyKMS
+}
+
 // Slot implements the config.Provider interface.
 func (e *EncryptionKey) Slot() int {
 	return e.KeySlot
@@ -1204,6 +1486,11 @@ func (e *EncryptionKeyStatic) Key() []byte {
 	return []byte(e.KeyData)
 }
 
+// Endpoint implements the config.Provider interface.
+func (e *EncryptionKeyKMS) Endpoint() string {
+	return e.KMSEndpoint
+}
+
 // Get implements the config.Provider interface.
 func (e *SystemDiskEncryptionConfig) Get(label string) config.Encryption {
 	switch label {
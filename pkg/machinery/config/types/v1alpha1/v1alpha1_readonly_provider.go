@@ -52,6 +52,11 @@ func (r *ReadonlyProvider) Cluster() config.ClusterConfig {
 	return r.cfg.Cluster()
 }
 
+// Documents implements the config.Provider interface.
+func (r *ReadonlyProvider) Documents() []config.Document {
+	return nil
+}
+
 // Validate checks configuration and returns warnings and fatal errors (as multierror).
 func (r *ReadonlyProvider) Validate(mode config.RuntimeMode, opts ...config.ValidationOption) ([]string, error) {
 	return r.cfg.Validate(mode, opts...)
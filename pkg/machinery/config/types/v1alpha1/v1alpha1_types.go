@@ -179,6 +179,24 @@ var (
 		},
 	}
 
+	machineVolumesExample = []*UserVolumeConfig{
+		{
+			VolumeName: "data",
+			VolumeDiskSelector: &InstallDiskSelector{
+				Model: "WDC*",
+			},
+			VolumeEncryption: &EncryptionConfig{
+				EncryptionProvider: "luks2",
+				EncryptionKeys: []*EncryptionKey{
+					{
+						KeyNodeID: &EncryptionKeyNodeID{},
+						KeySlot:   0,
+					},
+				},
+			},
+		},
+	}
+
 	machineInstallExample = &InstallConfig{
 		InstallDisk:            "/dev/sda",
 		InstallExtraKernelArgs: []string{"console=ttyS1", "panic=10"},
@@ -187,6 +205,13 @@ var (
 		InstallWipe:            false,
 	}
 
+	machineInstallEphemeralExample = &InstallEphemeralConfig{
+		EphemeralDiskSelector: &InstallDiskSelector{
+			Type: InstallDiskType(disk.TypeNVMe),
+		},
+		EphemeralMaxSize: DiskSize(500000000000),
+	}
+
 	machineInstallDiskSelectorExample = &InstallDiskSelector{
 		Model: "WDC*",
 		Size: &InstallDiskSizeMatcher{
@@ -240,6 +265,14 @@ var (
 		"net.ipv4.ip_forward": "0",
 	}
 
+	machineNodeLabelsExample = map[string]string{
+		"exampleLabel": "exampleLabelValue",
+	}
+
+	machineNodeTaintsExample = map[string]string{
+		"exampleTaint": "exampleTaintValue:NoSchedule",
+	}
+
 	machineSysfsExample = map[string]string{
 		"devices.system.cpu.cpu0.cpufreq.scaling_governor": "performance",
 	}
@@ -256,10 +289,36 @@ var (
 		},
 	}
 
+	machineVolumeEncryptionExample = &EncryptionConfig{
+		EncryptionProvider: "luks2",
+		EncryptionKeys: []*EncryptionKey{
+			{
+				KeyNodeID: &EncryptionKeyNodeID{},
+				KeySlot:   0,
+			},
+		},
+	}
+
 	machineFeaturesExample = &FeaturesConfig{
 		RBAC: pointer.To(true),
 	}
 
+	machineFeaturesRBACRolesExample = []*RBACRole{
+		{
+			RoleName:  "sre",
+			RoleAllow: []string{"os:reader", "/machine.MachineService/Reboot"},
+		},
+	}
+
+	machineFeaturesOIDCExample = &OIDCConfig{
+		OIDCIssuerURL: "https://dex.example.com",
+		OIDCClientID:  "talos",
+		OIDCGroupRoles: map[string][]string{
+			"platform-admins": {"os:admin"},
+			"sre":             {"sre"},
+		},
+	}
+
 	machineUdevExample = &UdevConfig{
 		UdevRules: []string{"SUBSYSTEM==\"drm\", KERNEL==\"renderD*\", GROUP=\"44\", MODE=\"0660\""},
 	}
@@ -347,6 +406,17 @@ var (
 
 	clusterEtcdSubnetExample = (&EtcdConfig{EtcdSubnet: "10.0.0.0/8"}).Subnet()
 
+	clusterEtcdBackupExample = &EtcdBackupConfig{
+		BackupInterval:    6 * time.Hour,
+		BackupRetention:   10,
+		BackupDestination: "s3://etcd-backups/cluster1",
+		BackupS3: &EtcdBackupS3Config{
+			S3Endpoint:        "https://minio.example.com",
+			S3AccessKeyID:     "backup",
+			S3SecretAccessKey: "secret",
+		},
+	}
+
 	clusterCoreDNSExample = &CoreDNS{
 		CoreDNSImage: (&CoreDNS{}).Image(),
 	}
@@ -405,6 +475,29 @@ var (
 			RouteNetwork: "10.2.0.0/16",
 			RouteGateway: "10.2.0.1",
 		},
+		{
+			RouteNetwork: "0.0.0.0/0",
+			RouteGateway: "10.3.0.1",
+			RouteTable:   "100",
+		},
+	}
+
+	networkConfigRoutingRulesExample = []*RoutingRule{
+		{
+			RulePriority: 1000,
+			RuleFrom:     "10.3.0.0/24",
+			RuleTable:    "100",
+		},
+	}
+
+	networkConfigHostDNSExample = &HostDNS{
+		HostDNSEnabled: true,
+		HostDNSDomainUpstreams: []*HostDNSDomainUpstream{
+			{
+				UpstreamDomain:      "corp.example.com",
+				UpstreamNameservers: []string{"10.0.0.53"},
+			},
+		},
 	}
 
 	networkConfigBondExample = &Bond{
@@ -420,6 +513,24 @@ var (
 		},
 	}
 
+	networkConfigMemberSelectorsExample = []NetworkDeviceSelector{
+		{
+			NetworkDeviceHardwareAddress: "00:25:90:*",
+			NetworkDeviceKernelDriver:    "ixgbe",
+		},
+	}
+
+	networkConfigBridgeVLANExample = &BridgeVLAN{
+		BridgeVLANFiltering: pointer.To(true),
+		BridgeVLANPorts: []*BridgePortVLAN{
+			{
+				PortInterface:   "eth0",
+				PortPVID:        10,
+				PortTaggedVLANs: []uint16{20, 30},
+			},
+		},
+	}
+
 	networkConfigDHCPOptionsExample = &DHCPOptions{
 		DHCPRouteMetric: 1024,
 	}
@@ -516,6 +627,24 @@ metadata:
 		mustParseURL("tcp://1.2.3.4:12345"),
 	}
 
+	loggingEndpointExample3 = &Endpoint{
+		mustParseURL("tls://logs.example.com:6514"),
+	}
+
+	loggingFilterExample = &LoggingFilterConfig{
+		FilterMinLevel:        "info",
+		FilterExcludeServices: []string{"machined"},
+		FilterSampling: &LoggingSamplingConfig{
+			SamplingInitial:    100,
+			SamplingThereafter: 10,
+		},
+	}
+
+	loggingExtraFieldsExample = map[string]string{
+		"cluster": "prod",
+		"role":    "controlplane",
+	}
+
 	machineLoggingExample = LoggingConfig{
 		LoggingDestinations: []LoggingDestination{
 			{
@@ -525,6 +654,16 @@ metadata:
 		},
 	}
 
+	loggingSpoolExample = &LoggingSpoolConfig{
+		SpoolEnabled: true,
+		SpoolMaxSize: DiskSize(128000000),
+	}
+
+	machineMetricsExample = &MetricsConfig{
+		MetricsEnabled:     true,
+		MetricsBindAddress: "10.5.0.2",
+	}
+
 	machineKernelExample = &KernelConfig{
 		KernelModules: []*KernelModuleConfig{
 			{
@@ -700,6 +839,15 @@ type MachineConfig struct {
 	//       value: machineDisksExample
 	MachineDisks []*MachineDisk `yaml:"disks,omitempty"` // Note: `size` is in units of bytes.
 	//   description: |
+	//     User volumes managed by Talos.
+	//     Volumes are partitioned, optionally encrypted, formatted and mounted by the controller,
+	//     which keeps reconciling them with the configuration: new volumes are created without a reboot,
+	//     and the volumes without fixed size are grown when the disk is resized.
+	//     Volumes removed from the configuration are unmounted, but the partitions and the data are kept.
+	//   examples:
+	//     - value: machineVolumesExample
+	MachineVolumes []*UserVolumeConfig `yaml:"volumes,omitempty"`
+	//   description: |
 	//     Used to provide instructions for installations.
 	//   examples:
 	//     - name: MachineInstall config usage example.
@@ -787,10 +935,37 @@ type MachineConfig struct {
 	//     - value: machineLoggingExample
 	MachineLogging *LoggingConfig `yaml:"logging,omitempty"`
 	//   description: |
+	//     Configures the Prometheus metrics endpoints of machined, apid and trustd.
+	//   examples:
+	//     - value: machineMetricsExample
+	MachineMetrics *MetricsConfig `yaml:"metrics,omitempty"`
+	//   description: |
 	//     Configures the kernel.
 	//   examples:
 	//     - value: machineKernelExample
 	MachineKernel *KernelConfig `yaml:"kernel,omitempty"`
+	//   description: |
+	//     Configures the node labels for the machine.
+	//
+	//     Labels are applied to the Kubernetes Node object and reconciled whenever the configuration changes.
+	//     Talos keeps track of the labels it has applied, labels set by other means are not touched.
+	//     Labels restricted by the `NodeRestriction` admission plugin (e.g. `node-role.kubernetes.io/*`) are not allowed, as labels are applied with the kubelet credentials.
+	//   examples:
+	//     - name: node labels example.
+	//       value: machineNodeLabelsExample
+	MachineNodeLabels map[string]string `yaml:"nodeLabels,omitempty"`
+	//   description: |
+	//     Configures the node taints for the machine.
+	//
+	//     Taint value is specified as `value:Effect`, the value is optional.
+	//     Taints are set by the kubelet when the node is registered and reconciled whenever the configuration changes.
+	//     Talos keeps track of the taints it has applied, taints set by other means are not touched.
+	//
+	//     Note: taints are applied with the kubelet credentials, so with the default `NodeRestriction` admission plugin taint changes take effect only when the node is registered again.
+	//   examples:
+	//     - name: node taints example.
+	//       value: machineNodeTaintsExample
+	MachineNodeTaints map[string]string `yaml:"nodeTaints,omitempty"`
 }
 
 // ClusterConfig represents the cluster-wide config values.
@@ -933,6 +1108,9 @@ type ClusterConfig struct {
 	AllowSchedulingOnMasters bool `yaml:"allowSchedulingOnMasters,omitempty"`
 }
 
+// ExtraMountList is a list of ExtraMount structures with custom merge logic.
+type ExtraMountList []ExtraMount
+
 // ExtraMount wraps OCI Mount specification.
 type ExtraMount struct {
 	specs.Mount `yaml:",inline"`
@@ -1004,7 +1182,7 @@ type KubeletConfig struct {
 	//     Note that either `bind` or `rbind` are required in the `options`.
 	//   examples:
 	//     - value: kubeletExtraMountsExample
-	KubeletExtraMounts []ExtraMount `yaml:"extraMounts,omitempty"`
+	KubeletExtraMounts ExtraMountList `yaml:"extraMounts,omitempty"`
 	//   description: |
 	//     The `extraConfig` field is used to provide kubelet configuration overrides.
 	//
@@ -1052,7 +1230,7 @@ type NetworkConfig struct {
 	//     This can be further tuned through this configuration parameter.
 	//   examples:
 	//     - value: machineNetworkConfigExample.NetworkInterfaces
-	NetworkInterfaces []*Device `yaml:"interfaces,omitempty"`
+	NetworkInterfaces NetworkDeviceList `yaml:"interfaces,omitempty"`
 	//   description: |
 	//     Used to statically set the nameservers for the machine.
 	//     Defaults to `1.1.1.1` and `8.8.8.8`
@@ -1079,6 +1257,21 @@ type NetworkConfig struct {
 	//     - false
 	//     - no
 	NetworkDisableSearchDomain bool `yaml:"disableSearchDomain,omitempty"`
+	//   description: |
+	//     Configures policy routing rules.
+	//     Routing rules select the routing table to look up based on the source and destination address,
+	//     input and output interface and firewall mark of the packet.
+	//     Static routes can be put into a custom routing table with the `table` field of the route.
+	//   examples:
+	//     - value: networkConfigRoutingRulesExample
+	NetworkRoutingRules []*RoutingRule `yaml:"routingRules,omitempty"`
+	//   description: |
+	//     Configures the host DNS caching forwarder.
+	//     When enabled, `/etc/resolv.conf` points to the forwarder listening on the link-local address `169.254.116.108`,
+	//     and the forwarder sends the queries to the configured nameservers.
+	//   examples:
+	//     - value: networkConfigHostDNSExample
+	NetworkHostDNS *HostDNS `yaml:"hostDNS,omitempty"`
 }
 
 // InstallConfig represents the installation options for preparing a node.
@@ -1133,6 +1326,36 @@ type InstallConfig struct {
 	//     Indicates if MBR partition should be marked as bootable (active).
 	//     Should be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.
 	InstallLegacyBIOSSupport bool `yaml:"legacyBIOSSupport,omitempty"`
+	//   description: |
+	//     Configures the placement and the size of the `EPHEMERAL` partition.
+	//     By default, the `EPHEMERAL` partition occupies the rest of the installation disk.
+	//   examples:
+	//     - value: machineInstallEphemeralExample
+	InstallEphemeral *InstallEphemeralConfig `yaml:"ephemeral,omitempty"`
+}
+
+// InstallEphemeralConfig represents the `EPHEMERAL` partition placement and size.
+type InstallEphemeralConfig struct {
+	//   description: |
+	//     Look up the disk to place the `EPHEMERAL` partition on using disk attributes, like `install.diskSelector`.
+	//     If not set, the `EPHEMERAL` partition is created on the installation disk.
+	//     The `EPHEMERAL` partition is appended to the existing partitions of the disk,
+	//     and it is created on boot if the partition is missing (e.g. after the reset).
+	//   examples:
+	//     - value: machineInstallDiskSelectorExample
+	EphemeralDiskSelector *InstallDiskSelector `yaml:"diskSelector,omitempty"`
+	//   description: >
+	//     The minimum size of the `EPHEMERAL` partition: either bytes or human readable representation.
+	//     The installation fails if there is not enough free space on the disk.
+	//   examples:
+	//     - value: DiskSize(10000000000)
+	EphemeralMinSize DiskSize `yaml:"minSize,omitempty"`
+	//   description: >
+	//     The maximum size of the `EPHEMERAL` partition: either bytes or human readable representation.
+	//     If not set, the `EPHEMERAL` partition occupies all free space on the disk.
+	//   examples:
+	//     - value: DiskSize(100000000000)
+	EphemeralMaxSize DiskSize `yaml:"maxSize,omitempty"`
 }
 
 // InstallDiskSizeMatcher disk size condition parser.
@@ -1543,6 +1766,57 @@ type EtcdConfig struct {
 	//   examples:
 	//     - value: clusterEtcdSubnetExample
 	EtcdSubnet string `yaml:"subnet,omitempty"`
+	//   description: |
+	//     Scheduled etcd backups configuration.
+	//     Backups to S3 are taken by the etcd leader only.
+	//     Local backups are per-node: each control plane node keeps its own backups on the EPHEMERAL partition.
+	//   examples:
+	//     - value: clusterEtcdBackupExample
+	EtcdBackup *EtcdBackupConfig `yaml:"backup,omitempty"`
+}
+
+// EtcdBackupConfig represents the scheduled etcd backups configuration.
+type EtcdBackupConfig struct {
+	//   description: |
+	//     The interval between the backups.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//     Defaults to 6 hours.
+	BackupInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     The number of most recent backups to keep, older backups are removed.
+	//     Zero value keeps all the backups.
+	BackupRetention int `yaml:"retention,omitempty"`
+	//   description: |
+	//     The backup destination.
+	//     Either an absolute path under `/var` (on the EPHEMERAL partition),
+	//     or an S3 URL in the `s3://bucket/prefix` format.
+	//   examples:
+	//     - value: '"/var/lib/etcd-backups"'
+	//     - value: '"s3://etcd-backups/cluster1"'
+	BackupDestination string `yaml:"destination"`
+	//   description: |
+	//     The S3-compatible storage settings for the `s3://` destination.
+	BackupS3 *EtcdBackupS3Config `yaml:"s3,omitempty"`
+}
+
+// EtcdBackupS3Config represents the S3-compatible etcd backup destination settings.
+type EtcdBackupS3Config struct {
+	//   description: |
+	//     The endpoint of the S3-compatible storage.
+	//     If not set, AWS S3 is used.
+	//   examples:
+	//     - value: '"https://minio.example.com"'
+	S3Endpoint string `yaml:"endpoint,omitempty"`
+	//   description: |
+	//     The region of the bucket.
+	//     Defaults to `us-east-1`.
+	S3Region string `yaml:"region,omitempty"`
+	//   description: |
+	//     The access key ID.
+	S3AccessKeyID string `yaml:"accessKeyID"`
+	//   description: |
+	//     The secret access key.
+	S3SecretAccessKey string `yaml:"secretAccessKey"`
 }
 
 // ClusterNetworkConfig represents kube networking configuration options.
@@ -1683,6 +1957,45 @@ type DiskPartition struct {
 	DiskMountPoint string `yaml:"mountpoint,omitempty"`
 }
 
+// UserVolumeConfig represents the user volume.
+type UserVolumeConfig struct {
+	//   description: |
+	//     Name of the volume.
+	//     The volume partition is labeled as `u-<name>`, and it is mounted at `/var/mnt/<name>` by default.
+	//   examples:
+	//     - value: '"data"'
+	VolumeName string `yaml:"name"`
+	//   description: |
+	//     Look up the disk to create the volume on using disk attributes, like `install.diskSelector`.
+	//     The first disk matching all of the attributes is used.
+	//   examples:
+	//     - value: machineInstallDiskSelectorExample
+	VolumeDiskSelector *InstallDiskSelector `yaml:"diskSelector"`
+	//   description: >
+	//     The size of the volume partition: either bytes or human readable representation.
+	//     If `size:` is omitted, the partition occupies all free space on the disk,
+	//     and the partition and the filesystem are grown when the disk is resized.
+	//   examples:
+	//     - value: DiskSize(100000000000)
+	VolumeSize DiskSize `yaml:"size,omitempty"`
+	//   description: |
+	//     The filesystem to format the volume with, the default is `xfs`.
+	//   values:
+	//     - xfs
+	//     - ext4
+	VolumeFilesystem string `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     Where to mount the volume, it should be under `/var`.
+	//   examples:
+	//     - value: '"/var/lib/data"'
+	VolumeMountPoint string `yaml:"mountpoint,omitempty"`
+	//   description: |
+	//     Volume encryption configuration, same as for the system disk encryption.
+	//   examples:
+	//     - value: machineVolumeEncryptionExample
+	VolumeEncryption *EncryptionConfig `yaml:"encryption,omitempty"`
+}
+
 // EncryptionConfig represents partition encryption settings.
 type EncryptionConfig struct {
 	//   description: >
@@ -1732,6 +2045,10 @@ type EncryptionKey struct {
 	//     Deterministically generated key from the node UUID and PartitionLabel.
 	KeyNodeID *EncryptionKeyNodeID `yaml:"nodeID,omitempty"`
 	//   description: >
+	//     Key generated on the node and sealed by the KMS.
+	//     The sealed key is stored in the LUKS2 token, the KMS is required to unseal it on boot.
+	KeyKMS *EncryptionKeyKMS `yaml:"kms,omitempty"`
+	//   description: >
 	//     Key slot number for LUKS2 encryption.
 	KeySlot int `yaml:"slot"`
 }
@@ -1746,6 +2063,16 @@ type EncryptionKeyStatic struct {
 // EncryptionKeyNodeID represents deterministically generated key from the node UUID and PartitionLabel.
 type EncryptionKeyNodeID struct{}
 
+// EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server.
+type EncryptionKeyKMS struct {
+	//   description: >
+	//     KMS endpoint to Seal/Unseal the key.
+	//     The endpoint scheme defines the transport: `http://` (insecure) or `https://` (TLS).
+	//   examples:
+	//     - value: '"https://192.168.88.21:4443"'
+	KMSEndpoint string `yaml:"endpoint"`
+}
+
 // Env represents a set of environment variables.
 type Env = map[string]string
 
@@ -1790,6 +2117,9 @@ type ExtraHost struct {
 	HostAliases []string `yaml:"aliases"`
 }
 
+// NetworkDeviceList is a list of *Device structures with custom merge logic.
+type NetworkDeviceList []*Device
+
 // Device represents a network interface.
 type Device struct {
 	//   description: |
@@ -1821,7 +2151,7 @@ type Device struct {
 	//     If used in combination with DHCP, these routes will be appended to routes returned by DHCP server.
 	//   examples:
 	//     - value: networkConfigRoutesExample
-	DeviceRoutes []*Route `yaml:"routes,omitempty"`
+	DeviceRoutes RouteList `yaml:"routes,omitempty"`
 	//   description: Bond specific options.
 	//   examples:
 	//     - value: networkConfigBondExample
@@ -1831,7 +2161,7 @@ type Device struct {
 	//     - value: networkConfigBridgeExample
 	DeviceBridge *Bridge `yaml:"bridge,omitempty"`
 	//   description: VLAN specific options.
-	DeviceVlans []*Vlan `yaml:"vlans,omitempty"`
+	DeviceVlans VlanList `yaml:"vlans,omitempty"`
 	//   description: |
 	//     The interface's MTU.
 	//     If used in combination with DHCP, this will override any MTU settings returned from DHCP server.
@@ -1945,6 +2275,13 @@ type Bond struct {
 	//   description: The interfaces that make up the bond.
 	BondInterfaces []string `yaml:"interfaces"`
 	//   description: |
+	//     Picks the interfaces that make up the bond using the device selectors.
+	//     Every physical link matching any of the selectors is added to the bond.
+	//     Mutually exclusive with `interfaces`.
+	//   examples:
+	//     - value: networkConfigMemberSelectorsExample
+	BondDeviceSelectors []NetworkDeviceSelector `yaml:"deviceSelectors,omitempty"`
+	//   description: |
 	//     A bond option.
 	//     Please see the official kernel documentation.
 	//     Not supported at the moment.
@@ -2063,11 +2400,52 @@ type Bridge struct {
 	//   description: The interfaces that make up the bond.
 	BridgedInterfaces []string `yaml:"interfaces"`
 	//   description: |
+	//     Picks the interfaces that make up the bridge using the device selectors.
+	//     Every physical link matching any of the selectors is added to the bridge.
+	//     Mutually exclusive with `interfaces`.
+	//   examples:
+	//     - value: networkConfigMemberSelectorsExample
+	BridgeDeviceSelectors []NetworkDeviceSelector `yaml:"deviceSelectors,omitempty"`
+	//   description: |
 	//     A bridge option.
 	//     Please see the official kernel documentation.
 	BridgeSTP *STP `yaml:"stp,omitempty"`
+	//   description: |
+	//     VLAN filtering settings of the bridge.
+	//   examples:
+	//     - value: networkConfigBridgeVLANExample
+	BridgeVLAN *BridgeVLAN `yaml:"vlan,omitempty"`
+}
+
+// BridgeVLAN contains the VLAN filtering settings of a bridge interface.
+type BridgeVLAN struct {
+	//   description: Whether VLAN filtering is enabled on the bridge.
+	BridgeVLANFiltering *bool `yaml:"vlanFiltering"`
+	//   description: |
+	//     The PVID assigned to the bridge ports which don't have VLAN settings.
+	//     Defaults to 1.
+	BridgeVLANDefaultPVID uint16 `yaml:"defaultPVID,omitempty"`
+	//   description: |
+	//     VLAN settings of the bridge ports.
+	//     The VLANs not listed for the port (including the default PVID) are removed from the port.
+	BridgeVLANPorts []*BridgePortVLAN `yaml:"ports,omitempty"`
 }
 
+// BridgePortVLAN contains the VLAN settings of a bridge port.
+type BridgePortVLAN struct {
+	//   description: The interface name of the bridge port.
+	PortInterface string `yaml:"interface"`
+	//   description: |
+	//     The VLAN ID assigned to the untagged ingress traffic.
+	//     Traffic of this VLAN is egressed untagged.
+	PortPVID uint16 `yaml:"pvid,omitempty"`
+	//   description: The list of VLAN IDs the port is a tagged member of.
+	PortTaggedVLANs []uint16 `yaml:"taggedVLANs,omitempty"`
+}
+
+// VlanList is a list of *Vlan structures with custom merge logic.
+type VlanList []*Vlan
+
 // Vlan represents vlan settings for a device.
 type Vlan struct {
 	//   description: The addresses in CIDR notation or as plain IPs to use.
@@ -2075,7 +2453,7 @@ type Vlan struct {
 	// docgen:nodoc
 	VlanCIDR string `yaml:"cidr,omitempty"`
 	//   description: A list of routes associated with the VLAN.
-	VlanRoutes []*Route `yaml:"routes"`
+	VlanRoutes RouteList `yaml:"routes"`
 	//   description: Indicates if DHCP should be used.
 	VlanDHCP bool `yaml:"dhcp"`
 	//   description: The VLAN's ID.
@@ -2086,6 +2464,9 @@ type Vlan struct {
 	VlanVIP *DeviceVIPConfig `yaml:"vip,omitempty"`
 }
 
+// RouteList is a list of *Route structures with custom merge logic.
+type RouteList []*Route
+
 // Route represents a network route.
 type Route struct {
 	//   description: The route's network (destination).
@@ -2096,6 +2477,65 @@ type Route struct {
 	RouteSource string `yaml:"source,omitempty"`
 	//   description: The optional metric for the route.
 	RouteMetric uint32 `yaml:"metric,omitempty"`
+	//   description: |
+	//     The routing table to add the route to (table name or number).
+	//     Defaults to the `main` routing table.
+	//   examples:
+	//     - value: '"main"'
+	//     - value: '"100"'
+	RouteTable string `yaml:"table,omitempty"`
+}
+
+// RoutingRule represents a policy routing rule.
+type RoutingRule struct {
+	//   description: |
+	//     The rule priority, rules are evaluated in the order of increasing priority.
+	//     Priority should be unique for each address family, values 1-32765 are available for the user rules.
+	RulePriority uint32 `yaml:"priority"`
+	//   description: |
+	//     The source address prefix to match.
+	//     If neither `from` nor `to` are set, the rule applies to both IPv4 and IPv6.
+	RuleFrom string `yaml:"from,omitempty"`
+	//   description: The destination address prefix to match.
+	RuleTo string `yaml:"to,omitempty"`
+	//   description: The input interface name to match.
+	RuleIIFName string `yaml:"iif,omitempty"`
+	//   description: The output interface name to match.
+	RuleOIFName string `yaml:"oif,omitempty"`
+	//   description: The firewall mark to match.
+	RuleFwMark uint32 `yaml:"fwMark,omitempty"`
+	//   description: The firewall mark mask (if not set, firewall mark is matched exactly).
+	RuleFwMask uint32 `yaml:"fwMask,omitempty"`
+	//   description: The routing table to look up (table name or number).
+	//   examples:
+	//     - value: '"100"'
+	RuleTable string `yaml:"table"`
+}
+
+// HostDNS represents the host DNS caching forwarder configuration.
+type HostDNS struct {
+	//   description: |
+	//     Enable the host DNS caching forwarder.
+	HostDNSEnabled bool `yaml:"enabled"`
+	//   description: |
+	//     The maximum number of responses to cache.
+	//     Defaults to 1024.
+	HostDNSCacheSize int `yaml:"cacheSize,omitempty"`
+	//   description: |
+	//     Per-domain upstream nameservers.
+	//     Queries for the domain (and its subdomains) are sent to the listed nameservers instead of the default ones.
+	//     Static entries from `extraHostEntries` are answered by the forwarder directly.
+	HostDNSDomainUpstreams []*HostDNSDomainUpstream `yaml:"domainUpstreams,omitempty"`
+}
+
+// HostDNSDomainUpstream represents upstream nameservers for a domain.
+type HostDNSDomainUpstream struct {
+	//   description: The domain name.
+	//   examples:
+	//     - value: '"corp.example.com"'
+	UpstreamDomain string `yaml:"domain"`
+	//   description: The list of nameservers for the domain.
+	UpstreamNameservers []string `yaml:"nameservers"`
 }
 
 // RegistryMirrorConfig represents mirror configuration for a registry.
@@ -2175,6 +2615,66 @@ type FeaturesConfig struct {
 	//   description: |
 	//     Enable role-based access control (RBAC).
 	RBAC *bool `yaml:"rbac,omitempty"`
+	//   description: |
+	//     Custom roles for the role-based access control (RBAC).
+	//     Custom roles are assigned to the client certificates like the built-in roles (e.g. with `talosctl config new --roles`),
+	//     and they should be defined on all nodes the client talks to.
+	//   examples:
+	//     - value: machineFeaturesRBACRolesExample
+	RBACRoles []*RBACRole `yaml:"rbacRoles,omitempty"`
+	//   description: |
+	//     OpenID Connect (OIDC) login for the Talos API.
+	//     When configured, apid exchanges the ID tokens issued by the OIDC provider for short-lived client certificates (`talosctl config login`).
+	//     Requires `rbac` to be enabled.
+	//   examples:
+	//     - value: machineFeaturesOIDCExample
+	OIDCConfig *OIDCConfig `yaml:"oidc,omitempty"`
+}
+
+// RBACRole describes a custom role for the role-based access control (RBAC).
+type RBACRole struct {
+	//   description: |
+	//     Name of the role.
+	//     Names with the `os:` prefix are reserved for the built-in roles.
+	//   examples:
+	//     - value: '"sre"'
+	RoleName string `yaml:"name"`
+	//   description: |
+	//     List of the Talos API methods allowed for the role.
+	//     Each entry is either a full gRPC method name (`/machine.MachineService/Reboot`),
+	//     all methods of a service (`/machine.MachineService/*`),
+	//     or a built-in role name (`os:reader`) which allows all methods allowed for that role.
+	//   examples:
+	//     - value: '[]string{"os:reader", "/machine.MachineService/Reboot"}'
+	RoleAllow []string `yaml:"allow"`
+}
+
+// OIDCConfig describes the OpenID Connect (OIDC) login for the Talos API.
+type OIDCConfig struct {
+	//   description: |
+	//     The URL of the OIDC issuer.
+	//   examples:
+	//     - value: '"https://dex.example.com"'
+	OIDCIssuerURL string `yaml:"issuerURL"`
+	//   description: |
+	//     The client ID registered in the OIDC provider.
+	//     ID tokens should be issued for this client ID (audience).
+	//   examples:
+	//     - value: '"talos"'
+	OIDCClientID string `yaml:"clientID"`
+	//   description: |
+	//     The ID token claim containing the user groups.
+	//     Defaults to `groups`.
+	OIDCGroupsClaim string `yaml:"groupsClaim,omitempty"`
+	//   description: |
+	//     Maps the user groups to the Talos API roles (built-in or custom).
+	//     Users not in any of the listed groups are not allowed to log in.
+	OIDCGroupRoles map[string][]string `yaml:"groupRoles"`
+	//   description: |
+	//     The lifetime of the issued client certificates.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//     Defaults to 1 hour.
+	OIDCCertLifetime time.Duration `yaml:"certLifetime,omitempty"`
 }
 
 // VolumeMountConfig struct describes extra volume mount for the static pods.
@@ -2293,21 +2793,130 @@ type LoggingConfig struct {
 	// description: |
 	//   Logging destination.
 	LoggingDestinations []LoggingDestination `yaml:"destinations"`
+	// description: |
+	//   Durable on-disk spool for the log events sent to the destinations.
+	// examples:
+	//   - value: loggingSpoolExample
+	LoggingSpool *LoggingSpoolConfig `yaml:"spool,omitempty"`
+}
+
+// LoggingSpoolConfig struct configures the on-disk spool of the log events.
+type LoggingSpoolConfig struct {
+	// description: |
+	//   Enable the on-disk spool.
+	//
+	//   Log events are written to the spool on the EPHEMERAL partition before being sent,
+	//   and they are replayed in order once the destination is reachable, including after a reboot.
+	SpoolEnabled bool `yaml:"enabled"`
+	// description: |
+	//   Maximum size of the spool for each destination: either bytes or human readable representation.
+	//   When the spool is full, the oldest log events are dropped.
+	//   Defaults to 64 MiB.
+	// examples:
+	//   - name: Human readable representation.
+	//     value: DiskSize(128000000)
+	//   - name: Precise value in bytes.
+	//     value: 128 * 1024 * 1024
+	SpoolMaxSize DiskSize `yaml:"maxSize,omitempty"`
 }
 
 // LoggingDestination struct configures Talos logging destination.
 type LoggingDestination struct {
 	// description: |
-	//   Where to send logs. Supported protocols are "tcp" and "udp".
+	//   Where to send logs. Supported protocols are "tcp", "udp" and "tls".
 	// examples:
 	//   - value: loggingEndpointExample1
 	//   - value: loggingEndpointExample2
+	//   - value: loggingEndpointExample3
 	LoggingEndpoint *Endpoint `yaml:"endpoint"`
 	// description: |
 	//   Logs format.
+	//
+	//   - `json_lines`: JSON objects, newline-delimited over TCP and TLS.
+	//   - `syslog`: RFC 5424 syslog messages, with RFC 6587 octet-counting framing over TCP and TLS.
+	//   - `gelf`: GELF 1.1 messages, null byte delimited over TCP and TLS, chunked over UDP.
 	// values:
 	//   - json_lines
+	//   - syslog
+	//   - gelf
 	LoggingFormat string `yaml:"format"`
+	// description: |
+	//   TLS settings for the "tls" endpoint.
+	LoggingTLS *LoggingTLSConfig `yaml:"tls,omitempty"`
+	// description: |
+	//   Filter for the log events sent to the destination.
+	// examples:
+	//   - value: loggingFilterExample
+	LoggingFilter *LoggingFilterConfig `yaml:"filter,omitempty"`
+	// description: |
+	//   Static fields added to every log event sent to the destination.
+	// examples:
+	//   - value: loggingExtraFieldsExample
+	LoggingExtraFields map[string]string `yaml:"extraFields,omitempty"`
+}
+
+// LoggingTLSConfig struct configures the TLS connection to the logging destination.
+type LoggingTLSConfig struct {
+	// description: |
+	//   PEM encoded CA certificate to verify the logging endpoint certificate.
+	//   If not set, the system CA certificates are used.
+	TLSCA Base64Bytes `yaml:"ca,omitempty"`
+}
+
+// LoggingFilterConfig struct configures the log events sent to the logging destination.
+type LoggingFilterConfig struct {
+	// description: |
+	//   Minimum level of the log events to send.
+	// values:
+	//   - debug
+	//   - info
+	//   - warn
+	//   - error
+	FilterMinLevel string `yaml:"minLevel,omitempty"`
+	// description: |
+	//   Send only the logs of the listed services.
+	//   If not set, the logs of all services are sent.
+	FilterIncludeServices []string `yaml:"includeServices,omitempty"`
+	// description: |
+	//   Don't send the logs of the listed services.
+	FilterExcludeServices []string `yaml:"excludeServices,omitempty"`
+	// description: |
+	//   Send only the log events with the message matching the regular expression.
+	FilterMessageRegex string `yaml:"messageRegex,omitempty"`
+	// description: |
+	//   Sampling of the log events.
+	FilterSampling *LoggingSamplingConfig `yaml:"sampling,omitempty"`
+}
+
+// LoggingSamplingConfig struct configures sampling of the log events.
+//
+// Every second, the first `initial` log events of each service are sent, and after that every `thereafter`-th event.
+type LoggingSamplingConfig struct {
+	// description: |
+	//   Number of the log events of each service sent every second before the sampling starts.
+	SamplingInitial int `yaml:"initial"`
+	// description: |
+	//   Send every Nth log event after the initial ones.
+	//   If set to zero, the rest of the log events are dropped.
+	SamplingThereafter int `yaml:"thereafter"`
+}
+
+// MetricsConfig struct configures the Prometheus metrics endpoints.
+type MetricsConfig struct {
+	// description: |
+	//   Enable the Prometheus metrics endpoints.
+	//
+	//   When enabled, machined, apid and trustd serve the metrics over HTTP on the `/metrics` path
+	//   (ports 50010, 50011 and 50012 respectively), and the metrics are also available via the Talos API (`talosctl metrics`).
+	MetricsEnabled bool `yaml:"enabled"`
+	// description: |
+	//   IP address the metrics endpoints listen on.
+	//
+	//   The metrics endpoints are not authenticated, so the access is restricted by the listen address.
+	//   Defaults to `127.0.0.1`, which makes the endpoints reachable from the node itself only.
+	// examples:
+	//   - value: '"10.5.0.2"'
+	MetricsBindAddress string `yaml:"bindAddress,omitempty"`
 }
 
 // KernelConfig struct configures Talos Linux kernel.
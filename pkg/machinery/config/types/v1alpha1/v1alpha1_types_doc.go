@@ -23,6 +23,7 @@ var (
 	KubeletNodeIPConfigDoc            encoder.Doc
 	NetworkConfigDoc                  encoder.Doc
 	InstallConfigDoc                  encoder.Doc
+	InstallEphemeralConfigDoc         encoder.Doc
 	InstallDiskSelectorDoc            encoder.Doc
 	InstallExtensionConfigDoc         encoder.Doc
 	TimeConfigDoc                     encoder.Doc
@@ -37,16 +38,20 @@ var (
 	ProxyConfigDoc                    encoder.Doc
 	SchedulerConfigDoc                encoder.Doc
 	EtcdConfigDoc                     encoder.Doc
+	EtcdBackupConfigDoc               encoder.Doc
+	EtcdBackupS3ConfigDoc             encoder.Doc
 	ClusterNetworkConfigDoc           encoder.Doc
 	CNIConfigDoc                      encoder.Doc
 	ExternalCloudProviderConfigDoc    encoder.Doc
 	AdminKubeconfigConfigDoc          encoder.Doc
 	MachineDiskDoc                    encoder.Doc
 	DiskPartitionDoc                  encoder.Doc
+	UserVolumeConfigDoc               encoder.Doc
 	EncryptionConfigDoc               encoder.Doc
 	EncryptionKeyDoc                  encoder.Doc
 	EncryptionKeyStaticDoc            encoder.Doc
 	EncryptionKeyNodeIDDoc            encoder.Doc
+	EncryptionKeyKMSDoc               encoder.Doc
 	MachineFileDoc                    encoder.Doc
 	ExtraHostDoc                      encoder.Doc
 	DeviceDoc                         encoder.Doc
@@ -59,14 +64,21 @@ var (
 	BondDoc                           encoder.Doc
 	STPDoc                            encoder.Doc
 	BridgeDoc                         encoder.Doc
+	BridgeVLANDoc                     encoder.Doc
+	BridgePortVLANDoc                 encoder.Doc
 	VlanDoc                           encoder.Doc
 	RouteDoc                          encoder.Doc
+	RoutingRuleDoc                    encoder.Doc
+	HostDNSDoc                        encoder.Doc
+	HostDNSDomainUpstreamDoc          encoder.Doc
 	RegistryMirrorConfigDoc           encoder.Doc
 	RegistryConfigDoc                 encoder.Doc
 	RegistryAuthConfigDoc             encoder.Doc
 	RegistryTLSConfigDoc              encoder.Doc
 	SystemDiskEncryptionConfigDoc     encoder.Doc
 	FeaturesConfigDoc                 encoder.Doc
+	RBACRoleDoc                       encoder.Doc
+	OIDCConfigDoc                     encoder.Doc
 	VolumeMountConfigDoc              encoder.Doc
 	ClusterInlineManifestDoc          encoder.Doc
 	NetworkKubeSpanDoc                encoder.Doc
@@ -77,7 +89,12 @@ var (
 	RegistryServiceConfigDoc          encoder.Doc
 	UdevConfigDoc                     encoder.Doc
 	LoggingConfigDoc                  encoder.Doc
+	LoggingSpoolConfigDoc             encoder.Doc
 	LoggingDestinationDoc             encoder.Doc
+	LoggingTLSConfigDoc               encoder.Doc
+	LoggingFilterConfigDoc            encoder.Doc
+	LoggingSamplingConfigDoc          encoder.Doc
+	MetricsConfigDoc                  encoder.Doc
 	KernelConfigDoc                   encoder.Doc
 	KernelModuleConfigDoc             encoder.Doc
 )
@@ -141,7 +158,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 21)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 25)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -207,101 +224,129 @@ func init() {
 	MachineConfigDoc.Fields[8].Comments[encoder.LineComment] = "Used to partition, format and mount additional disks."
 
 	MachineConfigDoc.Fields[8].AddExample("MachineDisks list example.", machineDisksExample)
-	MachineConfigDoc.Fields[9].Name = "install"
-	MachineConfigDoc.Fields[9].Type = "InstallConfig"
+	MachineConfigDoc.Fields[9].Name = "volumes"
+	MachineConfigDoc.Fields[9].Type = "[]UserVolumeConfig"
 	MachineConfigDoc.Fields[9].Note = ""
-	MachineConfigDoc.Fields[9].Description = "Used to provide instructions for installations."
-	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "Used to provide instructions for installations."
-
-	MachineConfigDoc.Fields[9].AddExample("MachineInstall config usage example.", machineInstallExample)
-	MachineConfigDoc.Fields[10].Name = "files"
-	MachineConfigDoc.Fields[10].Type = "[]MachineFile"
-	MachineConfigDoc.Fields[10].Note = "Note: The specified `path` is relative to `/var`.\n"
-	MachineConfigDoc.Fields[10].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
-	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Allows the addition of user specified files."
-
-	MachineConfigDoc.Fields[10].AddExample("MachineFiles usage example.", machineFilesExample)
-	MachineConfigDoc.Fields[11].Name = "env"
-	MachineConfigDoc.Fields[11].Type = "Env"
-	MachineConfigDoc.Fields[11].Note = ""
-	MachineConfigDoc.Fields[11].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
-	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
+	MachineConfigDoc.Fields[9].Description = "User volumes managed by Talos.\nVolumes are partitioned, optionally encrypted, formatted and mounted by the controller,\nwhich keeps reconciling them with the configuration: new volumes are created without a reboot,\nand the volumes without fixed size are grown when the disk is resized.\nVolumes removed from the configuration are unmounted, but the partitions and the data are kept."
+	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "User volumes managed by Talos."
+
+	MachineConfigDoc.Fields[9].AddExample("", machineVolumesExample)
+	MachineConfigDoc.Fields[10].Name = "install"
+	MachineConfigDoc.Fields[10].Type = "InstallConfig"
+	MachineConfigDoc.Fields[10].Note = ""
+	MachineConfigDoc.Fields[10].Description = "Used to provide instructions for installations."
+	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Used to provide instructions for installations."
+
+	MachineConfigDoc.Fields[10].AddExample("MachineInstall config usage example.", machineInstallExample)
+	MachineConfigDoc.Fields[11].Name = "files"
+	MachineConfigDoc.Fields[11].Type = "[]MachineFile"
+	MachineConfigDoc.Fields[11].Note = "Note: The specified `path` is relative to `/var`.\n"
+	MachineConfigDoc.Fields[11].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
+	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "Allows the addition of user specified files."
+
+	MachineConfigDoc.Fields[11].AddExample("MachineFiles usage example.", machineFilesExample)
+	MachineConfigDoc.Fields[12].Name = "env"
+	MachineConfigDoc.Fields[12].Type = "Env"
+	MachineConfigDoc.Fields[12].Note = ""
+	MachineConfigDoc.Fields[12].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
+	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
 
-	MachineConfigDoc.Fields[11].AddExample("Environment variables definition examples.", machineEnvExamples[0])
+	MachineConfigDoc.Fields[12].AddExample("Environment variables definition examples.", machineEnvExamples[0])
 
-	MachineConfigDoc.Fields[11].AddExample("", machineEnvExamples[1])
+	MachineConfigDoc.Fields[12].AddExample("", machineEnvExamples[1])
 
-	MachineConfigDoc.Fields[11].AddExample("", machineEnvExamples[2])
-	MachineConfigDoc.Fields[11].Values = []string{
+	MachineConfigDoc.Fields[12].AddExample("", machineEnvExamples[2])
+	MachineConfigDoc.Fields[12].Values = []string{
 		"`GRPC_GO_LOG_VERBOSITY_LEVEL`",
 		"`GRPC_GO_LOG_SEVERITY_LEVEL`",
 		"`http_proxy`",
 		"`https_proxy`",
 		"`no_proxy`",
 	}
-	MachineConfigDoc.Fields[12].Name = "time"
-	MachineConfigDoc.Fields[12].Type = "TimeConfig"
-	MachineConfigDoc.Fields[12].Note = ""
-	MachineConfigDoc.Fields[12].Description = "Used to configure the machine's time settings."
-	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
-
-	MachineConfigDoc.Fields[12].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
-	MachineConfigDoc.Fields[13].Name = "sysctls"
-	MachineConfigDoc.Fields[13].Type = "map[string]string"
+	MachineConfigDoc.Fields[13].Name = "time"
+	MachineConfigDoc.Fields[13].Type = "TimeConfig"
 	MachineConfigDoc.Fields[13].Note = ""
-	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's sysctls."
-	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's time settings."
+	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
 
-	MachineConfigDoc.Fields[13].AddExample("MachineSysctls usage example.", machineSysctlsExample)
-	MachineConfigDoc.Fields[14].Name = "sysfs"
+	MachineConfigDoc.Fields[13].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
+	MachineConfigDoc.Fields[14].Name = "sysctls"
 	MachineConfigDoc.Fields[14].Type = "map[string]string"
 	MachineConfigDoc.Fields[14].Note = ""
-	MachineConfigDoc.Fields[14].Description = "Used to configure the machine's sysfs."
-	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure the machine's sysfs."
+	MachineConfigDoc.Fields[14].Description = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
 
-	MachineConfigDoc.Fields[14].AddExample("MachineSysfs usage example.", machineSysfsExample)
-	MachineConfigDoc.Fields[15].Name = "registries"
-	MachineConfigDoc.Fields[15].Type = "RegistriesConfig"
+	MachineConfigDoc.Fields[14].AddExample("MachineSysctls usage example.", machineSysctlsExample)
+	MachineConfigDoc.Fields[15].Name = "sysfs"
+	MachineConfigDoc.Fields[15].Type = "map[string]string"
 	MachineConfigDoc.Fields[15].Note = ""
-	MachineConfigDoc.Fields[15].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
-	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
+	MachineConfigDoc.Fields[15].Description = "Used to configure the machine's sysfs."
+	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the machine's sysfs."
 
-	MachineConfigDoc.Fields[15].AddExample("", machineConfigRegistriesExample)
-	MachineConfigDoc.Fields[16].Name = "systemDiskEncryption"
-	MachineConfigDoc.Fields[16].Type = "SystemDiskEncryptionConfig"
+	MachineConfigDoc.Fields[15].AddExample("MachineSysfs usage example.", machineSysfsExample)
+	MachineConfigDoc.Fields[16].Name = "registries"
+	MachineConfigDoc.Fields[16].Type = "RegistriesConfig"
 	MachineConfigDoc.Fields[16].Note = ""
-	MachineConfigDoc.Fields[16].Description = "Machine system disk encryption configuration.\nDefines each system partition encryption parameters."
-	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Machine system disk encryption configuration."
+	MachineConfigDoc.Fields[16].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
+	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
 
-	MachineConfigDoc.Fields[16].AddExample("", machineSystemDiskEncryptionExample)
-	MachineConfigDoc.Fields[17].Name = "features"
-	MachineConfigDoc.Fields[17].Type = "FeaturesConfig"
+	MachineConfigDoc.Fields[16].AddExample("", machineConfigRegistriesExample)
+	MachineConfigDoc.Fields[17].Name = "systemDiskEncryption"
+	MachineConfigDoc.Fields[17].Type = "SystemDiskEncryptionConfig"
 	MachineConfigDoc.Fields[17].Note = ""
-	MachineConfigDoc.Fields[17].Description = "Features describe individual Talos features that can be switched on or off."
-	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
+	MachineConfigDoc.Fields[17].Description = "Machine system disk encryption configuration.\nDefines each system partition encryption parameters."
+	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Machine system disk encryption configuration."
 
-	MachineConfigDoc.Fields[17].AddExample("", machineFeaturesExample)
-	MachineConfigDoc.Fields[18].Name = "udev"
-	MachineConfigDoc.Fields[18].Type = "UdevConfig"
+	MachineConfigDoc.Fields[17].AddExample("", machineSystemDiskEncryptionExample)
+	MachineConfigDoc.Fields[18].Name = "features"
+	MachineConfigDoc.Fields[18].Type = "FeaturesConfig"
 	MachineConfigDoc.Fields[18].Note = ""
-	MachineConfigDoc.Fields[18].Description = "Configures the udev system."
-	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Configures the udev system."
+	MachineConfigDoc.Fields[18].Description = "Features describe individual Talos features that can be switched on or off."
+	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
 
-	MachineConfigDoc.Fields[18].AddExample("", machineUdevExample)
-	MachineConfigDoc.Fields[19].Name = "logging"
-	MachineConfigDoc.Fields[19].Type = "LoggingConfig"
+	MachineConfigDoc.Fields[18].AddExample("", machineFeaturesExample)
+	MachineConfigDoc.Fields[19].Name = "udev"
+	MachineConfigDoc.Fields[19].Type = "UdevConfig"
 	MachineConfigDoc.Fields[19].Note = ""
-	MachineConfigDoc.Fields[19].Description = "Configures the logging system."
-	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "Configures the logging system."
+	MachineConfigDoc.Fields[19].Description = "Configures the udev system."
+	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "Configures the udev system."
 
-	MachineConfigDoc.Fields[19].AddExample("", machineLoggingExample)
-	MachineConfigDoc.Fields[20].Name = "kernel"
-	MachineConfigDoc.Fields[20].Type = "KernelConfig"
+	MachineConfigDoc.Fields[19].AddExample("", machineUdevExample)
+	MachineConfigDoc.Fields[20].Name = "logging"
+	MachineConfigDoc.Fields[20].Type = "LoggingConfig"
 	MachineConfigDoc.Fields[20].Note = ""
-	MachineConfigDoc.Fields[20].Description = "Configures the kernel."
-	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "Configures the kernel."
-
-	MachineConfigDoc.Fields[20].AddExample("", machineKernelExample)
+	MachineConfigDoc.Fields[20].Description = "Configures the logging system."
+	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "Configures the logging system."
+
+	MachineConfigDoc.Fields[20].AddExample("", machineLoggingExample)
+	MachineConfigDoc.Fields[21].Name = "metrics"
+	MachineConfigDoc.Fields[21].Type = "MetricsConfig"
+	MachineConfigDoc.Fields[21].Note = ""
+	MachineConfigDoc.Fields[21].Description = "Configures the Prometheus metrics endpoints of machined, apid and trustd."
+	MachineConfigDoc.Fields[21].Comments[encoder.LineComment] = "Configures the Prometheus metrics endpoints of machined, apid and trustd."
+
+	MachineConfigDoc.Fields[21].AddExample("", machineMetricsExample)
+	MachineConfigDoc.Fields[22].Name = "kernel"
+	MachineConfigDoc.Fields[22].Type = "KernelConfig"
+	MachineConfigDoc.Fields[22].Note = ""
+	MachineConfigDoc.Fields[22].Description = "Configures the kernel."
+	MachineConfigDoc.Fields[22].Comments[encoder.LineComment] = "Configures the kernel."
+
+	MachineConfigDoc.Fields[22].AddExample("", machineKernelExample)
+	MachineConfigDoc.Fields[23].Name = "nodeLabels"
+	MachineConfigDoc.Fields[23].Type = "map[string]string"
+	MachineConfigDoc.Fields[23].Note = ""
+	MachineConfigDoc.Fields[23].Description = "Configures the node labels for the machine.\n\nLabels are applied to the Kubernetes Node object and reconciled whenever the configuration changes.\nTalos keeps track of the labels it has applied, labels set by other means are not touched.\nLabels restricted by the `NodeRestriction` admission plugin (e.g. `node-role.kubernetes.io/*`) are not allowed, as labels are applied with the kubelet credentials."
+	MachineConfigDoc.Fields[23].Comments[encoder.LineComment] = "Configures the node labels for the machine."
+
+	MachineConfigDoc.Fields[23].AddExample("node labels example.", machineNodeLabelsExample)
+	MachineConfigDoc.Fields[24].Name = "nodeTaints"
+	MachineConfigDoc.Fields[24].Type = "map[string]string"
+	MachineConfigDoc.Fields[24].Note = ""
+	MachineConfigDoc.Fields[24].Description = "Configures the node taints for the machine.\n\nTaint value is specified as `value:Effect`, the value is optional.\nTaints are set by the kubelet when the node is registered and reconciled whenever the configuration changes.\nTalos keeps track of the taints it has applied, taints set by other means are not touched.\n\nNote: taints are applied with the kubelet credentials, so with the default `NodeRestriction` admission plugin taint changes take effect only when the node is registered again."
+	MachineConfigDoc.Fields[24].Comments[encoder.LineComment] = "Configures the node taints for the machine."
+
+	MachineConfigDoc.Fields[24].AddExample("node taints example.", machineNodeTaintsExample)
 
 	ClusterConfigDoc.Type = "ClusterConfig"
 	ClusterConfigDoc.Comments[encoder.LineComment] = "ClusterConfig represents the cluster-wide config values."
@@ -456,7 +501,7 @@ func init() {
 		"X-ExtraInfo": "info",
 	})
 	ClusterConfigDoc.Fields[20].Name = "inlineManifests"
-	ClusterConfigDoc.Fields[20].Type = "ClusterInlineManifests"
+	ClusterConfigDoc.Fields[20].Type = "[]ClusterInlineManifest"
 	ClusterConfigDoc.Fields[20].Note = ""
 	ClusterConfigDoc.Fields[20].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
 	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
@@ -646,7 +691,7 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 6)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 8)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
@@ -691,6 +736,20 @@ func init() {
 		"false",
 		"no",
 	}
+	NetworkConfigDoc.Fields[6].Name = "routingRules"
+	NetworkConfigDoc.Fields[6].Type = "[]RoutingRule"
+	NetworkConfigDoc.Fields[6].Note = ""
+	NetworkConfigDoc.Fields[6].Description = "Configures policy routing rules.\nRouting rules select the routing table to look up based on the source and destination address,\ninput and output interface and firewall mark of the packet.\nStatic routes can be put into a custom routing table with the `table` field of the route."
+	NetworkConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures policy routing rules."
+
+	NetworkConfigDoc.Fields[6].AddExample("", networkConfigRoutingRulesExample)
+	NetworkConfigDoc.Fields[7].Name = "hostDNS"
+	NetworkConfigDoc.Fields[7].Type = "HostDNS"
+	NetworkConfigDoc.Fields[7].Note = ""
+	NetworkConfigDoc.Fields[7].Description = "Configures the host DNS caching forwarder.\nWhen enabled, `/etc/resolv.conf` points to the forwarder listening on the link-local address `169.254.116.108`,\nand the forwarder sends the queries to the configured nameservers."
+	NetworkConfigDoc.Fields[7].Comments[encoder.LineComment] = "Configures the host DNS caching forwarder."
+
+	NetworkConfigDoc.Fields[7].AddExample("", networkConfigHostDNSExample)
 
 	InstallConfigDoc.Type = "InstallConfig"
 	InstallConfigDoc.Comments[encoder.LineComment] = "InstallConfig represents the installation options for preparing a node."
@@ -703,7 +762,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 8)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 9)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -768,17 +827,70 @@ func init() {
 	InstallConfigDoc.Fields[7].Note = ""
 	InstallConfigDoc.Fields[7].Description = "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme."
 	InstallConfigDoc.Fields[7].Comments[encoder.LineComment] = "Indicates if MBR partition should be marked as bootable (active)."
+	InstallConfigDoc.Fields[8].Name = "ephemeral"
+	InstallConfigDoc.Fields[8].Type = "InstallEphemeralConfig"
+	InstallConfigDoc.Fields[8].Note = ""
+	InstallConfigDoc.Fields[8].Description = "Configures the placement and the size of the `EPHEMERAL` partition.\nBy default, the `EPHEMERAL` partition occupies the rest of the installation disk."
+	InstallConfigDoc.Fields[8].Comments[encoder.LineComment] = "Configures the placement and the size of the `EPHEMERAL` partition."
+
+	InstallConfigDoc.Fields[8].AddExample("", machineInstallEphemeralExample)
+
+	InstallEphemeralConfigDoc.Type = "InstallEphemeralConfig"
+	InstallEphemeralConfigDoc.Comments[encoder.LineComment] = "InstallEphemeralConfig represents the `EPHEMERAL` partition placement and size."
+	InstallEphemeralConfigDoc.Description = "InstallEphemeralConfig represents the `EPHEMERAL` partition placement and size."
+
+	InstallEphemeralConfigDoc.AddExample("", machineInstallEphemeralExample)
+	InstallEphemeralConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "ephemeral",
+		},
+	}
+	InstallEphemeralConfigDoc.Fields = make([]encoder.Doc, 3)
+	InstallEphemeralConfigDoc.Fields[0].Name = "diskSelector"
+	InstallEphemeralConfigDoc.Fields[0].Type = "InstallDiskSelector"
+	InstallEphemeralConfigDoc.Fields[0].Note = ""
+	InstallEphemeralConfigDoc.Fields[0].Description = "Look up the disk to place the `EPHEMERAL` partition on using disk attributes, like `install.diskSelector`.\nIf not set, the `EPHEMERAL` partition is created on the installation disk.\nThe `EPHEMERAL` partition is appended to the existing partitions of the disk,\nand it is created on boot if the partition is missing (e.g. after the reset)."
+	InstallEphemeralConfigDoc.Fields[0].Comments[encoder.LineComment] = "Look up the disk to place the `EPHEMERAL` partition on using disk attributes, like `install.diskSelector`."
+
+	InstallEphemeralConfigDoc.Fields[0].AddExample("", machineInstallDiskSelectorExample)
+	InstallEphemeralConfigDoc.Fields[1].Name = "minSize"
+	InstallEphemeralConfigDoc.Fields[1].Type = "DiskSize"
+	InstallEphemeralConfigDoc.Fields[1].Note = ""
+	InstallEphemeralConfigDoc.Fields[1].Description = "The minimum size of the `EPHEMERAL` partition: either bytes or human readable representation. The installation fails if there is not enough free space on the disk."
+	InstallEphemeralConfigDoc.Fields[1].Comments[encoder.LineComment] = "The minimum size of the `EPHEMERAL` partition: either bytes or human readable representation. The installation fails if there is not enough free space on the disk."
+
+	InstallEphemeralConfigDoc.Fields[1].AddExample("", DiskSize(10000000000))
+	InstallEphemeralConfigDoc.Fields[2].Name = "maxSize"
+	InstallEphemeralConfigDoc.Fields[2].Type = "DiskSize"
+	InstallEphemeralConfigDoc.Fields[2].Note = ""
+	InstallEphemeralConfigDoc.Fields[2].Description = "The maximum size of the `EPHEMERAL` partition: either bytes or human readable representation. If not set, the `EPHEMERAL` partition occupies all free space on the disk."
+	InstallEphemeralConfigDoc.Fields[2].Comments[encoder.LineComment] = "The maximum size of the `EPHEMERAL` partition: either bytes or human readable representation. If not set, the `EPHEMERAL` partition occupies all free space on the disk."
+
+	InstallEphemeralConfigDoc.Fields[2].AddExample("", DiskSize(100000000000))
 
 	InstallDiskSelectorDoc.Type = "InstallDiskSelector"
 	InstallDiskSelectorDoc.Comments[encoder.LineComment] = "InstallDiskSelector represents a disk query parameters for the install disk lookup."
 	InstallDiskSelectorDoc.Description = "InstallDiskSelector represents a disk query parameters for the install disk lookup."
 
+	InstallDiskSelectorDoc.AddExample("", machineInstallDiskSelectorExample)
+
+	InstallDiskSelectorDoc.AddExample("", machineInstallDiskSelectorExample)
+
 	InstallDiskSelectorDoc.AddExample("", machineInstallDiskSelectorExample)
 	InstallDiskSelectorDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "InstallConfig",
 			FieldName: "diskSelector",
 		},
+		{
+			TypeName:  "InstallEphemeralConfig",
+			FieldName: "diskSelector",
+		},
+		{
+			TypeName:  "UserVolumeConfig",
+			FieldName: "diskSelector",
+		},
 	}
 	InstallDiskSelectorDoc.Fields = make([]encoder.Doc, 9)
 	InstallDiskSelectorDoc.Fields[0].Name = "size"
@@ -960,6 +1072,8 @@ func init() {
 	EndpointDoc.AddExample("", loggingEndpointExample1)
 
 	EndpointDoc.AddExample("", loggingEndpointExample2)
+
+	EndpointDoc.AddExample("", loggingEndpointExample3)
 	EndpointDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "ControlPlaneConfig",
@@ -1192,7 +1306,7 @@ func init() {
 			FieldName: "etcd",
 		},
 	}
-	EtcdConfigDoc.Fields = make([]encoder.Doc, 4)
+	EtcdConfigDoc.Fields = make([]encoder.Doc, 5)
 	EtcdConfigDoc.Fields[0].Name = "image"
 	EtcdConfigDoc.Fields[0].Type = "string"
 	EtcdConfigDoc.Fields[0].Note = ""
@@ -1220,6 +1334,83 @@ func init() {
 	EtcdConfigDoc.Fields[3].Comments[encoder.LineComment] = "The subnet from which the advertise URL should be."
 
 	EtcdConfigDoc.Fields[3].AddExample("", clusterEtcdSubnetExample)
+	EtcdConfigDoc.Fields[4].Name = "backup"
+	EtcdConfigDoc.Fields[4].Type = "EtcdBackupConfig"
+	EtcdConfigDoc.Fields[4].Note = ""
+	EtcdConfigDoc.Fields[4].Description = "Scheduled etcd backups configuration.\nBackups to S3 are taken by the etcd leader only.\nLocal backups are per-node: each control plane node keeps its own backups on the EPHEMERAL partition."
+	EtcdConfigDoc.Fields[4].Comments[encoder.LineComment] = "Scheduled etcd backups configuration."
+
+	EtcdConfigDoc.Fields[4].AddExample("", clusterEtcdBackupExample)
+
+	EtcdBackupConfigDoc.Type = "EtcdBackupConfig"
+	EtcdBackupConfigDoc.Comments[encoder.LineComment] = "EtcdBackupConfig represents the scheduled etcd backups configuration."
+	EtcdBackupConfigDoc.Description = "EtcdBackupConfig represents the scheduled etcd backups configuration."
+
+	EtcdBackupConfigDoc.AddExample("", clusterEtcdBackupExample)
+	EtcdBackupConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EtcdConfig",
+			FieldName: "backup",
+		},
+	}
+	EtcdBackupConfigDoc.Fields = make([]encoder.Doc, 4)
+	EtcdBackupConfigDoc.Fields[0].Name = "interval"
+	EtcdBackupConfigDoc.Fields[0].Type = "Duration"
+	EtcdBackupConfigDoc.Fields[0].Note = ""
+	EtcdBackupConfigDoc.Fields[0].Description = "The interval between the backups.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 6 hours."
+	EtcdBackupConfigDoc.Fields[0].Comments[encoder.LineComment] = "The interval between the backups."
+	EtcdBackupConfigDoc.Fields[1].Name = "retention"
+	EtcdBackupConfigDoc.Fields[1].Type = "int"
+	EtcdBackupConfigDoc.Fields[1].Note = ""
+	EtcdBackupConfigDoc.Fields[1].Description = "The number of most recent backups to keep, older backups are removed.\nZero value keeps all the backups."
+	EtcdBackupConfigDoc.Fields[1].Comments[encoder.LineComment] = "The number of most recent backups to keep, older backups are removed."
+	EtcdBackupConfigDoc.Fields[2].Name = "destination"
+	EtcdBackupConfigDoc.Fields[2].Type = "string"
+	EtcdBackupConfigDoc.Fields[2].Note = ""
+	EtcdBackupConfigDoc.Fields[2].Description = "The backup destination.\nEither an absolute path under `/var` (on the EPHEMERAL partition),\nor an S3 URL in the `s3://bucket/prefix` format."
+	EtcdBackupConfigDoc.Fields[2].Comments[encoder.LineComment] = "The backup destination."
+
+	EtcdBackupConfigDoc.Fields[2].AddExample("", "/var/lib/etcd-backups")
+
+	EtcdBackupConfigDoc.Fields[2].AddExample("", "s3://etcd-backups/cluster1")
+	EtcdBackupConfigDoc.Fields[3].Name = "s3"
+	EtcdBackupConfigDoc.Fields[3].Type = "EtcdBackupS3Config"
+	EtcdBackupConfigDoc.Fields[3].Note = ""
+	EtcdBackupConfigDoc.Fields[3].Description = "The S3-compatible storage settings for the `s3://` destination."
+	EtcdBackupConfigDoc.Fields[3].Comments[encoder.LineComment] = "The S3-compatible storage settings for the `s3://` destination."
+
+	EtcdBackupS3ConfigDoc.Type = "EtcdBackupS3Config"
+	EtcdBackupS3ConfigDoc.Comments[encoder.LineComment] = "EtcdBackupS3Config represents the S3-compatible etcd backup destination settings."
+	EtcdBackupS3ConfigDoc.Description = "EtcdBackupS3Config represents the S3-compatible etcd backup destination settings."
+	EtcdBackupS3ConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EtcdBackupConfig",
+			FieldName: "s3",
+		},
+	}
+	EtcdBackupS3ConfigDoc.Fields = make([]encoder.Doc, 4)
+	EtcdBackupS3ConfigDoc.Fields[0].Name = "endpoint"
+	EtcdBackupS3ConfigDoc.Fields[0].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[0].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[0].Description = "The endpoint of the S3-compatible storage.\nIf not set, AWS S3 is used."
+	EtcdBackupS3ConfigDoc.Fields[0].Comments[encoder.LineComment] = "The endpoint of the S3-compatible storage."
+
+	EtcdBackupS3ConfigDoc.Fields[0].AddExample("", "https://minio.example.com")
+	EtcdBackupS3ConfigDoc.Fields[1].Name = "region"
+	EtcdBackupS3ConfigDoc.Fields[1].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[1].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[1].Description = "The region of the bucket.\nDefaults to `us-east-1`."
+	EtcdBackupS3ConfigDoc.Fields[1].Comments[encoder.LineComment] = "The region of the bucket."
+	EtcdBackupS3ConfigDoc.Fields[2].Name = "accessKeyID"
+	EtcdBackupS3ConfigDoc.Fields[2].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[2].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[2].Description = "The access key ID."
+	EtcdBackupS3ConfigDoc.Fields[2].Comments[encoder.LineComment] = "The access key ID."
+	EtcdBackupS3ConfigDoc.Fields[3].Name = "secretAccessKey"
+	EtcdBackupS3ConfigDoc.Fields[3].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[3].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[3].Description = "The secret access key."
+	EtcdBackupS3ConfigDoc.Fields[3].Comments[encoder.LineComment] = "The secret access key."
 
 	ClusterNetworkConfigDoc.Type = "ClusterNetworkConfig"
 	ClusterNetworkConfigDoc.Comments[encoder.LineComment] = "ClusterNetworkConfig represents kube networking configuration options."
@@ -1390,10 +1581,73 @@ func init() {
 	DiskPartitionDoc.Fields[1].Description = "Where to mount the partition."
 	DiskPartitionDoc.Fields[1].Comments[encoder.LineComment] = "Where to mount the partition."
 
+	UserVolumeConfigDoc.Type = "UserVolumeConfig"
+	UserVolumeConfigDoc.Comments[encoder.LineComment] = "UserVolumeConfig represents the user volume."
+	UserVolumeConfigDoc.Description = "UserVolumeConfig represents the user volume."
+
+	UserVolumeConfigDoc.AddExample("", machineVolumesExample)
+	UserVolumeConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "volumes",
+		},
+	}
+	UserVolumeConfigDoc.Fields = make([]encoder.Doc, 6)
+	UserVolumeConfigDoc.Fields[0].Name = "name"
+	UserVolumeConfigDoc.Fields[0].Type = "string"
+	UserVolumeConfigDoc.Fields[0].Note = ""
+	UserVolumeConfigDoc.Fields[0].Description = "Name of the volume.\nThe volume partition is labeled as `u-<name>`, and it is mounted at `/var/mnt/<name>` by default."
+	UserVolumeConfigDoc.Fields[0].Comments[encoder.LineComment] = "Name of the volume."
+
+	UserVolumeConfigDoc.Fields[0].AddExample("", "data")
+	UserVolumeConfigDoc.Fields[1].Name = "diskSelector"
+	UserVolumeConfigDoc.Fields[1].Type = "InstallDiskSelector"
+	UserVolumeConfigDoc.Fields[1].Note = ""
+	UserVolumeConfigDoc.Fields[1].Description = "Look up the disk to create the volume on using disk attributes, like `install.diskSelector`.\nThe first disk matching all of the attributes is used."
+	UserVolumeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Look up the disk to create the volume on using disk attributes, like `install.diskSelector`."
+
+	UserVolumeConfigDoc.Fields[1].AddExample("", machineInstallDiskSelectorExample)
+	UserVolumeConfigDoc.Fields[2].Name = "size"
+	UserVolumeConfigDoc.Fields[2].Type = "DiskSize"
+	UserVolumeConfigDoc.Fields[2].Note = ""
+	UserVolumeConfigDoc.Fields[2].Description = "The size of the volume partition: either bytes or human readable representation. If `size:` is omitted, the partition occupies all free space on the disk, and the partition and the filesystem are grown when the disk is resized."
+	UserVolumeConfigDoc.Fields[2].Comments[encoder.LineComment] = "The size of the volume partition: either bytes or human readable representation. If `size:` is omitted, the partition occupies all free space on the disk, and the partition and the filesystem are grown when the disk is resized."
+
+	UserVolumeConfigDoc.Fields[2].AddExample("", DiskSize(100000000000))
+	UserVolumeConfigDoc.Fields[3].Name = "filesystem"
+	UserVolumeConfigDoc.Fields[3].Type = "string"
+	UserVolumeConfigDoc.Fields[3].Note = ""
+	UserVolumeConfigDoc.Fields[3].Description = "The filesystem to format the volume with, the default is `xfs`."
+	UserVolumeConfigDoc.Fields[3].Comments[encoder.LineComment] = "The filesystem to format the volume with, the default is `xfs`."
+	UserVolumeConfigDoc.Fields[3].Values = []string{
+		"xfs",
+		"ext4",
+	}
+	UserVolumeConfigDoc.Fields[4].Name = "mountpoint"
+	UserVolumeConfigDoc.Fields[4].Type = "string"
+	UserVolumeConfigDoc.Fields[4].Note = ""
+	UserVolumeConfigDoc.Fields[4].Description = "Where to mount the volume, it should be under `/var`."
+	UserVolumeConfigDoc.Fields[4].Comments[encoder.LineComment] = "Where to mount the volume, it should be under `/var`."
+
+	UserVolumeConfigDoc.Fields[4].AddExample("", "/var/lib/data")
+	UserVolumeConfigDoc.Fields[5].Name = "encryption"
+	UserVolumeConfigDoc.Fields[5].Type = "EncryptionConfig"
+	UserVolumeConfigDoc.Fields[5].Note = ""
+	UserVolumeConfigDoc.Fields[5].Description = "Volume encryption configuration, same as for the system disk encryption."
+	UserVolumeConfigDoc.Fields[5].Comments[encoder.LineComment] = "Volume encryption configuration, same as for the system disk encryption."
+
+	UserVolumeConfigDoc.Fields[5].AddExample("", machineVolumeEncryptionExample)
+
 	EncryptionConfigDoc.Type = "EncryptionConfig"
 	EncryptionConfigDoc.Comments[encoder.LineComment] = "EncryptionConfig represents partition encryption settings."
 	EncryptionConfigDoc.Description = "EncryptionConfig represents partition encryption settings."
+
+	EncryptionConfigDoc.AddExample("", machineVolumeEncryptionExample)
 	EncryptionConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "UserVolumeConfig",
+			FieldName: "encryption",
+		},
 		{
 			TypeName:  "SystemDiskEncryptionConfig",
 			FieldName: "state",
@@ -1462,7 +1716,7 @@ func init() {
 			FieldName: "keys",
 		},
 	}
-	EncryptionKeyDoc.Fields = make([]encoder.Doc, 3)
+	EncryptionKeyDoc.Fields = make([]encoder.Doc, 4)
 	EncryptionKeyDoc.Fields[0].Name = "static"
 	EncryptionKeyDoc.Fields[0].Type = "EncryptionKeyStatic"
 	EncryptionKeyDoc.Fields[0].Note = ""
@@ -1473,11 +1727,16 @@ func init() {
 	EncryptionKeyDoc.Fields[1].Note = ""
 	EncryptionKeyDoc.Fields[1].Description = "Deterministically generated key from the node UUID and PartitionLabel."
 	EncryptionKeyDoc.Fields[1].Comments[encoder.LineComment] = "Deterministically generated key from the node UUID and PartitionLabel."
-	EncryptionKeyDoc.Fields[2].Name = "slot"
-	EncryptionKeyDoc.Fields[2].Type = "int"
+	EncryptionKeyDoc.Fields[2].Name = "kms"
+	EncryptionKeyDoc.Fields[2].Type = "EncryptionKeyKMS"
 	EncryptionKeyDoc.Fields[2].Note = ""
-	EncryptionKeyDoc.Fields[2].Description = "Key slot number for LUKS2 encryption."
-	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[2].Description = "Key generated on the node and sealed by the KMS. The sealed key is stored in the LUKS2 token, the KMS is required to unseal it on boot."
+	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Key generated on the node and sealed by the KMS. The sealed key is stored in the LUKS2 token, the KMS is required to unseal it on boot."
+	EncryptionKeyDoc.Fields[3].Name = "slot"
+	EncryptionKeyDoc.Fields[3].Type = "int"
+	EncryptionKeyDoc.Fields[3].Note = ""
+	EncryptionKeyDoc.Fields[3].Description = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[3].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
 
 	EncryptionKeyStaticDoc.Type = "EncryptionKeyStatic"
 	EncryptionKeyStaticDoc.Comments[encoder.LineComment] = "EncryptionKeyStatic represents throw away key type."
@@ -1506,6 +1765,24 @@ func init() {
 	}
 	EncryptionKeyNodeIDDoc.Fields = make([]encoder.Doc, 0)
 
+	EncryptionKeyKMSDoc.Type = "EncryptionKeyKMS"
+	EncryptionKeyKMSDoc.Comments[encoder.LineComment] = "EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server."
+	EncryptionKeyKMSDoc.Description = "EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server."
+	EncryptionKeyKMSDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "kms",
+		},
+	}
+	EncryptionKeyKMSDoc.Fields = make([]encoder.Doc, 1)
+	EncryptionKeyKMSDoc.Fields[0].Name = "endpoint"
+	EncryptionKeyKMSDoc.Fields[0].Type = "string"
+	EncryptionKeyKMSDoc.Fields[0].Note = ""
+	EncryptionKeyKMSDoc.Fields[0].Description = "KMS endpoint to Seal/Unseal the key. The endpoint scheme defines the transport: `http://` (insecure) or `https://` (TLS)."
+	EncryptionKeyKMSDoc.Fields[0].Comments[encoder.LineComment] = "KMS endpoint to Seal/Unseal the key. The endpoint scheme defines the transport: `http://` (insecure) or `https://` (TLS)."
+
+	EncryptionKeyKMSDoc.Fields[0].AddExample("", "https://192.168.88.21:4443")
+
 	MachineFileDoc.Type = "MachineFile"
 	MachineFileDoc.Comments[encoder.LineComment] = "MachineFile represents a file to write to disk."
 	MachineFileDoc.Description = "MachineFile represents a file to write to disk."
@@ -1848,142 +2125,149 @@ func init() {
 			FieldName: "bond",
 		},
 	}
-	BondDoc.Fields = make([]encoder.Doc, 27)
+	BondDoc.Fields = make([]encoder.Doc, 28)
 	BondDoc.Fields[0].Name = "interfaces"
 	BondDoc.Fields[0].Type = "[]string"
 	BondDoc.Fields[0].Note = ""
 	BondDoc.Fields[0].Description = "The interfaces that make up the bond."
 	BondDoc.Fields[0].Comments[encoder.LineComment] = "The interfaces that make up the bond."
-	BondDoc.Fields[1].Name = "arpIPTarget"
-	BondDoc.Fields[1].Type = "[]string"
+	BondDoc.Fields[1].Name = "deviceSelectors"
+	BondDoc.Fields[1].Type = "[]NetworkDeviceSelector"
 	BondDoc.Fields[1].Note = ""
-	BondDoc.Fields[1].Description = "A bond option.\nPlease see the official kernel documentation.\nNot supported at the moment."
-	BondDoc.Fields[1].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[2].Name = "mode"
-	BondDoc.Fields[2].Type = "string"
+	BondDoc.Fields[1].Description = "Picks the interfaces that make up the bond using the device selectors.\nEvery physical link matching any of the selectors is added to the bond.\nMutually exclusive with `interfaces`."
+	BondDoc.Fields[1].Comments[encoder.LineComment] = "Picks the interfaces that make up the bond using the device selectors."
+
+	BondDoc.Fields[1].AddExample("", networkConfigMemberSelectorsExample)
+	BondDoc.Fields[2].Name = "arpIPTarget"
+	BondDoc.Fields[2].Type = "[]string"
 	BondDoc.Fields[2].Note = ""
-	BondDoc.Fields[2].Description = "A bond option.\nPlease see the official kernel documentation."
+	BondDoc.Fields[2].Description = "A bond option.\nPlease see the official kernel documentation.\nNot supported at the moment."
 	BondDoc.Fields[2].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[3].Name = "xmitHashPolicy"
+	BondDoc.Fields[3].Name = "mode"
 	BondDoc.Fields[3].Type = "string"
 	BondDoc.Fields[3].Note = ""
 	BondDoc.Fields[3].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[3].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[4].Name = "lacpRate"
+	BondDoc.Fields[4].Name = "xmitHashPolicy"
 	BondDoc.Fields[4].Type = "string"
 	BondDoc.Fields[4].Note = ""
 	BondDoc.Fields[4].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[4].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[5].Name = "adActorSystem"
+	BondDoc.Fields[5].Name = "lacpRate"
 	BondDoc.Fields[5].Type = "string"
 	BondDoc.Fields[5].Note = ""
-	BondDoc.Fields[5].Description = "A bond option.\nPlease see the official kernel documentation.\nNot supported at the moment."
+	BondDoc.Fields[5].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[5].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[6].Name = "arpValidate"
+	BondDoc.Fields[6].Name = "adActorSystem"
 	BondDoc.Fields[6].Type = "string"
 	BondDoc.Fields[6].Note = ""
-	BondDoc.Fields[6].Description = "A bond option.\nPlease see the official kernel documentation."
+	BondDoc.Fields[6].Description = "A bond option.\nPlease see the official kernel documentation.\nNot supported at the moment."
 	BondDoc.Fields[6].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[7].Name = "arpAllTargets"
+	BondDoc.Fields[7].Name = "arpValidate"
 	BondDoc.Fields[7].Type = "string"
 	BondDoc.Fields[7].Note = ""
 	BondDoc.Fields[7].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[7].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[8].Name = "primary"
+	BondDoc.Fields[8].Name = "arpAllTargets"
 	BondDoc.Fields[8].Type = "string"
 	BondDoc.Fields[8].Note = ""
 	BondDoc.Fields[8].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[8].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[9].Name = "primaryReselect"
+	BondDoc.Fields[9].Name = "primary"
 	BondDoc.Fields[9].Type = "string"
 	BondDoc.Fields[9].Note = ""
 	BondDoc.Fields[9].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[9].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[10].Name = "failOverMac"
+	BondDoc.Fields[10].Name = "primaryReselect"
 	BondDoc.Fields[10].Type = "string"
 	BondDoc.Fields[10].Note = ""
 	BondDoc.Fields[10].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[10].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[11].Name = "adSelect"
+	BondDoc.Fields[11].Name = "failOverMac"
 	BondDoc.Fields[11].Type = "string"
 	BondDoc.Fields[11].Note = ""
 	BondDoc.Fields[11].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[11].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[12].Name = "miimon"
-	BondDoc.Fields[12].Type = "uint32"
+	BondDoc.Fields[12].Name = "adSelect"
+	BondDoc.Fields[12].Type = "string"
 	BondDoc.Fields[12].Note = ""
 	BondDoc.Fields[12].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[12].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[13].Name = "updelay"
+	BondDoc.Fields[13].Name = "miimon"
 	BondDoc.Fields[13].Type = "uint32"
 	BondDoc.Fields[13].Note = ""
 	BondDoc.Fields[13].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[13].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[14].Name = "downdelay"
+	BondDoc.Fields[14].Name = "updelay"
 	BondDoc.Fields[14].Type = "uint32"
 	BondDoc.Fields[14].Note = ""
 	BondDoc.Fields[14].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[14].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[15].Name = "arpInterval"
+	BondDoc.Fields[15].Name = "downdelay"
 	BondDoc.Fields[15].Type = "uint32"
 	BondDoc.Fields[15].Note = ""
 	BondDoc.Fields[15].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[15].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[16].Name = "resendIgmp"
+	BondDoc.Fields[16].Name = "arpInterval"
 	BondDoc.Fields[16].Type = "uint32"
 	BondDoc.Fields[16].Note = ""
 	BondDoc.Fields[16].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[16].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[17].Name = "minLinks"
+	BondDoc.Fields[17].Name = "resendIgmp"
 	BondDoc.Fields[17].Type = "uint32"
 	BondDoc.Fields[17].Note = ""
 	BondDoc.Fields[17].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[17].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[18].Name = "lpInterval"
+	BondDoc.Fields[18].Name = "minLinks"
 	BondDoc.Fields[18].Type = "uint32"
 	BondDoc.Fields[18].Note = ""
 	BondDoc.Fields[18].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[18].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[19].Name = "packetsPerSlave"
+	BondDoc.Fields[19].Name = "lpInterval"
 	BondDoc.Fields[19].Type = "uint32"
 	BondDoc.Fields[19].Note = ""
 	BondDoc.Fields[19].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[19].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[20].Name = "numPeerNotif"
-	BondDoc.Fields[20].Type = "uint8"
+	BondDoc.Fields[20].Name = "packetsPerSlave"
+	BondDoc.Fields[20].Type = "uint32"
 	BondDoc.Fields[20].Note = ""
 	BondDoc.Fields[20].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[20].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[21].Name = "tlbDynamicLb"
+	BondDoc.Fields[21].Name = "numPeerNotif"
 	BondDoc.Fields[21].Type = "uint8"
 	BondDoc.Fields[21].Note = ""
 	BondDoc.Fields[21].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[21].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[22].Name = "allSlavesActive"
+	BondDoc.Fields[22].Name = "tlbDynamicLb"
 	BondDoc.Fields[22].Type = "uint8"
 	BondDoc.Fields[22].Note = ""
 	BondDoc.Fields[22].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[22].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[23].Name = "useCarrier"
-	BondDoc.Fields[23].Type = "bool"
+	BondDoc.Fields[23].Name = "allSlavesActive"
+	BondDoc.Fields[23].Type = "uint8"
 	BondDoc.Fields[23].Note = ""
 	BondDoc.Fields[23].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[23].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[24].Name = "adActorSysPrio"
-	BondDoc.Fields[24].Type = "uint16"
+	BondDoc.Fields[24].Name = "useCarrier"
+	BondDoc.Fields[24].Type = "bool"
 	BondDoc.Fields[24].Note = ""
 	BondDoc.Fields[24].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[24].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[25].Name = "adUserPortKey"
+	BondDoc.Fields[25].Name = "adActorSysPrio"
 	BondDoc.Fields[25].Type = "uint16"
 	BondDoc.Fields[25].Note = ""
 	BondDoc.Fields[25].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[25].Comments[encoder.LineComment] = "A bond option."
-	BondDoc.Fields[26].Name = "peerNotifyDelay"
-	BondDoc.Fields[26].Type = "uint32"
+	BondDoc.Fields[26].Name = "adUserPortKey"
+	BondDoc.Fields[26].Type = "uint16"
 	BondDoc.Fields[26].Note = ""
 	BondDoc.Fields[26].Description = "A bond option.\nPlease see the official kernel documentation."
 	BondDoc.Fields[26].Comments[encoder.LineComment] = "A bond option."
+	BondDoc.Fields[27].Name = "peerNotifyDelay"
+	BondDoc.Fields[27].Type = "uint32"
+	BondDoc.Fields[27].Note = ""
+	BondDoc.Fields[27].Description = "A bond option.\nPlease see the official kernel documentation."
+	BondDoc.Fields[27].Comments[encoder.LineComment] = "A bond option."
 
 	STPDoc.Type = "STP"
 	STPDoc.Comments[encoder.LineComment] = "STP contains the various options for configuring the STP properties of a bridge interface."
@@ -2012,17 +2296,85 @@ func init() {
 			FieldName: "bridge",
 		},
 	}
-	BridgeDoc.Fields = make([]encoder.Doc, 2)
+	BridgeDoc.Fields = make([]encoder.Doc, 4)
 	BridgeDoc.Fields[0].Name = "interfaces"
 	BridgeDoc.Fields[0].Type = "[]string"
 	BridgeDoc.Fields[0].Note = ""
 	BridgeDoc.Fields[0].Description = "The interfaces that make up the bond."
 	BridgeDoc.Fields[0].Comments[encoder.LineComment] = "The interfaces that make up the bond."
-	BridgeDoc.Fields[1].Name = "stp"
-	BridgeDoc.Fields[1].Type = "STP"
+	BridgeDoc.Fields[1].Name = "deviceSelectors"
+	BridgeDoc.Fields[1].Type = "[]NetworkDeviceSelector"
 	BridgeDoc.Fields[1].Note = ""
-	BridgeDoc.Fields[1].Description = "A bridge option.\nPlease see the official kernel documentation."
-	BridgeDoc.Fields[1].Comments[encoder.LineComment] = "A bridge option."
+	BridgeDoc.Fields[1].Description = "Picks the interfaces that make up the bridge using the device selectors.\nEvery physical link matching any of the selectors is added to the bridge.\nMutually exclusive with `interfaces`."
+	BridgeDoc.Fields[1].Comments[encoder.LineComment] = "Picks the interfaces that make up the bridge using the device selectors."
+
+	BridgeDoc.Fields[1].AddExample("", networkConfigMemberSelectorsExample)
+	BridgeDoc.Fields[2].Name = "stp"
+	BridgeDoc.Fields[2].Type = "STP"
+	BridgeDoc.Fields[2].Note = ""
+	BridgeDoc.Fields[2].Description = "A bridge option.\nPlease see the official kernel documentation."
+	BridgeDoc.Fields[2].Comments[encoder.LineComment] = "A bridge option."
+	BridgeDoc.Fields[3].Name = "vlan"
+	BridgeDoc.Fields[3].Type = "BridgeVLAN"
+	BridgeDoc.Fields[3].Note = ""
+	BridgeDoc.Fields[3].Description = "VLAN filtering settings of the bridge."
+	BridgeDoc.Fields[3].Comments[encoder.LineComment] = "VLAN filtering settings of the bridge."
+
+	BridgeDoc.Fields[3].AddExample("", networkConfigBridgeVLANExample)
+
+	BridgeVLANDoc.Type = "BridgeVLAN"
+	BridgeVLANDoc.Comments[encoder.LineComment] = "BridgeVLAN contains the VLAN filtering settings of a bridge interface."
+	BridgeVLANDoc.Description = "BridgeVLAN contains the VLAN filtering settings of a bridge interface."
+
+	BridgeVLANDoc.AddExample("", networkConfigBridgeVLANExample)
+	BridgeVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Bridge",
+			FieldName: "vlan",
+		},
+	}
+	BridgeVLANDoc.Fields = make([]encoder.Doc, 3)
+	BridgeVLANDoc.Fields[0].Name = "vlanFiltering"
+	BridgeVLANDoc.Fields[0].Type = "bool"
+	BridgeVLANDoc.Fields[0].Note = ""
+	BridgeVLANDoc.Fields[0].Description = "Whether VLAN filtering is enabled on the bridge."
+	BridgeVLANDoc.Fields[0].Comments[encoder.LineComment] = "Whether VLAN filtering is enabled on the bridge."
+	BridgeVLANDoc.Fields[1].Name = "defaultPVID"
+	BridgeVLANDoc.Fields[1].Type = "uint16"
+	BridgeVLANDoc.Fields[1].Note = ""
+	BridgeVLANDoc.Fields[1].Description = "The PVID assigned to the bridge ports which don't have VLAN settings.\nDefaults to 1."
+	BridgeVLANDoc.Fields[1].Comments[encoder.LineComment] = "The PVID assigned to the bridge ports which don't have VLAN settings."
+	BridgeVLANDoc.Fields[2].Name = "ports"
+	BridgeVLANDoc.Fields[2].Type = "[]BridgePortVLAN"
+	BridgeVLANDoc.Fields[2].Note = ""
+	BridgeVLANDoc.Fields[2].Description = "VLAN settings of the bridge ports.\nThe VLANs not listed for the port (including the default PVID) are removed from the port."
+	BridgeVLANDoc.Fields[2].Comments[encoder.LineComment] = "VLAN settings of the bridge ports."
+
+	BridgePortVLANDoc.Type = "BridgePortVLAN"
+	BridgePortVLANDoc.Comments[encoder.LineComment] = "BridgePortVLAN contains the VLAN settings of a bridge port."
+	BridgePortVLANDoc.Description = "BridgePortVLAN contains the VLAN settings of a bridge port."
+	BridgePortVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "BridgeVLAN",
+			FieldName: "ports",
+		},
+	}
+	BridgePortVLANDoc.Fields = make([]encoder.Doc, 3)
+	BridgePortVLANDoc.Fields[0].Name = "interface"
+	BridgePortVLANDoc.Fields[0].Type = "string"
+	BridgePortVLANDoc.Fields[0].Note = ""
+	BridgePortVLANDoc.Fields[0].Description = "The interface name of the bridge port."
+	BridgePortVLANDoc.Fields[0].Comments[encoder.LineComment] = "The interface name of the bridge port."
+	BridgePortVLANDoc.Fields[1].Name = "pvid"
+	BridgePortVLANDoc.Fields[1].Type = "uint16"
+	BridgePortVLANDoc.Fields[1].Note = ""
+	BridgePortVLANDoc.Fields[1].Description = "The VLAN ID assigned to the untagged ingress traffic.\nTraffic of this VLAN is egressed untagged."
+	BridgePortVLANDoc.Fields[1].Comments[encoder.LineComment] = "The VLAN ID assigned to the untagged ingress traffic."
+	BridgePortVLANDoc.Fields[2].Name = "taggedVLANs"
+	BridgePortVLANDoc.Fields[2].Type = "[]uint16"
+	BridgePortVLANDoc.Fields[2].Note = ""
+	BridgePortVLANDoc.Fields[2].Description = "The list of VLAN IDs the port is a tagged member of."
+	BridgePortVLANDoc.Fields[2].Comments[encoder.LineComment] = "The list of VLAN IDs the port is a tagged member of."
 
 	VlanDoc.Type = "Vlan"
 	VlanDoc.Comments[encoder.LineComment] = "Vlan represents vlan settings for a device."
@@ -2080,7 +2432,7 @@ func init() {
 			FieldName: "routes",
 		},
 	}
-	RouteDoc.Fields = make([]encoder.Doc, 4)
+	RouteDoc.Fields = make([]encoder.Doc, 5)
 	RouteDoc.Fields[0].Name = "network"
 	RouteDoc.Fields[0].Type = "string"
 	RouteDoc.Fields[0].Note = ""
@@ -2101,6 +2453,121 @@ func init() {
 	RouteDoc.Fields[3].Note = ""
 	RouteDoc.Fields[3].Description = "The optional metric for the route."
 	RouteDoc.Fields[3].Comments[encoder.LineComment] = "The optional metric for the route."
+	RouteDoc.Fields[4].Name = "table"
+	RouteDoc.Fields[4].Type = "string"
+	RouteDoc.Fields[4].Note = ""
+	RouteDoc.Fields[4].Description = "The routing table to add the route to (table name or number).\nDefaults to the `main` routing table."
+	RouteDoc.Fields[4].Comments[encoder.LineComment] = "The routing table to add the route to (table name or number)."
+
+	RouteDoc.Fields[4].AddExample("", "main")
+
+	RouteDoc.Fields[4].AddExample("", "100")
+
+	RoutingRuleDoc.Type = "RoutingRule"
+	RoutingRuleDoc.Comments[encoder.LineComment] = "RoutingRule represents a policy routing rule."
+	RoutingRuleDoc.Description = "RoutingRule represents a policy routing rule."
+
+	RoutingRuleDoc.AddExample("", networkConfigRoutingRulesExample)
+	RoutingRuleDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "routingRules",
+		},
+	}
+	RoutingRuleDoc.Fields = make([]encoder.Doc, 8)
+	RoutingRuleDoc.Fields[0].Name = "priority"
+	RoutingRuleDoc.Fields[0].Type = "uint32"
+	RoutingRuleDoc.Fields[0].Note = ""
+	RoutingRuleDoc.Fields[0].Description = "The rule priority, rules are evaluated in the order of increasing priority.\nPriority should be unique for each address family, values 1-32765 are available for the user rules."
+	RoutingRuleDoc.Fields[0].Comments[encoder.LineComment] = "The rule priority, rules are evaluated in the order of increasing priority."
+	RoutingRuleDoc.Fields[1].Name = "from"
+	RoutingRuleDoc.Fields[1].Type = "string"
+	RoutingRuleDoc.Fields[1].Note = ""
+	RoutingRuleDoc.Fields[1].Description = "The source address prefix to match.\nIf neither `from` nor `to` are set, the rule applies to both IPv4 and IPv6."
+	RoutingRuleDoc.Fields[1].Comments[encoder.LineComment] = "The source address prefix to match."
+	RoutingRuleDoc.Fields[2].Name = "to"
+	RoutingRuleDoc.Fields[2].Type = "string"
+	RoutingRuleDoc.Fields[2].Note = ""
+	RoutingRuleDoc.Fields[2].Description = "The destination address prefix to match."
+	RoutingRuleDoc.Fields[2].Comments[encoder.LineComment] = "The destination address prefix to match."
+	RoutingRuleDoc.Fields[3].Name = "iif"
+	RoutingRuleDoc.Fields[3].Type = "string"
+	RoutingRuleDoc.Fields[3].Note = ""
+	RoutingRuleDoc.Fields[3].Description = "The input interface name to match."
+	RoutingRuleDoc.Fields[3].Comments[encoder.LineComment] = "The input interface name to match."
+	RoutingRuleDoc.Fields[4].Name = "oif"
+	RoutingRuleDoc.Fields[4].Type = "string"
+	RoutingRuleDoc.Fields[4].Note = ""
+	RoutingRuleDoc.Fields[4].Description = "The output interface name to match."
+	RoutingRuleDoc.Fields[4].Comments[encoder.LineComment] = "The output interface name to match."
+	RoutingRuleDoc.Fields[5].Name = "fwMark"
+	RoutingRuleDoc.Fields[5].Type = "uint32"
+	RoutingRuleDoc.Fields[5].Note = ""
+	RoutingRuleDoc.Fields[5].Description = "The firewall mark to match."
+	RoutingRuleDoc.Fields[5].Comments[encoder.LineComment] = "The firewall mark to match."
+	RoutingRuleDoc.Fields[6].Name = "fwMask"
+	RoutingRuleDoc.Fields[6].Type = "uint32"
+	RoutingRuleDoc.Fields[6].Note = ""
+	RoutingRuleDoc.Fields[6].Description = "The firewall mark mask (if not set, firewall mark is matched exactly)."
+	RoutingRuleDoc.Fields[6].Comments[encoder.LineComment] = "The firewall mark mask (if not set, firewall mark is matched exactly)."
+	RoutingRuleDoc.Fields[7].Name = "table"
+	RoutingRuleDoc.Fields[7].Type = "string"
+	RoutingRuleDoc.Fields[7].Note = ""
+	RoutingRuleDoc.Fields[7].Description = "The routing table to look up (table name or number)."
+	RoutingRuleDoc.Fields[7].Comments[encoder.LineComment] = "The routing table to look up (table name or number)."
+
+	RoutingRuleDoc.Fields[7].AddExample("", "100")
+
+	HostDNSDoc.Type = "HostDNS"
+	HostDNSDoc.Comments[encoder.LineComment] = "HostDNS represents the host DNS caching forwarder configuration."
+	HostDNSDoc.Description = "HostDNS represents the host DNS caching forwarder configuration."
+
+	HostDNSDoc.AddExample("", networkConfigHostDNSExample)
+	HostDNSDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "hostDNS",
+		},
+	}
+	HostDNSDoc.Fields = make([]encoder.Doc, 3)
+	HostDNSDoc.Fields[0].Name = "enabled"
+	HostDNSDoc.Fields[0].Type = "bool"
+	HostDNSDoc.Fields[0].Note = ""
+	HostDNSDoc.Fields[0].Description = "Enable the host DNS caching forwarder."
+	HostDNSDoc.Fields[0].Comments[encoder.LineComment] = "Enable the host DNS caching forwarder."
+	HostDNSDoc.Fields[1].Name = "cacheSize"
+	HostDNSDoc.Fields[1].Type = "int"
+	HostDNSDoc.Fields[1].Note = ""
+	HostDNSDoc.Fields[1].Description = "The maximum number of responses to cache.\nDefaults to 1024."
+	HostDNSDoc.Fields[1].Comments[encoder.LineComment] = "The maximum number of responses to cache."
+	HostDNSDoc.Fields[2].Name = "domainUpstreams"
+	HostDNSDoc.Fields[2].Type = "[]HostDNSDomainUpstream"
+	HostDNSDoc.Fields[2].Note = ""
+	HostDNSDoc.Fields[2].Description = "Per-domain upstream nameservers.\nQueries for the domain (and its subdomains) are sent to the listed nameservers instead of the default ones.\nStatic entries from `extraHostEntries` are answered by the forwarder directly."
+	HostDNSDoc.Fields[2].Comments[encoder.LineComment] = "Per-domain upstream nameservers."
+
+	HostDNSDomainUpstreamDoc.Type = "HostDNSDomainUpstream"
+	HostDNSDomainUpstreamDoc.Comments[encoder.LineComment] = "HostDNSDomainUpstream represents upstream nameservers for a domain."
+	HostDNSDomainUpstreamDoc.Description = "HostDNSDomainUpstream represents upstream nameservers for a domain."
+	HostDNSDomainUpstreamDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "HostDNS",
+			FieldName: "domainUpstreams",
+		},
+	}
+	HostDNSDomainUpstreamDoc.Fields = make([]encoder.Doc, 2)
+	HostDNSDomainUpstreamDoc.Fields[0].Name = "domain"
+	HostDNSDomainUpstreamDoc.Fields[0].Type = "string"
+	HostDNSDomainUpstreamDoc.Fields[0].Note = ""
+	HostDNSDomainUpstreamDoc.Fields[0].Description = "The domain name."
+	HostDNSDomainUpstreamDoc.Fields[0].Comments[encoder.LineComment] = "The domain name."
+
+	HostDNSDomainUpstreamDoc.Fields[0].AddExample("", "corp.example.com")
+	HostDNSDomainUpstreamDoc.Fields[1].Name = "nameservers"
+	HostDNSDomainUpstreamDoc.Fields[1].Type = "[]string"
+	HostDNSDomainUpstreamDoc.Fields[1].Note = ""
+	HostDNSDomainUpstreamDoc.Fields[1].Description = "The list of nameservers for the domain."
+	HostDNSDomainUpstreamDoc.Fields[1].Comments[encoder.LineComment] = "The list of nameservers for the domain."
 
 	RegistryMirrorConfigDoc.Type = "RegistryMirrorConfig"
 	RegistryMirrorConfigDoc.Comments[encoder.LineComment] = "RegistryMirrorConfig represents mirror configuration for a registry."
@@ -2248,12 +2715,95 @@ func init() {
 			FieldName: "features",
 		},
 	}
-	FeaturesConfigDoc.Fields = make([]encoder.Doc, 1)
+	FeaturesConfigDoc.Fields = make([]encoder.Doc, 3)
 	FeaturesConfigDoc.Fields[0].Name = "rbac"
 	FeaturesConfigDoc.Fields[0].Type = "bool"
 	FeaturesConfigDoc.Fields[0].Note = ""
 	FeaturesConfigDoc.Fields[0].Description = "Enable role-based access control (RBAC)."
 	FeaturesConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable role-based access control (RBAC)."
+	FeaturesConfigDoc.Fields[1].Name = "rbacRoles"
+	FeaturesConfigDoc.Fields[1].Type = "[]RBACRole"
+	FeaturesConfigDoc.Fields[1].Note = ""
+	FeaturesConfigDoc.Fields[1].Description = "Custom roles for the role-based access control (RBAC).\nCustom roles are assigned to the client certificates like the built-in roles (e.g. with `talosctl config new --roles`),\nand they should be defined on all nodes the client talks to."
+	FeaturesConfigDoc.Fields[1].Comments[encoder.LineComment] = "Custom roles for the role-based access control (RBAC)."
+
+	FeaturesConfigDoc.Fields[1].AddExample("", machineFeaturesRBACRolesExample)
+	FeaturesConfigDoc.Fields[2].Name = "oidc"
+	FeaturesConfigDoc.Fields[2].Type = "OIDCConfig"
+	FeaturesConfigDoc.Fields[2].Note = ""
+	FeaturesConfigDoc.Fields[2].Description = "OpenID Connect (OIDC) login for the Talos API.\nWhen configured, apid exchanges the ID tokens issued by the OIDC provider for short-lived client certificates (`talosctl config login`).\nRequires `rbac` to be enabled."
+	FeaturesConfigDoc.Fields[2].Comments[encoder.LineComment] = "OpenID Connect (OIDC) login for the Talos API."
+
+	FeaturesConfigDoc.Fields[2].AddExample("", machineFeaturesOIDCExample)
+
+	RBACRoleDoc.Type = "RBACRole"
+	RBACRoleDoc.Comments[encoder.LineComment] = "RBACRole describes a custom role for the role-based access control (RBAC)."
+	RBACRoleDoc.Description = "RBACRole describes a custom role for the role-based access control (RBAC)."
+
+	RBACRoleDoc.AddExample("", machineFeaturesRBACRolesExample)
+	RBACRoleDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "rbacRoles",
+		},
+	}
+	RBACRoleDoc.Fields = make([]encoder.Doc, 2)
+	RBACRoleDoc.Fields[0].Name = "name"
+	RBACRoleDoc.Fields[0].Type = "string"
+	RBACRoleDoc.Fields[0].Note = ""
+	RBACRoleDoc.Fields[0].Description = "Name of the role.\nNames with the `os:` prefix are reserved for the built-in roles."
+	RBACRoleDoc.Fields[0].Comments[encoder.LineComment] = "Name of the role."
+
+	RBACRoleDoc.Fields[0].AddExample("", "sre")
+	RBACRoleDoc.Fields[1].Name = "allow"
+	RBACRoleDoc.Fields[1].Type = "[]string"
+	RBACRoleDoc.Fields[1].Note = ""
+	RBACRoleDoc.Fields[1].Description = "List of the Talos API methods allowed for the role.\nEach entry is either a full gRPC method name (`/machine.MachineService/Reboot`),\nall methods of a service (`/machine.MachineService/*`),\nor a built-in role name (`os:reader`) which allows all methods allowed for that role."
+	RBACRoleDoc.Fields[1].Comments[encoder.LineComment] = "List of the Talos API methods allowed for the role."
+
+	RBACRoleDoc.Fields[1].AddExample("", []string{"os:reader", "/machine.MachineService/Reboot"})
+
+	OIDCConfigDoc.Type = "OIDCConfig"
+	OIDCConfigDoc.Comments[encoder.LineComment] = "OIDCConfig describes the OpenID Connect (OIDC) login for the Talos API."
+	OIDCConfigDoc.Description = "OIDCConfig describes the OpenID Connect (OIDC) login for the Talos API."
+
+	OIDCConfigDoc.AddExample("", machineFeaturesOIDCExample)
+	OIDCConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "oidc",
+		},
+	}
+	OIDCConfigDoc.Fields = make([]encoder.Doc, 5)
+	OIDCConfigDoc.Fields[0].Name = "issuerURL"
+	OIDCConfigDoc.Fields[0].Type = "string"
+	OIDCConfigDoc.Fields[0].Note = ""
+	OIDCConfigDoc.Fields[0].Description = "The URL of the OIDC issuer."
+	OIDCConfigDoc.Fields[0].Comments[encoder.LineComment] = "The URL of the OIDC issuer."
+
+	OIDCConfigDoc.Fields[0].AddExample("", "https://dex.example.com")
+	OIDCConfigDoc.Fields[1].Name = "clientID"
+	OIDCConfigDoc.Fields[1].Type = "string"
+	OIDCConfigDoc.Fields[1].Note = ""
+	OIDCConfigDoc.Fields[1].Description = "The client ID registered in the OIDC provider.\nID tokens should be issued for this client ID (audience)."
+	OIDCConfigDoc.Fields[1].Comments[encoder.LineComment] = "The client ID registered in the OIDC provider."
+
+	OIDCConfigDoc.Fields[1].AddExample("", "talos")
+	OIDCConfigDoc.Fields[2].Name = "groupsClaim"
+	OIDCConfigDoc.Fields[2].Type = "string"
+	OIDCConfigDoc.Fields[2].Note = ""
+	OIDCConfigDoc.Fields[2].Description = "The ID token claim containing the user groups.\nDefaults to `groups`."
+	OIDCConfigDoc.Fields[2].Comments[encoder.LineComment] = "The ID token claim containing the user groups."
+	OIDCConfigDoc.Fields[3].Name = "groupRoles"
+	OIDCConfigDoc.Fields[3].Type = "map[string][]string"
+	OIDCConfigDoc.Fields[3].Note = ""
+	OIDCConfigDoc.Fields[3].Description = "Maps the user groups to the Talos API roles (built-in or custom).\nUsers not in any of the listed groups are not allowed to log in."
+	OIDCConfigDoc.Fields[3].Comments[encoder.LineComment] = "Maps the user groups to the Talos API roles (built-in or custom)."
+	OIDCConfigDoc.Fields[4].Name = "certLifetime"
+	OIDCConfigDoc.Fields[4].Type = "Duration"
+	OIDCConfigDoc.Fields[4].Note = ""
+	OIDCConfigDoc.Fields[4].Description = "The lifetime of the issued client certificates.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 1 hour."
+	OIDCConfigDoc.Fields[4].Comments[encoder.LineComment] = "The lifetime of the issued client certificates."
 
 	VolumeMountConfigDoc.Type = "VolumeMountConfig"
 	VolumeMountConfigDoc.Comments[encoder.LineComment] = "VolumeMountConfig struct describes extra volume mount for the static pods."
@@ -2298,6 +2848,14 @@ func init() {
 	ClusterInlineManifestDoc.Type = "ClusterInlineManifest"
 	ClusterInlineManifestDoc.Comments[encoder.LineComment] = "ClusterInlineManifest struct describes inline bootstrap manifests for the user."
 	ClusterInlineManifestDoc.Description = "ClusterInlineManifest struct describes inline bootstrap manifests for the user."
+
+	ClusterInlineManifestDoc.AddExample("", clusterInlineManifestsExample)
+	ClusterInlineManifestDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "ClusterConfig",
+			FieldName: "inlineManifests",
+		},
+	}
 	ClusterInlineManifestDoc.Fields = make([]encoder.Doc, 2)
 	ClusterInlineManifestDoc.Fields[0].Name = "name"
 	ClusterInlineManifestDoc.Fields[0].Type = "string"
@@ -2349,6 +2907,14 @@ func init() {
 			TypeName:  "Device",
 			FieldName: "deviceSelector",
 		},
+		{
+			TypeName:  "Bond",
+			FieldName: "deviceSelectors",
+		},
+		{
+			TypeName:  "Bridge",
+			FieldName: "deviceSelectors",
+		},
 	}
 	NetworkDeviceSelectorDoc.Fields = make([]encoder.Doc, 4)
 	NetworkDeviceSelectorDoc.Fields[0].Name = "busPath"
@@ -2484,12 +3050,46 @@ func init() {
 			FieldName: "logging",
 		},
 	}
-	LoggingConfigDoc.Fields = make([]encoder.Doc, 1)
+	LoggingConfigDoc.Fields = make([]encoder.Doc, 2)
 	LoggingConfigDoc.Fields[0].Name = "destinations"
 	LoggingConfigDoc.Fields[0].Type = "[]LoggingDestination"
 	LoggingConfigDoc.Fields[0].Note = ""
 	LoggingConfigDoc.Fields[0].Description = "Logging destination."
 	LoggingConfigDoc.Fields[0].Comments[encoder.LineComment] = "Logging destination."
+	LoggingConfigDoc.Fields[1].Name = "spool"
+	LoggingConfigDoc.Fields[1].Type = "LoggingSpoolConfig"
+	LoggingConfigDoc.Fields[1].Note = ""
+	LoggingConfigDoc.Fields[1].Description = "Durable on-disk spool for the log events sent to the destinations."
+	LoggingConfigDoc.Fields[1].Comments[encoder.LineComment] = "Durable on-disk spool for the log events sent to the destinations."
+
+	LoggingConfigDoc.Fields[1].AddExample("", loggingSpoolExample)
+
+	LoggingSpoolConfigDoc.Type = "LoggingSpoolConfig"
+	LoggingSpoolConfigDoc.Comments[encoder.LineComment] = "LoggingSpoolConfig struct configures the on-disk spool of the log events."
+	LoggingSpoolConfigDoc.Description = "LoggingSpoolConfig struct configures the on-disk spool of the log events."
+
+	LoggingSpoolConfigDoc.AddExample("", loggingSpoolExample)
+	LoggingSpoolConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingConfig",
+			FieldName: "spool",
+		},
+	}
+	LoggingSpoolConfigDoc.Fields = make([]encoder.Doc, 2)
+	LoggingSpoolConfigDoc.Fields[0].Name = "enabled"
+	LoggingSpoolConfigDoc.Fields[0].Type = "bool"
+	LoggingSpoolConfigDoc.Fields[0].Note = ""
+	LoggingSpoolConfigDoc.Fields[0].Description = "Enable the on-disk spool.\n\nLog events are written to the spool on the EPHEMERAL partition before being sent,\nand they are replayed in order once the destination is reachable, including after a reboot."
+	LoggingSpoolConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable the on-disk spool."
+	LoggingSpoolConfigDoc.Fields[1].Name = "maxSize"
+	LoggingSpoolConfigDoc.Fields[1].Type = "DiskSize"
+	LoggingSpoolConfigDoc.Fields[1].Note = ""
+	LoggingSpoolConfigDoc.Fields[1].Description = "Maximum size of the spool for each destination: either bytes or human readable representation.\nWhen the spool is full, the oldest log events are dropped.\nDefaults to 64 MiB."
+	LoggingSpoolConfigDoc.Fields[1].Comments[encoder.LineComment] = "Maximum size of the spool for each destination: either bytes or human readable representation."
+
+	LoggingSpoolConfigDoc.Fields[1].AddExample("Human readable representation.", DiskSize(128000000))
+
+	LoggingSpoolConfigDoc.Fields[1].AddExample("Precise value in bytes.", 128*1024*1024)
 
 	LoggingDestinationDoc.Type = "LoggingDestination"
 	LoggingDestinationDoc.Comments[encoder.LineComment] = "LoggingDestination struct configures Talos logging destination."
@@ -2500,24 +3100,153 @@ func init() {
 			FieldName: "destinations",
 		},
 	}
-	LoggingDestinationDoc.Fields = make([]encoder.Doc, 2)
+	LoggingDestinationDoc.Fields = make([]encoder.Doc, 5)
 	LoggingDestinationDoc.Fields[0].Name = "endpoint"
 	LoggingDestinationDoc.Fields[0].Type = "Endpoint"
 	LoggingDestinationDoc.Fields[0].Note = ""
-	LoggingDestinationDoc.Fields[0].Description = "Where to send logs. Supported protocols are \"tcp\" and \"udp\"."
-	LoggingDestinationDoc.Fields[0].Comments[encoder.LineComment] = "Where to send logs. Supported protocols are \"tcp\" and \"udp\"."
+	LoggingDestinationDoc.Fields[0].Description = "Where to send logs. Supported protocols are \"tcp\", \"udp\" and \"tls\"."
+	LoggingDestinationDoc.Fields[0].Comments[encoder.LineComment] = "Where to send logs. Supported protocols are \"tcp\", \"udp\" and \"tls\"."
 
 	LoggingDestinationDoc.Fields[0].AddExample("", loggingEndpointExample1)
 
 	LoggingDestinationDoc.Fields[0].AddExample("", loggingEndpointExample2)
+
+	LoggingDestinationDoc.Fields[0].AddExample("", loggingEndpointExample3)
 	LoggingDestinationDoc.Fields[1].Name = "format"
 	LoggingDestinationDoc.Fields[1].Type = "string"
 	LoggingDestinationDoc.Fields[1].Note = ""
-	LoggingDestinationDoc.Fields[1].Description = "Logs format."
+	LoggingDestinationDoc.Fields[1].Description = "Logs format.\n\n- `json_lines`: JSON objects, newline-delimited over TCP and TLS.\n- `syslog`: RFC 5424 syslog messages, with RFC 6587 octet-counting framing over TCP and TLS.\n- `gelf`: GELF 1.1 messages, null byte delimited over TCP and TLS, chunked over UDP."
 	LoggingDestinationDoc.Fields[1].Comments[encoder.LineComment] = "Logs format."
 	LoggingDestinationDoc.Fields[1].Values = []string{
 		"json_lines",
+		"syslog",
+		"gelf",
+	}
+	LoggingDestinationDoc.Fields[2].Name = "tls"
+	LoggingDestinationDoc.Fields[2].Type = "LoggingTLSConfig"
+	LoggingDestinationDoc.Fields[2].Note = ""
+	LoggingDestinationDoc.Fields[2].Description = "TLS settings for the \"tls\" endpoint."
+	LoggingDestinationDoc.Fields[2].Comments[encoder.LineComment] = "TLS settings for the \"tls\" endpoint."
+	LoggingDestinationDoc.Fields[3].Name = "filter"
+	LoggingDestinationDoc.Fields[3].Type = "LoggingFilterConfig"
+	LoggingDestinationDoc.Fields[3].Note = ""
+	LoggingDestinationDoc.Fields[3].Description = "Filter for the log events sent to the destination."
+	LoggingDestinationDoc.Fields[3].Comments[encoder.LineComment] = "Filter for the log events sent to the destination."
+
+	LoggingDestinationDoc.Fields[3].AddExample("", loggingFilterExample)
+	LoggingDestinationDoc.Fields[4].Name = "extraFields"
+	LoggingDestinationDoc.Fields[4].Type = "map[string]string"
+	LoggingDestinationDoc.Fields[4].Note = ""
+	LoggingDestinationDoc.Fields[4].Description = "Static fields added to every log event sent to the destination."
+	LoggingDestinationDoc.Fields[4].Comments[encoder.LineComment] = "Static fields added to every log event sent to the destination."
+
+	LoggingDestinationDoc.Fields[4].AddExample("", loggingExtraFieldsExample)
+
+	LoggingTLSConfigDoc.Type = "LoggingTLSConfig"
+	LoggingTLSConfigDoc.Comments[encoder.LineComment] = "LoggingTLSConfig struct configures the TLS connection to the logging destination."
+	LoggingTLSConfigDoc.Description = "LoggingTLSConfig struct configures the TLS connection to the logging destination."
+	LoggingTLSConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingDestination",
+			FieldName: "tls",
+		},
 	}
+	LoggingTLSConfigDoc.Fields = make([]encoder.Doc, 1)
+	LoggingTLSConfigDoc.Fields[0].Name = "ca"
+	LoggingTLSConfigDoc.Fields[0].Type = "Base64Bytes"
+	LoggingTLSConfigDoc.Fields[0].Note = ""
+	LoggingTLSConfigDoc.Fields[0].Description = "PEM encoded CA certificate to verify the logging endpoint certificate.\nIf not set, the system CA certificates are used."
+	LoggingTLSConfigDoc.Fields[0].Comments[encoder.LineComment] = "PEM encoded CA certificate to verify the logging endpoint certificate."
+
+	LoggingFilterConfigDoc.Type = "LoggingFilterConfig"
+	LoggingFilterConfigDoc.Comments[encoder.LineComment] = "LoggingFilterConfig struct configures the log events sent to the logging destination."
+	LoggingFilterConfigDoc.Description = "LoggingFilterConfig struct configures the log events sent to the logging destination."
+
+	LoggingFilterConfigDoc.AddExample("", loggingFilterExample)
+	LoggingFilterConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingDestination",
+			FieldName: "filter",
+		},
+	}
+	LoggingFilterConfigDoc.Fields = make([]encoder.Doc, 5)
+	LoggingFilterConfigDoc.Fields[0].Name = "minLevel"
+	LoggingFilterConfigDoc.Fields[0].Type = "string"
+	LoggingFilterConfigDoc.Fields[0].Note = ""
+	LoggingFilterConfigDoc.Fields[0].Description = "Minimum level of the log events to send."
+	LoggingFilterConfigDoc.Fields[0].Comments[encoder.LineComment] = "Minimum level of the log events to send."
+	LoggingFilterConfigDoc.Fields[0].Values = []string{
+		"debug",
+		"info",
+		"warn",
+		"error",
+	}
+	LoggingFilterConfigDoc.Fields[1].Name = "includeServices"
+	LoggingFilterConfigDoc.Fields[1].Type = "[]string"
+	LoggingFilterConfigDoc.Fields[1].Note = ""
+	LoggingFilterConfigDoc.Fields[1].Description = "Send only the logs of the listed services.\nIf not set, the logs of all services are sent."
+	LoggingFilterConfigDoc.Fields[1].Comments[encoder.LineComment] = "Send only the logs of the listed services."
+	LoggingFilterConfigDoc.Fields[2].Name = "excludeServices"
+	LoggingFilterConfigDoc.Fields[2].Type = "[]string"
+	LoggingFilterConfigDoc.Fields[2].Note = ""
+	LoggingFilterConfigDoc.Fields[2].Description = "Don't send the logs of the listed services."
+	LoggingFilterConfigDoc.Fields[2].Comments[encoder.LineComment] = "Don't send the logs of the listed services."
+	LoggingFilterConfigDoc.Fields[3].Name = "messageRegex"
+	LoggingFilterConfigDoc.Fields[3].Type = "string"
+	LoggingFilterConfigDoc.Fields[3].Note = ""
+	LoggingFilterConfigDoc.Fields[3].Description = "Send only the log events with the message matching the regular expression."
+	LoggingFilterConfigDoc.Fields[3].Comments[encoder.LineComment] = "Send only the log events with the message matching the regular expression."
+	LoggingFilterConfigDoc.Fields[4].Name = "sampling"
+	LoggingFilterConfigDoc.Fields[4].Type = "LoggingSamplingConfig"
+	LoggingFilterConfigDoc.Fields[4].Note = ""
+	LoggingFilterConfigDoc.Fields[4].Description = "Sampling of the log events."
+	LoggingFilterConfigDoc.Fields[4].Comments[encoder.LineComment] = "Sampling of the log events."
+
+	LoggingSamplingConfigDoc.Type = "LoggingSamplingConfig"
+	LoggingSamplingConfigDoc.Comments[encoder.LineComment] = "LoggingSamplingConfig struct configures sampling of the log events."
+	LoggingSamplingConfigDoc.Description = "LoggingSamplingConfig struct configures sampling of the log events.\n\nEvery second, the first `initial` log events of each service are sent, and after that every `thereafter`-th event.\n"
+	LoggingSamplingConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingFilterConfig",
+			FieldName: "sampling",
+		},
+	}
+	LoggingSamplingConfigDoc.Fields = make([]encoder.Doc, 2)
+	LoggingSamplingConfigDoc.Fields[0].Name = "initial"
+	LoggingSamplingConfigDoc.Fields[0].Type = "int"
+	LoggingSamplingConfigDoc.Fields[0].Note = ""
+	LoggingSamplingConfigDoc.Fields[0].Description = "Number of the log events of each service sent every second before the sampling starts."
+	LoggingSamplingConfigDoc.Fields[0].Comments[encoder.LineComment] = "Number of the log events of each service sent every second before the sampling starts."
+	LoggingSamplingConfigDoc.Fields[1].Name = "thereafter"
+	LoggingSamplingConfigDoc.Fields[1].Type = "int"
+	LoggingSamplingConfigDoc.Fields[1].Note = ""
+	LoggingSamplingConfigDoc.Fields[1].Description = "Send every Nth log event after the initial ones.\nIf set to zero, the rest of the log events are dropped."
+	LoggingSamplingConfigDoc.Fields[1].Comments[encoder.LineComment] = "Send every Nth log event after the initial ones."
+
+	MetricsConfigDoc.Type = "MetricsConfig"
+	MetricsConfigDoc.Comments[encoder.LineComment] = "MetricsConfig struct configures the Prometheus metrics endpoints."
+	MetricsConfigDoc.Description = "MetricsConfig struct configures the Prometheus metrics endpoints."
+
+	MetricsConfigDoc.AddExample("", machineMetricsExample)
+	MetricsConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "metrics",
+		},
+	}
+	MetricsConfigDoc.Fields = make([]encoder.Doc, 2)
+	MetricsConfigDoc.Fields[0].Name = "enabled"
+	MetricsConfigDoc.Fields[0].Type = "bool"
+	MetricsConfigDoc.Fields[0].Note = ""
+	MetricsConfigDoc.Fields[0].Description = "Enable the Prometheus metrics endpoints.\n\nWhen enabled, machined, apid and trustd serve the metrics over HTTP on the `/metrics` path\n(ports 50010, 50011 and 50012 respectively), and the metrics are also available via the Talos API (`talosctl metrics`)."
+	MetricsConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable the Prometheus metrics endpoints."
+	MetricsConfigDoc.Fields[1].Name = "bindAddress"
+	MetricsConfigDoc.Fields[1].Type = "string"
+	MetricsConfigDoc.Fields[1].Note = ""
+	MetricsConfigDoc.Fields[1].Description = "IP address the metrics endpoints listen on.\n\nThe metrics endpoints are not authenticated, so the access is restricted by the listen address.\nDefaults to `127.0.0.1`, which makes the endpoints reachable from the node itself only."
+	MetricsConfigDoc.Fields[1].Comments[encoder.LineComment] = "IP address the metrics endpoints listen on."
+
+	MetricsConfigDoc.Fields[1].AddExample("", "10.5.0.2")
 
 	KernelConfigDoc.Type = "KernelConfig"
 	KernelConfigDoc.Comments[encoder.LineComment] = "KernelConfig struct configures Talos Linux kernel."
@@ -2598,6 +3327,10 @@ func (_ InstallConfig) Doc() *encoder.Doc {
 	return &InstallConfigDoc
 }
 
+func (_ InstallEphemeralConfig) Doc() *encoder.Doc {
+	return &InstallEphemeralConfigDoc
+}
+
 func (_ InstallDiskSelector) Doc() *encoder.Doc {
 	return &InstallDiskSelectorDoc
 }
@@ -2654,6 +3387,14 @@ func (_ EtcdConfig) Doc() *encoder.Doc {
 	return &EtcdConfigDoc
 }
 
+func (_ EtcdBackupConfig) Doc() *encoder.Doc {
+	return &EtcdBackupConfigDoc
+}
+
+func (_ EtcdBackupS3Config) Doc() *encoder.Doc {
+	return &EtcdBackupS3ConfigDoc
+}
+
 func (_ ClusterNetworkConfig) Doc() *encoder.Doc {
 	return &ClusterNetworkConfigDoc
 }
@@ -2678,6 +3419,10 @@ func (_ DiskPartition) Doc() *encoder.Doc {
 	return &DiskPartitionDoc
 }
 
+func (_ UserVolumeConfig) Doc() *encoder.Doc {
+	return &UserVolumeConfigDoc
+}
+
 func (_ EncryptionConfig) Doc() *encoder.Doc {
 	return &EncryptionConfigDoc
 }
@@ -2694,6 +3439,10 @@ func (_ EncryptionKeyNodeID) Doc() *encoder.Doc {
 	return &EncryptionKeyNodeIDDoc
 }
 
+func (_ EncryptionKeyKMS) Doc() *encoder.Doc {
+	return &EncryptionKeyKMSDoc
+}
+
 func (_ MachineFile) Doc() *encoder.Doc {
 	return &MachineFileDoc
 }
@@ -2742,6 +3491,14 @@ func (_ Bridge) Doc() *encoder.Doc {
 	return &BridgeDoc
 }
 
+func (_ BridgeVLAN) Doc() *encoder.Doc {
+	return &BridgeVLANDoc
+}
+
+func (_ BridgePortVLAN) Doc() *encoder.Doc {
+	return &BridgePortVLANDoc
+}
+
 func (_ Vlan) Doc() *encoder.Doc {
 	return &VlanDoc
 }
@@ -2750,6 +3507,18 @@ func (_ Route) Doc() *encoder.Doc {
 	return &RouteDoc
 }
 
+func (_ RoutingRule) Doc() *encoder.Doc {
+	return &RoutingRuleDoc
+}
+
+func (_ HostDNS) Doc() *encoder.Doc {
+	return &HostDNSDoc
+}
+
+func (_ HostDNSDomainUpstream) Doc() *encoder.Doc {
+	return &HostDNSDomainUpstreamDoc
+}
+
 func (_ RegistryMirrorConfig) Doc() *encoder.Doc {
 	return &RegistryMirrorConfigDoc
 }
@@ -2774,6 +3543,14 @@ func (_ FeaturesConfig) Doc() *encoder.Doc {
 	return &FeaturesConfigDoc
 }
 
+func (_ RBACRole) Doc() *encoder.Doc {
+	return &RBACRoleDoc
+}
+
+func (_ OIDCConfig) Doc() *encoder.Doc {
+	return &OIDCConfigDoc
+}
+
 func (_ VolumeMountConfig) Doc() *encoder.Doc {
 	return &VolumeMountConfigDoc
 }
@@ -2813,11 +3590,28 @@ func (_ UdevConfig) Doc() *encoder.Doc {
 func (_ LoggingConfig) Doc() *encoder.Doc {
 	return &LoggingConfigDoc
 }
+func (_ LoggingSpoolConfig) Doc() *encoder.Doc {
+	return &LoggingSpoolConfigDoc
+}
 
 func (_ LoggingDestination) Doc() *encoder.Doc {
 	return &LoggingDestinationDoc
 }
 
+func (_ LoggingTLSConfig) Doc() *encoder.Doc {
+	return &LoggingTLSConfigDoc
+}
+func (_ LoggingFilterConfig) Doc() *encoder.Doc {
+	return &LoggingFilterConfigDoc
+}
+func (_ LoggingSamplingConfig) Doc() *encoder.Doc {
+	return &LoggingSamplingConfigDoc
+}
+
+func (_ MetricsConfig) Doc() *encoder.Doc {
+	return &MetricsConfigDoc
+}
+
 func (_ KernelConfig) Doc() *encoder.Doc {
 	return &KernelConfigDoc
 }
@@ -2843,6 +3637,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&KubeletNodeIPConfigDoc,
 			&NetworkConfigDoc,
 			&InstallConfigDoc,
+			&InstallEphemeralConfigDoc,
 			&InstallDiskSelectorDoc,
 			&InstallExtensionConfigDoc,
 			&TimeConfigDoc,
@@ -2857,16 +3652,20 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ProxyConfigDoc,
 			&SchedulerConfigDoc,
 			&EtcdConfigDoc,
+			&EtcdBackupConfigDoc,
+			&EtcdBackupS3ConfigDoc,
 			&ClusterNetworkConfigDoc,
 			&CNIConfigDoc,
 			&ExternalCloudProviderConfigDoc,
 			&AdminKubeconfigConfigDoc,
 			&MachineDiskDoc,
 			&DiskPartitionDoc,
+			&UserVolumeConfigDoc,
 			&EncryptionConfigDoc,
 			&EncryptionKeyDoc,
 			&EncryptionKeyStaticDoc,
 			&EncryptionKeyNodeIDDoc,
+			&EncryptionKeyKMSDoc,
 			&MachineFileDoc,
 			&ExtraHostDoc,
 			&DeviceDoc,
@@ -2879,14 +3678,21 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&BondDoc,
 			&STPDoc,
 			&BridgeDoc,
+			&BridgeVLANDoc,
+			&BridgePortVLANDoc,
 			&VlanDoc,
 			&RouteDoc,
+			&RoutingRuleDoc,
+			&HostDNSDoc,
+			&HostDNSDomainUpstreamDoc,
 			&RegistryMirrorConfigDoc,
 			&RegistryConfigDoc,
 			&RegistryAuthConfigDoc,
 			&RegistryTLSConfigDoc,
 			&SystemDiskEncryptionConfigDoc,
 			&FeaturesConfigDoc,
+			&RBACRoleDoc,
+			&OIDCConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
 			&NetworkKubeSpanDoc,
@@ -2897,7 +3703,12 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&RegistryServiceConfigDoc,
 			&UdevConfigDoc,
 			&LoggingConfigDoc,
+			&LoggingSpoolConfigDoc,
 			&LoggingDestinationDoc,
+			&LoggingTLSConfigDoc,
+			&LoggingFilterConfigDoc,
+			&LoggingSamplingConfigDoc,
+			&MetricsConfigDoc,
 			&KernelConfigDoc,
 			&KernelModuleConfigDoc,
 		},
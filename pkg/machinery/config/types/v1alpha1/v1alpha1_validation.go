@@ -11,10 +11,13 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/go-debug"
@@ -23,6 +26,8 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/generic/maps"
+	"github.com/talos-systems/talos/pkg/machinery/generic/slices"
 	"github.com/talos-systems/talos/pkg/machinery/kubelet"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 )
@@ -175,6 +180,8 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 
 					bridgedInterfaces[iface] = device.Interface()
 				}
+
+				result = multierror.Append(result, validateBridgeVLAN(device.Interface(), device.DeviceBridge))
 			}
 		}
 
@@ -183,6 +190,12 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 			warnings = append(warnings, warn...)
 			result = multierror.Append(result, err)
 		}
+
+		result = multierror.Append(result, validateRoutingRules(c.MachineConfig.MachineNetwork.NetworkRoutingRules))
+
+		if c.MachineConfig.MachineNetwork.NetworkHostDNS != nil {
+			result = multierror.Append(result, validateHostDNS(c.MachineConfig.MachineNetwork.NetworkHostDNS))
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -201,28 +214,17 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, err)
 	}
 
+	result = multierror.Append(result, validateNodeLabels(c.MachineConfig.MachineNodeLabels), validateNodeTaints(c.MachineConfig.MachineNodeTaints))
+
 	for _, label := range []string{constants.EphemeralPartitionLabel, constants.StatePartitionLabel} {
 		encryptionConfig := c.MachineConfig.SystemDiskEncryption().Get(label)
 		if encryptionConfig != nil {
-			if len(encryptionConfig.Keys()) == 0 {
-				result = multierror.Append(result, fmt.Errorf("no encryption keys provided for the ephemeral partition encryption"))
-			}
-
-			slotsInUse := map[int]bool{}
-			for _, key := range encryptionConfig.Keys() {
-				if slotsInUse[key.Slot()] {
-					result = multierror.Append(result, fmt.Errorf("encryption key slot %d is already in use", key.Slot()))
-				}
-
-				slotsInUse[key.Slot()] = true
-
-				if key.NodeID() == nil && key.Static() == nil {
-					result = multierror.Append(result, fmt.Errorf("encryption key at slot %d doesn't have any settings", key.Slot()))
-				}
-			}
+			result = multierror.Append(result, validateEncryption(encryptionConfig)...)
 		}
 	}
 
+	result = multierror.Append(result, validateUserVolumes(c.MachineConfig.MachineVolumes)...)
+
 	if c.Machine().Network().KubeSpan().Enabled() {
 		if !c.Cluster().Discovery().Enabled() {
 			result = multierror.Append(result, fmt.Errorf(".cluster.discovery should be enabled when .machine.network.kubespan is enabled"))
@@ -242,6 +244,14 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, err)
 	}
 
+	if c.MachineConfig.MachineMetrics != nil {
+		result = multierror.Append(result, c.MachineConfig.MachineMetrics.Validate())
+	}
+
+	if c.MachineConfig.MachineFeatures != nil {
+		result = multierror.Append(result, c.MachineConfig.MachineFeatures.Validate())
+	}
+
 	if c.MachineConfig.MachineInstall != nil {
 		extensions := map[string]struct{}{}
 
@@ -252,6 +262,16 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 
 			extensions[ext.Image()] = struct{}{}
 		}
+
+		if ephemeral := c.MachineConfig.MachineInstall.InstallEphemeral; ephemeral != nil {
+			if ephemeral.EphemeralDiskSelector != nil && len(ephemeral.EphemeralDiskSelector.DiskMatchers()) == 0 {
+				result = multierror.Append(result, fmt.Errorf("install.ephemeral.diskSelector should have at least one disk attribute"))
+			}
+
+			if ephemeral.EphemeralMaxSize != 0 && ephemeral.EphemeralMinSize > ephemeral.EphemeralMaxSize {
+				result = multierror.Append(result, fmt.Errorf("install.ephemeral.minSize %d is greater than maxSize %d", ephemeral.EphemeralMinSize, ephemeral.EphemeralMaxSize))
+			}
+		}
 	}
 
 	if opts.Strict {
@@ -265,6 +285,88 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 	return warnings, result.ErrorOrNil()
 }
 
+func validateEncryption(encryptionConfig config.Encryption) []error {
+	var errs []error
+
+	if len(encryptionConfig.Keys()) == 0 {
+		errs = append(errs, fmt.Errorf("no encryption keys provided"))
+	}
+
+	slotsInUse := map[int]bool{}
+	for _, key := range encryptionConfig.Keys() {
+		if slotsInUse[key.Slot()] {
+			errs = append(errs, fmt.Errorf("encryption key slot %d is already in use", key.Slot()))
+		}
+
+		slotsInUse[key.Slot()] = true
+
+		if key.NodeID() == nil && key.Static() == nil && key.KMS() == nil {
+			errs = append(errs, fmt.Errorf("encryption key at slot %d doesn't have any settings", key.Slot()))
+		}
+
+		if key.KMS() != nil {
+			if u, err := url.Parse(key.KMS().Endpoint()); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				errs = append(errs, fmt.Errorf("encryption key at slot %d: KMS endpoint %q: expected HTTP(S) URL", key.Slot(), key.KMS().Endpoint()))
+			}
+		}
+	}
+
+	return errs
+}
+
+// rxUserVolumeName limits the volume name so that the partition label with the prefix fits into the GPT partition name.
+var rxUserVolumeName = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,32}[a-z0-9])?$`)
+
+//nolint:gocyclo
+func validateUserVolumes(volumes []*UserVolumeConfig) []error {
+	var errs []error
+
+	names := map[string]struct{}{}
+	mountPoints := map[string]struct{}{}
+
+	for _, volume := range volumes {
+		if !rxUserVolumeName.MatchString(volume.VolumeName) {
+			errs = append(errs, fmt.Errorf("volume name %q is invalid: it should consist of lowercase alphanumeric characters or '-', up to 34 characters", volume.VolumeName))
+		}
+
+		if _, ok := names[volume.VolumeName]; ok {
+			errs = append(errs, fmt.Errorf("volume %q is defined more than once", volume.VolumeName))
+		}
+
+		names[volume.VolumeName] = struct{}{}
+
+		if volume.VolumeDiskSelector == nil || len(volume.VolumeDiskSelector.DiskMatchers()) == 0 {
+			errs = append(errs, fmt.Errorf("volume %q: diskSelector is required", volume.VolumeName))
+		}
+
+		switch volume.Filesystem() {
+		case "xfs", "ext4":
+		default:
+			errs = append(errs, fmt.Errorf("volume %q: unsupported filesystem %q", volume.VolumeName, volume.Filesystem()))
+		}
+
+		mountPoint := filepath.Clean(volume.MountPoint())
+
+		if !strings.HasPrefix(mountPoint, constants.EphemeralMountPoint+"/") {
+			errs = append(errs, fmt.Errorf("volume %q: mountpoint %q should be under %s", volume.VolumeName, volume.MountPoint(), constants.EphemeralMountPoint))
+		}
+
+		if _, ok := mountPoints[mountPoint]; ok {
+			errs = append(errs, fmt.Errorf("volume %q: mountpoint %q is already in use", volume.VolumeName, volume.MountPoint()))
+		}
+
+		mountPoints[mountPoint] = struct{}{}
+
+		if volume.VolumeEncryption != nil {
+			for _, err := range validateEncryption(volume.VolumeEncryption) {
+				errs = append(errs, fmt.Errorf("volume %q: %w", volume.VolumeName, err))
+			}
+		}
+	}
+
+	return errs
+}
+
 var rxDNSName = regexp.MustCompile(`^([a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62}){1}(\.[a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62})*[\._]?$`)
 
 func isValidDNSName(name string) bool {
@@ -307,6 +409,10 @@ func (c *ClusterConfig) Validate() error {
 		}
 	}
 
+	if c.EtcdConfig != nil && c.EtcdConfig.EtcdBackup != nil {
+		result = multierror.Append(result, validateEtcdBackup(c.EtcdConfig.EtcdBackup))
+	}
+
 	result = multierror.Append(result, c.ClusterInlineManifests.Validate(), c.ClusterDiscoveryConfig.Validate(c))
 
 	return result.ErrorOrNil()
@@ -470,6 +576,11 @@ func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
 
 	if d.DeviceBond != nil {
 		result = multierror.Append(result, checkBond(d.DeviceBond))
+		result = multierror.Append(result, checkMemberSelectors("networking.os.device.bond", d.DeviceBond.BondInterfaces, d.DeviceBond.BondDeviceSelectors))
+	}
+
+	if d.DeviceBridge != nil {
+		result = multierror.Append(result, checkMemberSelectors("networking.os.device.bridge", d.DeviceBridge.BridgedInterfaces, d.DeviceBridge.BridgeDeviceSelectors))
 	}
 
 	if d.DeviceWireguardConfig != nil {
@@ -588,6 +699,23 @@ func checkBond(b *Bond) error {
 	return result.ErrorOrNil()
 }
 
+// checkMemberSelectors ensures that the bond or bridge members are picked either by name or by device selectors.
+func checkMemberSelectors(path string, interfaces []string, selectors []NetworkDeviceSelector) error {
+	var result *multierror.Error
+
+	if len(interfaces) > 0 && len(selectors) > 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s], [%s]: %w", path+".interfaces", path+".deviceSelectors", ErrMutuallyExclusive))
+	}
+
+	for idx, selector := range selectors {
+		if reflect.ValueOf(selector).IsZero() {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".deviceSelectors["+strconv.Itoa(idx)+"]", ErrEmpty))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 func checkWireguard(b *DeviceWireguardConfig) error {
 	var result *multierror.Error
 
@@ -755,11 +883,341 @@ func CheckDeviceRoutes(d *Device, _ map[string]string) ([]string, error) {
 				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.route["+strconv.Itoa(idx)+"].source", route.Source(), ErrInvalidAddress))
 			}
 		}
+
+		if _, err := nethelpers.RoutingTableByName(route.Table()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", "networking.os.device.route["+strconv.Itoa(idx)+"].table", err))
+		}
 	}
 
 	return nil, result.ErrorOrNil()
 }
 
+// validateRoutingRules ensures that the routing rules are valid and rule priorities are unique.
+//
+//nolint:gocyclo
+func validateRoutingRules(rules []*RoutingRule) error {
+	var result *multierror.Error
+
+	// maps family to the priorities in use
+	priorities := map[int]map[uint32]struct{}{
+		4: {},
+		6: {},
+	}
+
+	for idx, rule := range rules {
+		path := "networking.routingRules[" + strconv.Itoa(idx) + "]"
+
+		if rule.Priority() == 0 || rule.Priority() > 32765 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: priority should be in range 1-32765", path+".priority", rule.Priority()))
+		}
+
+		families := map[int]struct{}{}
+
+		for _, field := range []struct {
+			name  string
+			value string
+		}{
+			{"from", rule.From()},
+			{"to", rule.To()},
+		} {
+			if field.value == "" {
+				continue
+			}
+
+			ip, _, err := net.ParseCIDR(field.value)
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+"."+field.name, field.value, ErrInvalidAddress))
+
+				continue
+			}
+
+			if ip.To4() != nil {
+				families[4] = struct{}{}
+			} else {
+				families[6] = struct{}{}
+			}
+		}
+
+		if len(families) > 1 {
+			result = multierror.Append(result, fmt.Errorf("[%s]: from and to should be of the same address family", path))
+		}
+
+		if len(families) == 0 {
+			families[4] = struct{}{}
+			families[6] = struct{}{}
+		}
+
+		for family := range families {
+			if _, exists := priorities[family][rule.Priority()]; exists {
+				result = multierror.Append(result, fmt.Errorf("[%s] %d: duplicate rule priority", path+".priority", rule.Priority()))
+
+				break
+			}
+
+			priorities[family][rule.Priority()] = struct{}{}
+		}
+
+		if rule.Table() == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: table should be set", path+".table"))
+		} else if _, err := nethelpers.RoutingTableByName(rule.Table()); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".table", err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateHostDNS ensures that the host DNS forwarder settings are valid.
+func validateHostDNS(hostDNS *HostDNS) error {
+	var result *multierror.Error
+
+	if hostDNS.HostDNSCacheSize < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: cache size should not be negative", "networking.hostDNS.cacheSize", hostDNS.HostDNSCacheSize))
+	}
+
+	domains := map[string]struct{}{}
+
+	for idx, upstream := range hostDNS.HostDNSDomainUpstreams {
+		path := "networking.hostDNS.domainUpstreams[" + strconv.Itoa(idx) + "]"
+
+		domain := strings.ToLower(strings.TrimSuffix(upstream.Domain(), "."))
+
+		if !isValidDNSName(domain) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid domain name", path+".domain", upstream.Domain()))
+		} else if _, exists := domains[domain]; exists {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: duplicate domain", path+".domain", upstream.Domain()))
+		}
+
+		domains[domain] = struct{}{}
+
+		if len(upstream.Nameservers()) == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s]: at least one nameserver should be set", path+".nameservers"))
+		}
+
+		for _, nameserver := range upstream.Nameservers() {
+			if net.ParseIP(nameserver) == nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".nameservers", nameserver, ErrInvalidAddress))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateEtcdBackup ensures that the scheduled etcd backups settings are valid.
+func validateEtcdBackup(backup *EtcdBackupConfig) error {
+	var result *multierror.Error
+
+	if backup.BackupInterval != 0 && backup.BackupInterval < time.Minute {
+		result = multierror.Append(result, fmt.Errorf("[%s] %s: interval should be at least 1m", "cluster.etcd.backup.interval", backup.BackupInterval))
+	}
+
+	if backup.BackupRetention < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: retention should not be negative", "cluster.etcd.backup.retention", backup.BackupRetention))
+	}
+
+	destination := backup.BackupDestination
+
+	switch {
+	case destination == "":
+		result = multierror.Append(result, fmt.Errorf("[%s]: destination is required", "cluster.etcd.backup.destination"))
+	case strings.HasPrefix(destination, "s3://"):
+		u, err := url.Parse(destination)
+		if err != nil || u.Host == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid S3 URL, expected s3://bucket/prefix", "cluster.etcd.backup.destination", destination))
+		}
+
+		if backup.BackupS3 == nil || backup.BackupS3.S3AccessKeyID == "" || backup.BackupS3.S3SecretAccessKey == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: access key ID and secret access key are required for S3 destination", "cluster.etcd.backup.s3"))
+		}
+
+		if backup.BackupS3 != nil && backup.BackupS3.S3Endpoint != "" {
+			if _, err = url.ParseRequestURI(backup.BackupS3.S3Endpoint); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "cluster.etcd.backup.s3.endpoint", backup.BackupS3.S3Endpoint, err))
+			}
+		}
+	default:
+		if !filepath.IsAbs(destination) || !strings.HasPrefix(filepath.Clean(destination), constants.EphemeralMountPoint+"/") {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: destination should be either a directory under %s or an S3 URL", "cluster.etcd.backup.destination", destination, constants.EphemeralMountPoint))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+var (
+	rxLabelName   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	rxLabelPrefix = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// isValidLabelKey checks that the key is a valid Kubernetes label (or taint) key: [prefix/]name.
+func isValidLabelKey(key string) bool {
+	name := key
+
+	if idx := strings.LastIndex(key, "/"); idx >= 0 {
+		prefix := key[:idx]
+		name = key[idx+1:]
+
+		if len(prefix) > 253 || !rxLabelPrefix.MatchString(prefix) {
+			return false
+		}
+	}
+
+	return len(name) <= 63 && rxLabelName.MatchString(name)
+}
+
+// isValidLabelValue checks that the value is a valid Kubernetes label (or taint) value.
+func isValidLabelValue(value string) bool {
+	return value == "" || (len(value) <= 63 && rxLabelName.MatchString(value))
+}
+
+// kubeletAllowedLabels is the list of labels in the kubernetes.io namespace the kubelet is allowed to set.
+var kubeletAllowedLabels = map[string]struct{}{
+	"kubernetes.io/hostname":                   {},
+	"kubernetes.io/arch":                       {},
+	"kubernetes.io/os":                         {},
+	"beta.kubernetes.io/arch":                  {},
+	"beta.kubernetes.io/os":                    {},
+	"beta.kubernetes.io/instance-type":         {},
+	"node.kubernetes.io/instance-type":         {},
+	"failure-domain.beta.kubernetes.io/region": {},
+	"failure-domain.beta.kubernetes.io/zone":   {},
+	"topology.kubernetes.io/region":            {},
+	"topology.kubernetes.io/zone":              {},
+}
+
+// isRestrictedNodeLabel checks whether the label can't be set by the kubelet with the NodeRestriction admission plugin.
+//
+// Labels are applied with the kubelet credentials, so labels in the kubernetes.io and k8s.io namespaces
+// (e.g. node-role.kubernetes.io/*) are rejected except for the ones the kubelet is allowed to set.
+func isRestrictedNodeLabel(key string) bool {
+	idx := strings.LastIndex(key, "/")
+	if idx < 0 {
+		return false
+	}
+
+	namespace := key[:idx]
+
+	if _, ok := kubeletAllowedLabels[key]; ok {
+		return false
+	}
+
+	for _, allowed := range []string{"kubelet.kubernetes.io", "node.kubernetes.io"} {
+		if namespace == allowed || strings.HasSuffix(namespace, "."+allowed) {
+			return false
+		}
+	}
+
+	for _, restricted := range []string{"kubernetes.io", "k8s.io"} {
+		if namespace == restricted || strings.HasSuffix(namespace, "."+restricted) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateNodeLabels ensures that the node labels are valid Kubernetes labels.
+func validateNodeLabels(labels map[string]string) error {
+	var result *multierror.Error
+
+	keys := maps.Keys(labels)
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !isValidLabelKey(key) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid label key", "machine.nodeLabels", key))
+		} else if isRestrictedNodeLabel(key) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: label is not allowed by the NodeRestriction admission plugin", "machine.nodeLabels", key))
+		}
+
+		if !isValidLabelValue(labels[key]) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid label value %q", "machine.nodeLabels", key, labels[key]))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateNodeTaints ensures that the node taints are valid Kubernetes taints.
+func validateNodeTaints(taints map[string]string) error {
+	var result *multierror.Error
+
+	keys := maps.Keys(taints)
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !isValidLabelKey(key) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid taint key", "machine.nodeTaints", key))
+		}
+
+		value, effect, found := strings.Cut(taints[key], ":")
+		if !found {
+			value, effect = "", value
+		}
+
+		if !isValidLabelValue(value) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid taint value %q", "machine.nodeTaints", key, value))
+		}
+
+		switch effect {
+		case "NoSchedule", "PreferNoSchedule", "NoExecute":
+		default:
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid taint effect %q", "machine.nodeTaints", key, effect))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validateBridgeVLAN ensures that the bridge VLAN settings are valid.
+//
+//nolint:gocyclo
+func validateBridgeVLAN(iface string, bridge *Bridge) error {
+	if bridge.BridgeVLAN == nil {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	validVLAN := func(vid uint16) bool {
+		return vid >= 1 && vid <= 4094
+	}
+
+	if bridge.BridgeVLAN.DefaultPVID() != 0 && !validVLAN(bridge.BridgeVLAN.DefaultPVID()) {
+		result = multierror.Append(result, fmt.Errorf("[networking.os.device.bridge.vlan.defaultPVID] %d: VLAN ID should be in range 1-4094", bridge.BridgeVLAN.DefaultPVID()))
+	}
+
+	ports := map[string]struct{}{}
+
+	for idx, port := range bridge.BridgeVLAN.Ports() {
+		path := "networking.os.device.bridge.vlan.ports[" + strconv.Itoa(idx) + "]"
+
+		if _, exists := ports[port.Interface()]; exists {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: duplicate bridge port", path+".interface", port.Interface()))
+		}
+
+		ports[port.Interface()] = struct{}{}
+
+		// bridge members picked by the device selectors are known only at runtime
+		if len(bridge.BridgeDeviceSelectors) == 0 && !slices.Contains(bridge.Interfaces(), func(name string) bool { return name == port.Interface() }) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: interface is not part of the bridge %q", path+".interface", port.Interface(), iface))
+		}
+
+		if port.PVID() != 0 && !validVLAN(port.PVID()) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: VLAN ID should be in range 1-4094", path+".pvid", port.PVID()))
+		}
+
+		for _, vid := range port.TaggedVLANs() {
+			if !validVLAN(vid) {
+				result = multierror.Append(result, fmt.Errorf("[%s] %d: VLAN ID should be in range 1-4094", path+".taggedVLANs", vid))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 // Validate kubelet configuration.
 func (k *KubeletConfig) Validate() ([]string, error) {
 	var result *multierror.Error
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -185,6 +187,31 @@ func TestValidate(t *testing.T) {
 			requiresInstall: true,
 			expectedError:   "1 error occurred:\n\t* duplicate system extension \"ghcr.io/siderolabs/gvisor:v0.1.0\"\n\n",
 		},
+		{
+			name: "MachineInstallEphemeralInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk: "/dev/vda",
+						InstallEphemeral: &v1alpha1.InstallEphemeralConfig{
+							EphemeralDiskSelector: &v1alpha1.InstallDiskSelector{},
+							EphemeralMinSize:      v1alpha1.DiskSize(200000000000),
+							EphemeralMaxSize:      v1alpha1.DiskSize(100000000000),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* install.ephemeral.diskSelector should have at least one disk attribute\n\t* install.ephemeral.minSize 200000000000 is greater than maxSize 100000000000\n\n",
+		},
 		{
 			name: "ExternalCloudProviderEnabled",
 			config: &v1alpha1.Config{
@@ -875,6 +902,261 @@ func TestValidate(t *testing.T) {
 				"\t* [networking.os.device.route[5].source] \"10.0.0.3/32\": invalid network address\n" +
 				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n\n",
 		},
+		{
+			name: "RoutingRules",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteGateway: "172.0.0.1",
+										RouteTable:   "100",
+									},
+									{
+										RouteGateway: "172.0.0.1",
+										RouteTable:   "foo",
+									},
+									{
+										RouteGateway: "172.0.0.1",
+										RouteTable:   "unspec",
+									},
+								},
+							},
+						},
+						NetworkRoutingRules: []*v1alpha1.RoutingRule{
+							{
+								RulePriority: 1000,
+								RuleFrom:     "10.3.0.0/24",
+								RuleTable:    "100",
+							},
+							{
+								RulePriority: 1000,
+								RuleFrom:     "fd00::/64",
+								RuleTable:    "main",
+							},
+							{
+								RulePriority: 1000,
+								RuleFwMark:   1,
+								RuleTable:    "100",
+							},
+							{
+								RulePriority: 32766,
+								RuleFrom:     "10.3.0.0/24",
+								RuleTo:       "fd00::/64",
+							},
+							{
+								RulePriority: 2000,
+								RuleTo:       "10.3.0.0",
+								RuleTable:    "0",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "8 errors occurred:\n\t* [networking.os.device.route[1].table]: invalid routing table \"foo\"\n" +
+				"\t* [networking.os.device.route[2].table]: routing table \"unspec\" is reserved\n" +
+				"\t* [networking.routingRules[2].priority] 1000: duplicate rule priority\n" +
+				"\t* [networking.routingRules[3].priority] 32766: priority should be in range 1-32765\n" +
+				"\t* [networking.routingRules[3]]: from and to should be of the same address family\n" +
+				"\t* [networking.routingRules[3].table]: table should be set\n" +
+				"\t* [networking.routingRules[4].to] \"10.3.0.0\": invalid network address\n" +
+				"\t* [networking.routingRules[4].table]: routing table \"0\" is reserved\n\n",
+		},
+		{
+			name: "BridgeVLAN",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgedInterfaces: []string{
+										"eth0",
+										"eth1",
+									},
+									BridgeVLAN: &v1alpha1.BridgeVLAN{
+										BridgeVLANFiltering:   pointer.To(true),
+										BridgeVLANDefaultPVID: 4095,
+										BridgeVLANPorts: []*v1alpha1.BridgePortVLAN{
+											{
+												PortInterface:   "eth0",
+												PortPVID:        10,
+												PortTaggedVLANs: []uint16{20, 30},
+											},
+											{
+												PortInterface: "eth0",
+												PortPVID:      20,
+											},
+											{
+												PortInterface:   "eth2",
+												PortTaggedVLANs: []uint16{0, 5000},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* [networking.os.device.bridge.vlan.defaultPVID] 4095: VLAN ID should be in range 1-4094\n" +
+				"\t* [networking.os.device.bridge.vlan.ports[1].interface] \"eth0\": duplicate bridge port\n" +
+				"\t* [networking.os.device.bridge.vlan.ports[2].interface] \"eth2\": interface is not part of the bridge \"br0\"\n" +
+				"\t* [networking.os.device.bridge.vlan.ports[2].taggedVLANs] 0: VLAN ID should be in range 1-4094\n" +
+				"\t* [networking.os.device.bridge.vlan.ports[2].taggedVLANs] 5000: VLAN ID should be in range 1-4094\n\n",
+		},
+		{
+			name: "MemberDeviceSelectors",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{"eth0"},
+									BondDeviceSelectors: []v1alpha1.NetworkDeviceSelector{
+										{
+											NetworkDeviceKernelDriver: "ixgbe",
+										},
+									},
+								},
+							},
+							{
+								DeviceInterface: "br0",
+								DeviceBridge: &v1alpha1.Bridge{
+									BridgeDeviceSelectors: []v1alpha1.NetworkDeviceSelector{
+										{
+											NetworkDeviceHardwareAddress: "00:25:90:*",
+										},
+										{},
+									},
+									BridgeVLAN: &v1alpha1.BridgeVLAN{
+										BridgeVLANFiltering: pointer.To(true),
+										BridgeVLANPorts: []*v1alpha1.BridgePortVLAN{
+											{
+												PortInterface: "eth1",
+												PortPVID:      10,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [networking.os.device.bond.interfaces], [networking.os.device.bond.deviceSelectors]: config sections are mutually exclusive\n" +
+				"\t* [networking.os.device.bridge.deviceSelectors[1]]: config section should contain at least one field\n\n",
+		},
+		{
+			name: "HostDNS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkHostDNS: &v1alpha1.HostDNS{
+							HostDNSEnabled:   true,
+							HostDNSCacheSize: -1,
+							HostDNSDomainUpstreams: []*v1alpha1.HostDNSDomainUpstream{
+								{
+									UpstreamDomain:      "corp.example.com",
+									UpstreamNameservers: []string{"10.0.0.53"},
+								},
+								{
+									UpstreamDomain:      "Corp.Example.com.",
+									UpstreamNameservers: []string{"10.0.0.54"},
+								},
+								{
+									UpstreamDomain:      "bad domain",
+									UpstreamNameservers: []string{"dns.example.com"},
+								},
+								{
+									UpstreamDomain: "lab.example.com",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* [networking.hostDNS.cacheSize] -1: cache size should not be negative\n" +
+				"\t* [networking.hostDNS.domainUpstreams[1].domain] \"Corp.Example.com.\": duplicate domain\n" +
+				"\t* [networking.hostDNS.domainUpstreams[2].domain] \"bad domain\": invalid domain name\n" +
+				"\t* [networking.hostDNS.domainUpstreams[2].nameservers] \"dns.example.com\": invalid network address\n" +
+				"\t* [networking.hostDNS.domainUpstreams[3].nameservers]: at least one nameserver should be set\n\n",
+		},
+		{
+			name: "NodeLabelsTaints",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNodeLabels: map[string]string{
+						"example.com/role":               "db",
+						"-bad":                           "value",
+						"good":                           "bad value!",
+						"node-role.kubernetes.io/worker": "",
+						"node.kubernetes.io/pool":        "db",
+						"topology.kubernetes.io/zone":    "zone-a",
+					},
+					MachineNodeTaints: map[string]string{
+						"dedicated": "db:NoSchedule",
+						"gpu":       "NoExecute",
+						"bad":       "value:Sometimes",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* [machine.nodeLabels] \"-bad\": invalid label key\n" +
+				"\t* [machine.nodeLabels] \"good\": invalid label value \"bad value!\"\n" +
+				"\t* [machine.nodeLabels] \"node-role.kubernetes.io/worker\": label is not allowed by the NodeRestriction admission plugin\n" +
+				"\t* [machine.nodeTaints] \"bad\": invalid taint effect \"Sometimes\"\n\n",
+		},
 		{
 			name: "KubeSpanNoDiscovery",
 			config: &v1alpha1.Config{
@@ -986,6 +1268,61 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* \"10.0.0.0\" is not a valid subnet\n\n",
 		},
+		{
+			name: "GoodEtcdBackup",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						EtcdBackup: &v1alpha1.EtcdBackupConfig{
+							BackupInterval:    time.Hour,
+							BackupRetention:   5,
+							BackupDestination: "s3://backups/cluster",
+							BackupS3: &v1alpha1.EtcdBackupS3Config{
+								S3Endpoint:        "https://minio.example.com",
+								S3AccessKeyID:     "key",
+								S3SecretAccessKey: "secret",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadEtcdBackup",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						EtcdBackup: &v1alpha1.EtcdBackupConfig{
+							BackupInterval:    time.Second,
+							BackupRetention:   -1,
+							BackupDestination: "/system/backups",
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* [cluster.etcd.backup.interval] 1s: interval should be at least 1m\n" +
+				"\t* [cluster.etcd.backup.retention] -1: retention should not be negative\n" +
+				"\t* [cluster.etcd.backup.destination] \"/system/backups\": destination should be either a directory under /var or an S3 URL\n\n",
+		},
 		{
 			name: "GoodKubeletSubnet",
 			config: &v1alpha1.Config{
@@ -1137,6 +1474,410 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* [networking.os.device.deviceSelector]: config section should contain at least one field\n\n",
 		},
+		{
+			name: "LoggingDestinations",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{mustParseURL(t, "udp://127.0.0.1:514")},
+								LoggingFormat:   "syslog",
+								LoggingFilter: &v1alpha1.LoggingFilterConfig{
+									FilterMinLevel:        "warn",
+									FilterIncludeServices: []string{"etcd", "kubelet"},
+									FilterMessageRegex:    "^\\[talos\\]",
+									FilterSampling: &v1alpha1.LoggingSamplingConfig{
+										SamplingInitial: 10,
+									},
+								},
+								LoggingExtraFields: map[string]string{
+									"cluster": "prod",
+								},
+							},
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{mustParseURL(t, "tls://logs.example.com:6514")},
+								LoggingFormat:   "gelf",
+								LoggingTLS:      &v1alpha1.LoggingTLSConfig{},
+							},
+						},
+						LoggingSpool: &v1alpha1.LoggingSpoolConfig{
+							SpoolEnabled: true,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadLoggingDestinations",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{mustParseURL(t, "tcp://127.0.0.1:514")},
+								LoggingFormat:   "rfc3164",
+								LoggingTLS: &v1alpha1.LoggingTLSConfig{
+									TLSCA: []byte("not a certificate"),
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* logging TLS settings require \"tls\" endpoint scheme, got \"tcp\"\n" +
+				"\t* unknown logging format \"rfc3164\"\n\t* failed to parse logging TLS CA certificate\n\n",
+		},
+		{
+			name: "BadLoggingFilter",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{mustParseURL(t, "udp://127.0.0.1:12201")},
+								LoggingFormat:   "gelf",
+								LoggingFilter: &v1alpha1.LoggingFilterConfig{
+									FilterMinLevel:     "verbose",
+									FilterMessageRegex: "[a-",
+									FilterSampling: &v1alpha1.LoggingSamplingConfig{
+										SamplingThereafter: -1,
+									},
+								},
+								LoggingExtraFields: map[string]string{
+									"talos-service": "foo",
+								},
+							},
+						},
+						LoggingSpool: &v1alpha1.LoggingSpoolConfig{
+							SpoolEnabled: true,
+							SpoolMaxSize: 1000,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n\t* unknown logging filter level \"verbose\"\n" +
+				"\t* invalid logging filter message regex: error parsing regexp: missing closing ]: `[a-`\n" +
+				"\t* logging sampling initial should be positive, got 0\n\t* logging sampling thereafter should not be negative, got -1\n" +
+				"\t* logging extra field name \"talos-service\" is reserved\n" +
+				"\t* logging spool max size should be at least 1048576 bytes, got 1000\n\n",
+		},
+		{
+			name: "BadMetricsBindAddress",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineMetrics: &v1alpha1.MetricsConfig{
+						MetricsEnabled:     true,
+						MetricsBindAddress: "localhost",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* metrics bind address \"localhost\" is not a valid IP address\n\n",
+		},
+		{
+			name: "BadRBACRoles",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						RBACRoles: []*v1alpha1.RBACRole{
+							{
+								RoleName:  "os:sre",
+								RoleAllow: []string{"os:operator", "/machine.MachineService/Reboot"},
+							},
+							{
+								RoleName:  "sre",
+								RoleAllow: []string{"/machine.MachineService/*"},
+							},
+							{
+								RoleName:  "sre",
+								RoleAllow: []string{"Reboot"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n" +
+				"\t* [features.rbacRoles[0].name] \"os:sre\": prefix \"os:\" is reserved for the built-in roles\n" +
+				"\t* [features.rbacRoles[0].allow] \"os:operator\": unknown built-in role\n" +
+				"\t* [features.rbacRoles[2].name] \"sre\": duplicate role name\n" +
+				"\t* [features.rbacRoles[2].allow] \"Reboot\": expected full gRPC method name (/<package>.<Service>/<Method>), service wildcard (/<package>.<Service>/*) or built-in role name\n\n",
+		},
+		{
+			name: "BadOIDC",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						RBAC: pointer.To(true),
+						RBACRoles: []*v1alpha1.RBACRole{
+							{
+								RoleName:  "sre",
+								RoleAllow: []string{"os:reader"},
+							},
+						},
+						OIDCConfig: &v1alpha1.OIDCConfig{
+							OIDCIssuerURL: "dex.example.com",
+							OIDCGroupRoles: map[string][]string{
+								"admins": {"os:admin"},
+								"sre":    {"sre", "oncall"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* [features.oidc.issuerURL] \"dex.example.com\": expected HTTP(S) URL\n" +
+				"\t* [features.oidc.clientID]: client ID is required\n" +
+				"\t* [features.oidc.groupRoles.sre] \"oncall\": role is neither built-in nor defined in features.rbacRoles\n\n",
+		},
+		{
+			name: "OIDCWithoutRBAC",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						OIDCConfig: &v1alpha1.OIDCConfig{
+							OIDCIssuerURL: "https://dex.example.com",
+							OIDCClientID:  "talos",
+							OIDCGroupRoles: map[string][]string{
+								"admins": {"os:admin"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [features.oidc]: OIDC login requires features.rbac to be enabled\n\n",
+		},
+		{
+			name: "BadEncryptionKMS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						StatePartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "https://192.168.88.21:4443",
+									},
+									KeySlot: 0,
+								},
+								{
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "192.168.88.21:4443",
+									},
+									KeySlot: 1,
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* encryption key at slot 1: KMS endpoint \"192.168.88.21:4443\": expected HTTP(S) URL\n\n",
+		},
+		{
+			name: "UserVolumes",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineVolumes: []*v1alpha1.UserVolumeConfig{
+						{
+							VolumeName: "data",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "WDC*",
+							},
+						},
+						{
+							VolumeName: "logs",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Serial: "12345",
+							},
+							VolumeSize:       v1alpha1.DiskSize(100 * 1024 * 1024 * 1024),
+							VolumeFilesystem: "ext4",
+							VolumeMountPoint: "/var/log/extra",
+							VolumeEncryption: &v1alpha1.EncryptionConfig{
+								EncryptionProvider: "luks2",
+								EncryptionKeys: []*v1alpha1.EncryptionKey{
+									{
+										KeyNodeID: &v1alpha1.EncryptionKeyNodeID{},
+										KeySlot:   0,
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BadUserVolumes",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineVolumes: []*v1alpha1.UserVolumeConfig{
+						{
+							VolumeName: "Data",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "WDC*",
+							},
+						},
+						{
+							VolumeName:       "logs",
+							VolumeFilesystem: "ext2",
+							VolumeMountPoint: "/opt/logs",
+						},
+						{
+							VolumeName: "logs",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "WDC*",
+							},
+							VolumeMountPoint: "/opt/logs/",
+							VolumeEncryption: &v1alpha1.EncryptionConfig{
+								EncryptionProvider: "luks2",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "8 errors occurred:\n" +
+				"\t* volume name \"Data\" is invalid: it should consist of lowercase alphanumeric characters or '-', up to 34 characters\n" +
+				"\t* volume \"logs\": diskSelector is required\n" +
+				"\t* volume \"logs\": unsupported filesystem \"ext2\"\n" +
+				"\t* volume \"logs\": mountpoint \"/opt/logs\" should be under /var\n" +
+				"\t* volume \"logs\" is defined more than once\n" +
+				"\t* volume \"logs\": mountpoint \"/opt/logs/\" should be under /var\n" +
+				"\t* volume \"logs\": mountpoint \"/opt/logs/\" is already in use\n" +
+				"\t* volume \"logs\": no encryption keys provided\n\n",
+		},
+		{
+			name: "UserVolumeFilesystems",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineVolumes: []*v1alpha1.UserVolumeConfig{
+						{
+							VolumeName: "data",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "WDC*",
+							},
+							VolumeFilesystem: "xfs",
+						},
+						{
+							VolumeName: "logs",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "WDC*",
+							},
+							VolumeFilesystem: "ext4",
+						},
+						{
+							VolumeName: "boot",
+							VolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "WDC*",
+							},
+							VolumeFilesystem: "vfat",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* volume \"boot\": unsupported filesystem \"vfat\"\n\n",
+		},
 	} {
 		test := test
 
@@ -1257,3 +1998,10 @@ func TestValidateCNI(t *testing.T) {
 		})
 	}
 }
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+
+	return u
+}
@@ -121,6 +121,11 @@ func (in *Bond) DeepCopyInto(out *Bond) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BondDeviceSelectors != nil {
+		in, out := &in.BondDeviceSelectors, &out.BondDeviceSelectors
+		*out = make([]NetworkDeviceSelector, len(*in))
+		copy(*out, *in)
+	}
 	if in.BondARPIPTarget != nil {
 		in, out := &in.BondARPIPTarget, &out.BondARPIPTarget
 		*out = make([]string, len(*in))
@@ -152,11 +157,21 @@ func (in *Bridge) DeepCopyInto(out *Bridge) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BridgeDeviceSelectors != nil {
+		in, out := &in.BridgeDeviceSelectors, &out.BridgeDeviceSelectors
+		*out = make([]NetworkDeviceSelector, len(*in))
+		copy(*out, *in)
+	}
 	if in.BridgeSTP != nil {
 		in, out := &in.BridgeSTP, &out.BridgeSTP
 		*out = new(STP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BridgeVLAN != nil {
+		in, out := &in.BridgeVLAN, &out.BridgeVLAN
+		*out = new(BridgeVLAN)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -170,6 +185,59 @@ func (in *Bridge) DeepCopy() *Bridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgePortVLAN) DeepCopyInto(out *BridgePortVLAN) {
+	*out = *in
+	if in.PortTaggedVLANs != nil {
+		in, out := &in.PortTaggedVLANs, &out.PortTaggedVLANs
+		*out = make([]uint16, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgePortVLAN.
+func (in *BridgePortVLAN) DeepCopy() *BridgePortVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgePortVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeVLAN) DeepCopyInto(out *BridgeVLAN) {
+	*out = *in
+	if in.BridgeVLANFiltering != nil {
+		in, out := &in.BridgeVLANFiltering, &out.BridgeVLANFiltering
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BridgeVLANPorts != nil {
+		in, out := &in.BridgeVLANPorts, &out.BridgeVLANPorts
+		*out = make([]*BridgePortVLAN, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BridgePortVLAN)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeVLAN.
+func (in *BridgeVLAN) DeepCopy() *BridgeVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CNIConfig) DeepCopyInto(out *CNIConfig) {
 	*out = *in
@@ -509,7 +577,7 @@ func (in *Device) DeepCopyInto(out *Device) {
 	}
 	if in.DeviceRoutes != nil {
 		in, out := &in.DeviceRoutes, &out.DeviceRoutes
-		*out = make([]*Route, len(*in))
+		*out = make(RouteList, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
@@ -530,7 +598,7 @@ func (in *Device) DeepCopyInto(out *Device) {
 	}
 	if in.DeviceVlans != nil {
 		in, out := &in.DeviceVlans, &out.DeviceVlans
-		*out = make([]*Vlan, len(*in))
+		*out = make(VlanList, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
@@ -720,6 +788,11 @@ func (in *EncryptionKey) DeepCopyInto(out *EncryptionKey) {
 		*out = new(EncryptionKeyNodeID)
 		**out = **in
 	}
+	if in.KeyKMS != nil {
+		in, out := &in.KeyKMS, &out.KeyKMS
+		*out = new(EncryptionKeyKMS)
+		**out = **in
+	}
 	return
 }
 
@@ -733,6 +806,22 @@ func (in *EncryptionKey) DeepCopy() *EncryptionKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyKMS) DeepCopyInto(out *EncryptionKeyKMS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyKMS.
+func (in *EncryptionKeyKMS) DeepCopy() *EncryptionKeyKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionKeyNodeID) DeepCopyInto(out *EncryptionKeyNodeID) {
 	*out = *in
@@ -765,6 +854,43 @@ func (in *EncryptionKeyStatic) DeepCopy() *EncryptionKeyStatic {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupConfig) DeepCopyInto(out *EtcdBackupConfig) {
+	*out = *in
+	if in.BackupS3 != nil {
+		in, out := &in.BackupS3, &out.BackupS3
+		*out = new(EtcdBackupS3Config)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupConfig.
+func (in *EtcdBackupConfig) DeepCopy() *EtcdBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupS3Config) DeepCopyInto(out *EtcdBackupS3Config) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupS3Config.
+func (in *EtcdBackupS3Config) DeepCopy() *EtcdBackupS3Config {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupS3Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 	*out = *in
@@ -779,6 +905,11 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.EtcdBackup != nil {
+		in, out := &in.EtcdBackup, &out.EtcdBackup
+		*out = new(EtcdBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -834,6 +965,28 @@ func (in *ExtraHost) DeepCopy() *ExtraHost {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ExtraMountList) DeepCopyInto(out *ExtraMountList) {
+	{
+		in := &in
+		*out = make(ExtraMountList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraMountList.
+func (in ExtraMountList) DeepCopy() ExtraMountList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtraMountList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 	*out = *in
@@ -842,6 +995,22 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RBACRoles != nil {
+		in, out := &in.RBACRoles, &out.RBACRoles
+		*out = make([]*RBACRole, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RBACRole)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.OIDCConfig != nil {
+		in, out := &in.OIDCConfig, &out.OIDCConfig
+		*out = new(OIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -855,6 +1024,54 @@ func (in *FeaturesConfig) DeepCopy() *FeaturesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNS) DeepCopyInto(out *HostDNS) {
+	*out = *in
+	if in.HostDNSDomainUpstreams != nil {
+		in, out := &in.HostDNSDomainUpstreams, &out.HostDNSDomainUpstreams
+		*out = make([]*HostDNSDomainUpstream, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HostDNSDomainUpstream)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNS.
+func (in *HostDNS) DeepCopy() *HostDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNSDomainUpstream) DeepCopyInto(out *HostDNSDomainUpstream) {
+	*out = *in
+	if in.UpstreamNameservers != nil {
+		in, out := &in.UpstreamNameservers, &out.UpstreamNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNSDomainUpstream.
+func (in *HostDNSDomainUpstream) DeepCopy() *HostDNSDomainUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNSDomainUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 	*out = *in
@@ -873,6 +1090,11 @@ func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 		*out = make([]InstallExtensionConfig, len(*in))
 		copy(*out, *in)
 	}
+	if in.InstallEphemeral != nil {
+		in, out := &in.InstallEphemeral, &out.InstallEphemeral
+		*out = new(InstallEphemeralConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -916,6 +1138,27 @@ func (in *InstallDiskSizeMatcher) DeepCopy() *InstallDiskSizeMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallEphemeralConfig) DeepCopyInto(out *InstallEphemeralConfig) {
+	*out = *in
+	if in.EphemeralDiskSelector != nil {
+		in, out := &in.EphemeralDiskSelector, &out.EphemeralDiskSelector
+		*out = new(InstallDiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallEphemeralConfig.
+func (in *InstallEphemeralConfig) DeepCopy() *InstallEphemeralConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallEphemeralConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallExtensionConfig) DeepCopyInto(out *InstallExtensionConfig) {
 	*out = *in
@@ -992,7 +1235,7 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	}
 	if in.KubeletExtraMounts != nil {
 		in, out := &in.KubeletExtraMounts, &out.KubeletExtraMounts
-		*out = make([]ExtraMount, len(*in))
+		*out = make(ExtraMountList, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1043,6 +1286,11 @@ func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LoggingSpool != nil {
+		in, out := &in.LoggingSpool, &out.LoggingSpool
+		*out = new(LoggingSpoolConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -1063,6 +1311,23 @@ func (in *LoggingDestination) DeepCopyInto(out *LoggingDestination) {
 		in, out := &in.LoggingEndpoint, &out.LoggingEndpoint
 		*out = (*in).DeepCopy()
 	}
+	if in.LoggingTLS != nil {
+		in, out := &in.LoggingTLS, &out.LoggingTLS
+		*out = new(LoggingTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoggingFilter != nil {
+		in, out := &in.LoggingFilter, &out.LoggingFilter
+		*out = new(LoggingFilterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoggingExtraFields != nil {
+		in, out := &in.LoggingExtraFields, &out.LoggingExtraFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1076,6 +1341,90 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingFilterConfig) DeepCopyInto(out *LoggingFilterConfig) {
+	*out = *in
+	if in.FilterIncludeServices != nil {
+		in, out := &in.FilterIncludeServices, &out.FilterIncludeServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterExcludeServices != nil {
+		in, out := &in.FilterExcludeServices, &out.FilterExcludeServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterSampling != nil {
+		in, out := &in.FilterSampling, &out.FilterSampling
+		*out = new(LoggingSamplingConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingFilterConfig.
+func (in *LoggingFilterConfig) DeepCopy() *LoggingFilterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingFilterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSamplingConfig) DeepCopyInto(out *LoggingSamplingConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSamplingConfig.
+func (in *LoggingSamplingConfig) DeepCopy() *LoggingSamplingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSamplingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSpoolConfig) DeepCopyInto(out *LoggingSpoolConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpoolConfig.
+func (in *LoggingSpoolConfig) DeepCopy() *LoggingSpoolConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSpoolConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTLSConfig) DeepCopyInto(out *LoggingTLSConfig) {
+	*out = *in
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTLSConfig.
+func (in *LoggingTLSConfig) DeepCopy() *LoggingTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1121,6 +1470,17 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			}
 		}
 	}
+	if in.MachineVolumes != nil {
+		in, out := &in.MachineVolumes, &out.MachineVolumes
+		*out = make([]*UserVolumeConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserVolumeConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.MachineInstall != nil {
 		in, out := &in.MachineInstall, &out.MachineInstall
 		*out = new(InstallConfig)
@@ -1184,11 +1544,30 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 		*out = new(LoggingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MachineMetrics != nil {
+		in, out := &in.MachineMetrics, &out.MachineMetrics
+		*out = new(MetricsConfig)
+		**out = **in
+	}
 	if in.MachineKernel != nil {
 		in, out := &in.MachineKernel, &out.MachineKernel
 		*out = new(KernelConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MachineNodeLabels != nil {
+		in, out := &in.MachineNodeLabels, &out.MachineNodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MachineNodeTaints != nil {
+		in, out := &in.MachineNodeTaints, &out.MachineNodeTaints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1303,12 +1682,28 @@ func (in *MachineSchedulerConfig) DeepCopy() *MachineSchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfig) DeepCopyInto(out *MetricsConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfig.
+func (in *MetricsConfig) DeepCopy() *MetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
 	if in.NetworkInterfaces != nil {
 		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]*Device, len(*in))
+		*out = make(NetworkDeviceList, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
@@ -1334,6 +1729,22 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		}
 	}
 	out.NetworkKubeSpan = in.NetworkKubeSpan
+	if in.NetworkRoutingRules != nil {
+		in, out := &in.NetworkRoutingRules, &out.NetworkRoutingRules
+		*out = make([]*RoutingRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RoutingRule)
+				**out = **in
+			}
+		}
+	}
+	if in.NetworkHostDNS != nil {
+		in, out := &in.NetworkHostDNS, &out.NetworkHostDNS
+		*out = new(HostDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1347,6 +1758,32 @@ func (in *NetworkConfig) DeepCopy() *NetworkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in NetworkDeviceList) DeepCopyInto(out *NetworkDeviceList) {
+	{
+		in := &in
+		*out = make(NetworkDeviceList, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Device)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkDeviceList.
+func (in NetworkDeviceList) DeepCopy() NetworkDeviceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkDeviceList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkDeviceSelector) DeepCopyInto(out *NetworkDeviceSelector) {
 	*out = *in
@@ -1379,6 +1816,37 @@ func (in *NetworkKubeSpan) DeepCopy() *NetworkKubeSpan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	if in.OIDCGroupRoles != nil {
+		in, out := &in.OIDCGroupRoles, &out.OIDCGroupRoles
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodCheckpointer) DeepCopyInto(out *PodCheckpointer) {
 	*out = *in
@@ -1418,6 +1886,27 @@ func (in *ProxyConfig) DeepCopy() *ProxyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACRole) DeepCopyInto(out *RBACRole) {
+	*out = *in
+	if in.RoleAllow != nil {
+		in, out := &in.RoleAllow, &out.RoleAllow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACRole.
+func (in *RBACRole) DeepCopy() *RBACRole {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistriesConfig) DeepCopyInto(out *RegistriesConfig) {
 	*out = *in
@@ -1600,6 +2089,48 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RouteList) DeepCopyInto(out *RouteList) {
+	{
+		in := &in
+		*out = make(RouteList, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Route)
+				**out = **in
+			}
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in RouteList) DeepCopy() RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRule) DeepCopyInto(out *RoutingRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRule.
+func (in *RoutingRule) DeepCopy() *RoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *STP) DeepCopyInto(out *STP) {
 	*out = *in
@@ -1731,6 +2262,32 @@ func (in *Unstructured) DeepCopyInto(out *Unstructured) {
 	return
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserVolumeConfig) DeepCopyInto(out *UserVolumeConfig) {
+	*out = *in
+	if in.VolumeDiskSelector != nil {
+		in, out := &in.VolumeDiskSelector, &out.VolumeDiskSelector
+		*out = new(InstallDiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeEncryption != nil {
+		in, out := &in.VolumeEncryption, &out.VolumeEncryption
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserVolumeConfig.
+func (in *UserVolumeConfig) DeepCopy() *UserVolumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UserVolumeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VIPEquinixMetalConfig) DeepCopyInto(out *VIPEquinixMetalConfig) {
 	*out = *in
@@ -1773,7 +2330,7 @@ func (in *Vlan) DeepCopyInto(out *Vlan) {
 	}
 	if in.VlanRoutes != nil {
 		in, out := &in.VlanRoutes, &out.VlanRoutes
-		*out = make([]*Route, len(*in))
+		*out = make(RouteList, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
@@ -1800,6 +2357,32 @@ func (in *Vlan) DeepCopy() *Vlan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in VlanList) DeepCopyInto(out *VlanList) {
+	{
+		in := &in
+		*out = make(VlanList, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Vlan)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VlanList.
+func (in VlanList) DeepCopy() VlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(VlanList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountConfig) DeepCopyInto(out *VolumeMountConfig) {
 	*out = *in
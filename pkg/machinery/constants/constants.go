@@ -143,6 +143,15 @@ const (
 	// the data path.
 	EphemeralMountPoint = "/var"
 
+	// UserVolumePartitionLabelPrefix is the prefix of the user volume partition label.
+	UserVolumePartitionLabelPrefix = "u-"
+
+	// UserVolumeMountPoint is the directory to mount user volumes at by default.
+	UserVolumeMountPoint = EphemeralMountPoint + "/" + "mnt"
+
+	// UserVolumeDefaultFilesystem is the default filesystem of the user volume.
+	UserVolumeDefaultFilesystem = "xfs"
+
 	// RootMountPoint is the label of the partition to use for mounting at
 	// the root path.
 	RootMountPoint = "/"
@@ -337,6 +346,18 @@ const (
 	// EtcdRecoverySnapshotPath is the path where etcd snapshot is uploaded for recovery.
 	EtcdRecoverySnapshotPath = "/var/lib/etcd.snapshot"
 
+	// EtcdBackupStagingPath is the path where scheduled etcd backups are staged before they are uploaded.
+	EtcdBackupStagingPath = "/var/lib/etcd-backup.snapshot"
+
+	// DefaultEtcdBackupInterval is the default interval between scheduled etcd backups.
+	DefaultEtcdBackupInterval = 6 * time.Hour
+
+	// EtcdBackupRetryInterval is the interval to retry a failed or skipped scheduled etcd backup.
+	EtcdBackupRetryInterval = time.Minute
+
+	// DefaultEtcdBackupS3Region is the default region of the S3 etcd backup destination.
+	DefaultEtcdBackupS3Region = "us-east-1"
+
 	// EtcdUserID is the user ID for the etcd process.
 	EtcdUserID = 60
 
@@ -382,6 +403,27 @@ const (
 	// TrustdUserID is the user ID for trustd.
 	TrustdUserID = 51
 
+	// ApidLoginPort is the port for the apid login service, which doesn't require client certificates.
+	ApidLoginPort = 50002
+
+	// DefaultOIDCGroupsClaim is the default ID token claim containing the user groups.
+	DefaultOIDCGroupsClaim = "groups"
+
+	// DefaultOIDCCertLifetime is the default lifetime of the client certificates issued via the OIDC login.
+	DefaultOIDCCertLifetime = time.Hour
+
+	// MachinedMetricsPort is the port for the machined metrics endpoint.
+	MachinedMetricsPort = 50010
+
+	// ApidMetricsPort is the port for the apid metrics endpoint.
+	ApidMetricsPort = 50011
+
+	// TrustdMetricsPort is the port for the trustd metrics endpoint.
+	TrustdMetricsPort = 50012
+
+	// DefaultMetricsBindAddress is the default address the metrics endpoints listen on.
+	DefaultMetricsBindAddress = "127.0.0.1"
+
 	// DefaultContainerdVersion is the default container runtime version.
 	DefaultContainerdVersion = "1.6.6"
 
@@ -418,6 +460,9 @@ const (
 	// MachineSocketPath is the path to file socket of machine API.
 	MachineSocketPath = SystemRunPath + "/machined/machine.sock"
 
+	// MachineAuditSocketPath is the path to file socket accepting the apid audit records.
+	MachineAuditSocketPath = SystemRunPath + "/machined/audit.sock"
+
 	// NetworkSocketPath is the path to file socket of network API.
 	NetworkSocketPath = SystemRunPath + "/networkd/networkd.sock"
 
@@ -536,6 +581,9 @@ const (
 	// FailurePauseTimeout is the timeout for the sequencer failures which can be fixed by updating the machine config.
 	FailurePauseTimeout = 35 * time.Minute
 
+	// KMSRetryTimeout is the timeout to wait for the KMS to become available when opening the encrypted system partitions.
+	KMSRetryTimeout = 10 * time.Minute
+
 	// EtcdJoinTimeout is the timeout for etcd to join the existing cluster.
 	//
 	// BootTimeout should be higher than EtcdJoinTimeout.
@@ -560,6 +608,15 @@ const (
 	// AnnotationStaticPodConfigFileVersion is the annotation key for the static pod configuration file version.
 	AnnotationStaticPodConfigFileVersion = "talos.dev/config-file-version"
 
+	// AnnotationOwnedLabels is the annotation key for the list of node labels owned by Talos.
+	AnnotationOwnedLabels = "talos.dev/owned-labels"
+
+	// AnnotationOwnedTaints is the annotation key for the list of node taints owned by Talos.
+	AnnotationOwnedTaints = "talos.dev/owned-taints"
+
+	// NodeApplyInterval is the interval to re-apply node labels and taints.
+	NodeApplyInterval = 5 * time.Minute
+
 	// DefaultNTPServer is the NTP server to use if not configured explicitly.
 	//
 	// TODO: Once we get naming sorted we need to apply for a project specific address
@@ -572,6 +629,20 @@ const (
 	// DefaultSecondaryResolver is the default secondary DNS server.
 	DefaultSecondaryResolver = "8.8.8.8"
 
+	// HostDNSAddress is the link-local address the host DNS forwarder listens on.
+	HostDNSAddress = "169.254.116.108"
+
+	// HostDNSLinkName is the link the host DNS forwarder address is assigned to.
+	HostDNSLinkName = "lo"
+
+	// DefaultHostDNSCacheSize is the default number of responses cached by the host DNS forwarder.
+	DefaultHostDNSCacheSize = 1024
+
+	// PodResolvConfPath is the path to the resolv.conf used by kubelet for the pods, it always lists upstream nameservers.
+	//
+	// The host DNS forwarder listens on the loopback interface which is not reachable from the pods.
+	PodResolvConfPath = "/system/resolved/resolv.conf"
+
 	// DefaultClusterIDSize is the default size in bytes for the cluster ID token.
 	DefaultClusterIDSize = 32
 
@@ -638,6 +709,21 @@ const (
 	// LoggingFormatJSONLines represents "JSON lines" logging format.
 	LoggingFormatJSONLines = "json_lines"
 
+	// LoggingFormatSyslog represents RFC 5424 syslog logging format.
+	LoggingFormatSyslog = "syslog"
+
+	// LoggingFormatGELF represents GELF logging format.
+	LoggingFormatGELF = "gelf"
+
+	// LogSpoolPath is the path to the on-disk spool of the log events sent to the logging destinations.
+	LogSpoolPath = "/var/log/spool"
+
+	// DefaultLogSpoolMaxSize is the default maximum size of the log spool for each logging destination.
+	DefaultLogSpoolMaxSize = 64 * 1024 * 1024
+
+	// MinLogSpoolMaxSize is the minimum allowed size of the log spool for each logging destination.
+	MinLogSpoolMaxSize = 1024 * 1024
+
 	// SideroLinkName is the interface name for SideroLink.
 	SideroLinkName = "siderolink"
 
@@ -0,0 +1,18 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=ConntrackState -linecomment -text
+
+// ConntrackState is a conntrack state.
+type ConntrackState uint32
+
+// ConntrackState constants.
+const (
+	ConntrackStateInvalid     ConntrackState = 1 // invalid
+	ConntrackStateEstablished ConntrackState = 2 // established
+	ConntrackStateRelated     ConntrackState = 4 // related
+	ConntrackStateNew         ConntrackState = 8 // new
+)
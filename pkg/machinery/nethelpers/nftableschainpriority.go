@@ -0,0 +1,20 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=NfTablesChainPriority -linecomment -text
+
+// NfTablesChainPriority wraps nftables.ChainPriority for YAML marshaling.
+type NfTablesChainPriority int32
+
+// Constants copied from nftables to provide Talos-specific type.
+const (
+	ChainPriorityRaw       NfTablesChainPriority = -300 // raw
+	ChainPriorityMangle    NfTablesChainPriority = -150 // mangle
+	ChainPriorityNATDest   NfTablesChainPriority = -100 // dstnat
+	ChainPriorityFilter    NfTablesChainPriority = 0    // filter
+	ChainPrioritySecurity  NfTablesChainPriority = 50   // security
+	ChainPriorityNATSource NfTablesChainPriority = 100  // srcnat
+)
@@ -0,0 +1,16 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=NfTablesVerdict -linecomment -text
+
+// NfTablesVerdict wraps nftables.Verdict for YAML marshaling.
+type NfTablesVerdict int32
+
+// Constants copied from nftables to provide Talos-specific type.
+const (
+	VerdictDrop   NfTablesVerdict = 0 // drop
+	VerdictAccept NfTablesVerdict = 1 // accept
+)
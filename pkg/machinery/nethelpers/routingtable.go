@@ -4,6 +4,11 @@
 
 package nethelpers
 
+import (
+	"fmt"
+	"strconv"
+)
+
 //go:generate enumer -type=RoutingTable -linecomment -text
 
 // RoutingTable is a routing table ID.
@@ -16,3 +21,28 @@ const (
 	TableMain    RoutingTable = 254 // main
 	TableLocal   RoutingTable = 255 // local
 )
+
+// RoutingTableByName converts string routing table name or number into a constant.
+//
+// Empty string stands for the main routing table.
+func RoutingTableByName(table string) (RoutingTable, error) {
+	if table == "" {
+		return TableMain, nil
+	}
+
+	rt, err := RoutingTableString(table)
+	if err != nil {
+		id, parseErr := strconv.ParseUint(table, 10, 32)
+		if parseErr != nil {
+			return 0, fmt.Errorf("invalid routing table %q", table)
+		}
+
+		rt = RoutingTable(id)
+	}
+
+	if rt == TableUnspec {
+		return 0, fmt.Errorf("routing table %q is reserved", table)
+	}
+
+	return rt, nil
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+func TestRoutingTableByName(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		expected    nethelpers.RoutingTable
+		expectedErr string
+	}{
+		{name: "", expected: nethelpers.TableMain},
+		{name: "main", expected: nethelpers.TableMain},
+		{name: "local", expected: nethelpers.TableLocal},
+		{name: "100", expected: 100},
+		{name: "unspec", expectedErr: "routing table \"unspec\" is reserved"},
+		{name: "0", expectedErr: "routing table \"0\" is reserved"},
+		{name: "foo", expectedErr: "invalid routing table \"foo\""},
+	} {
+		table, err := nethelpers.RoutingTableByName(test.name)
+
+		if test.expectedErr != "" {
+			assert.EqualError(t, err, test.expectedErr, test.name)
+
+			continue
+		}
+
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, table, test.name)
+	}
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// BlockDeviceType is type of BlockDevice resource.
+const BlockDeviceType = resource.Type("BlockDevices.block.talos.dev")
+
+// BlockDevice resource holds status of the block device as seen by the kernel.
+//
+// Resource ID is the kernel name of the device, e.g. `sda` or `nvme0n1p1`.
+type BlockDevice = typed.Resource[BlockDeviceSpec, BlockDeviceRD]
+
+// Block device types.
+const (
+	BlockDeviceTypeDisk      = "disk"
+	BlockDeviceTypePartition = "partition"
+)
+
+// BlockDeviceSpec is the spec for devices status.
+type BlockDeviceSpec struct {
+	// Type is either `disk` or `partition`.
+	Type string `yaml:"type"`
+
+	Major int `yaml:"major"`
+	Minor int `yaml:"minor"`
+
+	// Parent is the kernel name of the parent disk for partitions.
+	Parent string `yaml:"parent,omitempty"`
+
+	// DevicePath is the sysfs path of the device.
+	DevicePath string `yaml:"devicePath"`
+
+	// Generation is bumped on each udev event for the device.
+	Generation int `yaml:"generation"`
+}
+
+// NewBlockDevice initializes a BlockDevice resource.
+func NewBlockDevice(namespace resource.Namespace, id resource.ID) *BlockDevice {
+	return typed.NewResource[BlockDeviceSpec, BlockDeviceRD](
+		resource.NewMetadata(namespace, BlockDeviceType, id, resource.VersionUndefined),
+		BlockDeviceSpec{},
+	)
+}
+
+// BlockDeviceRD is auxiliary resource data for BlockDevice.
+type BlockDeviceRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (BlockDeviceRD) ResourceDefinition(resource.Metadata, BlockDeviceSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BlockDeviceType,
+		Aliases:          []resource.Type{"devices"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Parent",
+				JSONPath: `{.parent}`,
+			},
+			{
+				Name:     "Generation",
+				JSONPath: `{.generation}`,
+			},
+		},
+	}
+}
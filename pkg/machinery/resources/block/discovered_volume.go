@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// DiscoveredVolumeType is type of DiscoveredVolume resource.
+const DiscoveredVolumeType = resource.Type("DiscoveredVolumes.block.talos.dev")
+
+// DiscoveredVolume resource holds the result of probing the block device contents.
+//
+// Resource ID is the kernel name of the block device, e.g. `sda` or `sda1`.
+type DiscoveredVolume = typed.Resource[DiscoveredVolumeSpec, DiscoveredVolumeRD]
+
+// DiscoveredVolumeSpec is the spec for DiscoveredVolumes status.
+type DiscoveredVolumeSpec struct {
+	DevPath string `yaml:"devPath"`
+	Type    string `yaml:"type"`
+	Parent  string `yaml:"parent,omitempty"`
+
+	Size       uint64 `yaml:"size"`
+	PrettySize string `yaml:"prettySize"`
+
+	// Name is the type of the discovered filesystem or partition table, e.g. `xfs` or `gpt`.
+	Name  string `yaml:"name,omitempty"`
+	Label string `yaml:"label,omitempty"`
+	UUID  string `yaml:"uuid,omitempty"`
+
+	PartitionIndex uint   `yaml:"partitionIndex,omitempty"`
+	PartitionLabel string `yaml:"partitionLabel,omitempty"`
+	PartitionUUID  string `yaml:"partitionUUID,omitempty"`
+	PartitionType  string `yaml:"partitionType,omitempty"`
+}
+
+// NewDiscoveredVolume initializes a DiscoveredVolume resource.
+func NewDiscoveredVolume(namespace resource.Namespace, id resource.ID) *DiscoveredVolume {
+	return typed.NewResource[DiscoveredVolumeSpec, DiscoveredVolumeRD](
+		resource.NewMetadata(namespace, DiscoveredVolumeType, id, resource.VersionUndefined),
+		DiscoveredVolumeSpec{},
+	)
+}
+
+// DiscoveredVolumeRD is auxiliary resource data for DiscoveredVolume.
+type DiscoveredVolumeRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DiscoveredVolumeRD) ResourceDefinition(resource.Metadata, DiscoveredVolumeSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiscoveredVolumeType,
+		Aliases:          []resource.Type{"dv"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "Discovered",
+				JSONPath: `{.name}`,
+			},
+			{
+				Name:     "Label",
+				JSONPath: `{.label}`,
+			},
+			{
+				Name:     "PartitionLabel",
+				JSONPath: `{.partitionLabel}`,
+			},
+		},
+	}
+}
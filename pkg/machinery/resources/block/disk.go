@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// DiskType is type of Disk resource.
+const DiskType = resource.Type("Disks.block.talos.dev")
+
+// Disk resource holds status of the hardware disk.
+//
+// Resource ID is the kernel name of the disk, e.g. `sda`.
+type Disk = typed.Resource[DiskSpec, DiskRD]
+
+// Disk transports.
+const (
+	TransportNVMe   = "nvme"
+	TransportVirtIO = "virtio"
+	TransportUSB    = "usb"
+	TransportMMC    = "mmc"
+	TransportSATA   = "sata"
+	TransportSCSI   = "scsi"
+)
+
+// DiskSpec is the spec for Disks status.
+type DiskSpec struct {
+	DevPath    string `yaml:"devPath"`
+	Size       uint64 `yaml:"size"`
+	PrettySize string `yaml:"prettySize"`
+	IOSize     uint   `yaml:"ioSize"`
+	SectorSize uint   `yaml:"sectorSize"`
+
+	Readonly   bool `yaml:"readonly"`
+	CDROM      bool `yaml:"cdrom"`
+	Rotational bool `yaml:"rotational"`
+
+	Model     string `yaml:"model,omitempty"`
+	Serial    string `yaml:"serial,omitempty"`
+	Modalias  string `yaml:"modalias,omitempty"`
+	WWID      string `yaml:"wwid,omitempty"`
+	BusPath   string `yaml:"busPath,omitempty"`
+	SubSystem string `yaml:"subSystem,omitempty"`
+	Transport string `yaml:"transport,omitempty"`
+}
+
+// NewDisk initializes a Disk resource.
+func NewDisk(namespace resource.Namespace, id resource.ID) *Disk {
+	return typed.NewResource[DiskSpec, DiskRD](
+		resource.NewMetadata(namespace, DiskType, id, resource.VersionUndefined),
+		DiskSpec{},
+	)
+}
+
+// DiskRD is auxiliary resource data for Disk.
+type DiskRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DiskRD) ResourceDefinition(resource.Metadata, DiskSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiskType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Size",
+				JSONPath: `{.prettySize}`,
+			},
+			{
+				Name:     "Rotational",
+				JSONPath: `{.rotational}`,
+			},
+			{
+				Name:     "Transport",
+				JSONPath: `{.transport}`,
+			},
+			{
+				Name:     "Model",
+				JSONPath: `{.model}`,
+			},
+			{
+				Name:     "Serial",
+				JSONPath: `{.serial}`,
+			},
+		},
+	}
+}
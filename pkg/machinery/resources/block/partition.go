@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// PartitionType is type of Partition resource.
+const PartitionType = resource.Type("Partitions.block.talos.dev")
+
+// Partition resource holds status of the partition of the disk.
+//
+// Resource ID is the kernel name of the partition, e.g. `sda1`.
+type Partition = typed.Resource[PartitionSpec, PartitionRD]
+
+// PartitionSpec is the spec for Partitions status.
+type PartitionSpec struct {
+	DevPath string `yaml:"devPath"`
+	Parent  string `yaml:"parent"`
+	Number  uint   `yaml:"number"`
+
+	// Offset and Size are in bytes.
+	Offset uint64 `yaml:"offset"`
+	Size   uint64 `yaml:"size"`
+
+	Label    string `yaml:"label,omitempty"`
+	UUID     string `yaml:"uuid,omitempty"`
+	TypeUUID string `yaml:"typeUUID,omitempty"`
+}
+
+// NewPartition initializes a Partition resource.
+func NewPartition(namespace resource.Namespace, id resource.ID) *Partition {
+	return typed.NewResource[PartitionSpec, PartitionRD](
+		resource.NewMetadata(namespace, PartitionType, id, resource.VersionUndefined),
+		PartitionSpec{},
+	)
+}
+
+// PartitionRD is auxiliary resource data for Partition.
+type PartitionRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (PartitionRD) ResourceDefinition(resource.Metadata, PartitionSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PartitionType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Parent",
+				JSONPath: `{.parent}`,
+			},
+			{
+				Name:     "Number",
+				JSONPath: `{.number}`,
+			},
+			{
+				Name:     "Label",
+				JSONPath: `{.label}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.size}`,
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/container"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 )
 
@@ -61,10 +62,17 @@ func (r *MachineConfig) Spec() interface{} {
 func (r *MachineConfig) DeepCopy() resource.Resource {
 	var cfgCopy config.Provider
 
-	switch r.spec.cfg.(type) {
+	switch cfg := r.spec.cfg.(type) {
 	case *v1alpha1.ReadonlyProvider:
 		// don't copy read only config
 		cfgCopy = r.spec.cfg
+	case *container.Container:
+		if cfg.Readonly() {
+			// don't copy read only config
+			cfgCopy = cfg
+		} else {
+			cfgCopy = cfg.Clone()
+		}
 	default:
 		cfgCopy = r.spec.cfg.Raw().(*v1alpha1.Config).DeepCopy()
 	}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+//go:generate deep-copy -type BackupSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+
+// BackupType is type of Backup resource.
+const BackupType = resource.Type("EtcdBackups.etcd.talos.dev")
+
+// Backup resource describes a completed etcd backup.
+//
+// Backup ID is the name of the snapshot file.
+type Backup = typed.Resource[BackupSpec, BackupRD]
+
+// BackupSpec describes a completed etcd backup.
+type BackupSpec struct {
+	// Location is the path or the S3 URL of the snapshot.
+	Location string `yaml:"location"`
+	// Timestamp is the time the snapshot was taken at.
+	Timestamp time.Time `yaml:"timestamp"`
+	// Size is the size of the snapshot in bytes.
+	Size int64 `yaml:"size"`
+	// Revision is the etcd revision of the snapshot.
+	Revision int64 `yaml:"revision"`
+	// SHA256 is the hex-encoded SHA256 checksum of the snapshot.
+	SHA256 string `yaml:"sha256"`
+}
+
+// NewBackup initializes a Backup resource.
+func NewBackup(namespace resource.Namespace, id resource.ID) *Backup {
+	return typed.NewResource[BackupSpec, BackupRD](
+		resource.NewMetadata(namespace, BackupType, id, resource.VersionUndefined),
+		BackupSpec{},
+	)
+}
+
+// BackupRD provides auxiliary methods for Backup.
+type BackupRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (BackupRD) ResourceDefinition(resource.Metadata, BackupSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BackupType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Timestamp",
+				JSONPath: `{.timestamp}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.size}`,
+			},
+			{
+				Name:     "Revision",
+				JSONPath: `{.revision}`,
+			},
+		},
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type BootstrapManifestsConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type BootstrapManifestsConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeLabelSpecSpec -type NodenameSpec -type NodeTaintSpecSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -221,12 +221,24 @@ func (o NodeIPConfigSpec) DeepCopy() NodeIPConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NodeLabelSpecSpec.
+func (o NodeLabelSpecSpec) DeepCopy() NodeLabelSpecSpec {
+	var cp NodeLabelSpecSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodenameSpec.
 func (o NodenameSpec) DeepCopy() NodenameSpec {
 	var cp NodenameSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of NodeTaintSpecSpec.
+func (o NodeTaintSpecSpec) DeepCopy() NodeTaintSpecSpec {
+	var cp NodeTaintSpecSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of SchedulerConfigSpec.
 func (o SchedulerConfigSpec) DeepCopy() SchedulerConfigSpec {
 	var cp SchedulerConfigSpec = o
@@ -37,7 +37,9 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.ManifestStatus{},
 		&k8s.Manifest{},
 		&k8s.BootstrapManifestsConfig{},
+		&k8s.NodeLabelSpec{},
 		&k8s.Nodename{},
+		&k8s.NodeTaintSpec{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
 		&k8s.SchedulerConfig{},
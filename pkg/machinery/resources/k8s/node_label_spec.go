@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// NodeLabelSpecType is type of NodeLabelSpec resource.
+const NodeLabelSpecType = resource.Type("NodeLabelSpecs.kubernetes.talos.dev")
+
+// NodeLabelSpec resource holds a label which should be applied to the Kubernetes Node object.
+type NodeLabelSpec = typed.Resource[NodeLabelSpecSpec, NodeLabelSpecRD]
+
+// NodeLabelSpecSpec describes a node label.
+type NodeLabelSpecSpec struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+// NewNodeLabelSpec initializes a NodeLabelSpec resource.
+func NewNodeLabelSpec(namespace resource.Namespace, id resource.ID) *NodeLabelSpec {
+	return typed.NewResource[NodeLabelSpecSpec, NodeLabelSpecRD](
+		resource.NewMetadata(namespace, NodeLabelSpecType, id, resource.VersionUndefined),
+		NodeLabelSpecSpec{},
+	)
+}
+
+// NodeLabelSpecRD provides auxiliary methods for NodeLabelSpec.
+type NodeLabelSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NodeLabelSpecRD) ResourceDefinition(resource.Metadata, NodeLabelSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeLabelSpecType,
+		Aliases:          []resource.Type{"nodelabels"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Key",
+				JSONPath: "{.key}",
+			},
+			{
+				Name:     "Value",
+				JSONPath: "{.value}",
+			},
+		},
+	}
+}
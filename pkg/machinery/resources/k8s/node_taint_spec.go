@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// NodeTaintSpecType is type of NodeTaintSpec resource.
+const NodeTaintSpecType = resource.Type("NodeTaintSpecs.kubernetes.talos.dev")
+
+// NodeTaintSpec resource holds a taint which should be applied to the Kubernetes Node object.
+type NodeTaintSpec = typed.Resource[NodeTaintSpecSpec, NodeTaintSpecRD]
+
+// NodeTaintSpecSpec describes a node taint.
+type NodeTaintSpecSpec struct {
+	Key    string `yaml:"key"`
+	Value  string `yaml:"value"`
+	Effect string `yaml:"effect"`
+}
+
+// NewNodeTaintSpec initializes a NodeTaintSpec resource.
+func NewNodeTaintSpec(namespace resource.Namespace, id resource.ID) *NodeTaintSpec {
+	return typed.NewResource[NodeTaintSpecSpec, NodeTaintSpecRD](
+		resource.NewMetadata(namespace, NodeTaintSpecType, id, resource.VersionUndefined),
+		NodeTaintSpecSpec{},
+	)
+}
+
+// NodeTaintSpecRD provides auxiliary methods for NodeTaintSpec.
+type NodeTaintSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NodeTaintSpecRD) ResourceDefinition(resource.Metadata, NodeTaintSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeTaintSpecType,
+		Aliases:          []resource.Type{"nodetaints"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Key",
+				JSONPath: "{.key}",
+			},
+			{
+				Name:     "Value",
+				JSONPath: "{.value}",
+			},
+			{
+				Name:     "Effect",
+				JSONPath: "{.effect}",
+			},
+		},
+	}
+}
@@ -2,12 +2,14 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostDNSStatusSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteRuleSpecSpec -type RouteRuleStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
 import (
 	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 )
 
 // DeepCopy generates a deep copy of AddressSpecSpec.
@@ -32,6 +34,50 @@ func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of HostDNSConfigSpec.
+func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
+	var cp HostDNSConfigSpec = o
+	if o.ListenAddresses != nil {
+		cp.ListenAddresses = make([]netaddr.IPPort, len(o.ListenAddresses))
+		copy(cp.ListenAddresses, o.ListenAddresses)
+	}
+	if o.DomainUpstreams != nil {
+		cp.DomainUpstreams = make([]HostDNSDomainUpstream, len(o.DomainUpstreams))
+		copy(cp.DomainUpstreams, o.DomainUpstreams)
+		for i2 := range o.DomainUpstreams {
+			if o.DomainUpstreams[i2].Upstreams != nil {
+				cp.DomainUpstreams[i2].Upstreams = make([]netaddr.IP, len(o.DomainUpstreams[i2].Upstreams))
+				copy(cp.DomainUpstreams[i2].Upstreams, o.DomainUpstreams[i2].Upstreams)
+			}
+		}
+	}
+	if o.StaticHosts != nil {
+		cp.StaticHosts = make([]HostDNSStaticHost, len(o.StaticHosts))
+		copy(cp.StaticHosts, o.StaticHosts)
+		for i2 := range o.StaticHosts {
+			if o.StaticHosts[i2].Aliases != nil {
+				cp.StaticHosts[i2].Aliases = make([]string, len(o.StaticHosts[i2].Aliases))
+				copy(cp.StaticHosts[i2].Aliases, o.StaticHosts[i2].Aliases)
+			}
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of HostDNSStatusSpec.
+func (o HostDNSStatusSpec) DeepCopy() HostDNSStatusSpec {
+	var cp HostDNSStatusSpec = o
+	if o.ListenAddresses != nil {
+		cp.ListenAddresses = make([]netaddr.IPPort, len(o.ListenAddresses))
+		copy(cp.ListenAddresses, o.ListenAddresses)
+	}
+	if o.Upstreams != nil {
+		cp.Upstreams = make([]HostDNSUpstreamStatus, len(o.Upstreams))
+		copy(cp.Upstreams, o.Upstreams)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HostnameSpecSpec.
 func (o HostnameSpecSpec) DeepCopy() HostnameSpecSpec {
 	var cp HostnameSpecSpec = o
@@ -53,6 +99,14 @@ func (o LinkRefreshSpec) DeepCopy() LinkRefreshSpec {
 // DeepCopy generates a deep copy of LinkSpecSpec.
 func (o LinkSpecSpec) DeepCopy() LinkSpecSpec {
 	var cp LinkSpecSpec = o
+	if o.BridgeSlave.VLAN.TaggedVLANs != nil {
+		cp.BridgeSlave.VLAN.TaggedVLANs = make([]uint16, len(o.BridgeSlave.VLAN.TaggedVLANs))
+		copy(cp.BridgeSlave.VLAN.TaggedVLANs, o.BridgeSlave.VLAN.TaggedVLANs)
+	}
+	if o.BridgeSelfVLAN.TaggedVLANs != nil {
+		cp.BridgeSelfVLAN.TaggedVLANs = make([]uint16, len(o.BridgeSelfVLAN.TaggedVLANs))
+		copy(cp.BridgeSelfVLAN.TaggedVLANs, o.BridgeSelfVLAN.TaggedVLANs)
+	}
 	if o.Wireguard.Peers != nil {
 		cp.Wireguard.Peers = make([]WireguardPeer, len(o.Wireguard.Peers))
 		copy(cp.Wireguard.Peers, o.Wireguard.Peers)
@@ -77,6 +131,10 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 		cp.BroadcastAddr = make([]byte, len(o.BroadcastAddr))
 		copy(cp.BroadcastAddr, o.BroadcastAddr)
 	}
+	if o.BridgePortVLAN.TaggedVLANs != nil {
+		cp.BridgePortVLAN.TaggedVLANs = make([]uint16, len(o.BridgePortVLAN.TaggedVLANs))
+		copy(cp.BridgePortVLAN.TaggedVLANs, o.BridgePortVLAN.TaggedVLANs)
+	}
 	if o.Wireguard.Peers != nil {
 		cp.Wireguard.Peers = make([]WireguardPeer, len(o.Wireguard.Peers))
 		copy(cp.Wireguard.Peers, o.Wireguard.Peers)
@@ -90,6 +148,58 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NfTablesChainSpec.
+func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
+	var cp NfTablesChainSpec = o
+	if o.Rules != nil {
+		cp.Rules = make([]NfTablesRule, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].MatchIIfName != nil {
+				cp.Rules[i2].MatchIIfName = new(NfTablesIfNameMatch)
+				*cp.Rules[i2].MatchIIfName = *o.Rules[i2].MatchIIfName
+			}
+			if o.Rules[i2].MatchSourceAddress != nil {
+				cp.Rules[i2].MatchSourceAddress = new(NfTablesAddressMatch)
+				*cp.Rules[i2].MatchSourceAddress = *o.Rules[i2].MatchSourceAddress
+				if o.Rules[i2].MatchSourceAddress.IncludeSubnets != nil {
+					cp.Rules[i2].MatchSourceAddress.IncludeSubnets = make([]netaddr.IPPrefix, len(o.Rules[i2].MatchSourceAddress.IncludeSubnets))
+					copy(cp.Rules[i2].MatchSourceAddress.IncludeSubnets, o.Rules[i2].MatchSourceAddress.IncludeSubnets)
+				}
+				if o.Rules[i2].MatchSourceAddress.ExcludeSubnets != nil {
+					cp.Rules[i2].MatchSourceAddress.ExcludeSubnets = make([]netaddr.IPPrefix, len(o.Rules[i2].MatchSourceAddress.ExcludeSubnets))
+					copy(cp.Rules[i2].MatchSourceAddress.ExcludeSubnets, o.Rules[i2].MatchSourceAddress.ExcludeSubnets)
+				}
+			}
+			if o.Rules[i2].MatchLayer4 != nil {
+				cp.Rules[i2].MatchLayer4 = new(NfTablesLayer4Match)
+				*cp.Rules[i2].MatchLayer4 = *o.Rules[i2].MatchLayer4
+				if o.Rules[i2].MatchLayer4.MatchDestinationPort != nil {
+					cp.Rules[i2].MatchLayer4.MatchDestinationPort = new(NfTablesPortMatch)
+					*cp.Rules[i2].MatchLayer4.MatchDestinationPort = *o.Rules[i2].MatchLayer4.MatchDestinationPort
+					if o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges != nil {
+						cp.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges = make([]PortRange, len(o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges))
+						copy(cp.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges, o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges)
+					}
+				}
+			}
+			if o.Rules[i2].MatchConntrackState != nil {
+				cp.Rules[i2].MatchConntrackState = new(NfTablesConntrackStateMatch)
+				*cp.Rules[i2].MatchConntrackState = *o.Rules[i2].MatchConntrackState
+				if o.Rules[i2].MatchConntrackState.States != nil {
+					cp.Rules[i2].MatchConntrackState.States = make([]nethelpers.ConntrackState, len(o.Rules[i2].MatchConntrackState.States))
+					copy(cp.Rules[i2].MatchConntrackState.States, o.Rules[i2].MatchConntrackState.States)
+				}
+			}
+			if o.Rules[i2].Verdict != nil {
+				cp.Rules[i2].Verdict = new(nethelpers.NfTablesVerdict)
+				*cp.Rules[i2].Verdict = *o.Rules[i2].Verdict
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeAddressSpec.
 func (o NodeAddressSpec) DeepCopy() NodeAddressSpec {
 	var cp NodeAddressSpec = o
@@ -140,6 +250,18 @@ func (o ResolverStatusSpec) DeepCopy() ResolverStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RouteRuleSpecSpec.
+func (o RouteRuleSpecSpec) DeepCopy() RouteRuleSpecSpec {
+	var cp RouteRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RouteRuleStatusSpec.
+func (o RouteRuleStatusSpec) DeepCopy() RouteRuleStatusSpec {
+	var cp RouteRuleStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of RouteSpecSpec.
 func (o RouteSpecSpec) DeepCopy() RouteSpecSpec {
 	var cp RouteSpecSpec = o
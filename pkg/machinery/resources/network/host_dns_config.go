@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"inet.af/netaddr"
+)
+
+// HostDNSConfigType is type of HostDNSConfig resource.
+const HostDNSConfigType = resource.Type("HostDNSConfigs.net.talos.dev")
+
+// HostDNSConfig resource holds the host DNS forwarder configuration.
+type HostDNSConfig = typed.Resource[HostDNSConfigSpec, HostDNSConfigRD]
+
+// HostDNSID is the ID of the singleton instance.
+const HostDNSID resource.ID = "hostdns"
+
+// HostDNSConfigSpec describes the host DNS forwarder configuration.
+//
+// Default upstreams are not part of the configuration, they come from the ResolverStatus.
+type HostDNSConfigSpec struct {
+	Enabled         bool                    `yaml:"enabled"`
+	ListenAddresses []netaddr.IPPort        `yaml:"listenAddresses"`
+	CacheSize       int                     `yaml:"cacheSize"`
+	DomainUpstreams []HostDNSDomainUpstream `yaml:"domainUpstreams,omitempty"`
+	StaticHosts     []HostDNSStaticHost     `yaml:"staticHosts,omitempty"`
+}
+
+// HostDNSDomainUpstream describes upstream nameservers used for the domain and its subdomains.
+type HostDNSDomainUpstream struct {
+	Domain    string       `yaml:"domain"`
+	Upstreams []netaddr.IP `yaml:"upstreams"`
+}
+
+// HostDNSStaticHost describes a static answer of the host DNS forwarder.
+type HostDNSStaticHost struct {
+	IP      netaddr.IP `yaml:"ip"`
+	Aliases []string   `yaml:"aliases"`
+}
+
+// NewHostDNSConfig initializes a HostDNSConfig resource.
+func NewHostDNSConfig(namespace resource.Namespace, id resource.ID) *HostDNSConfig {
+	return typed.NewResource[HostDNSConfigSpec, HostDNSConfigRD](
+		resource.NewMetadata(namespace, HostDNSConfigType, id, resource.VersionUndefined),
+		HostDNSConfigSpec{},
+	)
+}
+
+// HostDNSConfigRD provides auxiliary methods for HostDNSConfig.
+type HostDNSConfigRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (HostDNSConfigRD) ResourceDefinition(resource.Metadata, HostDNSConfigSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             HostDNSConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Enabled",
+				JSONPath: "{.enabled}",
+			},
+			{
+				Name:     "Listen Addresses",
+				JSONPath: "{.listenAddresses}",
+			},
+		},
+	}
+}
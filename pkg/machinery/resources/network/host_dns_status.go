@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"inet.af/netaddr"
+)
+
+// HostDNSStatusType is type of HostDNSStatus resource.
+const HostDNSStatusType = resource.Type("HostDNSStatuses.net.talos.dev")
+
+// HostDNSStatus resource holds the host DNS forwarder status.
+//
+// The resource exists only while the forwarder is running.
+type HostDNSStatus = typed.Resource[HostDNSStatusSpec, HostDNSStatusRD]
+
+// HostDNSStatusSpec describes the host DNS forwarder state, cache stats and upstream health.
+type HostDNSStatusSpec struct {
+	ListenAddresses []netaddr.IPPort        `yaml:"listenAddresses"`
+	CacheSize       int                     `yaml:"cacheSize"`
+	CacheEntries    int                     `yaml:"cacheEntries"`
+	CacheHits       uint64                  `yaml:"cacheHits"`
+	CacheMisses     uint64                  `yaml:"cacheMisses"`
+	Upstreams       []HostDNSUpstreamStatus `yaml:"upstreams"`
+}
+
+// HostDNSUpstreamStatus describes the health of a single upstream nameserver.
+type HostDNSUpstreamStatus struct {
+	Address   netaddr.IPPort `yaml:"address"`
+	Domain    string         `yaml:"domain,omitempty"`
+	Healthy   bool           `yaml:"healthy"`
+	Queries   uint64         `yaml:"queries"`
+	Failures  uint64         `yaml:"failures"`
+	LastError string         `yaml:"lastError,omitempty"`
+}
+
+// NewHostDNSStatus initializes a HostDNSStatus resource.
+func NewHostDNSStatus(namespace resource.Namespace, id resource.ID) *HostDNSStatus {
+	return typed.NewResource[HostDNSStatusSpec, HostDNSStatusRD](
+		resource.NewMetadata(namespace, HostDNSStatusType, id, resource.VersionUndefined),
+		HostDNSStatusSpec{},
+	)
+}
+
+// HostDNSStatusRD provides auxiliary methods for HostDNSStatus.
+type HostDNSStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (HostDNSStatusRD) ResourceDefinition(resource.Metadata, HostDNSStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             HostDNSStatusType,
+		Aliases:          []resource.Type{"hostdns"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Listen Addresses",
+				JSONPath: "{.listenAddresses}",
+			},
+			{
+				Name:     "Cache Entries",
+				JSONPath: "{.cacheEntries}",
+			},
+			{
+				Name:     "Cache Hits",
+				JSONPath: "{.cacheHits}",
+			},
+			{
+				Name:     "Cache Misses",
+				JSONPath: "{.cacheMisses}",
+			},
+		},
+	}
+}
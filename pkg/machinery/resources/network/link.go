@@ -52,7 +52,8 @@ type BondMasterSpec struct {
 
 // BridgeMasterSpec describes bridge settings if Kind == "bridge".
 type BridgeMasterSpec struct {
-	STP STPSpec `yaml:"stp,omitempty"`
+	STP  STPSpec        `yaml:"stp,omitempty"`
+	VLAN BridgeVLANSpec `yaml:"vlan,omitempty"`
 }
 
 // STPSpec describes Spanning Tree Protocol (STP) settings of a bridge.
@@ -60,6 +61,53 @@ type STPSpec struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+// BridgeVLANSpec describes VLAN settings of a bridge.
+type BridgeVLANSpec struct {
+	FilteringEnabled bool   `yaml:"filteringEnabled"`
+	DefaultPVID      uint16 `yaml:"defaultPVID,omitempty"`
+}
+
+// BridgePortVLANSpec describes VLAN settings of a bridge port.
+type BridgePortVLANSpec struct {
+	// PVID is the VLAN assigned to untagged ingress traffic, it is egressed untagged.
+	PVID uint16 `yaml:"pvid,omitempty"`
+	// TaggedVLANs is the list of VLANs the port is a tagged member of.
+	TaggedVLANs []uint16 `yaml:"taggedVLANs,omitempty"`
+}
+
+// IsZero checks if the BridgePortVLANSpec is zero value.
+func (spec *BridgePortVLANSpec) IsZero() bool {
+	return spec.PVID == 0 && len(spec.TaggedVLANs) == 0
+}
+
+// Equal checks two BridgePortVLANSpecs for equality.
+//
+// Both specs should be sorted before calling this method.
+func (spec *BridgePortVLANSpec) Equal(other *BridgePortVLANSpec) bool {
+	if spec.PVID != other.PVID {
+		return false
+	}
+
+	if len(spec.TaggedVLANs) != len(other.TaggedVLANs) {
+		return false
+	}
+
+	for i := range spec.TaggedVLANs {
+		if spec.TaggedVLANs[i] != other.TaggedVLANs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sort the spec so that comparison is possible.
+func (spec *BridgePortVLANSpec) Sort() {
+	sort.Slice(spec.TaggedVLANs, func(i, j int) bool {
+		return spec.TaggedVLANs[i] < spec.TaggedVLANs[j]
+	})
+}
+
 // WireguardSpec describes Wireguard settings if Kind == "wireguard".
 type WireguardSpec struct {
 	// PrivateKey is used to configure the link, present only in the LinkSpec.
@@ -47,6 +47,9 @@ type LinkSpecSpec struct {
 	// BridgeSlave indicates master link for bridged interfaces.
 	BridgeSlave BridgeSlave `yaml:"bridgeSlave,omitempty"`
 
+	// BridgeSelfVLAN contains the VLAN settings of the bridge itself (for VLAN filtering bridges).
+	BridgeSelfVLAN BridgePortVLANSpec `yaml:"bridgeSelfVLAN,omitempty"`
+
 	// These structures are present depending on "Kind" for Logical interfaces.
 	VLAN         VLANSpec         `yaml:"vlan,omitempty"`
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty"`
@@ -70,6 +73,9 @@ type BondSlave struct {
 type BridgeSlave struct {
 	// MasterName indicates master link for enslaved bridged interfaces.
 	MasterName string `yaml:"masterName,omitempty"`
+
+	// VLAN contains the VLAN settings of the bridge port.
+	VLAN BridgePortVLANSpec `yaml:"vlan,omitempty"`
 }
 
 // Merge with other, overwriting fields from other if set.
@@ -87,7 +93,14 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.VLAN, other.VLAN)
 	updateIfNotZero(&spec.BondMaster, other.BondMaster)
 	updateIfNotZero(&spec.BridgeMaster, other.BridgeMaster)
-	updateIfNotZero(&spec.BridgeSlave, other.BridgeSlave)
+
+	if other.BridgeSlave.MasterName != "" || !other.BridgeSlave.VLAN.IsZero() {
+		spec.BridgeSlave = other.BridgeSlave
+	}
+
+	if !other.BridgeSelfVLAN.IsZero() {
+		spec.BridgeSelfVLAN = other.BridgeSelfVLAN
+	}
 
 	// Wireguard config should be able to apply non-zero values in earlier config layers which may be zero values in later layers.
 	// Thus, we handle each Wireguard configuration value discretely.
@@ -50,8 +50,10 @@ type LinkStatusSpec struct {
 	// Following fields are only populated with respective Kind.
 	VLAN         VLANSpec         `yaml:"vlan,omitempty"`
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty"`
-	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty"`
-	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty"`
+	// BridgePortVLAN is populated for the bridge ports and for the bridge itself.
+	BridgePortVLAN BridgePortVLANSpec `yaml:"bridgePortVLAN,omitempty"`
+	BondMaster     BondMasterSpec     `yaml:"bondMaster,omitempty"`
+	Wireguard      WireguardSpec      `yaml:"wireguard,omitempty"`
 }
 
 // Physical checks if the link is physical ethernet.
@@ -216,3 +216,28 @@ func TestWireguardSpecMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestBridgePortVLANSpec(t *testing.T) {
+	var zero network.BridgePortVLANSpec
+
+	assert.True(t, zero.IsZero())
+
+	spec1 := network.BridgePortVLANSpec{
+		PVID:        10,
+		TaggedVLANs: []uint16{30, 20},
+	}
+
+	spec2 := network.BridgePortVLANSpec{
+		PVID:        10,
+		TaggedVLANs: []uint16{20, 30},
+	}
+
+	assert.False(t, spec1.IsZero())
+	assert.False(t, spec1.Equal(&spec2))
+
+	spec1.Sort()
+
+	assert.True(t, spec1.Equal(&spec2))
+	assert.False(t, spec1.Equal(&network.BridgePortVLANSpec{PVID: 20, TaggedVLANs: []uint16{20, 30}}))
+	assert.False(t, spec1.Equal(&network.BridgePortVLANSpec{PVID: 10, TaggedVLANs: []uint16{20}}))
+}
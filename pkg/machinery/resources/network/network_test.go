@@ -28,11 +28,14 @@ func TestRegisterResource(t *testing.T) {
 		&network.AddressStatus{},
 		&network.AddressSpec{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
+		&network.HostDNSStatus{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -40,6 +43,8 @@ func TestRegisterResource(t *testing.T) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RouteRuleStatus{},
+		&network.RouteRuleSpec{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
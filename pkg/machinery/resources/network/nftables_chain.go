@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// NfTablesChainType is type of NfTablesChain resource.
+const NfTablesChainType = resource.Type("NfTablesChains.net.talos.dev")
+
+// NfTablesChain resource holds definition of the nftables chain.
+type NfTablesChain = typed.Resource[NfTablesChainSpec, NfTablesChainRD]
+
+// NfTablesChainSpec describes nftables chain.
+//
+// All chains are created as filter chains in the `talos` table of `inet` family.
+type NfTablesChainSpec struct {
+	Hook     nethelpers.NfTablesChainHook     `yaml:"hook"`
+	Priority nethelpers.NfTablesChainPriority `yaml:"priority"`
+	Policy   nethelpers.NfTablesVerdict       `yaml:"policy"`
+
+	Rules []NfTablesRule `yaml:"rules"`
+}
+
+// NfTablesRule describes a single rule in the nftables chain.
+//
+// All the match conditions should be satisfied for the rule to match,
+// matched rule applies the verdict (if set).
+type NfTablesRule struct {
+	MatchIIfName        *NfTablesIfNameMatch         `yaml:"matchIIfName,omitempty"`
+	MatchSourceAddress  *NfTablesAddressMatch        `yaml:"matchSourceAddress,omitempty"`
+	MatchLayer4         *NfTablesLayer4Match         `yaml:"matchLayer4,omitempty"`
+	MatchConntrackState *NfTablesConntrackStateMatch `yaml:"matchConntrackState,omitempty"`
+
+	Verdict *nethelpers.NfTablesVerdict `yaml:"verdict,omitempty"`
+}
+
+// NfTablesIfNameMatch matches the input interface name.
+type NfTablesIfNameMatch struct {
+	InterfaceName string `yaml:"interfaceName"`
+}
+
+// NfTablesAddressMatch matches the address against the list of subnets.
+//
+// Address matches if it's in one of the IncludeSubnets and not in any of the ExcludeSubnets.
+type NfTablesAddressMatch struct {
+	IncludeSubnets []netaddr.IPPrefix `yaml:"includeSubnets,omitempty"`
+	ExcludeSubnets []netaddr.IPPrefix `yaml:"excludeSubnets,omitempty"`
+}
+
+// NfTablesLayer4Match matches the layer 4 protocol and (optionally) the destination port.
+type NfTablesLayer4Match struct {
+	Protocol             nethelpers.Protocol `yaml:"protocol"`
+	MatchDestinationPort *NfTablesPortMatch  `yaml:"matchDestinationPort,omitempty"`
+}
+
+// NfTablesPortMatch matches the port against the list of port ranges.
+type NfTablesPortMatch struct {
+	Ranges []PortRange `yaml:"ranges,omitempty"`
+}
+
+// PortRange is a range of ports, both ends inclusive.
+type PortRange struct {
+	Lo uint16 `yaml:"lo"`
+	Hi uint16 `yaml:"hi"`
+}
+
+// NfTablesConntrackStateMatch matches the connection tracking state.
+//
+// Any of the listed states should be set for the match to succeed.
+type NfTablesConntrackStateMatch struct {
+	States []nethelpers.ConntrackState `yaml:"states"`
+}
+
+// NewNfTablesChain initializes a NfTablesChain resource.
+func NewNfTablesChain(namespace resource.Namespace, id resource.ID) *NfTablesChain {
+	return typed.NewResource[NfTablesChainSpec, NfTablesChainRD](
+		resource.NewMetadata(namespace, NfTablesChainType, id, resource.VersionUndefined),
+		NfTablesChainSpec{},
+	)
+}
+
+// NfTablesChainRD provides auxiliary methods for NfTablesChain.
+type NfTablesChainRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NfTablesChainRD) ResourceDefinition(resource.Metadata, NfTablesChainSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NfTablesChainType,
+		Aliases:          []resource.Type{"chain", "chains"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// RouteRuleSpecType is type of RouteRuleSpec resource.
+const RouteRuleSpecType = resource.Type("RouteRuleSpecs.net.talos.dev")
+
+// RouteRuleSpec resource holds routing rule specification to be applied to the kernel.
+type RouteRuleSpec = typed.Resource[RouteRuleSpecSpec, RouteRuleSpecRD]
+
+// RouteRuleSpecSpec describes the routing rule.
+//
+// Rule matches the packet if all the conditions match, zero value means no match condition,
+// matched packet is routed using the routing table Table.
+type RouteRuleSpecSpec struct {
+	Family      nethelpers.Family       `yaml:"family"`
+	Source      netaddr.IPPrefix        `yaml:"src"`
+	Destination netaddr.IPPrefix        `yaml:"dst"`
+	IIFName     string                  `yaml:"iifName,omitempty"`
+	OIFName     string                  `yaml:"oifName,omitempty"`
+	FwMark      uint32                  `yaml:"fwMark,omitempty"`
+	FwMask      uint32                  `yaml:"fwMask,omitempty"`
+	Table       nethelpers.RoutingTable `yaml:"table"`
+	Priority    uint32                  `yaml:"priority"`
+	ConfigLayer ConfigLayer             `yaml:"layer"`
+}
+
+// NewRouteRuleSpec initializes a RouteRuleSpec resource.
+func NewRouteRuleSpec(namespace resource.Namespace, id resource.ID) *RouteRuleSpec {
+	return typed.NewResource[RouteRuleSpecSpec, RouteRuleSpecRD](
+		resource.NewMetadata(namespace, RouteRuleSpecType, id, resource.VersionUndefined),
+		RouteRuleSpecSpec{},
+	)
+}
+
+// RouteRuleSpecRD provides auxiliary methods for RouteRuleSpec.
+type RouteRuleSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (RouteRuleSpecRD) ResourceDefinition(resource.Metadata, RouteRuleSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RouteRuleSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func TestRouteRuleSpecMarshalYAML(t *testing.T) {
+	spec := network.RouteRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netaddr.MustParseIPPrefix("10.3.0.0/24"),
+		Destination: netaddr.MustParseIPPrefix("192.168.3.0/25"),
+		IIFName:     "eth0",
+		FwMark:      0x10,
+		FwMask:      0xff,
+		Table:       nethelpers.TableMain,
+		Priority:    1000,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	marshaled, err := yaml.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`family: inet4
+src: 10.3.0.0/24
+dst: 192.168.3.0/25
+iifName: eth0
+fwMark: 16
+fwMask: 255
+table: main
+priority: 1000
+layer: configuration
+`,
+		string(marshaled))
+
+	var spec2 network.RouteRuleSpecSpec
+
+	require.NoError(t, yaml.Unmarshal(marshaled, &spec2))
+
+	assert.Equal(t, spec, spec2)
+}
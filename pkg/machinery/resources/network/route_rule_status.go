@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// RouteRuleStatusType is type of RouteRuleStatus resource.
+const RouteRuleStatusType = resource.Type("RouteRuleStatuses.net.talos.dev")
+
+// RouteRuleStatus resource holds the routing rule status.
+type RouteRuleStatus = typed.Resource[RouteRuleStatusSpec, RouteRuleStatusRD]
+
+// RouteRuleStatusSpec describes status of the routing rule.
+type RouteRuleStatusSpec struct {
+	Family      nethelpers.Family       `yaml:"family"`
+	Source      netaddr.IPPrefix        `yaml:"src"`
+	Destination netaddr.IPPrefix        `yaml:"dst"`
+	IIFName     string                  `yaml:"iifName,omitempty"`
+	OIFName     string                  `yaml:"oifName,omitempty"`
+	FwMark      uint32                  `yaml:"fwMark,omitempty"`
+	FwMask      uint32                  `yaml:"fwMask,omitempty"`
+	Table       nethelpers.RoutingTable `yaml:"table"`
+	Priority    uint32                  `yaml:"priority"`
+}
+
+// NewRouteRuleStatus initializes a RouteRuleStatus resource.
+func NewRouteRuleStatus(namespace resource.Namespace, id resource.ID) *RouteRuleStatus {
+	return typed.NewResource[RouteRuleStatusSpec, RouteRuleStatusRD](
+		resource.NewMetadata(namespace, RouteRuleStatusType, id, resource.VersionUndefined),
+		RouteRuleStatusSpec{},
+	)
+}
+
+// RouteRuleStatusRD provides auxiliary methods for RouteRuleStatus.
+type RouteRuleStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (RouteRuleStatusRD) ResourceDefinition(resource.Metadata, RouteRuleStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RouteRuleStatusType,
+		Aliases:          []resource.Type{"routerule", "routerules"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Priority",
+				JSONPath: `{.priority}`,
+			},
+			{
+				Name:     "Source",
+				JSONPath: `{.src}`,
+			},
+			{
+				Name:     "Destination",
+				JSONPath: `{.dst}`,
+			},
+			{
+				Name:     "Table",
+				JSONPath: `{.table}`,
+			},
+		},
+	}
+}
@@ -2,10 +2,20 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MountStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type EncryptionStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MountStatusSpec -type PlatformMetadataSpec -type VolumeStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
+// DeepCopy generates a deep copy of EncryptionStatusSpec.
+func (o EncryptionStatusSpec) DeepCopy() EncryptionStatusSpec {
+	var cp EncryptionStatusSpec = o
+	if o.KeySlots != nil {
+		cp.KeySlots = make([]EncryptionKeySlotStatus, len(o.KeySlots))
+		copy(cp.KeySlots, o.KeySlots)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of KernelModuleSpecSpec.
 func (o KernelModuleSpecSpec) DeepCopy() KernelModuleSpecSpec {
 	var cp KernelModuleSpecSpec = o
@@ -33,3 +43,15 @@ func (o MountStatusSpec) DeepCopy() MountStatusSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of PlatformMetadataSpec.
+func (o PlatformMetadataSpec) DeepCopy() PlatformMetadataSpec {
+	var cp PlatformMetadataSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of VolumeStatusSpec.
+func (o VolumeStatusSpec) DeepCopy() VolumeStatusSpec {
+	var cp VolumeStatusSpec = o
+	return cp
+}
@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// EncryptionStatusType is type of EncryptionStatus resource.
+const EncryptionStatusType = resource.Type("EncryptionStatuses.runtime.talos.dev")
+
+// EncryptionStatus resource holds the encryption status of the mounted system partition.
+//
+// Resource ID is the partition label.
+type EncryptionStatus = typed.Resource[EncryptionStatusSpec, EncryptionStatusRD]
+
+// EncryptionStatusSpec describes the encryption settings and the key slots of the partition.
+type EncryptionStatusSpec struct {
+	Provider   string                    `yaml:"provider"`
+	Cipher     string                    `yaml:"cipher"`
+	KeySize    uint                      `yaml:"keySize"`
+	SectorSize uint64                    `yaml:"sectorSize"`
+	KeySlots   []EncryptionKeySlotStatus `yaml:"keySlots"`
+}
+
+// EncryptionKeySlotStatus describes the key slot of the encrypted partition.
+type EncryptionKeySlotStatus struct {
+	Slot int `yaml:"slot"`
+	// Type is the key kind from the machine config (static, nodeID, kms),
+	// or unknown if the slot is not managed by the machine config.
+	Type string `yaml:"type"`
+}
+
+// NewEncryptionStatus initializes a EncryptionStatus resource.
+func NewEncryptionStatus(namespace resource.Namespace, id resource.ID) *EncryptionStatus {
+	return typed.NewResource[EncryptionStatusSpec, EncryptionStatusRD](
+		resource.NewMetadata(namespace, EncryptionStatusType, id, resource.VersionUndefined),
+		EncryptionStatusSpec{},
+	)
+}
+
+// EncryptionStatusRD is auxiliary resource data for EncryptionStatus.
+type EncryptionStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (EncryptionStatusRD) ResourceDefinition(resource.Metadata, EncryptionStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EncryptionStatusType,
+		Aliases:          []resource.Type{"encryption"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Provider",
+				JSONPath: `{.provider}`,
+			},
+			{
+				Name:     "Cipher",
+				JSONPath: `{.cipher}`,
+			},
+			{
+				Name:     "Key Size",
+				JSONPath: `{.keySize}`,
+			},
+			{
+				Name:     "Sector Size",
+				JSONPath: `{.sectorSize}`,
+			},
+		},
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 //nolint:lll
-//go:generate deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MountStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type EncryptionStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MountStatusSpec -type PlatformMetadataSpec -type VolumeStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
 // ExtensionStatusType is type of Extension resource.
 const ExtensionStatusType = resource.Type("ExtensionStatuses.runtime.talos.dev")
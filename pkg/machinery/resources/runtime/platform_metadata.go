@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// PlatformMetadataType is type of PlatformMetadata resource.
+const PlatformMetadataType = resource.Type("PlatformMetadatas.runtime.talos.dev")
+
+// PlatformMetadataID is the ID of the singleton instance.
+const PlatformMetadataID resource.ID = "platformmetadata"
+
+// PlatformMetadata resource holds information about the instance as reported by the platform.
+type PlatformMetadata = typed.Resource[PlatformMetadataSpec, PlatformMetadataRD]
+
+// PlatformMetadataSpec describes platform metadata properties.
+type PlatformMetadataSpec struct {
+	Platform     string `yaml:"platform,omitempty"`
+	Hostname     string `yaml:"hostname,omitempty"`
+	Region       string `yaml:"region,omitempty"`
+	Zone         string `yaml:"zone,omitempty"`
+	InstanceType string `yaml:"instanceType,omitempty"`
+	InstanceID   string `yaml:"instanceId,omitempty"`
+	ProviderID   string `yaml:"providerId,omitempty"`
+	Spot         bool   `yaml:"spot,omitempty"`
+}
+
+// NewPlatformMetadata initializes a PlatformMetadata resource.
+func NewPlatformMetadata(namespace resource.Namespace, id resource.ID) *PlatformMetadata {
+	return typed.NewResource[PlatformMetadataSpec, PlatformMetadataRD](
+		resource.NewMetadata(namespace, PlatformMetadataType, id, resource.VersionUndefined),
+		PlatformMetadataSpec{},
+	)
+}
+
+// PlatformMetadataRD is auxiliary resource data for PlatformMetadata.
+type PlatformMetadataRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (PlatformMetadataRD) ResourceDefinition(resource.Metadata, PlatformMetadataSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PlatformMetadataType,
+		Aliases:          []resource.Type{"platformmetadata"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Platform",
+				JSONPath: `{.platform}`,
+			},
+			{
+				Name:     "Type",
+				JSONPath: `{.instanceType}`,
+			},
+			{
+				Name:     "Region",
+				JSONPath: `{.region}`,
+			},
+			{
+				Name:     "Zone",
+				JSONPath: `{.zone}`,
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// VolumeStatusType is type of VolumeStatus resource.
+const VolumeStatusType = resource.Type("VolumeStatuses.runtime.talos.dev")
+
+// VolumeStatus resource holds the status of the user volume.
+//
+// Resource ID is the volume name.
+type VolumeStatus = typed.Resource[VolumeStatusSpec, VolumeStatusRD]
+
+// VolumePhase is the phase of the user volume lifecycle.
+type VolumePhase string
+
+// Volume phases.
+const (
+	// VolumePhaseWaiting means that no disk matches the volume disk selector yet.
+	VolumePhaseWaiting VolumePhase = "waiting"
+	// VolumePhaseReady means that the volume is mounted.
+	VolumePhaseReady VolumePhase = "ready"
+	// VolumePhaseFailed means that the volume can't be provisioned or mounted, see the error.
+	VolumePhaseFailed VolumePhase = "failed"
+)
+
+// VolumeStatusSpec describes the state of the user volume.
+type VolumeStatusSpec struct {
+	Phase      VolumePhase `yaml:"phase"`
+	Disk       string      `yaml:"disk,omitempty"`
+	Partition  string      `yaml:"partition,omitempty"`
+	Label      string      `yaml:"label"`
+	Size       uint64      `yaml:"size,omitempty"`
+	Filesystem string      `yaml:"filesystem"`
+	Encrypted  bool        `yaml:"encrypted"`
+	MountPoint string      `yaml:"mountpoint"`
+	Error      string      `yaml:"error,omitempty"`
+}
+
+// NewVolumeStatus initializes a VolumeStatus resource.
+func NewVolumeStatus(namespace resource.Namespace, id resource.ID) *VolumeStatus {
+	return typed.NewResource[VolumeStatusSpec, VolumeStatusRD](
+		resource.NewMetadata(namespace, VolumeStatusType, id, resource.VersionUndefined),
+		VolumeStatusSpec{},
+	)
+}
+
+// VolumeStatusRD is auxiliary resource data for VolumeStatus.
+type VolumeStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (VolumeStatusRD) ResourceDefinition(resource.Metadata, VolumeStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VolumeStatusType,
+		Aliases:          []resource.Type{"volumes"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: `{.phase}`,
+			},
+			{
+				Name:     "Partition",
+				JSONPath: `{.partition}`,
+			},
+			{
+				Name:     "Encrypted",
+				JSONPath: `{.encrypted}`,
+			},
+			{
+				Name:     "Mountpoint",
+				JSONPath: `{.mountpoint}`,
+			},
+		},
+	}
+}
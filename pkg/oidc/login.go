@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultScopes are requested by the login flow if no scopes are specified.
+var DefaultScopes = []string{"openid", "email", "profile", "groups", "offline_access"}
+
+// callbackPath is the path of the redirect URI served by the login flow.
+const callbackPath = "/callback"
+
+// Token is the result of the login flow.
+type Token struct {
+	IDToken      string
+	RefreshToken string
+}
+
+// Login runs the OAuth 2.0 authorization code flow with PKCE to obtain the ID token.
+type Login struct {
+	IssuerURL string
+	ClientID  string
+	Scopes    []string
+
+	// RedirectPort is the port of the local redirect URI (http://localhost:<port>/callback),
+	// which should be allowed for the client in the OIDC provider.
+	RedirectPort int
+
+	// OpenURL is called with the URL the user should open in the browser.
+	OpenURL func(url string) error
+
+	// Client is used for the requests to the provider, defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Run runs the login flow.
+func (l *Login) Run(ctx context.Context) (*Token, error) {
+	provider, err := Discover(ctx, l.Client, l.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(l.RedirectPort)))
+	if err != nil {
+		return nil, fmt.Errorf("error listening for the redirect: %w", err)
+	}
+
+	//nolint:errcheck
+	defer listener.Close()
+
+	config := l.config(provider)
+	config.RedirectURL = fmt.Sprintf("http://localhost:%d%s", listener.Addr().(*net.TCPAddr).Port, callbackPath)
+
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := sha256.Sum256([]byte(verifier))
+
+	authURL := config.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+
+	type result struct {
+		code string
+		err  error
+	}
+
+	resultCh := make(chan result, 1)
+
+	server := &http.Server{ //nolint:gosec
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != callbackPath {
+				http.NotFound(w, r)
+
+				return
+			}
+
+			query := r.URL.Query()
+
+			var res result
+
+			switch {
+			case query.Get("error") != "":
+				res.err = fmt.Errorf("login failed: %s: %s", query.Get("error"), query.Get("error_description"))
+			case query.Get("state") != state:
+				res.err = errors.New("login failed: state mismatch")
+			default:
+				res.code = query.Get("code")
+			}
+
+			if res.err != nil {
+				http.Error(w, res.err.Error(), http.StatusBadRequest)
+			} else {
+				fmt.Fprintln(w, "Login successful, you can close this window.")
+			}
+
+			select {
+			case resultCh <- res:
+			default:
+			}
+		}),
+	}
+
+	go server.Serve(listener) //nolint:errcheck
+
+	//nolint:errcheck
+	defer server.Close()
+
+	if err = l.OpenURL(authURL); err != nil {
+		return nil, err
+	}
+
+	var res result
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-resultCh:
+	}
+
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	token, err := config.Exchange(l.context(ctx), res.code, oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging authorization code: %w", err)
+	}
+
+	return tokenFromOAuth2(token)
+}
+
+// Refresh obtains a new ID token with the refresh token.
+func (l *Login) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	provider, err := Discover(ctx, l.Client, l.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := l.config(provider).TokenSource(l.context(ctx), &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing ID token: %w", err)
+	}
+
+	result, err := tokenFromOAuth2(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// providers might not rotate refresh tokens
+	if result.RefreshToken == "" {
+		result.RefreshToken = refreshToken
+	}
+
+	return result, nil
+}
+
+func (l *Login) config(provider *Provider) *oauth2.Config {
+	scopes := l.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+
+	return &oauth2.Config{
+		ClientID: l.ClientID,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  provider.AuthorizationEndpoint,
+			TokenURL: provider.TokenEndpoint,
+		},
+		Scopes: scopes,
+	}
+}
+
+func (l *Login) context(ctx context.Context) context.Context {
+	if l.Client == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, oauth2.HTTPClient, l.Client)
+}
+
+func tokenFromOAuth2(token *oauth2.Token) (*Token, error) {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return nil, errors.New("OIDC provider didn't return an ID token")
+	}
+
+	return &Token{
+		IDToken:      idToken,
+		RefreshToken: token.RefreshToken,
+	}, nil
+}
+
+func randomString() (string, error) {
+	buf := make([]byte, 32)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/oidc"
+	"github.com/talos-systems/talos/pkg/oidc/oidctest"
+)
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	issuer, err := oidctest.NewIssuer("talos")
+	require.NoError(t, err)
+
+	defer issuer.Close()
+
+	issuer.Groups = []string{"sre", "dev"}
+
+	verifier := &oidc.Verifier{
+		IssuerURL:   issuer.URL,
+		ClientID:    "talos",
+		GroupsClaim: "groups",
+	}
+
+	token, err := issuer.IssueToken("talos", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	claims, err := verifier.Verify(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user", claims.Subject)
+	assert.Equal(t, "user@example.com", claims.Email)
+	assert.Equal(t, []string{"sre", "dev"}, claims.Groups)
+
+	// wrong audience
+	token, err = issuer.IssueToken("kubernetes", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, token)
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+
+	// expired
+	token, err = issuer.IssueToken("talos", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, token)
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+
+	// signed by another issuer with the same key ID
+	otherIssuer, err := oidctest.NewIssuer("talos")
+	require.NoError(t, err)
+
+	defer otherIssuer.Close()
+
+	otherIssuer.URL = issuer.URL
+
+	token, err = otherIssuer.IssueToken("talos", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, token)
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+
+	// malformed
+	_, err = verifier.Verify(ctx, "not-a-token")
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+}
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	issuer, err := oidctest.NewIssuer("talos")
+	require.NoError(t, err)
+
+	defer issuer.Close()
+
+	issuer.Groups = []string{"sre"}
+
+	login := &oidc.Login{
+		IssuerURL: issuer.URL,
+		ClientID:  "talos",
+		OpenURL: func(url string) error {
+			// the mock issuer redirects back to the login flow immediately
+			go func() {
+				resp, err := http.Get(url) //nolint:gosec,noctx
+				if err == nil {
+					resp.Body.Close() //nolint:errcheck
+				}
+			}()
+
+			return nil
+		},
+	}
+
+	token, err := login.Run(ctx)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, token.RefreshToken)
+
+	verifier := &oidc.Verifier{
+		IssuerURL:   issuer.URL,
+		ClientID:    "talos",
+		GroupsClaim: "groups",
+	}
+
+	claims, err := verifier.Verify(ctx, token.IDToken)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"sre"}, claims.Groups)
+
+	refreshed, err := login.Refresh(ctx, token.RefreshToken)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, token.RefreshToken, refreshed.RefreshToken)
+
+	_, err = verifier.Verify(ctx, refreshed.IDToken)
+	require.NoError(t, err)
+
+	// refresh tokens are single-use
+	_, err = login.Refresh(ctx, token.RefreshToken)
+	assert.Error(t, err)
+}
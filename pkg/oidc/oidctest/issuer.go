@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package oidctest provides a mock OpenID Connect issuer for tests.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const keyID = "oidctest"
+
+// Issuer is a mock OpenID Connect issuer.
+//
+// The authorization endpoint approves all requests immediately, and issues ID tokens for the configured user.
+type Issuer struct {
+	*httptest.Server
+
+	ClientID string
+
+	// User claims.
+	Subject string
+	Email   string
+	Groups  []string
+
+	// TokenLifetime is the lifetime of the issued ID tokens.
+	TokenLifetime time.Duration
+
+	key    *rsa.PrivateKey
+	signer jose.Signer
+
+	mu            sync.Mutex
+	codes         map[string]string // code -> code challenge
+	refreshTokens map[string]struct{}
+}
+
+// NewIssuer starts a mock OpenID Connect issuer.
+//
+// The issuer should be closed with Close.
+func NewIssuer(clientID string) (*Issuer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key: jose.JSONWebKey{
+			Key:   key,
+			KeyID: keyID,
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := &Issuer{
+		ClientID:      clientID,
+		Subject:       "user",
+		Email:         "user@example.com",
+		TokenLifetime: time.Hour,
+		key:           key,
+		signer:        signer,
+		codes:         map[string]string{},
+		refreshTokens: map[string]struct{}{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.handleDiscovery)
+	mux.HandleFunc("/keys", issuer.handleKeys)
+	mux.HandleFunc("/authorize", issuer.handleAuthorize)
+	mux.HandleFunc("/token", issuer.handleToken)
+
+	issuer.Server = httptest.NewServer(mux)
+
+	return issuer, nil
+}
+
+// IssueToken returns an ID token for the configured user.
+func (i *Issuer) IssueToken(audience string, expiry time.Time) (string, error) {
+	return jwt.Signed(i.signer).Claims(jwt.Claims{
+		Issuer:   i.URL,
+		Subject:  i.Subject,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(expiry),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}).Claims(map[string]interface{}{
+		"email":  i.Email,
+		"groups": i.Groups,
+	}).CompactSerialize()
+}
+
+func (i *Issuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{
+		"issuer":                 i.URL,
+		"authorization_endpoint": i.URL + "/authorize",
+		"token_endpoint":         i.URL + "/token",
+		"jwks_uri":               i.URL + "/keys",
+	})
+}
+
+func (i *Issuer) handleKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{
+				Key:       &i.key.PublicKey,
+				KeyID:     keyID,
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			},
+		},
+	})
+}
+
+func (i *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("client_id") != i.ClientID || query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+
+		return
+	}
+
+	redirectURL, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect URI", http.StatusBadRequest)
+
+		return
+	}
+
+	code := i.newSecret()
+
+	i.mu.Lock()
+	i.codes[code] = query.Get("code_challenge")
+	i.mu.Unlock()
+
+	redirectQuery := redirectURL.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", query.Get("state"))
+	redirectURL.RawQuery = redirectQuery.Encode()
+
+	http.Redirect(w, r, redirectURL.String(), http.StatusFound)
+}
+
+func (i *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		challenge, ok := i.codes[r.PostForm.Get("code")]
+		delete(i.codes, r.PostForm.Get("code"))
+
+		verifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+
+		if !ok || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != challenge {
+			writeError(w, "invalid_grant")
+
+			return
+		}
+	case "refresh_token":
+		if _, ok := i.refreshTokens[r.PostForm.Get("refresh_token")]; !ok {
+			writeError(w, "invalid_grant")
+
+			return
+		}
+
+		delete(i.refreshTokens, r.PostForm.Get("refresh_token"))
+	default:
+		writeError(w, "unsupported_grant_type")
+
+		return
+	}
+
+	idToken, err := i.IssueToken(i.ClientID, time.Now().Add(i.TokenLifetime))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	refreshToken := i.newSecret()
+	i.refreshTokens[refreshToken] = struct{}{}
+
+	writeJSON(w, map[string]interface{}{
+		"access_token":  i.newSecret(),
+		"token_type":    "Bearer",
+		"expires_in":    int(i.TokenLifetime.Seconds()),
+		"id_token":      idToken,
+		"refresh_token": refreshToken,
+	})
+}
+
+func (i *Issuer) newSecret() string {
+	buf := make([]byte, 16)
+
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+
+	return fmt.Sprintf("%x", buf)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func writeError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+
+	json.NewEncoder(w).Encode(map[string]string{"error": code}) //nolint:errcheck
+}
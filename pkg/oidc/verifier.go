@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// clockSkew is the allowed clock skew between the issuer and the verifier.
+	clockSkew = time.Minute
+
+	// keysRefreshInterval limits the rate of the JWKS refreshes triggered by the unknown key IDs.
+	keysRefreshInterval = 10 * time.Second
+)
+
+// supportedAlgorithms lists the accepted ID token signature algorithms.
+var supportedAlgorithms = map[jose.SignatureAlgorithm]struct{}{
+	jose.RS256: {},
+	jose.RS384: {},
+	jose.RS512: {},
+	jose.ES256: {},
+	jose.ES384: {},
+	jose.ES512: {},
+	jose.PS256: {},
+	jose.PS384: {},
+	jose.PS512: {},
+}
+
+// ErrInvalidToken is returned when the ID token fails the verification.
+var ErrInvalidToken = errors.New("invalid ID token")
+
+// Claims are the verified claims of the ID token.
+type Claims struct {
+	Subject string
+	Email   string
+	Groups  []string
+	Expiry  time.Time
+}
+
+// Verifier verifies the ID tokens issued by the OpenID Connect provider.
+type Verifier struct {
+	// IssuerURL is the URL of the issuer, the ID tokens should have the matching `iss` claim.
+	IssuerURL string
+
+	// ClientID should be in the `aud` claim of the ID tokens.
+	ClientID string
+
+	// GroupsClaim is the name of the claim containing the user groups.
+	GroupsClaim string
+
+	// Client is used to fetch the provider metadata and the signing keys, defaults to http.DefaultClient.
+	Client *http.Client
+
+	mu            sync.Mutex
+	provider      *Provider
+	keys          jose.JSONWebKeySet
+	keysFetchedAt time.Time
+}
+
+// Verify checks the ID token signature and claims, and returns the verified claims.
+func (v *Verifier) Verify(ctx context.Context, rawIDToken string) (*Claims, error) {
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one signature", ErrInvalidToken)
+	}
+
+	header := token.Headers[0]
+
+	if _, ok := supportedAlgorithms[jose.SignatureAlgorithm(header.Algorithm)]; !ok {
+		return nil, fmt.Errorf("%w: unsupported signature algorithm %q", ErrInvalidToken, header.Algorithm)
+	}
+
+	key, err := v.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		claims jwt.Claims
+		extra  map[string]interface{}
+	)
+
+	if err = token.Claims(key, &claims, &extra); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: missing expiry", ErrInvalidToken)
+	}
+
+	if err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:   v.IssuerURL,
+		Audience: jwt.Audience{v.ClientID},
+		Time:     time.Now(),
+	}, clockSkew); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	result := &Claims{
+		Subject: claims.Subject,
+		Expiry:  claims.Expiry.Time(),
+	}
+
+	result.Email, _ = extra["email"].(string) //nolint:errcheck
+
+	switch groups := extra[v.GroupsClaim].(type) {
+	case string:
+		result.Groups = []string{groups}
+	case []interface{}:
+		for _, group := range groups {
+			if s, ok := group.(string); ok {
+				result.Groups = append(result.Groups, s)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// key returns the signing key with the given ID.
+//
+// The keys are refetched from the provider if the key ID is unknown (e.g. after the key rotation).
+func (v *Verifier) key(ctx context.Context, keyID string) (*jose.JSONWebKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key := v.findKey(keyID); key != nil {
+		return key, nil
+	}
+
+	if time.Since(v.keysFetchedAt) < keysRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidToken, keyID)
+	}
+
+	if v.provider == nil {
+		provider, err := Discover(ctx, v.Client, v.IssuerURL)
+		if err != nil {
+			return nil, err
+		}
+
+		v.provider = provider
+	}
+
+	var keys jose.JSONWebKeySet
+
+	if err := getJSON(ctx, v.Client, v.provider.JWKSURI, &keys); err != nil {
+		return nil, fmt.Errorf("error fetching OIDC provider signing keys: %w", err)
+	}
+
+	v.keys = keys
+	v.keysFetchedAt = time.Now()
+
+	if key := v.findKey(keyID); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidToken, keyID)
+}
+
+func (v *Verifier) findKey(keyID string) *jose.JSONWebKey {
+	if keyID == "" {
+		// tokens without key ID can be verified only if the provider has a single key
+		if len(v.keys.Keys) == 1 {
+			return &v.keys.Keys[0]
+		}
+
+		return nil
+	}
+
+	if keys := v.keys.Key(keyID); len(keys) > 0 {
+		return &keys[0]
+	}
+
+	return nil
+}